@@ -0,0 +1,70 @@
+package chathub
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// SessionConns 按登录会话登记 WebSocket 连接，撤销会话（退出设备、封禁、重置密码）时据此断开对应连接。
+// 只记录本实例上的连接；其他实例上的连接由 RPC 经通知流广播的会话撤销事件断开（见 notification.applySessionKick）
+type SessionConns struct {
+	mu    sync.Mutex
+	conns map[uint]map[*websocket.Conn]uint // userID -> conn -> sessionID（旧版令牌为 0）
+}
+
+func NewSessionConns() *SessionConns {
+	return &SessionConns{conns: make(map[uint]map[*websocket.Conn]uint)}
+}
+
+var DefaultSessions = NewSessionConns()
+
+func (s *SessionConns) Add(userID, sessionID uint, conn *websocket.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	set, ok := s.conns[userID]
+	if !ok {
+		set = make(map[*websocket.Conn]uint)
+		s.conns[userID] = set
+	}
+	set[conn] = sessionID
+}
+
+func (s *SessionConns) Remove(userID uint, conn *websocket.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	set, ok := s.conns[userID]
+	if !ok {
+		return
+	}
+	delete(set, conn)
+	if len(set) == 0 {
+		delete(s.conns, userID)
+	}
+}
+
+// Close 关闭用户属于这些会话的连接；不传 sessionIDs 时关闭该用户的全部连接。返回关闭的连接数
+func (s *SessionConns) Close(userID uint, sessionIDs ...uint) int {
+	want := make(map[uint]bool, len(sessionIDs))
+	for _, id := range sessionIDs {
+		want[id] = true
+	}
+
+	s.mu.Lock()
+	var targets []*websocket.Conn
+	for conn, sid := range s.conns[userID] {
+		if len(sessionIDs) == 0 || want[sid] {
+			targets = append(targets, conn)
+		}
+	}
+	s.mu.Unlock()
+
+	// 关闭后读循环退出，由连接自己 Remove 并清理订阅
+	for _, conn := range targets {
+		_ = conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "session revoked"), time.Now().Add(time.Second))
+		_ = conn.Close()
+	}
+	return len(targets)
+}
@@ -0,0 +1,34 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"backend/api/internal/types"
+)
+
+// ErrNoUserInContext RequireAuth 分组之外调用，或 token 中缺少 user_id
+var ErrNoUserInContext = errors.New("user not logged in or user_id not found in context")
+
+// ContextUserID 读取 RequireAuthMiddleware 写入上下文的 user_id（与 go-zero JWT 中间件一致，为 json.Number）。
+// 仅适用于 @server 中声明了 middleware: RequireAuth 的路由。
+func ContextUserID(ctx context.Context) (string, error) {
+	switch v := ctx.Value("user_id").(type) {
+	case json.Number:
+		return v.String(), nil
+	case string:
+		if v != "" {
+			return v, nil
+		}
+	case float64:
+		return fmt.Sprintf("%d", int64(v)), nil
+	}
+	return "", ErrNoUserInContext
+}
+
+// UnauthorizedResp 未登录时的统一响应体
+func UnauthorizedResp() types.BaseResp {
+	return types.BaseResp{Code: 401, Message: "请先登录", Success: false}
+}
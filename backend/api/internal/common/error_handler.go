@@ -3,6 +3,7 @@ package common
 import (
 	"backend/api/internal/types"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -45,6 +46,8 @@ func HandleRPCError(err error, successMessage string) types.BaseResp {
 		httpCode = 404
 	case codes.AlreadyExists:
 		httpCode = 409
+	case codes.ResourceExhausted:
+		httpCode = 429
 	case codes.Internal:
 		httpCode = 500
 	default:
@@ -74,3 +77,17 @@ func HandleError(err error) types.BaseResp {
 		Success: false,
 	}
 }
+
+// RPCErrorInfo 读取 RPC 错误附带的 errdetails.ErrorInfo（见 errorx.WithInfo）；没有时返回 false
+func RPCErrorInfo(err error) (*errdetails.ErrorInfo, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,84 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"backend/api/internal/types"
+	"backend/utils"
+)
+
+// ErrNotOwner 登录用户无权操作该用户的资源
+var ErrNotOwner = errors.New("not the owner of the resource")
+
+type actorKey struct{}
+
+// Actor 当前请求的登录用户，由 TokenGuardMiddleware 在令牌有效时写入上下文。
+// 与 ContextUserID 不同，非 RequireAuth 分组的路由（自行解析令牌的路由）同样可用
+type Actor struct {
+	UserID uint
+	Role   string
+	// SessionID 令牌所属的登录会话；旧版令牌没有会话，为 0
+	SessionID uint
+}
+
+// IsAdmin 是否为管理员（admin / super_admin）
+func (a Actor) IsAdmin() bool {
+	return utils.IsAdminRole(a.Role)
+}
+
+// ID 字符串形式的用户 ID，用于传给 RPC
+func (a Actor) ID() string {
+	return strconv.FormatUint(uint64(a.UserID), 10)
+}
+
+// WithActor 把登录用户写入上下文
+func WithActor(ctx context.Context, a Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, a)
+}
+
+// ActorFrom 读取登录用户；未携带有效令牌时返回 false
+func ActorFrom(ctx context.Context) (Actor, bool) {
+	a, ok := ctx.Value(actorKey{}).(Actor)
+	return a, ok && a.UserID != 0
+}
+
+// ViewerID 当前登录用户的 ID（字符串），未登录时为空。
+// 动态可见性等按查看者判断的接口只认令牌，不信任客户端传来的 viewer_user_id
+func ViewerID(ctx context.Context) string {
+	if a, ok := ActorFrom(ctx); ok {
+		return a.ID()
+	}
+	return ""
+}
+
+// CheckOwner 校验登录用户就是 userID 本人。allowAdmin 为 true 时管理员也可以操作他人的资源（显式放行）。
+// 未登录返回 ErrNoUserInContext，无权返回 ErrNotOwner
+func CheckOwner(ctx context.Context, userID string, allowAdmin bool) error {
+	a, ok := ActorFrom(ctx)
+	if !ok {
+		return ErrNoUserInContext
+	}
+	if id, err := strconv.ParseUint(strings.TrimSpace(userID), 10, 32); err == nil && uint(id) == a.UserID {
+		return nil
+	}
+	if allowAdmin && a.IsAdmin() {
+		return nil
+	}
+	return ErrNotOwner
+}
+
+// ForbiddenResp 无权操作他人资源时的统一响应体
+func ForbiddenResp() types.BaseResp {
+	return types.BaseResp{Code: 403, Message: "无权操作其他用户的数据", Success: false}
+}
+
+// OwnershipResp 把 CheckOwner 的错误转为响应体
+func OwnershipResp(err error) types.BaseResp {
+	if errors.Is(err, ErrNoUserInContext) {
+		return UnauthorizedResp()
+	}
+	return ForbiddenResp()
+}
@@ -0,0 +1,25 @@
+package common
+
+import (
+	"context"
+)
+
+type requestInfoKey struct{}
+
+// RequestInfo 请求来源信息，由 RequestInfoMiddleware 写入上下文，供限流、审计等使用
+type RequestInfo struct {
+	ClientIP       string
+	UserAgent      string
+	AcceptLanguage string
+}
+
+// WithRequestInfo 把请求来源信息写入上下文
+func WithRequestInfo(ctx context.Context, info RequestInfo) context.Context {
+	return context.WithValue(ctx, requestInfoKey{}, info)
+}
+
+// RequestInfoFrom 读取请求来源信息；未经过中间件时返回零值
+func RequestInfoFrom(ctx context.Context) RequestInfo {
+	info, _ := ctx.Value(requestInfoKey{}).(RequestInfo)
+	return info
+}
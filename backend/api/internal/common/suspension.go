@@ -0,0 +1,55 @@
+package common
+
+import (
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+)
+
+// SuspensionFromRPC 处罚记录转为响应结构（管理端与用户端共用）；没有处罚时返回 nil
+func SuspensionFromRPC(s *super.Suspension) *types.Suspension {
+	if s == nil {
+		return nil
+	}
+	return &types.Suspension{
+		Id:          s.Id,
+		UserId:      s.UserId,
+		Kind:        s.Kind,
+		Reason:      s.Reason,
+		HideContent: s.HideContent,
+		ExpiresAt:   s.ExpiresAt,
+		CreatedBy:   s.CreatedBy,
+		CreatedAt:   s.CreatedAt,
+		LiftedAt:    s.LiftedAt,
+		LiftReason:  s.LiftReason,
+		Active:      s.Active,
+	}
+}
+
+// SuspensionAppealFromRPC 申诉转为响应结构；用户信息只带管理端处理申诉需要的字段
+func SuspensionAppealFromRPC(a *super.SuspensionAppeal) types.SuspensionAppeal {
+	if a == nil {
+		return types.SuspensionAppeal{}
+	}
+	out := types.SuspensionAppeal{
+		Id:           a.Id,
+		SuspensionId: a.SuspensionId,
+		Message:      a.Message,
+		Status:       a.Status,
+		ReviewNote:   a.ReviewNote,
+		ReviewedBy:   a.ReviewedBy,
+		ReviewedAt:   a.ReviewedAt,
+		CreatedAt:    a.CreatedAt,
+		Suspension:   SuspensionFromRPC(a.Suspension),
+	}
+	if u := a.User; u != nil {
+		out.User = types.User{
+			Id:        u.Id,
+			Username:  u.Username,
+			Email:     u.Email,
+			MoeNo:     u.MoeNo,
+			Avatar:    u.Avatar,
+			CreatedAt: u.CreatedAt,
+		}
+	}
+	return out
+}
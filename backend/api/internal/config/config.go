@@ -8,12 +8,6 @@ import (
 type Config struct {
 	rest.RestConf
 
-	// JWT 认证配置
-	Auth struct {
-		AccessSecret string
-		AccessExpire int64
-	} `json:"Auth" yaml:"Auth"`
-
 	// RPC服务配置
 	SuperRpc zrpc.RpcClientConf `json:"SuperRpc" yaml:"SuperRpc"`
 
@@ -26,6 +20,13 @@ type Config struct {
 	// 本地“云空间”配置（图片上传落盘）
 	Image ImageConf `json:"Image" yaml:"Image"`
 
+	// WebSocket 鉴权配置
+	WebSocket WebSocketConf `json:"WebSocket,optional" yaml:"WebSocket"`
+
+	// TrustedProxies 反向代理的 IP 或网段（CIDR）。只有请求直接来自这些地址时才读取 X-Forwarded-For，
+	// 为空时一律使用连接的对端地址
+	TrustedProxies []string `json:"TrustedProxies,optional" yaml:"TrustedProxies"`
+
 	// 客户端 GET /api/public/client-config 使用的公网 API 根地址。
 	// 仅由 super.go 的 applyUnifiedConfigOverrides 从 backend/config/config.yaml 写入；
 	// yaml:"-" 表示不参与 etc/super.yaml 解析，不必在 go-zero 主配置里重复配置。
@@ -45,6 +46,12 @@ type OllamaConf struct {
 	MemoryExtractPrompt string `json:"MemoryExtractPrompt" yaml:"MemoryExtractPrompt"`
 }
 
+type WebSocketConf struct {
+	// RejectQueryToken: 拒绝 ?token=<JWT> 方式连接 WebSocket，只接受 ?ticket=（POST /api/ws/ticket 签发）
+	// 或 Authorization 头。旧客户端全部升级后建议开启。
+	RejectQueryToken bool `json:"RejectQueryToken,optional" yaml:"RejectQueryToken"`
+}
+
 type AgoraConf struct {
 	AppId          string `json:"AppId" yaml:"AppId"`
 	AppCertificate string `json:"AppCertificate" yaml:"AppCertificate"`
@@ -0,0 +1,24 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"backend/api/internal/logic/admin"
+	"backend/api/internal/svc"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+func ListMfaPoliciesHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := admin.NewListMfaPoliciesLogic(r.Context(), svcCtx)
+		resp, err := l.ListMfaPolicies()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
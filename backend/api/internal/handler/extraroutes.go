@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"net/http"
+
+	user "backend/api/internal/handler/user"
+	"backend/api/internal/svc"
+
+	"github.com/zeromicro/go-zero/rest"
+)
+
+// RegisterExtraHandlers 不在 super.api 中声明的路由：响应不是 JSON、handler 直接写响应体，
+// 声明在 .api 里 goctl 会生成用不到的 logic。与 RegisterHandlers 一起调用
+func RegisterExtraHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					// 导出个人数据：返回 ZIP 文件，data/*.json 为各类数据，media/ 为上传的图片
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/export",
+					Handler: user.ExportUserDataHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+}
@@ -12,16 +12,16 @@ import (
 	"github.com/zeromicro/go-zero/rest/httpx"
 )
 
-func BroadcastNotificationHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+func MuteNotificationHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		var req types.BroadcastNotificationReq
+		var req types.MuteNotificationReq
 		if err := httpx.Parse(r, &req); err != nil {
 			httpx.ErrorCtx(r.Context(), w, err)
 			return
 		}
 
-		l := notification.NewBroadcastNotificationLogic(r.Context(), svcCtx)
-		resp, err := l.BroadcastNotification(&req)
+		l := notification.NewMuteNotificationLogic(r.Context(), svcCtx)
+		resp, err := l.MuteNotification(&req)
 		if err != nil {
 			httpx.ErrorCtx(r.Context(), w, err)
 		} else {
@@ -6,11 +6,14 @@ package handler
 import (
 	"net/http"
 
+	admin "backend/api/internal/handler/admin"
 	appcfg "backend/api/internal/handler/appcfg"
 	avatar "backend/api/internal/handler/avatar"
 	chat "backend/api/internal/handler/chat"
 	checkin "backend/api/internal/handler/checkin"
 	comment "backend/api/internal/handler/comment"
+	device "backend/api/internal/handler/device"
+	e2ee "backend/api/internal/handler/e2ee"
 	emoji "backend/api/internal/handler/emoji"
 	image "backend/api/internal/handler/image"
 	llm "backend/api/internal/handler/llm"
@@ -25,6 +28,83 @@ import (
 )
 
 func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth, serverCtx.RequireSuperAdmin},
+			[]rest.Route{
+				{
+					Method:  http.MethodPut,
+					Path:    "/api/admin/users/:user_id/role",
+					Handler: admin.GrantUserRoleHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/api/admin/users/:user_id/role",
+					Handler: admin.RevokeUserRoleHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth, serverCtx.RequireAdmin},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/admin/role-audit-logs",
+					Handler: admin.ListRoleAuditLogsHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth, serverCtx.RequireAdmin},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/admin/mfa-policies",
+					Handler: admin.ListMfaPoliciesHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/api/admin/mfa-policies/:role",
+					Handler: admin.SetMfaPolicyHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth, serverCtx.RequireAdmin},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/admin/suspension-appeals",
+					Handler: admin.ListSuspensionAppealsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/admin/suspension-appeals/:id/review",
+					Handler: admin.ReviewSuspensionAppealHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/admin/users/:user_id/suspension",
+					Handler: admin.SuspendUserHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/api/admin/users/:user_id/suspension",
+					Handler: admin.LiftSuspensionHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
@@ -80,8 +160,28 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		},
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					// 签发 WebSocket 连接票据（一次性，30 秒内有效，绑定用户与端点）
+					Method:  http.MethodPost,
+					Path:    "/api/ws/ticket",
+					Handler: chat.IssueWsTicketHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
+			{
+				// WebSocket统一网关（一次鉴权，按频道订阅 chat/presence/notifications/world:<room>）
+				Method:  http.MethodGet,
+				Path:    "/ws",
+				Handler: chat.GatewayHandler(serverCtx),
+			},
 			{
 				// WebSocket聊天服务
 				Method:  http.MethodGet,
@@ -139,13 +239,21 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		},
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/comments",
+					Handler: comment.CreateCommentHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
-			{
-				Method:  http.MethodPost,
-				Path:    "/api/comments",
-				Handler: comment.CreateCommentHandler(serverCtx),
-			},
 			{
 				Method:  http.MethodPost,
 				Path:    "/api/comments/:comment_id/like",
@@ -154,6 +262,52 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		},
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/devices",
+					Handler: device.RegisterDeviceHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/devices/unregister",
+					Handler: device.UnregisterDeviceHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/e2ee/keys",
+					Handler: e2ee.UploadPreKeyBundleHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/e2ee/keys/:user_id",
+					Handler: e2ee.GetPreKeyBundlesHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/e2ee/messages",
+					Handler: e2ee.GetPendingEncryptedMessagesHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/e2ee/messages/ack",
+					Handler: e2ee.AckEncryptedMessagesHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
@@ -231,21 +385,6 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 
 	server.AddRoutes(
 		[]rest.Route{
-			{
-				Method:  http.MethodPost,
-				Path:    "/api/notification/broadcast",
-				Handler: notification.BroadcastNotificationHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPost,
-				Path:    "/api/notification/send",
-				Handler: notification.SendNotificationHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPost,
-				Path:    "/api/notification/send-batch",
-				Handler: notification.SendBatchNotificationHandler(serverCtx),
-			},
 			{
 				Method:  http.MethodGet,
 				Path:    "/api/notifications",
@@ -269,6 +408,80 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		},
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/notifications/mutes",
+					Handler: notification.MuteNotificationHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/api/notifications/mutes/:target_type/:target_id",
+					Handler: notification.UnmuteNotificationHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/notifications/preferences",
+					Handler: notification.GetNotificationPreferencesHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/api/notifications/preferences",
+					Handler: notification.UpdateNotificationPreferencesHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth, serverCtx.RequireAdmin},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/notification/send",
+					Handler: notification.SendNotificationHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/notification/send-batch",
+					Handler: notification.SendBatchNotificationHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth, serverCtx.RequireAdmin},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/admin/notification-campaigns",
+					Handler: notification.CreateNotificationCampaignHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/admin/notification-campaigns",
+					Handler: notification.ListNotificationCampaignsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/admin/notification-campaigns/:id",
+					Handler: notification.GetNotificationCampaignHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/admin/notification-campaigns/:id/cancel",
+					Handler: notification.CancelNotificationCampaignHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
@@ -276,11 +489,6 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/api/posts",
 				Handler: post.GetPostsHandler(serverCtx),
 			},
-			{
-				Method:  http.MethodPost,
-				Path:    "/api/posts",
-				Handler: post.CreatePostHandler(serverCtx),
-			},
 			{
 				Method:  http.MethodGet,
 				Path:    "/api/posts/:post_id",
@@ -304,6 +512,29 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		},
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/posts",
+					Handler: post.CreatePostHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/api/posts/:post_id",
+					Handler: post.UpdatePostHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/api/posts/:post_id",
+					Handler: post.DeletePostHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
@@ -471,11 +702,21 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/api/user/check-email",
 				Handler: user.CheckUserByEmailHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/user/email/verify-link",
+				Handler: user.VerifyEmailLinkHandler(serverCtx),
+			},
 			{
 				Method:  http.MethodPost,
 				Path:    "/api/user/login",
 				Handler: user.LoginHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/user/login/mfa",
+				Handler: user.VerifyLoginMfaHandler(serverCtx),
+			},
 			{
 				Method:  http.MethodPost,
 				Path:    "/api/user/refresh-token",
@@ -492,9 +733,9 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Handler: user.ResetPasswordHandler(serverCtx),
 			},
 			{
-				Method:  http.MethodGet,
-				Path:    "/api/users",
-				Handler: user.GetUsersHandler(serverCtx),
+				Method:  http.MethodPost,
+				Path:    "/api/user/reset-password/request",
+				Handler: user.RequestPasswordResetHandler(serverCtx),
 			},
 			{
 				Method:  http.MethodGet,
@@ -504,54 +745,306 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		},
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth, serverCtx.RequireAdmin},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/users",
+					Handler: user.GetUsersHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/suspension",
+					Handler: user.GetUserSuspensionHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/suspension/appeal",
+					Handler: user.SubmitSuspensionAppealHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
-				Method:  http.MethodGet,
-				Path:    "/api/vip/plans",
-				Handler: vip.GetVipPlansHandler(serverCtx),
+				Method:  http.MethodPost,
+				Path:    "/api/user/login/oidc/:provider",
+				Handler: user.OidcLoginHandler(serverCtx),
 			},
 			{
 				Method:  http.MethodPost,
-				Path:    "/api/vip/plans",
-				Handler: vip.CreateVipPlanHandler(serverCtx),
+				Path:    "/api/user/login/oidc/:provider/authorize",
+				Handler: user.StartOidcLoginHandler(serverCtx),
 			},
 			{
 				Method:  http.MethodGet,
-				Path:    "/api/vip/plans/:plan_id",
-				Handler: vip.GetVipPlanHandler(serverCtx),
+				Path:    "/api/user/login/oidc/providers",
+				Handler: user.ListOidcProvidersHandler(serverCtx),
 			},
 		},
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/identities",
+					Handler: user.ListUserIdentitiesHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/api/user/:user_id/identities/:identity_id",
+					Handler: user.UnlinkUserIdentityHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/identities/:provider",
+					Handler: user.LinkUserIdentityHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/identities/:provider/authorize",
+					Handler: user.StartUserIdentityLinkHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
 				Method:  http.MethodPost,
-				Path:    "/api/voice/answer",
-				Handler: voice.VoiceAnswerHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPost,
-				Path:    "/api/voice/call",
-				Handler: voice.VoiceCallHandler(serverCtx),
-			},
-			{
-				Method:  http.MethodPost,
-				Path:    "/api/voice/cancel",
-				Handler: voice.VoiceCancelHandler(serverCtx),
+				Path:    "/api/suspension/appeal",
+				Handler: user.SubmitSuspensionAppealByTokenHandler(serverCtx),
 			},
+		},
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/email/verification",
+					Handler: user.SendEmailVerificationHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/email/verify",
+					Handler: user.VerifyEmailHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/security-events",
+					Handler: user.ListSecurityEventsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/sessions",
+					Handler: user.ListUserSessionsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/api/user/:user_id/sessions",
+					Handler: user.RevokeAllUserSessionsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/api/user/:user_id/sessions/:session_id",
+					Handler: user.RevokeUserSessionHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/mfa",
+					Handler: user.GetMfaStatusHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/mfa/recovery-codes",
+					Handler: user.RegenerateRecoveryCodesHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/mfa/totp",
+					Handler: user.BeginTotpEnrollmentHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/mfa/totp/confirm",
+					Handler: user.ConfirmTotpEnrollmentHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/mfa/totp/disable",
+					Handler: user.DisableTotpHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/blocks",
+					Handler: user.BlockUserHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/blocks",
+					Handler: user.ListBlockedUsersHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/api/user/:user_id/blocks/:target_user_id",
+					Handler: user.UnblockUserHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/follow-requests",
+					Handler: user.ListFollowRequestsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/follow-requests/:request_id/approve",
+					Handler: user.ApproveFollowRequestHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/user/:user_id/follow-requests/:request_id/reject",
+					Handler: user.RejectFollowRequestHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/privacy",
+					Handler: user.GetPrivacySettingsHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPut,
+					Path:    "/api/user/:user_id/privacy",
+					Handler: user.UpdatePrivacySettingsHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/user/:user_id/deletion",
+					Handler: user.GetAccountDeletionHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodDelete,
+					Path:    "/api/user/:user_id/deletion",
+					Handler: user.CancelAccountDeletionHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
 			{
-				Method:  http.MethodPost,
-				Path:    "/api/voice/reject",
-				Handler: voice.VoiceRejectHandler(serverCtx),
+				Method:  http.MethodGet,
+				Path:    "/api/vip/plans",
+				Handler: vip.GetVipPlansHandler(serverCtx),
 			},
 			{
 				Method:  http.MethodGet,
-				Path:    "/api/voice/token",
-				Handler: voice.GetRtcTokenHandler(serverCtx),
+				Path:    "/api/vip/plans/:plan_id",
+				Handler: vip.GetVipPlanHandler(serverCtx),
 			},
 		},
-		rest.WithJwt(serverCtx.Config.Auth.AccessSecret),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth, serverCtx.RequireAdmin},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/vip/plans",
+					Handler: vip.CreateVipPlanHandler(serverCtx),
+				},
+			}...,
+		),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.RequireAuth},
+			[]rest.Route{
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/voice/answer",
+					Handler: voice.VoiceAnswerHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/voice/call",
+					Handler: voice.VoiceCallHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/voice/cancel",
+					Handler: voice.VoiceCancelHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodPost,
+					Path:    "/api/voice/reject",
+					Handler: voice.VoiceRejectHandler(serverCtx),
+				},
+				{
+					Method:  http.MethodGet,
+					Path:    "/api/voice/token",
+					Handler: voice.GetRtcTokenHandler(serverCtx),
+				},
+			}...,
+		),
 	)
 }
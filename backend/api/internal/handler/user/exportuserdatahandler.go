@@ -0,0 +1,140 @@
+package user
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// ExportUserDataHandler 直接把个人数据写成 ZIP 返回：RPC 按文件分块流式返回 JSON，
+// 上传的图片在 API 本机目录中，由这里一并打包
+func ExportUserDataHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AccountDeletionReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+		uid, err := strconv.ParseUint(req.UserId, 10, 64)
+		if err != nil || uid == 0 {
+			httpx.OkJsonCtx(r.Context(), w, types.BaseResp{Code: 400, Message: "用户ID格式错误", Success: false})
+			return
+		}
+
+		stream, err := svcCtx.SuperRpcClient.ExportUserData(r.Context(), &super.ExportUserDataReq{UserId: req.UserId})
+		var chunk *super.ExportChunk
+		if err == nil {
+			// 第一块到达前出错（用户不存在、数据库错误）仍可返回 JSON
+			chunk, err = stream.Recv()
+		}
+		if err != nil {
+			logx.WithContext(r.Context()).Errorf("[注销] 导出个人数据失败 用户ID=%s 错误=%v", req.UserId, err)
+			httpx.OkJsonCtx(r.Context(), w, common.HandleRPCError(err, ""))
+			return
+		}
+
+		filename := fmt.Sprintf("moe-social-export-%s-%s.zip", req.UserId, time.Now().Format("20060102"))
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		w.Header().Set("Cache-Control", "no-store")
+
+		zw := zip.NewWriter(w)
+		if err := writeExportZip(zw, stream, chunk, exportImageDir(svcCtx), uint(uid)); err != nil {
+			// 响应头已发出，只能中断下载，客户端会得到不完整的 ZIP
+			logx.WithContext(r.Context()).Errorf("[注销] 写入导出文件失败 用户ID=%s 错误=%v", req.UserId, err)
+			return
+		}
+		if err := zw.Close(); err != nil {
+			logx.WithContext(r.Context()).Errorf("[注销] 写入导出文件失败 用户ID=%s 错误=%v", req.UserId, err)
+		}
+	}
+}
+
+func exportImageDir(svcCtx *svc.ServiceContext) string {
+	imgDir := strings.TrimSpace(svcCtx.Config.Image.LocalDir)
+	if imgDir == "" {
+		imgDir = "./data/images"
+	}
+	return imgDir
+}
+
+// writeExportZip 同名的连续分块写入同一个文件，最后附上用户上传的图片
+func writeExportZip(zw *zip.Writer, stream super.Super_ExportUserDataClient, chunk *super.ExportChunk, imgDir string, userID uint) error {
+	var (
+		entry io.Writer
+		name  string
+		err   error
+	)
+	for {
+		if entry == nil || chunk.Name != name {
+			name = chunk.Name
+			if entry, err = zw.Create(name); err != nil {
+				return err
+			}
+		}
+		if _, err = entry.Write(chunk.Data); err != nil {
+			return err
+		}
+		chunk, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	dirs, err := utils.UserImageDirs(imgDir, userID)
+	if err != nil {
+		return err
+	}
+	for _, dir := range dirs {
+		folder := filepath.Base(dir)
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(dir, path)
+			if err != nil {
+				return err
+			}
+			return addExportFile(zw, "media/images/"+folder+"/"+filepath.ToSlash(rel), path)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addExportFile(zw *zip.Writer, name, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	// 图片本身已压缩，直接存储
+	entry, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Store})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(entry, f)
+	return err
+}
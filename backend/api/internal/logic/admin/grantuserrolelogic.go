@@ -0,0 +1,38 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GrantUserRoleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGrantUserRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GrantUserRoleLogic {
+	return &GrantUserRoleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 授予角色（也可直接指定为 user），仅超级管理员
+func (l *GrantUserRoleLogic) GrantUserRole(req *types.GrantUserRoleReq) (resp *types.UserRoleResp, err error) {
+	if !utils.ValidRole(req.Role) {
+		return &types.UserRoleResp{
+			BaseResp: types.BaseResp{Code: 400, Message: "角色必须是 user、admin 或 super_admin", Success: false},
+		}, nil
+	}
+	return updateUserRole(l.ctx, l.svcCtx, req.UserId, req.Role, req.Reason, "授予角色成功"), nil
+}
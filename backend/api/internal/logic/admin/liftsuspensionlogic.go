@@ -0,0 +1,54 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+	"strconv"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LiftSuspensionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewLiftSuspensionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LiftSuspensionLogic {
+	return &LiftSuspensionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *LiftSuspensionLogic) LiftSuspension(req *types.LiftSuspensionReq) (resp *types.SuspensionResp, err error) {
+	actorID, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.SuspensionResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.LiftSuspension(l.ctx, &super.LiftSuspensionReq{
+		ActorUserId: actorID,
+		UserId:      req.UserId,
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		return &types.SuspensionResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	if id, err := strconv.ParseUint(req.UserId, 10, 32); err == nil {
+		l.svcCtx.TokenGuard.Invalidate(uint(id))
+	}
+	return &types.SuspensionResp{
+		BaseResp: common.HandleRPCError(nil, "处罚已解除"),
+		Data:     common.SuspensionFromRPC(rpcResp.Suspension),
+	}, nil
+}
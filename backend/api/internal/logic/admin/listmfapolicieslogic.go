@@ -0,0 +1,58 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListMfaPoliciesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListMfaPoliciesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListMfaPoliciesLogic {
+	return &ListMfaPoliciesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListMfaPoliciesLogic) ListMfaPolicies() (resp *types.ListMfaPoliciesResp, err error) {
+	actorID, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.ListMfaPoliciesResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.ListMfaPolicies(l.ctx, &super.ListMfaPoliciesReq{ActorUserId: actorID})
+	if err != nil {
+		return &types.ListMfaPoliciesResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	policies := make([]types.MfaPolicy, 0, len(rpcResp.Policies))
+	for _, p := range rpcResp.Policies {
+		policies = append(policies, mfaPolicyFromRPC(p))
+	}
+	return &types.ListMfaPoliciesResp{
+		BaseResp: common.HandleRPCError(nil, "获取两步验证策略成功"),
+		Data:     policies,
+	}, nil
+}
+
+func mfaPolicyFromRPC(p *super.MfaPolicy) types.MfaPolicy {
+	return types.MfaPolicy{
+		Role:      p.Role,
+		Required:  p.Required,
+		UpdatedBy: p.UpdatedBy,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
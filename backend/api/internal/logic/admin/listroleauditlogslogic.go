@@ -0,0 +1,65 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListRoleAuditLogsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListRoleAuditLogsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListRoleAuditLogsLogic {
+	return &ListRoleAuditLogsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListRoleAuditLogsLogic) ListRoleAuditLogs(req *types.ListRoleAuditLogsReq) (resp *types.ListRoleAuditLogsResp, err error) {
+	actorID, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.ListRoleAuditLogsResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.ListRoleAuditLogs(l.ctx, &super.ListRoleAuditLogsReq{
+		ActorUserId: actorID,
+		UserId:      req.UserId,
+		Page:        int32(req.Page),
+		PageSize:    int32(req.PageSize),
+	})
+	if err != nil {
+		return &types.ListRoleAuditLogsResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	logs := make([]types.RoleAuditLog, 0, len(rpcResp.Logs))
+	for _, g := range rpcResp.Logs {
+		logs = append(logs, types.RoleAuditLog{
+			Id:          g.Id,
+			UserId:      g.UserId,
+			ActorUserId: g.ActorUserId,
+			Action:      g.Action,
+			FromRole:    g.FromRole,
+			ToRole:      g.ToRole,
+			Reason:      g.Reason,
+			ClientIp:    g.ClientIp,
+			CreatedAt:   g.CreatedAt,
+		})
+	}
+	return &types.ListRoleAuditLogsResp{
+		BaseResp: common.HandleRPCError(nil, "获取审计记录成功"),
+		Data:     types.RoleAuditLogList{Logs: logs, Total: rpcResp.Total},
+	}, nil
+}
@@ -0,0 +1,55 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListSuspensionAppealsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListSuspensionAppealsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListSuspensionAppealsLogic {
+	return &ListSuspensionAppealsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListSuspensionAppealsLogic) ListSuspensionAppeals(req *types.ListSuspensionAppealsReq) (resp *types.ListSuspensionAppealsResp, err error) {
+	actorID, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.ListSuspensionAppealsResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.ListSuspensionAppeals(l.ctx, &super.ListSuspensionAppealsReq{
+		ActorUserId: actorID,
+		Status:      req.Status,
+		Page:        int32(req.Page),
+		PageSize:    int32(req.PageSize),
+	})
+	if err != nil {
+		return &types.ListSuspensionAppealsResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	appeals := make([]types.SuspensionAppeal, 0, len(rpcResp.Appeals))
+	for _, a := range rpcResp.Appeals {
+		appeals = append(appeals, common.SuspensionAppealFromRPC(a))
+	}
+	return &types.ListSuspensionAppealsResp{
+		BaseResp: common.HandleRPCError(nil, "获取申诉列表成功"),
+		Data:     types.SuspensionAppealList{Appeals: appeals, Total: rpcResp.Total},
+	}, nil
+}
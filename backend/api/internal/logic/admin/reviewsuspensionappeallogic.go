@@ -0,0 +1,62 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+	"strconv"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReviewSuspensionAppealLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewReviewSuspensionAppealLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewSuspensionAppealLogic {
+	return &ReviewSuspensionAppealLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 处理申诉：通过时解除处罚，丢弃本实例缓存的令牌状态使其立即生效
+func (l *ReviewSuspensionAppealLogic) ReviewSuspensionAppeal(req *types.ReviewSuspensionAppealReq) (resp *types.SuspensionAppealResp, err error) {
+	actorID, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.SuspensionAppealResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.ReviewSuspensionAppeal(l.ctx, &super.ReviewSuspensionAppealReq{
+		ActorUserId: actorID,
+		AppealId:    req.Id,
+		Approve:     req.Approve,
+		Note:        req.Note,
+	})
+	if err != nil {
+		return &types.SuspensionAppealResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	msg := "已驳回申诉"
+	if req.Approve {
+		msg = "申诉已通过，处罚已解除"
+		if s := rpcResp.Appeal.GetSuspension(); s != nil {
+			if id, err := strconv.ParseUint(s.UserId, 10, 32); err == nil {
+				l.svcCtx.TokenGuard.Invalidate(uint(id))
+			}
+		}
+	}
+	return &types.SuspensionAppealResp{
+		BaseResp: common.HandleRPCError(nil, msg),
+		Data:     common.SuspensionAppealFromRPC(rpcResp.Appeal),
+	}, nil
+}
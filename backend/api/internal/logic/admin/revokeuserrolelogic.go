@@ -0,0 +1,33 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeUserRoleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRevokeUserRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeUserRoleLogic {
+	return &RevokeUserRoleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 撤销角色：改回普通用户，仅超级管理员
+func (l *RevokeUserRoleLogic) RevokeUserRole(req *types.RevokeUserRoleReq) (resp *types.UserRoleResp, err error) {
+	return updateUserRole(l.ctx, l.svcCtx, req.UserId, utils.RoleUser, req.Reason, "撤销角色成功"), nil
+}
@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+	"strconv"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+)
+
+// updateUserRole 调用 RPC 修改角色（RPC 中写审计记录），成功后丢弃本实例缓存的角色，立即生效
+func updateUserRole(ctx context.Context, svcCtx *svc.ServiceContext, userID, role, reason, successMsg string) *types.UserRoleResp {
+	actorID, err := common.ContextUserID(ctx)
+	if err != nil {
+		return &types.UserRoleResp{BaseResp: common.UnauthorizedResp()}
+	}
+
+	rpcResp, err := svcCtx.SuperRpcClient.UpdateUserRole(ctx, &super.UpdateUserRoleReq{
+		ActorUserId: actorID,
+		UserId:      userID,
+		Role:        role,
+		Reason:      reason,
+		ClientIp:    common.RequestInfoFrom(ctx).ClientIP,
+	})
+	if err != nil {
+		return &types.UserRoleResp{BaseResp: common.HandleRPCError(err, "")}
+	}
+
+	if id, err := strconv.ParseUint(rpcResp.UserId, 10, 32); err == nil {
+		svcCtx.TokenGuard.Invalidate(uint(id))
+	}
+	return &types.UserRoleResp{
+		BaseResp: common.HandleRPCError(nil, successMsg),
+		Data: types.UserRoleData{
+			UserId:       rpcResp.UserId,
+			Role:         rpcResp.Role,
+			PreviousRole: rpcResp.PreviousRole,
+		},
+	}
+}
@@ -0,0 +1,51 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetMfaPolicyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSetMfaPolicyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetMfaPolicyLogic {
+	return &SetMfaPolicyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 设置某个角色是否必须开启两步验证
+func (l *SetMfaPolicyLogic) SetMfaPolicy(req *types.SetMfaPolicyReq) (resp *types.SetMfaPolicyResp, err error) {
+	actorID, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.SetMfaPolicyResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.SetMfaPolicy(l.ctx, &super.SetMfaPolicyReq{
+		ActorUserId: actorID,
+		Role:        req.Role,
+		Required:    req.Required,
+	})
+	if err != nil {
+		return &types.SetMfaPolicyResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	resp = &types.SetMfaPolicyResp{BaseResp: common.HandleRPCError(nil, "设置两步验证策略成功")}
+	if rpcResp.Policy != nil {
+		resp.Data = mfaPolicyFromRPC(rpcResp.Policy)
+	}
+	return resp, nil
+}
@@ -0,0 +1,65 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+	"strconv"
+
+	"backend/api/internal/chathub"
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SuspendUserLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSuspendUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SuspendUserLogic {
+	return &SuspendUserLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 封禁或限制用户：封禁后立即丢弃本实例缓存的令牌状态并断开该用户的 WebSocket 连接；
+// 其他实例由 RPC 经通知流广播的会话撤销事件同样断开
+func (l *SuspendUserLogic) SuspendUser(req *types.SuspendUserReq) (resp *types.SuspensionResp, err error) {
+	actorID, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.SuspensionResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.SuspendUser(l.ctx, &super.SuspendUserReq{
+		ActorUserId:     actorID,
+		UserId:          req.UserId,
+		Kind:            req.Kind,
+		Reason:          req.Reason,
+		DurationSeconds: req.DurationSeconds,
+		HideContent:     req.HideContent,
+	})
+	if err != nil {
+		return &types.SuspensionResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	if id, err := strconv.ParseUint(req.UserId, 10, 32); err == nil {
+		l.svcCtx.TokenGuard.Invalidate(uint(id))
+		// 限制（restrict）只禁止发布内容，不断开连接
+		if rpcResp.Suspension.GetKind() == "suspend" {
+			closed := chathub.DefaultSessions.Close(uint(id))
+			l.Infof("[处罚] 已封禁 用户ID=%d 断开连接=%d", id, closed)
+		}
+	}
+	return &types.SuspensionResp{
+		BaseResp: common.HandleRPCError(nil, "处罚已生效"),
+		Data:     common.SuspensionFromRPC(rpcResp.Suspension),
+	}, nil
+}
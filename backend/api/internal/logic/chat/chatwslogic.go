@@ -3,25 +3,18 @@ package chat
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"net/http"
-	"strings"
-	"sync"
+	"strconv"
 	"time"
 
+	"backend/api/internal/common"
+	"backend/api/internal/logic/e2ee"
 	"backend/api/internal/svc"
-	"backend/utils"
+	"backend/api/internal/wsresume"
+	"backend/rpc/pb/super"
 
-	"github.com/gorilla/websocket"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
-// 全局聊天连接映射
-var (
-	chatConnections      = make(map[string]*websocket.Conn)
-	chatConnectionsMutex sync.RWMutex
-)
-
 // 聊天消息结构
 type ChatMessage struct {
 	From    string `json:"from"`
@@ -45,96 +38,37 @@ func NewChatWsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ChatWsLogi
 }
 
 func (l *ChatWsLogic) ChatWs() error {
-	// 从上下文获取 HTTP 请求
-	r, ok := l.ctx.Value("http.Request").(*http.Request)
+	// 兼容旧端点：等价于连上 /ws 网关后只订阅 chat 频道，下行帧不带 channel 字段
+	r, w, ok := wsRequestFromContext(l.ctx)
 	if !ok {
 		return nil
 	}
-
-	w, ok := l.ctx.Value("http.ResponseWriter").(*http.ResponseWriter)
+	userID, sessionID, ok := wsAuthUserID(l.svcCtx, w, r)
 	if !ok {
 		return nil
 	}
+	// 断线重连时客户端带上最后收到的 seq，补发期间错过的事件
+	resumeFrom := wsresume.ParseResumeFrom(r.URL.Query().Get("resume_from"))
 
-	// 验证 token
-	token := r.Header.Get("Authorization")
-	if token == "" {
-		// 尝试从查询参数获取 token
-		token = r.URL.Query().Get("token")
-		if token == "" {
-			http.Error(*w, "Unauthorized", http.StatusUnauthorized)
-			return nil
-		}
-	} else {
-		// 处理 Bearer token
-		if strings.HasPrefix(token, "Bearer ") {
-			token = strings.TrimPrefix(token, "Bearer ")
-		}
-	}
-
-	// 验证 token
-	claims, err := utils.ParseToken(token)
+	s, err := upgradeWsSession(l.ctx, l.svcCtx, w, r, userID, sessionID, channelChat)
 	if err != nil {
-		http.Error(*w, "Invalid token", http.StatusUnauthorized)
+		l.Logger.Errorf("Error upgrading connection: %v", err)
 		return nil
 	}
-
-	// 获取用户 ID
-	userID := fmt.Sprintf("%d", claims.UserID)
-
-	// 升级 HTTP 连接为 WebSocket
-	conn, err := upgrader.Upgrade(*w, r, nil)
-	if err != nil {
-		l.Logger.Errorf("Error upgrading connection: %v", err)
+	if err := s.subscribe(channelChat, resumeFrom); err != nil {
+		s.close()
 		return nil
 	}
+	l.Logger.Infof("Chat user %s connected (resume_from=%d)", userID, resumeFrom)
 
-	// 存储用户连接
-	chatConnectionsMutex.Lock()
-	chatConnections[userID] = conn
-	chatConnectionsMutex.Unlock()
-	l.Logger.Infof("Chat user %s connected", userID)
-
-	// 处理消息
-	go l.handleConnection(userID, conn)
+	go s.serve(60 * time.Second)
 
 	return nil
 }
 
-// 处理 WebSocket 连接
-func (l *ChatWsLogic) handleConnection(userID string, conn *websocket.Conn) {
-	defer func() {
-		TryMatchCancel(userID)
-		chatConnectionsMutex.Lock()
-		delete(chatConnections, userID)
-		chatConnectionsMutex.Unlock()
-		conn.Close()
-		l.Logger.Infof("Chat user %s disconnected", userID)
-	}()
-
-	// 设置读取超时
-	conn.SetReadDeadline(time.Now().Add(60 * time.Second))
-	conn.SetPongHandler(func(string) error {
-		conn.SetReadDeadline(time.Now().Add(60 * time.Second))
-		return nil
-	})
-
-	for {
-		_, message, err := conn.ReadMessage()
-		if err != nil {
-			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-				l.Logger.Errorf("WebSocket error: %v", err)
-			}
-			break
-		}
-		l.Logger.Infof("Received chat message from %s: %s", userID, message)
-		// 处理前端发送的消息
-		l.handleMessage(userID, message)
-	}
-}
-
 // 处理前端发送的消息
-func (l *ChatWsLogic) handleMessage(userID string, message []byte) {
+func (l *ChatWsLogic) handleMessage(s *wsSession, message []byte) {
+	userID := s.userID
 	// 解析消息
 	var msg map[string]interface{}
 	if err := json.Unmarshal(message, &msg); err != nil {
@@ -142,9 +76,6 @@ func (l *ChatWsLogic) handleMessage(userID string, message []byte) {
 		return
 	}
 
-	// 打印完整消息，用于调试
-	l.Logger.Infof("Received full message from %s: %v", userID, msg)
-
 	// 根据消息类型处理
 	msgType, ok := msg["type"].(string)
 	if !ok {
@@ -155,26 +86,59 @@ func (l *ChatWsLogic) handleMessage(userID string, message []byte) {
 	switch msgType {
 	case "ping":
 		// 响应 ping
-		l.sendToUser(userID, map[string]interface{}{
+		s.writeJSON(channelChat, map[string]interface{}{
 			"type": "pong",
 		})
 	case "match_join":
-		TryMatchJoin(userID, l.sendToUser)
+		l.handleMatchJoin(s)
 	case "match_cancel":
 		TryMatchCancel(userID)
-		l.sendToUser(userID, map[string]interface{}{
+		s.writeJSON(channelChat, map[string]interface{}{
 			"type": "match_cancelled",
 		})
 	case "message":
 		// 处理聊天消息
-		l.handleChatMessage(userID, msg)
+		l.handleChatMessage(s, msg)
+	case "e2ee_message":
+		// 端到端加密消息：服务端只存储并转发密文
+		l.handleEncryptedMessage(s, msg)
+	case "e2ee_ack":
+		l.handleEncryptedAck(userID, msg)
 	default:
 		l.Logger.Infof("Unknown message type: %s", msgType)
 	}
 }
 
+// 加入在线匹配，排除与自己存在拉黑关系的用户；被限制的账号不能匹配聊天
+func (l *ChatWsLogic) handleMatchJoin(s *wsSession) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if uid, err := strconv.ParseUint(s.userID, 10, 32); err == nil && l.svcCtx.TokenGuard.Restricted(ctx, uint(uid)) {
+		s.writeJSON(channelChat, map[string]interface{}{
+			"type":    "match_error",
+			"message": "账号处于限制状态，暂时只能浏览",
+		})
+		return
+	}
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetBlockedUserIds(ctx, &super.GetBlockedUserIdsReq{UserId: s.userID})
+	if err != nil {
+		l.Logger.Errorf("Load blocked users of %s failed: %v", s.userID, err)
+		s.writeJSON(channelChat, map[string]interface{}{
+			"type":    "match_error",
+			"message": "匹配失败，请稍后重试",
+		})
+		return
+	}
+	excluded := make(map[string]bool, len(rpcResp.UserIds))
+	for _, id := range rpcResp.UserIds {
+		excluded[id] = true
+	}
+	TryMatchJoin(s.userID, excluded, l.sendToUser)
+}
+
 // 处理聊天消息
-func (l *ChatWsLogic) handleChatMessage(userID string, msg map[string]interface{}) {
+func (l *ChatWsLogic) handleChatMessage(s *wsSession, msg map[string]interface{}) {
+	userID := s.userID
 	// 提取消息内容
 	content, ok := msg["content"].(string)
 	if !ok {
@@ -193,6 +157,22 @@ func (l *ChatWsLogic) handleChatMessage(userID string, msg map[string]interface{
 		}
 	}
 
+	// 存在拉黑关系或不在对方的私信权限范围内时不转发（密文消息由 StoreEncryptedMessage 校验）
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := l.svcCtx.SuperRpcClient.CheckDirectMessage(ctx, &super.CheckDirectMessageReq{SenderId: userID, RecipientId: targetID}); err != nil {
+		r := common.HandleRPCError(err, "")
+		if r.Code >= 500 {
+			l.Logger.Errorf("Check direct message from %s to %s failed: %v", userID, targetID, err)
+		}
+		s.writeJSON(channelChat, map[string]interface{}{
+			"type":      "message_error",
+			"target_id": targetID,
+			"message":   r.Message,
+		})
+		return
+	}
+
 	// 尝试获取发送者信息，支持多种字段名
 	senderName := "用户"
 	senderAvatar := ""
@@ -225,36 +205,146 @@ func (l *ChatWsLogic) handleChatMessage(userID string, msg map[string]interface{
 		"senderAvatar":  senderAvatar, // 同时添加驼峰命名的字段，确保前端兼容
 	}
 
-	// 发送消息给目标用户
-	l.sendToUser(targetID, chatMsg)
+	// 发送消息给目标用户，对方不在线时留一条私信通知
+	if !l.publishToUser(targetID, chatMsg) {
+		l.notifyOffline(userID, targetID)
+	}
 }
 
-// 发送消息给指定用户
-func (l *ChatWsLogic) sendToUser(userID string, data interface{}) bool {
-	chatConnectionsMutex.RLock()
-	conn, ok := chatConnections[userID]
-	chatConnectionsMutex.RUnlock()
+// 处理端到端加密消息：落库后原样转发给接收者，发送者收到 e2ee_sent 回执
+func (l *ChatWsLogic) handleEncryptedMessage(s *wsSession, msg map[string]interface{}) {
+	userID := s.userID
+	targetID, _ := msg["target_id"].(string)
+	if targetID == "" {
+		targetID, _ = msg["to"].(string)
+	}
+	clientMsgID, _ := msg["client_msg_id"].(string)
+	ciphertext, _ := msg["ciphertext"].(string)
+	senderDevice, _ := msg["sender_device_id"].(string)
+	recipientDevice, _ := msg["recipient_device_id"].(string)
+	msgType, _ := toFloat(msg["message_type"])
+	if targetID == "" || ciphertext == "" {
+		s.writeJSON(channelChat, map[string]interface{}{
+			"type":          "e2ee_error",
+			"client_msg_id": clientMsgID,
+			"message":       "缺少 target_id 或 ciphertext",
+		})
+		return
+	}
 
-	if !ok {
-		return false
+	// WebSocket 读循环在 handler 返回后仍在运行，请求上下文此时已取消，RPC 需独立上下文
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	rpcResp, err := l.svcCtx.SuperRpcClient.StoreEncryptedMessage(ctx, &super.StoreEncryptedMessageReq{
+		ActorUserId:       userID,
+		SenderDeviceId:    senderDevice,
+		RecipientId:       targetID,
+		RecipientDeviceId: recipientDevice,
+		MessageType:       int32(msgType),
+		Ciphertext:        ciphertext,
+	})
+	if err != nil {
+		l.Logger.Errorf("Store e2ee message from %s to %s failed: %v", userID, targetID, err)
+		s.writeJSON(channelChat, map[string]interface{}{
+			"type":          "e2ee_error",
+			"client_msg_id": clientMsgID,
+			"message":       common.HandleRPCError(err, "").Message,
+		})
+		return
+	}
+
+	stored := e2ee.EncryptedMessageFromRpc(rpcResp.Message)
+	delivered := l.publishToUser(targetID, map[string]interface{}{
+		"type":    "e2ee_message",
+		"from":    userID,
+		"message": stored,
+	})
+	s.writeJSON(channelChat, map[string]interface{}{
+		"type":          "e2ee_sent",
+		"client_msg_id": clientMsgID,
+		"id":            stored.Id,
+		"online":        delivered,
+	})
+	if !delivered {
+		l.notifyOffline(userID, targetID)
+	}
+}
+
+// 接收者离线时写入私信通知（同一发送者的未读私信通知只保留一条），并推送到接收者的手机。
+// 消息正文不落库也不进日志，通知和推送只说明谁发来了私信
+func (l *ChatWsLogic) notifyOffline(userID, targetID string) {
+	go l.pushOffline(userID, targetID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := l.svcCtx.SuperRpcClient.CreateNotification(ctx, &super.CreateNotificationReq{
+		UserId:   targetID,
+		SenderId: userID,
+		Kind:     "private_message",
+	}); err != nil {
+		l.Logger.Errorf("Create private message notification for %s failed: %v", targetID, err)
+	}
+}
+
+// 离线私信的设备推送；同一会话只保留最新一条，正文只提示有新消息
+func (l *ChatWsLogic) pushOffline(userID, targetID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	if _, err := l.svcCtx.SuperRpcClient.SendDevicePush(ctx, &super.SendDevicePushReq{
+		UserId:       targetID,
+		ActorId:      userID,
+		Kind:         "private_message",
+		Body:         "点击查看消息",
+		Data:         map[string]string{"type": "chat_message", "from": userID},
+		HighPriority: true,
+		CollapseKey:  "chat:" + userID,
+	}); err != nil {
+		l.Logger.Errorf("Push private message to devices of %s failed: %v", targetID, err)
+	}
+}
+
+// 接收设备确认密文已解密入库，之后不再出现在离线补拉结果中
+func (l *ChatWsLogic) handleEncryptedAck(userID string, msg map[string]interface{}) {
+	deviceID, _ := msg["device_id"].(string)
+	rawIDs, _ := msg["ids"].([]interface{})
+	ids := make([]string, 0, len(rawIDs))
+	for _, v := range rawIDs {
+		if s, ok := v.(string); ok && s != "" {
+			ids = append(ids, s)
+		}
+	}
+	if len(ids) == 0 {
+		return
 	}
 
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := l.svcCtx.SuperRpcClient.AckEncryptedMessages(ctx, &super.AckEncryptedMessagesReq{
+		ActorUserId: userID,
+		DeviceId:    deviceID,
+		Ids:         ids,
+	}); err != nil {
+		l.Logger.Errorf("Ack e2ee messages for %s failed: %v", userID, err)
+	}
+}
+
+// 发送消息给指定用户（即时帧：匹配状态等，不编号也不缓冲）
+func (l *ChatWsLogic) sendToUser(userID string, data interface{}) bool {
 	msgData, err := json.Marshal(data)
 	if err != nil {
 		l.Logger.Errorf("Error marshaling message: %v", err)
 		return false
 	}
+	return deliver(channelChat, userID, msgData)
+}
 
-	err = conn.WriteMessage(websocket.TextMessage, msgData)
+// 发送需要可靠送达的事件（聊天消息、密文转发）：分配 seq 并写入重连缓冲，
+// 用户不在线时同样缓冲，保留期内重连可补发
+func (l *ChatWsLogic) publishToUser(userID string, data interface{}) bool {
+	msgData, err := json.Marshal(data)
 	if err != nil {
-		l.Logger.Errorf("Error sending message to %s: %v", userID, err)
-		// 移除无效连接
-		chatConnectionsMutex.Lock()
-		delete(chatConnections, userID)
-		chatConnectionsMutex.Unlock()
-		conn.Close()
+		l.Logger.Errorf("Error marshaling message: %v", err)
 		return false
 	}
-
-	return true
+	return deliver(channelChat, userID, wsresume.Chat.Publish(userID, msgData))
 }
@@ -0,0 +1,54 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package chat
+
+import (
+	"context"
+	"time"
+
+	"backend/api/internal/svc"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GatewayLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// WebSocket统一网关（一次鉴权，按频道订阅 chat/presence/notifications/world:<room>）
+func NewGatewayLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GatewayLogic {
+	return &GatewayLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GatewayLogic) Gateway() error {
+	r, w, ok := wsRequestFromContext(l.ctx)
+	if !ok {
+		return nil
+	}
+	userID, sessionID, ok := wsAuthUserID(l.svcCtx, w, r)
+	if !ok {
+		return nil
+	}
+
+	s, err := upgradeWsSession(l.ctx, l.svcCtx, w, r, userID, sessionID, "")
+	if err != nil {
+		l.Logger.Errorf("Error upgrading connection: %v", err)
+		return nil
+	}
+	// 连接建立后客户端再逐个 subscribe，频道消息格式见 wssession.go
+	s.writeJSON("", map[string]interface{}{
+		"type":    "ready",
+		"user_id": userID,
+	})
+	l.Logger.Infof("Gateway user %s connected", userID)
+
+	go s.serve(75 * time.Second)
+
+	return nil
+}
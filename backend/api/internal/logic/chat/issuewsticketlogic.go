@@ -0,0 +1,70 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package chat
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/api/internal/wsticket"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type IssueWsTicketLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// 签发 WebSocket 连接票据（一次性，30 秒内有效，绑定用户与端点）
+func NewIssueWsTicketLogic(ctx context.Context, svcCtx *svc.ServiceContext) *IssueWsTicketLogic {
+	return &IssueWsTicketLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *IssueWsTicketLogic) IssueWsTicket(req *types.WsTicketReq) (resp *types.WsTicketResp, err error) {
+	me, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.WsTicketResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	endpoint := strings.TrimSpace(req.Endpoint)
+	if endpoint == "" {
+		endpoint = "/ws"
+	}
+	if !wsticket.ValidEndpoint(endpoint) {
+		return &types.WsTicketResp{
+			BaseResp: types.BaseResp{Code: 400, Message: "不支持的 WebSocket 端点", Success: false},
+		}, nil
+	}
+
+	actor, _ := common.ActorFrom(l.ctx)
+	rpcResp, err := l.svcCtx.SuperRpcClient.IssueWsTicket(l.ctx, &super.IssueWsTicketReq{
+		UserId:    me,
+		SessionId: strconv.FormatUint(uint64(actor.SessionID), 10),
+		Endpoint:  endpoint,
+	})
+	if err != nil {
+		l.Logger.Errorf("Issue ws ticket for %s failed: %v", me, err)
+		return &types.WsTicketResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	return &types.WsTicketResp{
+		BaseResp: common.HandleRPCError(nil, "ok"),
+		Data: types.WsTicketData{
+			Ticket:    rpcResp.Ticket,
+			Endpoint:  endpoint,
+			ExpiresIn: rpcResp.ExpiresIn,
+		},
+	}, nil
+}
@@ -20,15 +20,18 @@ func removeFromMatchQueue(uid string) {
 }
 
 // TryMatchJoin 将用户加入在线匹配队列；若已有他人等待则立即配对并通过 send 下发 match_found。
-func TryMatchJoin(userID string, send func(string, interface{}) bool) {
+// excluded 为与该用户存在拉黑关系的用户，不会与其配对。
+func TryMatchJoin(userID string, excluded map[string]bool, send func(string, interface{}) bool) {
 	matchMu.Lock()
 	defer matchMu.Unlock()
 
 	removeFromMatchQueue(userID)
 
-	if len(matchQueue) > 0 {
-		peer := matchQueue[0]
-		matchQueue = matchQueue[1:]
+	for i, peer := range matchQueue {
+		if excluded[peer] {
+			continue
+		}
+		matchQueue = append(matchQueue[:i], matchQueue[i+1:]...)
 		send(userID, map[string]interface{}{
 			"type":    "match_found",
 			"peer_id": peer,
@@ -3,32 +3,19 @@ package chat
 import (
 	"context"
 	"encoding/json"
-	"fmt"
-	"net/http"
-	"strings"
-	"sync"
 	"time"
 
+	"backend/api/internal/presence"
 	"backend/api/internal/svc"
-	"backend/utils"
 
-	"github.com/gorilla/websocket"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
-// 全局在线状态连接映射
-var (
-	presenceConnections = make(map[string]*websocket.Conn)
-	presenceConnectionsMutex sync.RWMutex
-	onlineUsers = make(map[string]bool)
-	onlineUsersMutex sync.RWMutex
-)
-
 // 在线状态消息结构
 type PresenceMessage struct {
-	Type       string   `json:"type"`
-	UserID     string   `json:"user_id,omitempty"`
-	Online     bool     `json:"online,omitempty"`
+	Type          string   `json:"type"`
+	UserID        string   `json:"user_id,omitempty"`
+	Online        bool     `json:"online,omitempty"`
 	OnlineUserIDs []string `json:"online_user_ids,omitempty"`
 }
 
@@ -48,116 +35,50 @@ func NewPresenceWsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Presen
 }
 
 func (l *PresenceWsLogic) PresenceWs() error {
-	// 从上下文获取 HTTP 请求
-	r, ok := l.ctx.Value("http.Request").(*http.Request)
+	// 兼容旧端点：等价于连上 /ws 网关后只订阅 presence 频道
+	r, w, ok := wsRequestFromContext(l.ctx)
 	if !ok {
 		return nil
 	}
-
-	w, ok := l.ctx.Value("http.ResponseWriter").(*http.ResponseWriter)
+	userID, sessionID, ok := wsAuthUserID(l.svcCtx, w, r)
 	if !ok {
 		return nil
 	}
 
-	// 验证 token
-	token := r.Header.Get("Authorization")
-	if token == "" {
-		// 尝试从查询参数获取 token
-		token = r.URL.Query().Get("token")
-		if token == "" {
-			http.Error(*w, "Unauthorized", http.StatusUnauthorized)
-			return nil
-		}
-	} else {
-		// 处理 Bearer token
-		if strings.HasPrefix(token, "Bearer ") {
-			token = strings.TrimPrefix(token, "Bearer ")
-		}
-	}
-
-	// 验证 token
-	claims, err := utils.ParseToken(token)
+	s, err := upgradeWsSession(l.ctx, l.svcCtx, w, r, userID, sessionID, channelPresence)
 	if err != nil {
-		http.Error(*w, "Invalid token", http.StatusUnauthorized)
+		l.Logger.Errorf("Error upgrading connection: %v", err)
 		return nil
 	}
-
-	// 获取用户 ID
-	userID := fmt.Sprintf("%d", claims.UserID)
-
-	// 升级 HTTP 连接为 WebSocket
-	conn, err := upgrader.Upgrade(*w, r, nil)
-	if err != nil {
-		l.Logger.Errorf("Error upgrading connection: %v", err)
+	if err := s.subscribe(channelPresence, 0); err != nil {
+		s.close()
 		return nil
 	}
-
-	// 存储用户连接
-	presenceConnectionsMutex.Lock()
-	presenceConnections[userID] = conn
-	presenceConnectionsMutex.Unlock()
-
-	// 更新在线状态
-	onlineUsersMutex.Lock()
-	onlineUsers[userID] = true
-	onlineUsersMutex.Unlock()
-
 	l.Logger.Infof("Presence user %s connected", userID)
 
-	// 发送在线状态快照
-	l.sendPresenceSnapshot(userID)
-
-	// 广播用户上线通知
-	l.broadcastPresence(userID, true)
-
-	// 处理消息
-	go l.handleConnection(userID, conn)
+	go s.serve(60 * time.Second)
 
 	return nil
 }
 
-// 处理 WebSocket 连接
-func (l *PresenceWsLogic) handleConnection(userID string, conn *websocket.Conn) {
-	defer func() {
-		presenceConnectionsMutex.Lock()
-		delete(presenceConnections, userID)
-		presenceConnectionsMutex.Unlock()
-
-		// 更新在线状态
-		onlineUsersMutex.Lock()
-		delete(onlineUsers, userID)
-		onlineUsersMutex.Unlock()
-
-		conn.Close()
-		l.Logger.Infof("Presence user %s disconnected", userID)
-
-		// 广播用户下线通知
-		l.broadcastPresence(userID, false)
-	}()
-
-	// 设置读取超时
-	conn.SetReadDeadline(time.Now().Add(60 * time.Second))
-	conn.SetPongHandler(func(string) error {
-		conn.SetReadDeadline(time.Now().Add(60 * time.Second))
-		return nil
-	})
+// 订阅 presence 频道：计入在线状态，下发快照，首个连接时广播上线
+func (l *PresenceWsLogic) join(s *wsSession) {
+	becameOnline := presence.DefaultState.Add(s.userID)
+	l.sendPresenceSnapshot(s)
+	if becameOnline {
+		l.broadcastPresence(s.userID, true)
+	}
+}
 
-	for {
-		_, message, err := conn.ReadMessage()
-		if err != nil {
-			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-				l.Logger.Errorf("WebSocket error: %v", err)
-			}
-			break
-		}
-		l.Logger.Infof("Received presence message from %s: %s", userID, message)
-		// 处理前端发送的消息
-		l.handleMessage(userID, message)
+// 退订 presence 频道：最后一个连接断开时广播下线
+func (l *PresenceWsLogic) leave(s *wsSession) {
+	if presence.DefaultState.Remove(s.userID) {
+		l.broadcastPresence(s.userID, false)
 	}
 }
 
 // 处理前端发送的消息
-func (l *PresenceWsLogic) handleMessage(userID string, message []byte) {
+func (l *PresenceWsLogic) handleMessage(s *wsSession, message []byte) {
 	// 解析消息
 	var msg map[string]interface{}
 	if err := json.Unmarshal(message, &msg); err != nil {
@@ -175,32 +96,25 @@ func (l *PresenceWsLogic) handleMessage(userID string, message []byte) {
 	switch msgType {
 	case "ping":
 		// 响应 ping
-		l.sendToUser(userID, map[string]interface{}{
+		s.writeJSON(channelPresence, map[string]interface{}{
 			"type": "pong",
 		})
 	case "get_online":
 		// 发送在线状态快照
-		l.sendPresenceSnapshot(userID)
+		l.sendPresenceSnapshot(s)
 	default:
 		l.Logger.Infof("Unknown message type: %s", msgType)
 	}
 }
 
 // 发送在线状态快照
-func (l *PresenceWsLogic) sendPresenceSnapshot(userID string) {
-	onlineUsersMutex.RLock()
-	userIDs := make([]string, 0, len(onlineUsers))
-	for id := range onlineUsers {
-		userIDs = append(userIDs, id)
-	}
-	onlineUsersMutex.RUnlock()
-
+func (l *PresenceWsLogic) sendPresenceSnapshot(s *wsSession) {
 	message := PresenceMessage{
-		Type:       "presence_snapshot",
-		OnlineUserIDs: userIDs,
+		Type:          "presence_snapshot",
+		OnlineUserIDs: presence.DefaultState.OnlineUserIDs(),
 	}
 
-	l.sendToUser(userID, message)
+	s.writeJSON(channelPresence, message)
 }
 
 // 广播用户在线状态变化
@@ -211,70 +125,25 @@ func (l *PresenceWsLogic) broadcastPresence(userID string, online bool) {
 		Online: online,
 	}
 
-	presenceConnectionsMutex.RLock()
-	for id, conn := range presenceConnections {
-		if id == userID {
-			continue
-		}
-
-		msgData, err := json.Marshal(message)
-		if err != nil {
-			l.Logger.Errorf("Error marshaling presence message: %v", err)
-			continue
-		}
-
-		err = conn.WriteMessage(websocket.TextMessage, msgData)
-		if err != nil {
-			l.Logger.Errorf("Error sending presence message to %s: %v", id, err)
-			// 移除无效连接
-			presenceConnectionsMutex.RUnlock()
-			presenceConnectionsMutex.Lock()
-			delete(presenceConnections, id)
-			presenceConnectionsMutex.Unlock()
-			presenceConnectionsMutex.RLock()
-		}
-	}
-	presenceConnectionsMutex.RUnlock()
-}
-
-// 发送消息给指定用户
-func (l *PresenceWsLogic) sendToUser(userID string, data interface{}) bool {
-	presenceConnectionsMutex.RLock()
-	conn, ok := presenceConnections[userID]
-	presenceConnectionsMutex.RUnlock()
-
-	if !ok {
-		return false
-	}
-
-	msgData, err := json.Marshal(data)
+	msgData, err := json.Marshal(message)
 	if err != nil {
-		l.Logger.Errorf("Error marshaling message: %v", err)
-		return false
+		l.Logger.Errorf("Error marshaling presence message: %v", err)
+		return
 	}
 
-	err = conn.WriteMessage(websocket.TextMessage, msgData)
-	if err != nil {
-		l.Logger.Errorf("Error sending message to %s: %v", userID, err)
-		// 移除无效连接
-		presenceConnectionsMutex.Lock()
-		delete(presenceConnections, userID)
-		presenceConnectionsMutex.Unlock()
-		conn.Close()
-		return false
+	for _, id := range channelUserIDs(channelPresence) {
+		if id == userID {
+			continue
+		}
+		deliver(channelPresence, id, msgData)
 	}
-
-	return true
 }
 
 // 获取在线用户列表
 func (l *PresenceWsLogic) GetOnlineUsers() map[string]bool {
-	onlineUsersMutex.RLock()
-	defer onlineUsersMutex.RUnlock()
-
 	result := make(map[string]bool)
-	for id, online := range onlineUsers {
-		result[id] = online
+	for _, id := range presence.DefaultState.OnlineUserIDs() {
+		result[id] = true
 	}
 
 	return result
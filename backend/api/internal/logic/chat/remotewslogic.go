@@ -3,14 +3,11 @@ package chat
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"net/http"
-	"strings"
-	"sync"
 	"time"
 
 	"backend/api/internal/svc"
-	"backend/utils"
+	"backend/api/internal/wsresume"
 
 	"github.com/gorilla/websocket"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -23,12 +20,6 @@ var upgrader = websocket.Upgrader{
 	},
 }
 
-// 全局用户连接映射
-var (
-	userConnections  = make(map[string]*websocket.Conn)
-	connectionsMutex sync.RWMutex
-)
-
 // 通知消息结构
 type NotificationMessage struct {
 	Type string      `json:"type"`
@@ -49,12 +40,6 @@ type SendBatchNotificationReq struct {
 	Data    interface{} `json:"data"`
 }
 
-// 广播通知请求结构
-type BroadcastNotificationReq struct {
-	Type string      `json:"type"`
-	Data interface{} `json:"data"`
-}
-
 type RemoteWsLogic struct {
 	logx.Logger
 	ctx    context.Context
@@ -71,93 +56,36 @@ func NewRemoteWsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoteWs
 }
 
 func (l *RemoteWsLogic) RemoteWs() error {
-	// 从上下文获取 HTTP 请求
-	r, ok := l.ctx.Value("http.Request").(*http.Request)
+	// 兼容旧端点：等价于连上 /ws 网关后只订阅 notifications 频道
+	r, w, ok := wsRequestFromContext(l.ctx)
 	if !ok {
 		return nil
 	}
-
-	w, ok := l.ctx.Value("http.ResponseWriter").(*http.ResponseWriter)
+	userID, sessionID, ok := wsAuthUserID(l.svcCtx, w, r)
 	if !ok {
 		return nil
 	}
+	// 断线重连时客户端带上最后收到的 seq，补发期间错过的通知
+	resumeFrom := wsresume.ParseResumeFrom(r.URL.Query().Get("resume_from"))
 
-	// 验证 token（与 Chat/Presence WS 一致：Header 优先，其次 query token，兼容穿透层剥离 Authorization）
-	token := r.Header.Get("Authorization")
-	if token == "" {
-		token = r.URL.Query().Get("token")
-		if token == "" {
-			http.Error(*w, "Unauthorized", http.StatusUnauthorized)
-			return nil
-		}
-	} else {
-		if strings.HasPrefix(token, "Bearer ") {
-			token = strings.TrimPrefix(token, "Bearer ")
-		}
-	}
-
-	// 验证 token
-	claims, err := utils.ParseToken(token)
+	s, err := upgradeWsSession(l.ctx, l.svcCtx, w, r, userID, sessionID, channelNotifications)
 	if err != nil {
-		http.Error(*w, "Invalid token", http.StatusUnauthorized)
+		l.Logger.Errorf("Error upgrading connection: %v", err)
 		return nil
 	}
-
-	// 获取用户 ID
-	userID := fmt.Sprintf("%d", claims.UserID)
-
-	// 升级 HTTP 连接为 WebSocket
-	conn, err := upgrader.Upgrade(*w, r, nil)
-	if err != nil {
-		l.Logger.Errorf("Error upgrading connection: %v", err)
+	if err := s.subscribe(channelNotifications, resumeFrom); err != nil {
+		s.close()
 		return nil
 	}
+	l.Logger.Infof("User %s connected (resume_from=%d)", userID, resumeFrom)
 
-	// 存储用户连接
-	connectionsMutex.Lock()
-	userConnections[userID] = conn
-	connectionsMutex.Unlock()
-	l.Logger.Infof("User %s connected", userID)
-
-	// 处理消息
-	go l.handleConnection(userID, conn)
+	go s.serve(60 * time.Second)
 
 	return nil
 }
 
-// 处理 WebSocket 连接
-func (l *RemoteWsLogic) handleConnection(userID string, conn *websocket.Conn) {
-	defer func() {
-		connectionsMutex.Lock()
-		delete(userConnections, userID)
-		connectionsMutex.Unlock()
-		conn.Close()
-		l.Logger.Infof("User %s disconnected", userID)
-	}()
-
-	// 设置读取超时
-	conn.SetReadDeadline(time.Now().Add(60 * time.Second))
-	conn.SetPongHandler(func(string) error {
-		conn.SetReadDeadline(time.Now().Add(60 * time.Second))
-		return nil
-	})
-
-	for {
-		_, message, err := conn.ReadMessage()
-		if err != nil {
-			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-				l.Logger.Errorf("WebSocket error: %v", err)
-			}
-			break
-		}
-		l.Logger.Infof("Received message from %s: %s", userID, message)
-		// 处理前端发送的消息
-		l.handleMessage(userID, message)
-	}
-}
-
 // 处理前端发送的消息
-func (l *RemoteWsLogic) handleMessage(userID string, message []byte) {
+func (l *RemoteWsLogic) handleMessage(s *wsSession, message []byte) {
 	// 解析消息
 	var msg map[string]interface{}
 	if err := json.Unmarshal(message, &msg); err != nil {
@@ -174,25 +102,18 @@ func (l *RemoteWsLogic) handleMessage(userID string, message []byte) {
 
 	switch msgType {
 	case "ping":
-		// 响应 ping
-		l.sendToUser(userID, map[string]interface{}{
-			"type": "pong",
+		// 响应 ping（沿用旧端点的通知信封格式）
+		s.writeJSON(channelNotifications, NotificationMessage{
+			Type: "notification",
+			Data: map[string]interface{}{"type": "pong"},
 		})
 	default:
 		l.Logger.Infof("Unknown message type: %s", msgType)
 	}
 }
 
-// 发送消息给指定用户
-func (l *RemoteWsLogic) sendToUser(userID string, data interface{}) bool {
-	connectionsMutex.RLock()
-	conn, ok := userConnections[userID]
-	connectionsMutex.RUnlock()
-
-	if !ok {
-		return false
-	}
-
+// 发送通知：分配 seq 并写入重连缓冲，用户不在线时同样缓冲，保留期内重连可补发
+func (l *RemoteWsLogic) publishToUser(userID string, data interface{}) bool {
 	message := NotificationMessage{
 		Type: "notification",
 		Data: data,
@@ -203,24 +124,12 @@ func (l *RemoteWsLogic) sendToUser(userID string, data interface{}) bool {
 		l.Logger.Errorf("Error marshaling notification: %v", err)
 		return false
 	}
-
-	err = conn.WriteMessage(websocket.TextMessage, msgData)
-	if err != nil {
-		l.Logger.Errorf("Error sending notification to %s: %v", userID, err)
-		// 移除无效连接
-		connectionsMutex.Lock()
-		delete(userConnections, userID)
-		connectionsMutex.Unlock()
-		conn.Close()
-		return false
-	}
-
-	return true
+	return deliver(channelNotifications, userID, wsresume.Remote.Publish(userID, msgData))
 }
 
 // 发送通知
 func (l *RemoteWsLogic) SendNotification(req *SendNotificationReq) bool {
-	return l.sendToUser(req.UserID, map[string]interface{}{
+	return l.publishToUser(req.UserID, map[string]interface{}{
 		"type": req.Type,
 		"data": req.Data,
 	})
@@ -230,7 +139,7 @@ func (l *RemoteWsLogic) SendNotification(req *SendNotificationReq) bool {
 func (l *RemoteWsLogic) SendBatchNotification(req *SendBatchNotificationReq) int {
 	successCount := 0
 	for _, userID := range req.UserIDs {
-		if l.sendToUser(userID, map[string]interface{}{
+		if l.publishToUser(userID, map[string]interface{}{
 			"type": req.Type,
 			"data": req.Data,
 		}) {
@@ -240,20 +149,3 @@ func (l *RemoteWsLogic) SendBatchNotification(req *SendBatchNotificationReq) int
 	return successCount
 }
 
-// 广播通知
-func (l *RemoteWsLogic) BroadcastNotification(req *BroadcastNotificationReq) int {
-	successCount := 0
-	connectionsMutex.RLock()
-	for userID := range userConnections {
-		connectionsMutex.RUnlock()
-		if l.sendToUser(userID, map[string]interface{}{
-			"type": req.Type,
-			"data": req.Data,
-		}) {
-			successCount++
-		}
-		connectionsMutex.RLock()
-	}
-	connectionsMutex.RUnlock()
-	return successCount
-}
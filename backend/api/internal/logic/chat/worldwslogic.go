@@ -11,9 +11,7 @@ import (
 	"time"
 
 	"backend/api/internal/svc"
-	"backend/utils"
 
-	"github.com/gorilla/websocket"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
@@ -23,9 +21,9 @@ var (
 )
 
 type worldMember struct {
-	writeMu sync.Mutex // gorilla/websocket：同一 Conn 禁止并发 WriteMessage
-	conn    *websocket.Conn
-	x, y    float64
+	session  *wsSession // 写入经由会话串行化（gorilla/websocket：同一 Conn 禁止并发 WriteMessage）
+	channel  string     // world:<room>，网关连接的下行帧据此带上 channel 字段
+	x, y     float64
 	username string
 	// lastMoveBroadcast：节流对外广播；m.x/m.y 仍每次更新供新加入者读快照
 	lastMoveBroadcast time.Time
@@ -44,20 +42,10 @@ func (m *worldMember) writeJSON(msg interface{}) bool {
 }
 
 func (m *worldMember) writeText(data []byte) bool {
-	if m == nil {
-		return false
-	}
-	m.writeMu.Lock()
-	defer m.writeMu.Unlock()
-	if m.conn == nil {
+	if m == nil || m.session == nil {
 		return false
 	}
-	_ = m.conn.SetWriteDeadline(time.Now().Add(8 * time.Second))
-	if err := m.conn.WriteMessage(websocket.TextMessage, data); err != nil {
-		logx.Errorf("world ws write: %v", err)
-		return false
-	}
-	return true
+	return m.session.writeFrame(m.channel, data)
 }
 
 var worldRoomPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,48}$`)
@@ -127,7 +115,7 @@ func worldBroadcast(roomID string, excludeUserID string, msg interface{}) {
 		if excludeUserID != "" && uid == excludeUserID {
 			continue
 		}
-		if m != nil && m.conn != nil {
+		if m != nil && m.session != nil {
 			recipients = append(recipients, m)
 		}
 	}
@@ -137,80 +125,66 @@ func worldBroadcast(roomID string, excludeUserID string, msg interface{}) {
 	}
 }
 
-func worldMemberLookup(roomID, userID string) *worldMember {
-	worldRoomsMutex.RLock()
-	defer worldRoomsMutex.RUnlock()
-	if room, ok := worldRooms[roomID]; ok {
-		return room[userID]
-	}
-	return nil
-}
-
-func worldLeaveRoom(roomID, userID string) {
+// worldLeaveRoom 仅当房间里登记的仍是 s 时移除（被同一用户新连接顶替后不误删新成员）
+func worldLeaveRoom(roomID string, s *wsSession) bool {
 	worldRoomsMutex.Lock()
 	defer worldRoomsMutex.Unlock()
 	room, ok := worldRooms[roomID]
 	if !ok {
-		return
+		return false
 	}
-	m := room[userID]
-	delete(room, userID)
+	m := room[s.userID]
+	if m == nil || m.session != s {
+		return false
+	}
+	delete(room, s.userID)
 	if len(room) == 0 {
 		delete(worldRooms, roomID)
 	}
-	if m != nil && m.conn != nil {
-		m.writeMu.Lock()
-		_ = m.conn.Close()
-		m.conn = nil
-		m.writeMu.Unlock()
-	}
+	return true
 }
 
 func (l *WorldWsLogic) WorldWs() error {
-	r, ok := l.ctx.Value("http.Request").(*http.Request)
+	// 兼容旧端点：等价于连上 /ws 网关后只订阅 world:<room> 频道
+	r, w, ok := wsRequestFromContext(l.ctx)
 	if !ok {
 		return nil
 	}
-
-	w, ok := l.ctx.Value("http.ResponseWriter").(*http.ResponseWriter)
+	userID, sessionID, ok := wsAuthUserID(l.svcCtx, w, r)
 	if !ok {
 		return nil
 	}
 
-	token := r.Header.Get("Authorization")
-	if token == "" {
-		token = r.URL.Query().Get("token")
-		if token == "" {
-			http.Error(*w, "Unauthorized", http.StatusUnauthorized)
-			return nil
-		}
-	} else if strings.HasPrefix(token, "Bearer ") {
-		token = strings.TrimPrefix(token, "Bearer ")
-	}
-
-	claims, err := utils.ParseToken(token)
-	if err != nil {
-		http.Error(*w, "Invalid token", http.StatusUnauthorized)
-		return nil
-	}
-
-	userID := fmt.Sprintf("%d", claims.UserID)
 	roomID := strings.TrimSpace(r.URL.Query().Get("room"))
 	if roomID == "" {
 		roomID = "default"
 	}
 	if !worldRoomPattern.MatchString(roomID) {
-		http.Error(*w, "Invalid room", http.StatusBadRequest)
+		http.Error(w, "Invalid room", http.StatusBadRequest)
 		return nil
 	}
 
-	conn, err := upgrader.Upgrade(*w, r, nil)
+	channel := channelWorldPrefix + roomID
+	s, err := upgradeWsSession(l.ctx, l.svcCtx, w, r, userID, sessionID, channel)
 	if err != nil {
 		l.Logger.Errorf("world ws upgrade: %v", err)
 		return nil
 	}
+	if err := s.subscribe(channel, 0); err != nil {
+		s.close()
+		return nil
+	}
+
+	go s.serve(75 * time.Second)
 
+	return nil
+}
+
+// join 加入房间：同一用户在该房间的旧连接被顶替，下发 world_welcome 并通知其他成员
+func (l *WorldWsLogic) join(s *wsSession, roomID string) bool {
+	userID := s.userID
 	sx, sy := worldPickSpawn(userID)
+	member := &worldMember{session: s, channel: channelWorldPrefix + roomID, x: sx, y: sy, username: ""}
 
 	worldRoomsMutex.Lock()
 	room := worldRooms[roomID]
@@ -218,16 +192,15 @@ func (l *WorldWsLogic) WorldWs() error {
 		room = make(map[string]*worldMember)
 		worldRooms[roomID] = room
 	}
-	if old, exists := room[userID]; exists && old != nil && old.conn != nil {
-		old.writeMu.Lock()
-		_ = old.conn.Close()
-		old.conn = nil
-		old.writeMu.Unlock()
-	}
-	member := &worldMember{conn: conn, x: sx, y: sy, username: ""}
+	old := room[userID]
 	room[userID] = member
 	worldRoomsMutex.Unlock()
 
+	// 在锁外踢掉旧连接：kick 会回调 leave，而 leave 需要 worldRoomsMutex
+	if old != nil && old.session != nil && old.session != s {
+		old.session.kick(old.channel)
+	}
+
 	peers := make([]map[string]interface{}, 0)
 	worldRoomsMutex.RLock()
 	if rmap, ok := worldRooms[roomID]; ok {
@@ -253,8 +226,7 @@ func (l *WorldWsLogic) WorldWs() error {
 		"y":       sy,
 		"peers":   peers,
 	}) {
-		worldLeaveRoom(roomID, userID)
-		return nil
+		return false
 	}
 
 	worldBroadcast(roomID, userID, map[string]interface{}{
@@ -264,42 +236,23 @@ func (l *WorldWsLogic) WorldWs() error {
 		"y":        sy,
 		"username": "",
 	})
-
-	go l.handleConnection(roomID, userID, conn)
-
-	return nil
+	return true
 }
 
-func (l *WorldWsLogic) handleConnection(roomID, userID string, conn *websocket.Conn) {
-	defer func() {
-		worldLeaveRoom(roomID, userID)
-		l.Logger.Infof("World ws user %s left room %s", userID, roomID)
-		worldBroadcast(roomID, "", map[string]interface{}{
-			"type":    "world_peer_left",
-			"user_id": userID,
-		})
-	}()
-
-	conn.SetReadDeadline(time.Now().Add(75 * time.Second))
-	conn.SetPongHandler(func(string) error {
-		conn.SetReadDeadline(time.Now().Add(75 * time.Second))
-		return nil
-	})
-
-	for {
-		_, message, err := conn.ReadMessage()
-		if err != nil {
-			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
-				l.Logger.Errorf("World ws read: %v", err)
-			}
-			break
-		}
-		conn.SetReadDeadline(time.Now().Add(75 * time.Second))
-		l.handleMessage(roomID, userID, message)
+// leave 离开房间并通知其他成员
+func (l *WorldWsLogic) leave(s *wsSession, roomID string) {
+	if !worldLeaveRoom(roomID, s) {
+		return
 	}
+	l.Logger.Infof("World ws user %s left room %s", s.userID, roomID)
+	worldBroadcast(roomID, "", map[string]interface{}{
+		"type":    "world_peer_left",
+		"user_id": s.userID,
+	})
 }
 
-func (l *WorldWsLogic) handleMessage(roomID, userID string, message []byte) {
+func (l *WorldWsLogic) handleMessage(s *wsSession, roomID string, message []byte) {
+	userID := s.userID
 	var msg map[string]interface{}
 	if err := json.Unmarshal(message, &msg); err != nil {
 		return
@@ -310,9 +263,7 @@ func (l *WorldWsLogic) handleMessage(roomID, userID string, message []byte) {
 	}
 	switch msgType {
 	case "ping":
-		if m := worldMemberLookup(roomID, userID); m != nil {
-			m.writeJSON(map[string]interface{}{"type": "pong"})
-		}
+		s.writeJSON(channelWorldPrefix+roomID, map[string]interface{}{"type": "pong"})
 	case "world_move":
 		x, xok := toFloat(msg["x"])
 		y, yok := toFloat(msg["y"])
@@ -0,0 +1,500 @@
+package chat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"backend/api/internal/chathub"
+	"backend/api/internal/svc"
+	"backend/api/internal/wsresume"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/gorilla/websocket"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 频道名：/ws 网关按频道订阅，旧端点各自固定一个频道
+const (
+	channelChat          = "chat"
+	channelPresence      = "presence"
+	channelNotifications = "notifications"
+	channelWorldPrefix   = "world:" // world:<room>
+)
+
+// 单个连接最多同时订阅的频道数（world 房间可能很多，避免无限订阅）
+const maxChannelsPerSession = 16
+
+var (
+	errUnknownChannel   = errors.New("未知频道")
+	errInvalidRoom      = errors.New("房间名不合法")
+	errTooManyChannels  = errors.New("订阅频道过多")
+	errAlreadySubscribe = errors.New("已订阅该频道")
+)
+
+// 频道订阅表：channel -> userID -> 会话集合（同一用户可多端、多连接同时在线）
+var (
+	channelSubsMutex sync.RWMutex
+	channelSubs      = make(map[string]map[string]map[*wsSession]struct{})
+)
+
+// wsSession 一条物理 WebSocket 连接。
+// 旧端点（/ws/chat 等）只订阅 legacy 指定的一个频道，下行帧保持原格式；
+// /ws 网关连接 legacy 为空，可订阅多个频道，下行帧开头带 "channel" 字段。
+type wsSession struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	userID string
+	conn   *websocket.Conn
+	legacy string
+	// sessionID 连接所用令牌的登录会话；会话撤销时由 chathub.DefaultSessions 断开连接
+	sessionID uint
+
+	writeMu sync.Mutex // gorilla/websocket：同一 Conn 禁止并发 WriteMessage
+
+	mu   sync.Mutex
+	subs map[string]struct{}
+}
+
+// wsRequestFromContext 取出 handler 放进上下文的 HTTP 请求与响应
+func wsRequestFromContext(ctx context.Context) (*http.Request, http.ResponseWriter, bool) {
+	r, ok := ctx.Value("http.Request").(*http.Request)
+	if !ok {
+		return nil, nil, false
+	}
+	w, ok := ctx.Value("http.ResponseWriter").(*http.ResponseWriter)
+	if !ok {
+		return nil, nil, false
+	}
+	return r, *w, true
+}
+
+// wsAuthUserID 鉴权顺序：?ticket=（一次性票据，须与当前端点一致）> Authorization 头（Bearer JWT）> ?token=（JWT，可由配置关闭）。
+// 同时返回令牌所属的登录会话（旧版令牌为 0）。失败时直接写 401，兑换票据时 RPC 故障写 503
+func wsAuthUserID(svcCtx *svc.ServiceContext, w http.ResponseWriter, r *http.Request) (string, uint, bool) {
+	if t := r.URL.Query().Get("ticket"); t != "" {
+		resp, err := svcCtx.SuperRpcClient.RedeemWsTicket(r.Context(), &super.RedeemWsTicketReq{Ticket: t, Endpoint: r.URL.Path})
+		if status.Code(err) == codes.InvalidArgument {
+			http.Error(w, "Invalid ticket", http.StatusUnauthorized)
+			return "", 0, false
+		}
+		if err != nil {
+			logx.WithContext(r.Context()).Errorf("Redeem ws ticket failed: %v", err)
+			http.Error(w, "Service unavailable", http.StatusServiceUnavailable)
+			return "", 0, false
+		}
+		sessionID, _ := strconv.ParseUint(resp.SessionId, 10, 32)
+		return resp.UserId, uint(sessionID), true
+	}
+
+	token := r.Header.Get("Authorization")
+	if token == "" {
+		token = r.URL.Query().Get("token")
+		if token == "" {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return "", 0, false
+		}
+		if svcCtx.Config.WebSocket.RejectQueryToken {
+			http.Error(w, "Query token disabled, use ticket", http.StatusUnauthorized)
+			return "", 0, false
+		}
+	} else {
+		token = strings.TrimPrefix(token, "Bearer ")
+	}
+
+	claims, err := utils.ParseToken(token)
+	if err != nil {
+		http.Error(w, "Invalid token", http.StatusUnauthorized)
+		return "", 0, false
+	}
+	return fmt.Sprintf("%d", claims.UserID), claims.SessionID, true
+}
+
+// upgradeWsSession 升级为 WebSocket 并创建会话；legacy 为空表示网关连接
+func upgradeWsSession(ctx context.Context, svcCtx *svc.ServiceContext, w http.ResponseWriter, r *http.Request, userID string, sessionID uint, legacy string) (*wsSession, error) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &wsSession{
+		Logger:    logx.WithContext(ctx),
+		ctx:       ctx,
+		svcCtx:    svcCtx,
+		userID:    userID,
+		conn:      conn,
+		legacy:    legacy,
+		sessionID: sessionID,
+		subs:      make(map[string]struct{}),
+	}
+	if uid, err := strconv.ParseUint(userID, 10, 32); err == nil {
+		chathub.DefaultSessions.Add(uint(uid), sessionID, conn)
+	}
+	return s, nil
+}
+
+// writeRaw 所有写入的唯一入口
+func (s *wsSession) writeRaw(data []byte) bool {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_ = s.conn.SetWriteDeadline(time.Now().Add(8 * time.Second))
+	if err := s.conn.WriteMessage(websocket.TextMessage, data); err != nil {
+		s.Logger.Errorf("Error writing to ws session of %s: %v", s.userID, err)
+		return false
+	}
+	return true
+}
+
+// writeFrame 写一帧频道消息；网关连接补上 channel 字段
+func (s *wsSession) writeFrame(channel string, data []byte) bool {
+	if s.legacy == "" && channel != "" {
+		data = withChannel(data, channel)
+	}
+	return s.writeRaw(data)
+}
+
+func (s *wsSession) writeJSON(channel string, v interface{}) bool {
+	data, err := json.Marshal(v)
+	if err != nil {
+		s.Logger.Errorf("Error marshaling ws message: %v", err)
+		return false
+	}
+	return s.writeFrame(channel, data)
+}
+
+// close 关闭底层连接；读循环随之退出并清理订阅
+func (s *wsSession) close() {
+	if uid, err := strconv.ParseUint(s.userID, 10, 32); err == nil {
+		chathub.DefaultSessions.Remove(uint(uid), s.conn)
+	}
+	_ = s.conn.Close()
+}
+
+func (s *wsSession) subscribed(channel string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.subs[channel]
+	return ok
+}
+
+// subscribe 订阅频道；chat 与 notifications 支持 resumeFrom 断线补发（见 wsresume）
+func (s *wsSession) subscribe(channel string, resumeFrom uint64) error {
+	roomID, isWorld := strings.CutPrefix(channel, channelWorldPrefix)
+	switch {
+	case channel == channelChat, channel == channelPresence, channel == channelNotifications:
+	case isWorld:
+		if !worldRoomPattern.MatchString(roomID) {
+			return errInvalidRoom
+		}
+	default:
+		return errUnknownChannel
+	}
+
+	s.mu.Lock()
+	if _, ok := s.subs[channel]; ok {
+		s.mu.Unlock()
+		return errAlreadySubscribe
+	}
+	if len(s.subs) >= maxChannelsPerSession {
+		s.mu.Unlock()
+		return errTooManyChannels
+	}
+	s.subs[channel] = struct{}{}
+	s.mu.Unlock()
+
+	switch {
+	case channel == channelChat:
+		s.attachResumable(wsresume.Chat, channel, resumeFrom)
+	case channel == channelNotifications:
+		s.attachResumable(wsresume.Remote, channel, resumeFrom)
+	case channel == channelPresence:
+		addChannelSub(channel, s)
+		NewPresenceWsLogic(s.ctx, s.svcCtx).join(s)
+	case isWorld:
+		addChannelSub(channel, s)
+		if !NewWorldWsLogic(s.ctx, s.svcCtx).join(s, roomID) {
+			s.unsubscribe(channel)
+			return errors.New("加入房间失败")
+		}
+	}
+	return nil
+}
+
+// attachResumable 先回放错过的事件再登记订阅，保证新事件不会插到回放内容之前
+func (s *wsSession) attachResumable(stream *wsresume.Stream, channel string, resumeFrom uint64) {
+	stream.Attach(s.userID, resumeFrom, func(missed [][]byte, latest uint64, ok bool) {
+		if !ok {
+			s.writeJSON(channel, map[string]interface{}{
+				"type":       "resync_required",
+				"latest_seq": latest,
+			})
+		} else if resumeFrom > 0 {
+			for _, data := range missed {
+				s.writeFrame(channel, data)
+			}
+			s.writeJSON(channel, map[string]interface{}{
+				"type":       "resumed",
+				"replayed":   len(missed),
+				"latest_seq": latest,
+			})
+		}
+		addChannelSub(channel, s)
+	})
+}
+
+// unsubscribe 退订频道并执行该频道的离开逻辑；未订阅时返回 false
+func (s *wsSession) unsubscribe(channel string) bool {
+	s.mu.Lock()
+	if _, ok := s.subs[channel]; !ok {
+		s.mu.Unlock()
+		return false
+	}
+	delete(s.subs, channel)
+	s.mu.Unlock()
+
+	removeChannelSub(channel, s)
+	switch {
+	case channel == channelChat:
+		// 该用户已没有任何聊天连接时才退出匹配队列
+		if len(channelSessions(channel, s.userID)) == 0 {
+			TryMatchCancel(s.userID)
+		}
+	case channel == channelPresence:
+		NewPresenceWsLogic(s.ctx, s.svcCtx).leave(s)
+	case strings.HasPrefix(channel, channelWorldPrefix):
+		NewWorldWsLogic(s.ctx, s.svcCtx).leave(s, strings.TrimPrefix(channel, channelWorldPrefix))
+	}
+	return true
+}
+
+// kick 被同一用户的新连接顶替时调用：网关连接收到 unsubscribed，旧端点连接直接关闭
+func (s *wsSession) kick(channel string) {
+	if !s.unsubscribe(channel) {
+		return
+	}
+	if s.legacy != "" {
+		s.close()
+		return
+	}
+	s.writeJSON("", map[string]interface{}{
+		"type":    "unsubscribed",
+		"channel": channel,
+		"reason":  "replaced",
+	})
+}
+
+// dispatch 把客户端上行消息交给对应频道处理
+func (s *wsSession) dispatch(channel string, message []byte) {
+	switch {
+	case channel == channelChat:
+		NewChatWsLogic(s.ctx, s.svcCtx).handleMessage(s, message)
+	case channel == channelPresence:
+		NewPresenceWsLogic(s.ctx, s.svcCtx).handleMessage(s, message)
+	case channel == channelNotifications:
+		NewRemoteWsLogic(s.ctx, s.svcCtx).handleMessage(s, message)
+	case strings.HasPrefix(channel, channelWorldPrefix):
+		NewWorldWsLogic(s.ctx, s.svcCtx).handleMessage(s, strings.TrimPrefix(channel, channelWorldPrefix), message)
+	}
+}
+
+// serve 读循环：心跳续期、按频道分发；退出时退订全部频道并关闭连接
+func (s *wsSession) serve(readTimeout time.Duration) {
+	defer func() {
+		s.mu.Lock()
+		channels := make([]string, 0, len(s.subs))
+		for ch := range s.subs {
+			channels = append(channels, ch)
+		}
+		s.mu.Unlock()
+		for _, ch := range channels {
+			s.unsubscribe(ch)
+		}
+		s.close()
+		s.Logger.Infof("WebSocket user %s disconnected (%s)", s.userID, s.describe())
+	}()
+
+	s.conn.SetReadDeadline(time.Now().Add(readTimeout))
+	s.conn.SetPongHandler(func(string) error {
+		s.conn.SetReadDeadline(time.Now().Add(readTimeout))
+		return nil
+	})
+
+	for {
+		_, message, err := s.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
+				s.Logger.Errorf("WebSocket error: %v", err)
+			}
+			return
+		}
+		s.conn.SetReadDeadline(time.Now().Add(readTimeout))
+		// 只记录帧大小：消息内容一律不进日志
+		s.Logger.Debugf("Received ws frame from %s (%d bytes)", s.userID, len(message))
+
+		if s.legacy != "" {
+			s.dispatch(s.legacy, message)
+			continue
+		}
+		s.handleGatewayFrame(message)
+	}
+}
+
+// gatewayFrame 网关上行帧的公共字段；其余字段由各频道自行解析
+type gatewayFrame struct {
+	Type       string          `json:"type"`
+	Channel    string          `json:"channel"`
+	ResumeFrom json.RawMessage `json:"resume_from"`
+}
+
+// handleGatewayFrame 不带 channel 的是网关控制消息（subscribe / unsubscribe / ping），其余按 channel 路由
+func (s *wsSession) handleGatewayFrame(message []byte) {
+	var frame gatewayFrame
+	if err := json.Unmarshal(message, &frame); err != nil || frame.Type == "" {
+		s.writeJSON("", map[string]interface{}{"type": "error", "message": "消息格式错误"})
+		return
+	}
+
+	if frame.Channel != "" && frame.Type != "subscribe" && frame.Type != "unsubscribe" {
+		if !s.subscribed(frame.Channel) {
+			s.writeJSON("", map[string]interface{}{
+				"type":    "error",
+				"channel": frame.Channel,
+				"message": "未订阅该频道",
+			})
+			return
+		}
+		s.dispatch(frame.Channel, message)
+		return
+	}
+
+	switch frame.Type {
+	case "ping":
+		s.writeJSON("", map[string]interface{}{"type": "pong"})
+	case "subscribe":
+		resumeFrom := wsresume.ParseResumeFrom(strings.Trim(string(frame.ResumeFrom), `"`))
+		if err := s.subscribe(frame.Channel, resumeFrom); err != nil {
+			s.writeJSON("", map[string]interface{}{
+				"type":    "error",
+				"channel": frame.Channel,
+				"message": err.Error(),
+			})
+			return
+		}
+		s.writeJSON("", map[string]interface{}{
+			"type":    "subscribed",
+			"channel": frame.Channel,
+		})
+	case "unsubscribe":
+		s.unsubscribe(frame.Channel)
+		s.writeJSON("", map[string]interface{}{
+			"type":    "unsubscribed",
+			"channel": frame.Channel,
+		})
+	default:
+		s.writeJSON("", map[string]interface{}{"type": "error", "message": "未知消息类型"})
+	}
+}
+
+func (s *wsSession) describe() string {
+	if s.legacy != "" {
+		return s.legacy
+	}
+	return "gateway"
+}
+
+func addChannelSub(channel string, s *wsSession) {
+	channelSubsMutex.Lock()
+	defer channelSubsMutex.Unlock()
+	users, ok := channelSubs[channel]
+	if !ok {
+		users = make(map[string]map[*wsSession]struct{})
+		channelSubs[channel] = users
+	}
+	set, ok := users[s.userID]
+	if !ok {
+		set = make(map[*wsSession]struct{})
+		users[s.userID] = set
+	}
+	set[s] = struct{}{}
+}
+
+func removeChannelSub(channel string, s *wsSession) {
+	channelSubsMutex.Lock()
+	defer channelSubsMutex.Unlock()
+	users, ok := channelSubs[channel]
+	if !ok {
+		return
+	}
+	set, ok := users[s.userID]
+	if !ok {
+		return
+	}
+	delete(set, s)
+	if len(set) == 0 {
+		delete(users, s.userID)
+	}
+	if len(users) == 0 {
+		delete(channelSubs, channel)
+	}
+}
+
+func channelSessions(channel, userID string) []*wsSession {
+	channelSubsMutex.RLock()
+	defer channelSubsMutex.RUnlock()
+	set := channelSubs[channel][userID]
+	out := make([]*wsSession, 0, len(set))
+	for s := range set {
+		out = append(out, s)
+	}
+	return out
+}
+
+// channelUserIDs 当前订阅了该频道的用户（已排序，便于稳定输出）
+func channelUserIDs(channel string) []string {
+	channelSubsMutex.RLock()
+	ids := make([]string, 0, len(channelSubs[channel]))
+	for id := range channelSubs[channel] {
+		ids = append(ids, id)
+	}
+	channelSubsMutex.RUnlock()
+	sort.Strings(ids)
+	return ids
+}
+
+// deliver 把一帧发给该用户订阅了此频道的所有连接；任一连接写成功即返回 true，写失败的连接被关闭
+func deliver(channel, userID string, data []byte) bool {
+	delivered := false
+	for _, s := range channelSessions(channel, userID) {
+		if s.writeFrame(channel, data) {
+			delivered = true
+		} else {
+			s.close()
+		}
+	}
+	return delivered
+}
+
+// withChannel 在 JSON 对象开头插入 "channel" 字段（网关连接用来区分频道）
+func withChannel(data []byte, channel string) []byte {
+	if len(data) < 2 || data[0] != '{' {
+		return data
+	}
+	name, _ := json.Marshal(channel)
+	out := make([]byte, 0, len(data)+len(name)+12)
+	out = append(out, `{"channel":`...)
+	out = append(out, name...)
+	if data[1] != '}' {
+		out = append(out, ',')
+	}
+	return append(out, data[1:]...)
+}
@@ -25,11 +25,17 @@ func NewCreateCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Cre
 	}
 }
 
+// CreateComment 以登录用户身份评论；请求体中的 user_id 已弃用并被忽略
 func (l *CreateCommentLogic) CreateComment(req *types.CreateCommentReq) (resp *types.CreateCommentResp, err error) {
+	userID, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.CreateCommentResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
 	// 调用RPC服务创建评论
 	rpcResp, err := l.svcCtx.SuperRpcClient.CreateComment(l.ctx, &super.CreateCommentReq{
 		PostId:  req.PostId,
-		UserId:  req.UserId,
+		UserId:  userID,
 		Content: req.Content,
 	})
 	if err != nil {
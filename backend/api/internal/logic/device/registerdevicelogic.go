@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package device
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RegisterDeviceLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRegisterDeviceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RegisterDeviceLogic {
+	return &RegisterDeviceLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RegisterDeviceLogic) RegisterDevice(req *types.RegisterDeviceReq) (resp *types.BaseResp, err error) {
+	me, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		r := common.UnauthorizedResp()
+		return &r, nil
+	}
+
+	_, err = l.svcCtx.SuperRpcClient.RegisterDevice(l.ctx, &super.RegisterDeviceReq{
+		UserId:     me,
+		Token:      req.Token,
+		Platform:   req.Platform,
+		Provider:   req.Provider,
+		AppVersion: req.AppVersion,
+	})
+	r := common.HandleRPCError(err, "设备已注册")
+	return &r, nil
+}
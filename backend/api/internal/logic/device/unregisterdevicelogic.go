@@ -0,0 +1,44 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package device
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnregisterDeviceLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUnregisterDeviceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnregisterDeviceLogic {
+	return &UnregisterDeviceLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UnregisterDeviceLogic) UnregisterDevice(req *types.UnregisterDeviceReq) (resp *types.BaseResp, err error) {
+	me, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		r := common.UnauthorizedResp()
+		return &r, nil
+	}
+
+	_, err = l.svcCtx.SuperRpcClient.UnregisterDevice(l.ctx, &super.UnregisterDeviceReq{
+		UserId: me,
+		Token:  req.Token,
+	})
+	r := common.HandleRPCError(err, "设备已注销")
+	return &r, nil
+}
@@ -0,0 +1,50 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package e2ee
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AckEncryptedMessagesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAckEncryptedMessagesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AckEncryptedMessagesLogic {
+	return &AckEncryptedMessagesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AckEncryptedMessagesLogic) AckEncryptedMessages(req *types.AckEncryptedMessagesReq) (resp *types.AckEncryptedMessagesResp, err error) {
+	me, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.AckEncryptedMessagesResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.AckEncryptedMessages(l.ctx, &super.AckEncryptedMessagesReq{
+		ActorUserId: me,
+		DeviceId:    req.DeviceId,
+		Ids:         req.Ids,
+	})
+	if err != nil {
+		return &types.AckEncryptedMessagesResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	return &types.AckEncryptedMessagesResp{
+		BaseResp: common.HandleRPCError(nil, "ok"),
+		Data:     int(rpcResp.Acked),
+	}, nil
+}
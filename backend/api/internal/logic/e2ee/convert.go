@@ -0,0 +1,42 @@
+package e2ee
+
+import (
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+)
+
+func signedPreKeyToRpc(k *types.SignedPreKey) *super.SignedPreKey {
+	return &super.SignedPreKey{
+		KeyId:     k.KeyId,
+		PublicKey: k.PublicKey,
+		Signature: k.Signature,
+	}
+}
+
+func signedPreKeyFromRpc(k *super.SignedPreKey) types.SignedPreKey {
+	if k == nil {
+		return types.SignedPreKey{}
+	}
+	return types.SignedPreKey{
+		KeyId:     k.KeyId,
+		PublicKey: k.PublicKey,
+		Signature: k.Signature,
+	}
+}
+
+// EncryptedMessageFromRpc 供 HTTP 补拉与 /ws/chat 实时转发共用同一 JSON 结构
+func EncryptedMessageFromRpc(m *super.EncryptedMessage) types.EncryptedMessage {
+	if m == nil {
+		return types.EncryptedMessage{}
+	}
+	return types.EncryptedMessage{
+		Id:                m.Id,
+		SenderId:          m.SenderId,
+		SenderDeviceId:    m.SenderDeviceId,
+		RecipientId:       m.RecipientId,
+		RecipientDeviceId: m.RecipientDeviceId,
+		MessageType:       int(m.MessageType),
+		Ciphertext:        m.Ciphertext,
+		CreatedAt:         m.CreatedAt,
+	}
+}
@@ -0,0 +1,55 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package e2ee
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPendingEncryptedMessagesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetPendingEncryptedMessagesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPendingEncryptedMessagesLogic {
+	return &GetPendingEncryptedMessagesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPendingEncryptedMessagesLogic) GetPendingEncryptedMessages(req *types.GetPendingEncryptedMessagesReq) (resp *types.GetPendingEncryptedMessagesResp, err error) {
+	me, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.GetPendingEncryptedMessagesResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.ListPendingEncryptedMessages(l.ctx, &super.ListPendingEncryptedMessagesReq{
+		ActorUserId: me,
+		DeviceId:    req.DeviceId,
+		Limit:       int32(req.Limit),
+	})
+	if err != nil {
+		return &types.GetPendingEncryptedMessagesResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	msgs := make([]types.EncryptedMessage, 0, len(rpcResp.Messages))
+	for _, m := range rpcResp.Messages {
+		msgs = append(msgs, EncryptedMessageFromRpc(m))
+	}
+
+	return &types.GetPendingEncryptedMessagesResp{
+		BaseResp: common.HandleRPCError(nil, "ok"),
+		Data:     msgs,
+	}, nil
+}
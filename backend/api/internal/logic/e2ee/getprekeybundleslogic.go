@@ -0,0 +1,65 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package e2ee
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPreKeyBundlesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetPreKeyBundlesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPreKeyBundlesLogic {
+	return &GetPreKeyBundlesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPreKeyBundlesLogic) GetPreKeyBundles(req *types.GetPreKeyBundlesReq) (resp *types.GetPreKeyBundlesResp, err error) {
+	me, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.GetPreKeyBundlesResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetPreKeyBundles(l.ctx, &super.GetPreKeyBundlesReq{
+		ActorUserId: me,
+		UserId:      req.UserId,
+		DeviceId:    req.DeviceId,
+	})
+	if err != nil {
+		return &types.GetPreKeyBundlesResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	bundles := make([]types.PreKeyBundle, 0, len(rpcResp.Bundles))
+	for _, b := range rpcResp.Bundles {
+		item := types.PreKeyBundle{
+			UserId:       b.UserId,
+			DeviceId:     b.DeviceId,
+			IdentityKey:  b.IdentityKey,
+			SignedPreKey: signedPreKeyFromRpc(b.SignedPreKey),
+		}
+		if b.OneTimePreKey != nil {
+			otk := signedPreKeyFromRpc(b.OneTimePreKey)
+			item.OneTimePreKey = &otk
+		}
+		bundles = append(bundles, item)
+	}
+
+	return &types.GetPreKeyBundlesResp{
+		BaseResp: common.HandleRPCError(nil, "ok"),
+		Data:     bundles,
+	}, nil
+}
@@ -0,0 +1,58 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package e2ee
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UploadPreKeyBundleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUploadPreKeyBundleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UploadPreKeyBundleLogic {
+	return &UploadPreKeyBundleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UploadPreKeyBundleLogic) UploadPreKeyBundle(req *types.UploadPreKeyBundleReq) (resp *types.UploadPreKeyBundleResp, err error) {
+	me, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.UploadPreKeyBundleResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	otks := make([]*super.SignedPreKey, 0, len(req.OneTimePreKeys))
+	for i := range req.OneTimePreKeys {
+		otks = append(otks, signedPreKeyToRpc(&req.OneTimePreKeys[i]))
+	}
+	rpcResp, err := l.svcCtx.SuperRpcClient.UploadPreKeyBundle(l.ctx, &super.UploadPreKeyBundleReq{
+		ActorUserId:    me,
+		DeviceId:       req.DeviceId,
+		IdentityKey:    req.IdentityKey,
+		SignedPreKey:   signedPreKeyToRpc(&req.SignedPreKey),
+		OneTimePreKeys: otks,
+	})
+	if err != nil {
+		return &types.UploadPreKeyBundleResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	return &types.UploadPreKeyBundleResp{
+		BaseResp: common.HandleRPCError(nil, "公钥已更新"),
+		Data: types.UploadPreKeyBundleData{
+			RemainingOneTimePreKeys: int(rpcResp.RemainingOneTimePreKeys),
+		},
+	}, nil
+}
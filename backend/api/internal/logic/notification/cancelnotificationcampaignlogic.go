@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package notification
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelNotificationCampaignLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCancelNotificationCampaignLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelNotificationCampaignLogic {
+	return &CancelNotificationCampaignLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CancelNotificationCampaignLogic) CancelNotificationCampaign(req *types.NotificationCampaignPathReq) (resp *types.NotificationCampaignResp, err error) {
+	me, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.NotificationCampaignResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.CancelNotificationCampaign(l.ctx, &super.GetNotificationCampaignReq{
+		ActorUserId: me,
+		Id:          req.Id,
+	})
+	if err != nil {
+		return &types.NotificationCampaignResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	return &types.NotificationCampaignResp{
+		BaseResp: common.HandleRPCError(nil, "推送活动已取消"),
+		Data:     campaignFromRpc(rpcResp.Campaign),
+	}, nil
+}
@@ -0,0 +1,75 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package notification
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateNotificationCampaignLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCreateNotificationCampaignLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateNotificationCampaignLogic {
+	return &CreateNotificationCampaignLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateNotificationCampaignLogic) CreateNotificationCampaign(req *types.CreateNotificationCampaignReq) (resp *types.NotificationCampaignResp, err error) {
+	me, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.NotificationCampaignResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.CreateNotificationCampaign(l.ctx, &super.CreateNotificationCampaignReq{
+		ActorUserId:  me,
+		Title:        req.Title,
+		Content:      req.Content,
+		Segment:      req.Segment,
+		MinLevel:     int32(req.MinLevel),
+		InactiveDays: int32(req.InactiveDays),
+		ScheduledAt:  req.ScheduledAt,
+	})
+	if err != nil {
+		return &types.NotificationCampaignResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	return &types.NotificationCampaignResp{
+		BaseResp: common.HandleRPCError(nil, "推送活动已创建"),
+		Data:     campaignFromRpc(rpcResp.Campaign),
+	}, nil
+}
+
+func campaignFromRpc(c *super.NotificationCampaign) types.NotificationCampaign {
+	return types.NotificationCampaign{
+		Id:           c.GetId(),
+		CreatorId:    c.GetCreatorId(),
+		Title:        c.GetTitle(),
+		Content:      c.GetContent(),
+		Segment:      c.GetSegment(),
+		MinLevel:     int(c.GetMinLevel()),
+		InactiveDays: int(c.GetInactiveDays()),
+		ScheduledAt:  c.GetScheduledAt(),
+		Status:       c.GetStatus(),
+		Error:        c.GetError(),
+		Recipients:   int(c.GetRecipients()),
+		Delivered:    int(c.GetDelivered()),
+		Read:         int(c.GetRead()),
+		StartedAt:    c.GetStartedAt(),
+		CompletedAt:  c.GetCompletedAt(),
+		CreatedAt:    c.GetCreatedAt(),
+	}
+}
@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package notification
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetNotificationCampaignLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetNotificationCampaignLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetNotificationCampaignLogic {
+	return &GetNotificationCampaignLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetNotificationCampaignLogic) GetNotificationCampaign(req *types.NotificationCampaignPathReq) (resp *types.NotificationCampaignResp, err error) {
+	me, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.NotificationCampaignResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetNotificationCampaign(l.ctx, &super.GetNotificationCampaignReq{
+		ActorUserId: me,
+		Id:          req.Id,
+	})
+	if err != nil {
+		return &types.NotificationCampaignResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	return &types.NotificationCampaignResp{
+		BaseResp: common.HandleRPCError(nil, "获取推送活动成功"),
+		Data:     campaignFromRpc(rpcResp.Campaign),
+	}, nil
+}
@@ -0,0 +1,76 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package notification
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetNotificationPreferencesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetNotificationPreferencesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetNotificationPreferencesLogic {
+	return &GetNotificationPreferencesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetNotificationPreferencesLogic) GetNotificationPreferences() (resp *types.NotificationPreferencesResp, err error) {
+	me, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.NotificationPreferencesResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetNotificationPreferences(l.ctx, &super.GetNotificationPreferencesReq{
+		UserId: me,
+	})
+	if err != nil {
+		return &types.NotificationPreferencesResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	return &types.NotificationPreferencesResp{
+		BaseResp: common.HandleRPCError(nil, "获取通知设置成功"),
+		Data:     preferencesFromRpc(rpcResp.Preferences),
+	}, nil
+}
+
+func preferencesFromRpc(p *super.NotificationPreferences) types.NotificationPreferences {
+	out := types.NotificationPreferences{
+		Kinds:    make([]types.NotificationKindPreference, 0, len(p.GetKinds())),
+		Timezone: p.GetTimezone(),
+		Mutes:    make([]types.NotificationMute, 0, len(p.GetMutes())),
+	}
+	if q := p.GetQuietHours(); q != nil {
+		out.QuietHours = types.NotificationQuietHours{Enabled: q.Enabled, Start: q.Start, End: q.End}
+	}
+	for _, k := range p.GetKinds() {
+		out.Kinds = append(out.Kinds, types.NotificationKindPreference{
+			Kind:  k.Kind,
+			Label: k.Label,
+			InApp: k.InApp,
+			Push:  k.Push,
+			Email: k.Email,
+		})
+	}
+	for _, m := range p.GetMutes() {
+		out.Mutes = append(out.Mutes, types.NotificationMute{
+			TargetType: m.TargetType,
+			TargetId:   m.TargetId,
+			CreatedAt:  m.CreatedAt,
+		})
+	}
+	return out
+}
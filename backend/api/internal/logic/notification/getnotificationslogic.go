@@ -39,18 +39,7 @@ func (l *GetNotificationsLogic) GetNotifications(req *types.GetNotificationsReq)
 
 	var notifications []types.Notification
 	for _, n := range rpcResp.Notifications {
-		notifications = append(notifications, types.Notification{
-			Id:           n.Id,
-			UserId:       n.UserId,
-			SenderId:     n.SenderId,
-			SenderName:   n.SenderName,
-			SenderAvatar: n.SenderAvatar,
-			Type:         int(n.Type),
-			PostId:       n.PostId,
-			Content:      n.Content,
-			IsRead:       n.IsRead,
-			CreatedAt:    n.CreatedAt,
-		})
+		notifications = append(notifications, NotificationFromRpc(n))
 	}
 
 	return &types.GetNotificationsResp{
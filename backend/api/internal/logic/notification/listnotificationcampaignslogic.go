@@ -0,0 +1,55 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package notification
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListNotificationCampaignsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListNotificationCampaignsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListNotificationCampaignsLogic {
+	return &ListNotificationCampaignsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListNotificationCampaignsLogic) ListNotificationCampaigns(req *types.ListNotificationCampaignsReq) (resp *types.ListNotificationCampaignsResp, err error) {
+	me, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.ListNotificationCampaignsResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.ListNotificationCampaigns(l.ctx, &super.ListNotificationCampaignsReq{
+		ActorUserId: me,
+		Page:        int32(req.Page),
+		PageSize:    int32(req.PageSize),
+	})
+	if err != nil {
+		return &types.ListNotificationCampaignsResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	campaigns := make([]types.NotificationCampaign, 0, len(rpcResp.Campaigns))
+	for _, c := range rpcResp.Campaigns {
+		campaigns = append(campaigns, campaignFromRpc(c))
+	}
+	return &types.ListNotificationCampaignsResp{
+		BaseResp: common.HandleRPCError(nil, "获取推送活动成功"),
+		Data:     campaigns,
+		Total:    int(rpcResp.Total),
+	}, nil
+}
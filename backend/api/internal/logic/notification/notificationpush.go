@@ -0,0 +1,228 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"backend/api/internal/chathub"
+	"backend/api/internal/logic/chat"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/discov"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+// NotificationFromRpc RPC 通知转 API 类型
+func NotificationFromRpc(n *super.Notification) types.Notification {
+	actors := make([]types.NotificationActor, 0, len(n.Actors))
+	for _, a := range n.Actors {
+		actors = append(actors, types.NotificationActor{
+			Id:     a.Id,
+			Name:   a.Name,
+			Avatar: a.Avatar,
+		})
+	}
+	return types.Notification{
+		Id:           n.Id,
+		UserId:       n.UserId,
+		SenderId:     n.SenderId,
+		SenderName:   n.SenderName,
+		SenderAvatar: n.SenderAvatar,
+		Type:         int(n.Type),
+		PostId:       n.PostId,
+		Content:      n.Content,
+		IsRead:       n.IsRead,
+		CreatedAt:    n.CreatedAt,
+		Kind:         n.Kind,
+		Title:        n.Title,
+		TargetType:   n.TargetType,
+		TargetId:     n.TargetId,
+		Route:        n.Route,
+		ActorCount:   int(n.ActorCount),
+		Actors:       actors,
+		UpdatedAt:    n.UpdatedAt,
+	}
+}
+
+// StartNotificationPush 后台订阅 RPC 层的新通知流（WatchNotifications），
+// 把每条通知连同接收者最新未读数推给本实例上订阅了 notifications 频道的连接：
+// /ws/remote 收到 {"type":"notification","data":{"type":"inbox","data":{"notification":{...},"unread_count":N}}}。
+//
+// 通知中心在每个 RPC 实例的进程内，只转发本实例创建的通知，所以要逐个订阅全部 RPC 实例，
+// 不能经负载均衡只连其中一个：配置 Etcd 时按服务发现的实例列表订阅并随实例上下线增减，
+// 配置 Endpoints 时订阅每个地址；只配置 Target 时无法枚举实例，退化为一条经负载均衡的流。
+// 每条流断开后各自指数退避重连；每个 API 实例都会收到全部事件，只推送自己持有的连接。
+// 同一条流还会收到会话撤销事件（封禁、退出设备、重置密码），由 applySessionKick 断开本实例上的连接。
+func StartNotificationPush(svcCtx *svc.ServiceContext) {
+	host, _ := os.Hostname()
+	instanceID := fmt.Sprintf("%s:%d", host, svcCtx.Config.Port)
+	conf := svcCtx.Config.SuperRpc
+	w := &notificationWatchers{svcCtx: svcCtx, instanceID: instanceID, cancels: make(map[string]context.CancelFunc)}
+
+	switch {
+	case len(conf.Etcd.Hosts) > 0 && conf.Etcd.Key != "":
+		var opts []discov.SubOption
+		if conf.Etcd.HasAccount() {
+			opts = append(opts, discov.WithSubEtcdAccount(conf.Etcd.User, conf.Etcd.Pass))
+		}
+		if conf.Etcd.HasTLS() {
+			opts = append(opts, discov.WithSubEtcdTLS(conf.Etcd.CertFile, conf.Etcd.CertKeyFile,
+				conf.Etcd.CACertFile, conf.Etcd.InsecureSkipVerify))
+		}
+		sub, err := discov.NewSubscriber(conf.Etcd.Hosts, conf.Etcd.Key, opts...)
+		if err != nil {
+			logx.Errorf("订阅 RPC 实例列表失败，通知推送只连接一个 RPC 实例: %v", err)
+			go keepWatching(context.Background(), svcCtx, svcCtx.SuperRpcClient, instanceID, conf.Etcd.Key)
+			return
+		}
+		sub.AddListener(func() { w.sync(sub.Values()) })
+		w.sync(sub.Values())
+	case len(conf.Endpoints) > 0:
+		w.sync(conf.Endpoints)
+	default:
+		go keepWatching(context.Background(), svcCtx, svcCtx.SuperRpcClient, instanceID, conf.Target)
+	}
+}
+
+// notificationWatchers 每个 RPC 实例一条订阅流，按地址增减
+type notificationWatchers struct {
+	svcCtx     *svc.ServiceContext
+	instanceID string
+
+	mu      sync.Mutex
+	cancels map[string]context.CancelFunc
+}
+
+// sync 为新出现的地址建立订阅，关闭已下线地址的订阅
+func (w *notificationWatchers) sync(addrs []string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	alive := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		alive[addr] = true
+		if _, ok := w.cancels[addr]; ok {
+			continue
+		}
+		if cancel := w.start(addr); cancel != nil {
+			w.cancels[addr] = cancel
+		}
+	}
+	for addr, cancel := range w.cancels {
+		if !alive[addr] {
+			cancel()
+			delete(w.cancels, addr)
+		}
+	}
+}
+
+func (w *notificationWatchers) start(addr string) context.CancelFunc {
+	conf := w.svcCtx.Config.SuperRpc
+	client, err := zrpc.NewClient(zrpc.RpcClientConf{
+		Endpoints:     []string{addr},
+		App:           conf.App,
+		Token:         conf.Token,
+		NonBlock:      true,
+		Timeout:       conf.Timeout,
+		KeepaliveTime: conf.KeepaliveTime,
+	})
+	if err != nil {
+		logx.Errorf("连接 RPC 实例 %s 失败，无法接收其通知: %v", addr, err)
+		return nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		defer client.Conn().Close()
+		keepWatching(ctx, w.svcCtx, super.NewSuperClient(client.Conn()), w.instanceID, addr)
+	}()
+	return cancel
+}
+
+// keepWatching 持续订阅一个 RPC 实例（或负载均衡后的某个实例）的通知流，断开后退避重连，直到 ctx 取消
+func keepWatching(ctx context.Context, svcCtx *svc.ServiceContext, client super.SuperClient, instanceID, addr string) {
+	backoff := time.Second
+	for {
+		received, err := watchNotifications(ctx, svcCtx, client, instanceID)
+		if ctx.Err() != nil {
+			return
+		}
+		if received {
+			backoff = time.Second
+		}
+		logx.Errorf("通知推送流（%s）断开，%v 后重连: %v", addr, backoff, err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		if backoff < 30*time.Second {
+			backoff *= 2
+		}
+	}
+}
+
+// watchNotifications 消费一次订阅流直到断开；received 表示期间至少收到过一条事件
+func watchNotifications(ctx context.Context, svcCtx *svc.ServiceContext, client super.SuperClient, instanceID string) (received bool, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := client.WatchNotifications(ctx, &super.WatchNotificationsReq{InstanceId: instanceID})
+	if err != nil {
+		return false, err
+	}
+	for {
+		ev, err := stream.Recv()
+		if err != nil {
+			return received, err
+		}
+		received = true
+		pushNotificationEvent(svcCtx, ev)
+	}
+}
+
+func pushNotificationEvent(svcCtx *svc.ServiceContext, ev *super.NotificationEvent) {
+	if kick := ev.GetKick(); kick != nil {
+		applySessionKick(svcCtx, kick)
+		return
+	}
+	n := ev.GetNotification()
+	if n == nil || n.UserId == "" {
+		return
+	}
+	chat.NewRemoteWsLogic(context.Background(), svcCtx).SendNotification(&chat.SendNotificationReq{
+		UserID: n.UserId,
+		Type:   "inbox",
+		Data: map[string]interface{}{
+			"notification": NotificationFromRpc(n),
+			"unread_count": ev.UnreadCount,
+		},
+	})
+}
+
+// applySessionKick 丢弃该用户缓存的令牌状态，并断开这些会话在本实例上的 WebSocket 连接（未指定会话时断开全部）
+func applySessionKick(svcCtx *svc.ServiceContext, kick *super.SessionKick) {
+	userID, err := strconv.ParseUint(kick.UserId, 10, 32)
+	if err != nil || userID == 0 {
+		return
+	}
+	sessionIDs := make([]uint, 0, len(kick.SessionIds))
+	for _, s := range kick.SessionIds {
+		if id, err := strconv.ParseUint(s, 10, 32); err == nil {
+			sessionIDs = append(sessionIDs, uint(id))
+		}
+	}
+	// 指定的会话都无法解析时不能退化为断开全部连接
+	if len(kick.SessionIds) > 0 && len(sessionIDs) == 0 {
+		return
+	}
+	svcCtx.TokenGuard.Invalidate(uint(userID))
+	if closed := chathub.DefaultSessions.Close(uint(userID), sessionIDs...); closed > 0 {
+		logx.Infof("[认证] 会话已撤销，断开本实例连接 用户ID=%d 会话=%v 断开连接数=%d", userID, kick.SessionIds, closed)
+	}
+}
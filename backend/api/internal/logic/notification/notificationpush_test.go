@@ -0,0 +1,71 @@
+package notification
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"backend/api/internal/chathub"
+	"backend/api/internal/middleware"
+	"backend/api/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/gorilla/websocket"
+)
+
+// dialSession 建立一条登记在 chathub.DefaultSessions 中的 WebSocket 连接，返回客户端一端
+func dialSession(t *testing.T, userID, sessionID uint) *websocket.Conn {
+	t.Helper()
+	var upgrader websocket.Upgrader
+	added := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		chathub.DefaultSessions.Add(userID, sessionID, conn)
+		close(added)
+		defer chathub.DefaultSessions.Remove(userID, conn)
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	t.Cleanup(srv.Close)
+	client, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(srv.URL, "http"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	<-added
+	return client
+}
+
+func closedByServer(c *websocket.Conn) bool {
+	_ = c.SetReadDeadline(time.Now().Add(300 * time.Millisecond))
+	_, _, err := c.ReadMessage()
+	return websocket.IsCloseError(err, websocket.ClosePolicyViolation)
+}
+
+// 会话撤销事件只断开指定会话在本实例上的连接
+func TestSessionKickClosesRevokedSession(t *testing.T) {
+	const userID = 42
+	revoked := dialSession(t, userID, 1)
+	other := dialSession(t, userID, 2)
+	svcCtx := &svc.ServiceContext{TokenGuard: middleware.NewTokenGuardMiddleware(nil)}
+
+	pushNotificationEvent(svcCtx, &super.NotificationEvent{Kick: &super.SessionKick{
+		UserId:     strconv.Itoa(userID),
+		SessionIds: []string{"1"},
+	}})
+	if !closedByServer(revoked) {
+		t.Fatal("被撤销会话的连接没有断开")
+	}
+	if closedByServer(other) {
+		t.Fatal("其他会话的连接被断开")
+	}
+}
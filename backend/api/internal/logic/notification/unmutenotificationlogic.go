@@ -0,0 +1,46 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package notification
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnmuteNotificationLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUnmuteNotificationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnmuteNotificationLogic {
+	return &UnmuteNotificationLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UnmuteNotificationLogic) UnmuteNotification(req *types.UnmuteNotificationReq) (resp *types.BaseResp, err error) {
+	me, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		r := common.UnauthorizedResp()
+		return &r, nil
+	}
+
+	_, err = l.svcCtx.SuperRpcClient.SetNotificationMute(l.ctx, &super.SetNotificationMuteReq{
+		UserId:     me,
+		TargetType: req.TargetType,
+		TargetId:   req.TargetId,
+		Muted:      false,
+	})
+	r := common.HandleRPCError(err, "已取消屏蔽")
+	return &r, nil
+}
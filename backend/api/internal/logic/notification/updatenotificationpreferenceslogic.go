@@ -0,0 +1,62 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package notification
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateNotificationPreferencesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUpdateNotificationPreferencesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateNotificationPreferencesLogic {
+	return &UpdateNotificationPreferencesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateNotificationPreferencesLogic) UpdateNotificationPreferences(req *types.UpdateNotificationPreferencesReq) (resp *types.NotificationPreferencesResp, err error) {
+	me, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.NotificationPreferencesResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
+	in := &super.UpdateNotificationPreferencesReq{
+		UserId:   me,
+		Timezone: req.Timezone,
+	}
+	for _, k := range req.Kinds {
+		in.Kinds = append(in.Kinds, &super.NotificationKindPreference{
+			Kind:  k.Kind,
+			InApp: k.InApp,
+			Push:  k.Push,
+			Email: k.Email,
+		})
+	}
+	if q := req.QuietHours; q != nil {
+		in.QuietHours = &super.NotificationQuietHours{Enabled: q.Enabled, Start: q.Start, End: q.End}
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.UpdateNotificationPreferences(l.ctx, in)
+	if err != nil {
+		return &types.NotificationPreferencesResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	return &types.NotificationPreferencesResp{
+		BaseResp: common.HandleRPCError(nil, "通知设置已保存"),
+		Data:     preferencesFromRpc(rpcResp.Preferences),
+	}, nil
+}
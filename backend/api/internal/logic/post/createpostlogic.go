@@ -25,7 +25,13 @@ func NewCreatePostLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Create
 	}
 }
 
+// CreatePost 以登录用户身份发帖；请求体中的 user_id 已弃用并被忽略，封禁、限制等检查针对的是令牌中的用户
 func (l *CreatePostLogic) CreatePost(req *types.CreatePostReq) (resp *types.CreatePostResp, err error) {
+	userID, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.CreatePostResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+
 	// 转换topic_tags格式
 	rpcTopicTags := make([]*super.TopicTag, 0, len(req.TopicTags))
 	for _, tag := range req.TopicTags {
@@ -38,7 +44,7 @@ func (l *CreatePostLogic) CreatePost(req *types.CreatePostReq) (resp *types.Crea
 
 	// 调用RPC服务创建帖子
 	rpcResp, err := l.svcCtx.SuperRpcClient.CreatePost(l.ctx, &super.CreatePostReq{
-		UserId:           req.UserId,
+		UserId:           userID,
 		Content:          req.Content,
 		Images:           req.Images,
 		TopicTags:        rpcTopicTags,
@@ -79,6 +85,8 @@ func (l *CreatePostLogic) CreatePost(req *types.CreatePostReq) (resp *types.Crea
 			HandDrawCard:     rpcResp.Post.HandDrawCard,
 			HandDrawThumbUrl: rpcResp.Post.HandDrawThumbUrl,
 			ModerationStatus: rpcResp.Post.ModerationStatus,
+			Edited:           rpcResp.Post.Edited,
+			EditedAt:         rpcResp.Post.EditedAt,
 		},
 	}, nil
 }
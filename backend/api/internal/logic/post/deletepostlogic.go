@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package post
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeletePostLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDeletePostLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeletePostLogic {
+	return &DeletePostLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeletePostLogic) DeletePost(req *types.DeletePostReq) (resp *types.BaseResp, err error) {
+	actorID, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		r := common.UnauthorizedResp()
+		return &r, nil
+	}
+
+	_, err = l.svcCtx.SuperRpcClient.DeletePost(l.ctx, &super.DeletePostReq{
+		PostId:      req.PostId,
+		ActorUserId: actorID,
+		Reason:      req.Reason,
+	})
+	r := common.HandleRPCError(err, "帖子已删除")
+	return &r, nil
+}
@@ -31,7 +31,7 @@ func (l *GetPostCommentsLogic) GetPostComments(req *types.GetPostCommentsReq) (r
 		PostId:        req.PostId,
 		Page:          int32(req.Page),
 		PageSize:      int32(req.PageSize),
-		ViewerUserId:  req.ViewerUserId,
+		ViewerUserId:  common.ViewerID(l.ctx),
 	})
 	if err != nil {
 		return &types.GetPostCommentsResp{
@@ -29,7 +29,7 @@ func (l *GetPostLogic) GetPost(req *types.GetPostReq) (resp *types.GetPostResp,
 	// 调用RPC服务获取帖子
 	rpcResp, err := l.svcCtx.SuperRpcClient.GetPost(l.ctx, &super.GetPostReq{
 		PostId:       req.PostId,
-		ViewerUserId: req.ViewerUserId,
+		ViewerUserId: common.ViewerID(l.ctx),
 	})
 	if err != nil {
 		return &types.GetPostResp{
@@ -65,6 +65,8 @@ func (l *GetPostLogic) GetPost(req *types.GetPostReq) (resp *types.GetPostResp,
 			HandDrawCard:     rpcResp.Post.HandDrawCard,
 			HandDrawThumbUrl: rpcResp.Post.HandDrawThumbUrl,
 			ModerationStatus: rpcResp.Post.ModerationStatus,
+			Edited:           rpcResp.Post.Edited,
+			EditedAt:         rpcResp.Post.EditedAt,
 		},
 	}, nil
 }
@@ -30,7 +30,7 @@ func (l *GetPostsLogic) GetPosts(req *types.GetPostsReq) (resp *types.GetPostsRe
 	rpcResp, err := l.svcCtx.SuperRpcClient.GetPosts(l.ctx, &super.GetPostsReq{
 		Page:           int32(req.Page),
 		PageSize:       int32(req.PageSize),
-		ViewerUserId:   req.ViewerUserId,
+		ViewerUserId:   common.ViewerID(l.ctx),
 		FeedMode:       req.FeedMode,
 		TopicTagId:     req.TopicTagId,
 		AuthorUserId:   req.AuthorUserId,
@@ -80,6 +80,8 @@ func (l *GetPostsLogic) GetPosts(req *types.GetPostsReq) (resp *types.GetPostsRe
 			HandDrawCard:     post.HandDrawCard,
 			HandDrawThumbUrl: post.HandDrawThumbUrl,
 			ModerationStatus: post.ModerationStatus,
+			Edited:           post.Edited,
+			EditedAt:         post.EditedAt,
 		})
 	}
 
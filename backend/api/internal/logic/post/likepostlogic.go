@@ -63,6 +63,8 @@ func (l *LikePostLogic) LikePost(req *types.LikePostReq) (resp *types.LikePostRe
 			HandDrawCard:     rpcResp.Post.HandDrawCard,
 			HandDrawThumbUrl: rpcResp.Post.HandDrawThumbUrl,
 			ModerationStatus: rpcResp.Post.ModerationStatus,
+			Edited:           rpcResp.Post.Edited,
+			EditedAt:         rpcResp.Post.EditedAt,
 		},
 	}, nil
 }
@@ -0,0 +1,39 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ApproveFollowRequestLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewApproveFollowRequestLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ApproveFollowRequestLogic {
+	return &ApproveFollowRequestLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ApproveFollowRequestLogic) ApproveFollowRequest(req *types.FollowRequestPathReq) (resp *types.BaseResp, err error) {
+	_, err = l.svcCtx.SuperRpcClient.RespondFollowRequest(l.ctx, &super.RespondFollowRequestReq{
+		ActorUserId: req.UserId,
+		RequestId:   req.RequestId,
+		Approve:     true,
+	})
+	r := common.HandleRPCError(err, "已批准关注请求")
+	return &r, nil
+}
@@ -0,0 +1,41 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BeginTotpEnrollmentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBeginTotpEnrollmentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BeginTotpEnrollmentLogic {
+	return &BeginTotpEnrollmentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 生成 TOTP 密钥，客户端用 otpauth_uri 生成二维码供验证器扫描
+func (l *BeginTotpEnrollmentLogic) BeginTotpEnrollment(req *types.MfaUserReq) (resp *types.BeginTotpEnrollmentResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.BeginTotpEnrollment(l.ctx, &super.BeginTotpEnrollmentReq{UserId: req.UserId})
+	if err != nil {
+		return &types.BeginTotpEnrollmentResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	return &types.BeginTotpEnrollmentResp{
+		BaseResp: common.HandleRPCError(nil, "请在验证器中添加后输入验证码"),
+		Data:     types.TotpEnrollment{Secret: rpcResp.Secret, OtpauthUri: rpcResp.OtpauthUri},
+	}, nil
+}
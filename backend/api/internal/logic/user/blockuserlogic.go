@@ -0,0 +1,38 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BlockUserLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBlockUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BlockUserLogic {
+	return &BlockUserLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *BlockUserLogic) BlockUser(req *types.BlockUserReq) (resp *types.BaseResp, err error) {
+	_, err = l.svcCtx.SuperRpcClient.BlockUser(l.ctx, &super.BlockUserReq{
+		ActorUserId:  req.UserId,
+		TargetUserId: req.TargetUserId,
+	})
+	r := common.HandleRPCError(err, "已拉黑")
+	return &r, nil
+}
@@ -0,0 +1,40 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelAccountDeletionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCancelAccountDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelAccountDeletionLogic {
+	return &CancelAccountDeletionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CancelAccountDeletionLogic) CancelAccountDeletion(req *types.AccountDeletionReq) (resp *types.BaseResp, err error) {
+	info := common.RequestInfoFrom(l.ctx)
+	_, err = l.svcCtx.SuperRpcClient.CancelAccountDeletion(l.ctx, &super.CancelAccountDeletionReq{
+		UserId:    req.UserId,
+		ClientIp:  info.ClientIP,
+		UserAgent: info.UserAgent,
+	})
+	r := common.HandleRPCError(err, "已撤销注销申请")
+	return &r, nil
+}
@@ -45,7 +45,8 @@ func (l *CheckFollowLogic) CheckFollow(req *types.CheckFollowReq) (resp *types.C
 	l.Debug("检查关注状态结果:", req.FollowerId, "是否关注", req.FollowingId, "：", rpcResp.IsFollowing)
 
 	return &types.CheckFollowResp{
-		BaseResp: common.HandleError(nil),
-		Data:     rpcResp.IsFollowing,
+		BaseResp:  common.HandleError(nil),
+		Data:      rpcResp.IsFollowing,
+		Requested: rpcResp.Requested,
 	}, nil
 }
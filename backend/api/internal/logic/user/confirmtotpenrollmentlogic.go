@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ConfirmTotpEnrollmentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewConfirmTotpEnrollmentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConfirmTotpEnrollmentLogic {
+	return &ConfirmTotpEnrollmentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 输入验证码确认绑定，成功后返回恢复码
+func (l *ConfirmTotpEnrollmentLogic) ConfirmTotpEnrollment(req *types.MfaCodeReq) (resp *types.RecoveryCodesResp, err error) {
+	info := common.RequestInfoFrom(l.ctx)
+	rpcResp, err := l.svcCtx.SuperRpcClient.ConfirmTotpEnrollment(l.ctx, &super.ConfirmTotpEnrollmentReq{
+		UserId:    req.UserId,
+		Code:      req.Code,
+		ClientIp:  info.ClientIP,
+		UserAgent: info.UserAgent,
+	})
+	if err != nil {
+		return &types.RecoveryCodesResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	return &types.RecoveryCodesResp{
+		BaseResp: common.HandleRPCError(nil, "已开启两步验证"),
+		Data:     types.RecoveryCodes{RecoveryCodes: rpcResp.RecoveryCodes},
+	}, nil
+}
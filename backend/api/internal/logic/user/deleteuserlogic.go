@@ -26,9 +26,14 @@ func NewDeleteUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Delete
 }
 
 func (l *DeleteUserLogic) DeleteUser(req *types.DeleteUserReq) (resp *types.DeleteUserResp, err error) {
-	// 调用RPC服务删除用户
-	_, err = l.svcCtx.SuperRpcClient.DeleteUser(l.ctx, &super.DeleteUserReq{
-		UserId: req.UserId,
+	// 调用RPC服务申请注销：等待期过后由后台任务删除数据
+	actorID, _ := common.ContextUserID(l.ctx)
+	info := common.RequestInfoFrom(l.ctx)
+	rpcResp, err := l.svcCtx.SuperRpcClient.DeleteUser(l.ctx, &super.DeleteUserReq{
+		UserId:      req.UserId,
+		ActorUserId: actorID,
+		ClientIp:    info.ClientIP,
+		UserAgent:   info.UserAgent,
 	})
 	if err != nil {
 		return &types.DeleteUserResp{
@@ -38,6 +43,7 @@ func (l *DeleteUserLogic) DeleteUser(req *types.DeleteUserReq) (resp *types.Dele
 
 	// 转换为API响应
 	return &types.DeleteUserResp{
-		BaseResp: common.HandleRPCError(nil, "删除用户成功"),
+		BaseResp: common.HandleRPCError(nil, "已申请注销，账号将在 7 天后删除，期间可以撤销"),
+		Data:     accountDeletionFromRPC(rpcResp.Deletion),
 	}, nil
 }
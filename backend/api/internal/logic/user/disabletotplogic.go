@@ -0,0 +1,42 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DisableTotpLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDisableTotpLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DisableTotpLogic {
+	return &DisableTotpLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DisableTotpLogic) DisableTotp(req *types.DisableTotpReq) (resp *types.BaseResp, err error) {
+	info := common.RequestInfoFrom(l.ctx)
+	_, err = l.svcCtx.SuperRpcClient.DisableTotp(l.ctx, &super.DisableTotpReq{
+		UserId:    req.UserId,
+		Password:  req.Password,
+		Code:      req.Code,
+		ClientIp:  info.ClientIP,
+		UserAgent: info.UserAgent,
+	})
+	r := common.HandleRPCError(err, "已关闭两步验证")
+	return &r, nil
+}
@@ -37,7 +37,7 @@ func (l *FollowUserLogic) FollowUser(req *types.FollowUserReq) (resp *types.Foll
 	if err != nil {
 		l.Error("调用关注用户RPC服务失败:", err)
 		return &types.FollowUserResp{
-			BaseResp: common.HandleError(err),
+			BaseResp: common.HandleRPCError(err, ""),
 			Data:     false,
 		}, nil
 	}
@@ -47,5 +47,6 @@ func (l *FollowUserLogic) FollowUser(req *types.FollowUserReq) (resp *types.Foll
 	return &types.FollowUserResp{
 		BaseResp: common.HandleError(nil),
 		Data:     rpcResp.Success,
+		Pending:  rpcResp.Pending,
 	}, nil
 }
@@ -74,6 +74,7 @@ func rpcUserToTypes(u *super.User) types.User {
 		Balance:         float64(u.Balance),
 		Inventory:       u.Inventory,
 		EquippedFrameId: u.EquippedFrameId,
+		EmailVerified:   u.EmailVerified,
 	}
 }
 
@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAccountDeletionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetAccountDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAccountDeletionLogic {
+	return &GetAccountDeletionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetAccountDeletionLogic) GetAccountDeletion(req *types.AccountDeletionReq) (resp *types.GetAccountDeletionResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetAccountDeletion(l.ctx, &super.GetAccountDeletionReq{UserId: req.UserId})
+	if err != nil {
+		return &types.GetAccountDeletionResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	return &types.GetAccountDeletionResp{
+		BaseResp: common.HandleRPCError(nil, "获取注销申请成功"),
+		Data:     accountDeletionFromRPC(rpcResp.Deletion),
+	}, nil
+}
+
+func accountDeletionFromRPC(d *super.AccountDeletion) types.AccountDeletion {
+	if d == nil {
+		return types.AccountDeletion{}
+	}
+	return types.AccountDeletion{
+		Status:      d.Status,
+		ScheduledAt: d.ScheduledAt,
+		RequestedAt: d.RequestedAt,
+		CanceledAt:  d.CanceledAt,
+	}
+}
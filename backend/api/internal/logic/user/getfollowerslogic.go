@@ -30,15 +30,16 @@ func (l *GetFollowersLogic) GetFollowers(req *types.GetFollowersReq) (resp *type
 	
 	// 调用RPC服务
 	rpcResp, err := l.svcCtx.SuperRpcClient.GetFollowers(l.ctx, &super.GetFollowersReq{
-		UserId:   req.UserId,
-		Page:     int32(req.Page),
-		PageSize: int32(req.PageSize),
+		UserId:       req.UserId,
+		Page:         int32(req.Page),
+		PageSize:     int32(req.PageSize),
+		ViewerUserId: common.ViewerID(l.ctx),
 	})
 	
 	if err != nil {
 		l.Error("调用获取粉丝列表RPC服务失败:", err)
 		return &types.GetFollowersResp{
-			BaseResp: common.HandleError(err),
+			BaseResp: common.HandleRPCError(err, ""),
 			Data:     nil,
 			Total:    0,
 		}, nil
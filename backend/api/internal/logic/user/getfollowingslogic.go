@@ -30,15 +30,16 @@ func (l *GetFollowingsLogic) GetFollowings(req *types.GetFollowingsReq) (resp *t
 	
 	// 调用RPC服务
 	rpcResp, err := l.svcCtx.SuperRpcClient.GetFollowings(l.ctx, &super.GetFollowingsReq{
-		UserId:   req.UserId,
-		Page:     int32(req.Page),
-		PageSize: int32(req.PageSize),
+		UserId:       req.UserId,
+		Page:         int32(req.Page),
+		PageSize:     int32(req.PageSize),
+		ViewerUserId: common.ViewerID(l.ctx),
 	})
 	
 	if err != nil {
 		l.Error("调用获取关注列表RPC服务失败:", err)
 		return &types.GetFollowingsResp{
-			BaseResp: common.HandleError(err),
+			BaseResp: common.HandleRPCError(err, ""),
 			Data:     nil,
 			Total:    0,
 		}, nil
@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetMfaStatusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetMfaStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetMfaStatusLogic {
+	return &GetMfaStatusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetMfaStatusLogic) GetMfaStatus(req *types.MfaUserReq) (resp *types.GetMfaStatusResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetMfaStatus(l.ctx, &super.GetMfaStatusReq{UserId: req.UserId})
+	if err != nil {
+		return &types.GetMfaStatusResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	return &types.GetMfaStatusResp{
+		BaseResp: common.HandleRPCError(nil, "获取两步验证状态成功"),
+		Data: types.MfaStatus{
+			Enabled:                rpcResp.Enabled,
+			Required:               rpcResp.Required,
+			RecoveryCodesRemaining: int(rpcResp.RecoveryCodesRemaining),
+			EnabledAt:              rpcResp.EnabledAt,
+		},
+	}, nil
+}
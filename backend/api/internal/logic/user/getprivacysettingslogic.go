@@ -0,0 +1,53 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPrivacySettingsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetPrivacySettingsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPrivacySettingsLogic {
+	return &GetPrivacySettingsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPrivacySettingsLogic) GetPrivacySettings(req *types.PrivacySettingsReq) (resp *types.PrivacySettingsResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetPrivacySettings(l.ctx, &super.GetPrivacySettingsReq{UserId: req.UserId})
+	if err != nil {
+		return &types.PrivacySettingsResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	return &types.PrivacySettingsResp{
+		BaseResp: common.HandleRPCError(nil, "获取隐私设置成功"),
+		Data:     privacySettingsFromRPC(rpcResp.Settings),
+	}, nil
+}
+
+// privacySettingsFromRPC 查询与更新隐私设置共用
+func privacySettingsFromRPC(s *super.PrivacySettings) types.PrivacySettings {
+	if s == nil {
+		return types.PrivacySettings{}
+	}
+	return types.PrivacySettings{
+		PrivateAccount:    s.PrivateAccount,
+		DmPermission:      s.DmPermission,
+		CommentPermission: s.CommentPermission,
+		HideFollowLists:   s.HideFollowLists,
+	}
+}
@@ -3,6 +3,7 @@ package user
 import (
 	"context"
 
+	"backend/api/internal/common"
 	"backend/api/internal/svc"
 	"backend/api/internal/types"
 	"backend/rpc/pb/super"
@@ -33,6 +34,11 @@ func (l *GetTransactionLogic) GetTransaction(req *types.GetTransactionReq) (resp
 	}
 
 	t := rpcResp.Transaction
+	// 交易记录只有本人和管理员可以查看
+	if err := common.CheckOwner(l.ctx, t.UserId, true); err != nil {
+		return &types.GetTransactionResp{BaseResp: common.OwnershipResp(err)}, nil
+	}
+
 	return &types.GetTransactionResp{
 		BaseResp: types.BaseResp{
 			Code:    200,
@@ -50,6 +50,7 @@ func (l *GetUserInfoLogic) GetUserInfo(req *types.GetUserInfoReq) (resp *types.G
 		Balance:         float64(rpcResp.User.Balance),
 		Inventory:       rpcResp.User.Inventory,
 		EquippedFrameId: rpcResp.User.EquippedFrameId,
+		EmailVerified:   rpcResp.User.EmailVerified,
 	}
 
 	return &types.GetUserInfoResp{
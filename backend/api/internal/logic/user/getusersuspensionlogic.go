@@ -0,0 +1,40 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUserSuspensionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetUserSuspensionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserSuspensionLogic {
+	return &GetUserSuspensionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetUserSuspensionLogic) GetUserSuspension(req *types.UserSuspensionReq) (resp *types.SuspensionResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.GetUserSuspension(l.ctx, &super.GetUserSuspensionReq{UserId: req.UserId})
+	if err != nil {
+		return &types.SuspensionResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	return &types.SuspensionResp{
+		BaseResp: common.HandleRPCError(nil, "获取处罚状态成功"),
+		Data:     common.SuspensionFromRPC(rpcResp.Suspension),
+	}, nil
+}
@@ -0,0 +1,63 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LinkUserIdentityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewLinkUserIdentityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LinkUserIdentityLogic {
+	return &LinkUserIdentityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 绑定第三方账号（二）：提交授权回调中的 code 与 state
+func (l *LinkUserIdentityLogic) LinkUserIdentity(req *types.LinkUserIdentityReq) (resp *types.UserIdentityResp, err error) {
+	info := common.RequestInfoFrom(l.ctx)
+	rpcResp, err := l.svcCtx.SuperRpcClient.LinkUserIdentity(l.ctx, &super.LinkUserIdentityReq{
+		ActorUserId: req.UserId,
+		Provider:    req.Provider,
+		State:       req.State,
+		Code:        req.Code,
+		ClientIp:    info.ClientIP,
+		UserAgent:   info.UserAgent,
+	})
+	if err != nil {
+		return &types.UserIdentityResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	return &types.UserIdentityResp{
+		BaseResp: common.HandleRPCError(nil, "绑定成功"),
+		Data:     userIdentityFromRPC(rpcResp.Identity),
+	}, nil
+}
+
+func userIdentityFromRPC(i *super.UserIdentity) types.UserIdentity {
+	if i == nil {
+		return types.UserIdentity{}
+	}
+	return types.UserIdentity{
+		Id:          i.Id,
+		Provider:    i.Provider,
+		Email:       i.Email,
+		DisplayName: i.DisplayName,
+		CreatedAt:   i.CreatedAt,
+		LastLoginAt: i.LastLoginAt,
+	}
+}
@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListBlockedUsersLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListBlockedUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListBlockedUsersLogic {
+	return &ListBlockedUsersLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListBlockedUsersLogic) ListBlockedUsers(req *types.ListBlockedUsersReq) (resp *types.ListBlockedUsersResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.ListBlockedUsers(l.ctx, &super.ListBlockedUsersReq{
+		ActorUserId: req.UserId,
+		Page:        int32(req.Page),
+		PageSize:    int32(req.PageSize),
+	})
+	if err != nil {
+		return &types.ListBlockedUsersResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	list := make([]types.BlockedUser, 0, len(rpcResp.Users))
+	for _, b := range rpcResp.Users {
+		list = append(list, types.BlockedUser{User: rpcUserToTypes(b.User), BlockedAt: b.BlockedAt})
+	}
+	return &types.ListBlockedUsersResp{
+		BaseResp: common.HandleRPCError(nil, "获取黑名单成功"),
+		Data:     list,
+		Total:    rpcResp.Total,
+	}, nil
+}
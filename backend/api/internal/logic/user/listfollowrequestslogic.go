@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFollowRequestsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListFollowRequestsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFollowRequestsLogic {
+	return &ListFollowRequestsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListFollowRequestsLogic) ListFollowRequests(req *types.ListFollowRequestsReq) (resp *types.ListFollowRequestsResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.ListFollowRequests(l.ctx, &super.ListFollowRequestsReq{
+		ActorUserId: req.UserId,
+		Page:        int32(req.Page),
+		PageSize:    int32(req.PageSize),
+	})
+	if err != nil {
+		return &types.ListFollowRequestsResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	list := make([]types.FollowRequestItem, 0, len(rpcResp.Requests))
+	for _, r := range rpcResp.Requests {
+		list = append(list, types.FollowRequestItem{Id: r.Id, User: rpcUserToTypes(r.User), CreatedAt: r.CreatedAt})
+	}
+	return &types.ListFollowRequestsResp{
+		BaseResp: common.HandleRPCError(nil, "获取关注请求成功"),
+		Data:     list,
+		Total:    rpcResp.Total,
+	}, nil
+}
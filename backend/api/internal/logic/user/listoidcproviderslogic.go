@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOidcProvidersLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListOidcProvidersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOidcProvidersLogic {
+	return &ListOidcProvidersLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 已配置的第三方登录方式，客户端据此展示登录按钮
+func (l *ListOidcProvidersLogic) ListOidcProviders() (resp *types.ListOidcProvidersResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.ListOidcProviders(l.ctx, &super.ListOidcProvidersReq{})
+	if err != nil {
+		return &types.ListOidcProvidersResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	providers := make([]types.OidcProvider, 0, len(rpcResp.Providers))
+	for _, p := range rpcResp.Providers {
+		providers = append(providers, types.OidcProvider{Name: p.Name, DisplayName: p.DisplayName})
+	}
+	return &types.ListOidcProvidersResp{
+		BaseResp: common.HandleRPCError(nil, "获取登录方式成功"),
+		Data:     providers,
+	}, nil
+}
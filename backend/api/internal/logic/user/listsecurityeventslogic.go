@@ -0,0 +1,57 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListSecurityEventsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListSecurityEventsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListSecurityEventsLogic {
+	return &ListSecurityEventsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 账号安全事件（登录、改密、新设备），最新的在前
+func (l *ListSecurityEventsLogic) ListSecurityEvents(req *types.ListSecurityEventsReq) (resp *types.ListSecurityEventsResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.ListSecurityEvents(l.ctx, &super.ListSecurityEventsReq{
+		UserId:   req.UserId,
+		Page:     int32(req.Page),
+		PageSize: int32(req.PageSize),
+	})
+	if err != nil {
+		return &types.ListSecurityEventsResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	events := make([]types.SecurityEvent, 0, len(rpcResp.Events))
+	for _, e := range rpcResp.Events {
+		events = append(events, types.SecurityEvent{
+			Id:        e.Id,
+			Kind:      e.Kind,
+			ClientIp:  e.ClientIp,
+			UserAgent: e.UserAgent,
+			Detail:    e.Detail,
+			CreatedAt: e.CreatedAt,
+		})
+	}
+	return &types.ListSecurityEventsResp{
+		BaseResp: common.HandleRPCError(nil, "获取安全记录成功"),
+		Data:     types.SecurityEventList{Events: events, Total: rpcResp.Total},
+	}, nil
+}
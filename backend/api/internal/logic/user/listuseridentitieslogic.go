@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListUserIdentitiesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListUserIdentitiesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListUserIdentitiesLogic {
+	return &ListUserIdentitiesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 已绑定的第三方账号
+func (l *ListUserIdentitiesLogic) ListUserIdentities(req *types.UserIdentitiesReq) (resp *types.ListUserIdentitiesResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.ListUserIdentities(l.ctx, &super.ListUserIdentitiesReq{UserId: req.UserId})
+	if err != nil {
+		return &types.ListUserIdentitiesResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	identities := make([]types.UserIdentity, 0, len(rpcResp.Identities))
+	for _, i := range rpcResp.Identities {
+		identities = append(identities, userIdentityFromRPC(i))
+	}
+	return &types.ListUserIdentitiesResp{
+		BaseResp: common.HandleRPCError(nil, "获取绑定账号成功"),
+		Data:     types.UserIdentityList{Identities: identities, HasPassword: rpcResp.HasPassword},
+	}, nil
+}
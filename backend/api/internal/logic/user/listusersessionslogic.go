@@ -0,0 +1,59 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListUserSessionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListUserSessionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListUserSessionsLogic {
+	return &ListUserSessionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 登录设备列表，标出发起本次请求的会话
+func (l *ListUserSessionsLogic) ListUserSessions(req *types.ListUserSessionsReq) (resp *types.ListUserSessionsResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.ListUserSessions(l.ctx, &super.ListUserSessionsReq{UserId: req.UserId})
+	if err != nil {
+		return &types.ListUserSessionsResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	var current string
+	if actor, ok := common.ActorFrom(l.ctx); ok && actor.ID() == req.UserId && actor.SessionID != 0 {
+		current = strconv.FormatUint(uint64(actor.SessionID), 10)
+	}
+	sessions := make([]types.UserSession, 0, len(rpcResp.Sessions))
+	for _, s := range rpcResp.Sessions {
+		sessions = append(sessions, types.UserSession{
+			Id:         s.Id,
+			UserAgent:  s.UserAgent,
+			ClientIp:   s.ClientIp,
+			CreatedAt:  s.CreatedAt,
+			LastUsedAt: s.LastUsedAt,
+			ExpiresAt:  s.ExpiresAt,
+			Current:    s.Id == current,
+		})
+	}
+	return &types.ListUserSessionsResp{
+		BaseResp: common.HandleRPCError(nil, "获取登录设备成功"),
+		Data:     sessions,
+	}, nil
+}
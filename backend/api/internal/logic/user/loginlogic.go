@@ -2,11 +2,13 @@ package user
 
 import (
 	"context"
+	"strconv"
 
 	"backend/api/internal/common"
 	"backend/api/internal/svc"
 	"backend/api/internal/types"
 	"backend/rpc/pb/super"
+	"backend/utils"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -27,20 +29,61 @@ func NewLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LoginLogic
 
 func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err error) {
 	// 调用RPC服务
+	info := common.RequestInfoFrom(l.ctx)
 	rpcResp, err := l.svcCtx.SuperRpcClient.Login(l.ctx, &super.LoginReq{
-		Username: req.Username,
-		Password: req.Password,
-		Email:    req.Email,
+		Username:     req.Username,
+		Password:     req.Password,
+		Email:        req.Email,
+		ClientIp:     info.ClientIP,
+		UserAgent:    info.UserAgent,
+		CaptchaToken: req.CaptchaToken,
 	})
 	if err != nil {
 		l.Errorf("[认证] 登录：调用用户服务失败 错误=%v", err)
+		return loginErrorResp(err), nil
+	}
+
+	return loginRespFromRPC(rpcResp), nil
+}
+
+// loginErrorResp 登录与两步验证共用：失败次数较多时告诉客户端展示人机验证，被锁定时告诉剩余秒数，
+// 账号被封禁时返回处罚信息与申诉令牌
+func loginErrorResp(err error) *types.LoginResp {
+	resp := &types.LoginResp{BaseResp: common.HandleRPCError(err, "")}
+	detail, ok := common.RPCErrorInfo(err)
+	if !ok {
+		return resp
+	}
+	switch detail.Reason {
+	case utils.LoginThrottledReason:
+		resp.CaptchaRequired = detail.Metadata[utils.LoginInfoCaptchaRequired] == "true"
+		resp.RetryAfter, _ = strconv.ParseInt(detail.Metadata[utils.LoginInfoRetryAfter], 10, 64)
+	case utils.AccountSuspendedReason:
+		resp.Suspension = &types.SuspensionNotice{
+			Reason:               detail.Metadata[utils.SuspensionInfoReason],
+			ExpiresAt:            detail.Metadata[utils.SuspensionInfoExpiresAt],
+			AppealToken:          detail.Metadata[utils.SuspensionInfoAppealToken],
+			AppealTokenExpiresAt: detail.Metadata[utils.SuspensionInfoAppealDeadline],
+		}
+	}
+	return resp
+}
+
+// loginRespFromRPC 登录与两步验证共用：已开启两步验证时只返回 mfa_token
+func loginRespFromRPC(rpcResp *super.LoginResp) *types.LoginResp {
+	if rpcResp.MfaRequired {
 		return &types.LoginResp{
-			BaseResp: common.HandleRPCError(err, ""),
-		}, nil
+			BaseResp: common.HandleRPCError(nil, "请输入两步验证码"),
+			Data: types.LoginData{
+				MfaRequired:  true,
+				MfaToken:     rpcResp.MfaToken,
+				MfaExpiresIn: rpcResp.MfaExpiresIn,
+			},
+		}
 	}
 
 	// 转换为API响应
-	resp = &types.LoginResp{
+	resp := &types.LoginResp{
 		BaseResp: common.HandleRPCError(nil, "登录成功"),
 	}
 
@@ -60,12 +103,18 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 			Balance:         float64(rpcResp.User.Balance),
 			Inventory:       rpcResp.User.Inventory,
 			EquippedFrameId: rpcResp.User.EquippedFrameId,
+			EmailVerified:   rpcResp.User.EmailVerified,
+			PendingEmail:    rpcResp.User.PendingEmail,
 		}
 		resp.Data = types.LoginData{
-			User:  u,
-			Token: rpcResp.Token,
+			User:                u,
+			Token:               rpcResp.Token,
+			RefreshToken:        rpcResp.RefreshToken,
+			ExpiresIn:           rpcResp.ExpiresIn,
+			MfaSetupRequired:    rpcResp.MfaSetupRequired,
+			DeletionScheduledAt: rpcResp.DeletionScheduledAt,
 		}
 	}
 
-	return resp, nil
+	return resp
 }
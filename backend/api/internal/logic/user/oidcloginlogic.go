@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type OidcLoginLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewOidcLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OidcLoginLogic {
+	return &OidcLoginLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 第三方登录：提交授权回调中的 code 与 state；与密码登录相同，开启了两步验证时只返回 mfa_token
+func (l *OidcLoginLogic) OidcLogin(req *types.OidcLoginReq) (resp *types.LoginResp, err error) {
+	info := common.RequestInfoFrom(l.ctx)
+	rpcResp, err := l.svcCtx.SuperRpcClient.OidcLogin(l.ctx, &super.OidcLoginReq{
+		Provider:  req.Provider,
+		State:     req.State,
+		Code:      req.Code,
+		ClientIp:  info.ClientIP,
+		UserAgent: info.UserAgent,
+	})
+	if err != nil {
+		l.Infof("[认证] 第三方登录失败 提供方=%s 错误=%v", req.Provider, err)
+		return loginErrorResp(err), nil
+	}
+	resp = loginRespFromRPC(rpcResp)
+	resp.Data.Registered = rpcResp.Registered
+	return resp, nil
+}
@@ -3,12 +3,14 @@ package user
 import (
 	"context"
 
+	"backend/api/internal/common"
 	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
-// RefreshTokenLogic 由 goctl 生成；实际逻辑在 refreshtokenhandler.go（需读取 Authorization）。
 type RefreshTokenLogic struct {
 	logx.Logger
 	ctx    context.Context
@@ -22,3 +24,25 @@ func NewRefreshTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Refr
 		svcCtx: svcCtx,
 	}
 }
+
+// 用刷新令牌换取新的访问令牌与刷新令牌。刷新令牌只能使用一次，客户端须保存新返回的刷新令牌；
+// 重复使用旧的刷新令牌会被视为被盗用，该会话立即失效
+func (l *RefreshTokenLogic) RefreshToken(req *types.RefreshTokenReq) (resp *types.RefreshTokenResp, err error) {
+	info := common.RequestInfoFrom(l.ctx)
+	rpcResp, err := l.svcCtx.SuperRpcClient.RefreshSession(l.ctx, &super.RefreshSessionReq{
+		RefreshToken: req.RefreshToken,
+		ClientIp:     info.ClientIP,
+		UserAgent:    info.UserAgent,
+	})
+	if err != nil {
+		return &types.RefreshTokenResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	return &types.RefreshTokenResp{
+		BaseResp: common.HandleRPCError(nil, "ok"),
+		Data: types.RefreshTokenData{
+			Token:        rpcResp.Token,
+			RefreshToken: rpcResp.RefreshToken,
+			ExpiresIn:    rpcResp.ExpiresIn,
+		},
+	}, nil
+}
@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RegenerateRecoveryCodesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRegenerateRecoveryCodesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RegenerateRecoveryCodesLogic {
+	return &RegenerateRecoveryCodesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 重新生成恢复码，旧的恢复码全部作废
+func (l *RegenerateRecoveryCodesLogic) RegenerateRecoveryCodes(req *types.MfaCodeReq) (resp *types.RecoveryCodesResp, err error) {
+	info := common.RequestInfoFrom(l.ctx)
+	rpcResp, err := l.svcCtx.SuperRpcClient.RegenerateRecoveryCodes(l.ctx, &super.RegenerateRecoveryCodesReq{
+		UserId:    req.UserId,
+		Code:      req.Code,
+		ClientIp:  info.ClientIP,
+		UserAgent: info.UserAgent,
+	})
+	if err != nil {
+		return &types.RecoveryCodesResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	return &types.RecoveryCodesResp{
+		BaseResp: common.HandleRPCError(nil, "已重新生成恢复码"),
+		Data:     types.RecoveryCodes{RecoveryCodes: rpcResp.RecoveryCodes},
+	}, nil
+}
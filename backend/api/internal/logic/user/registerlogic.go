@@ -26,11 +26,17 @@ func NewRegisterLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Register
 }
 
 func (l *RegisterLogic) Register(req *types.RegisterReq) (resp *types.RegisterResp, err error) {
+	lang := req.Lang
+	if lang == "" {
+		lang = common.RequestInfoFrom(l.ctx).AcceptLanguage
+	}
+
 	// 调用RPC服务
 	rpcResp, err := l.svcCtx.SuperRpcClient.Register(l.ctx, &super.RegisterReq{
 		Username: req.Username,
 		Password: req.Password,
 		Email:    req.Email,
+		Lang:     lang,
 	})
 	if err != nil {
 		return &types.RegisterResp{
@@ -39,16 +45,17 @@ func (l *RegisterLogic) Register(req *types.RegisterReq) (resp *types.RegisterRe
 	}
 
 	u := types.User{
-		Id:           rpcResp.User.Id,
-		Username:     rpcResp.User.Username,
-		Email:        rpcResp.User.Email,
-		MoeNo:        rpcResp.User.MoeNo,
-		Avatar:       rpcResp.User.Avatar,
-		CreatedAt:    rpcResp.User.CreatedAt,
-		UpdatedAt:    rpcResp.User.UpdatedAt,
-		IsVip:        rpcResp.User.IsVip,
-		VipExpiresAt: rpcResp.User.VipExpiresAt,
-		AutoRenew:    rpcResp.User.AutoRenew,
+		Id:            rpcResp.User.Id,
+		Username:      rpcResp.User.Username,
+		Email:         rpcResp.User.Email,
+		MoeNo:         rpcResp.User.MoeNo,
+		Avatar:        rpcResp.User.Avatar,
+		CreatedAt:     rpcResp.User.CreatedAt,
+		UpdatedAt:     rpcResp.User.UpdatedAt,
+		IsVip:         rpcResp.User.IsVip,
+		VipExpiresAt:  rpcResp.User.VipExpiresAt,
+		AutoRenew:     rpcResp.User.AutoRenew,
+		EmailVerified: rpcResp.User.EmailVerified,
 	}
 
 	return &types.RegisterResp{
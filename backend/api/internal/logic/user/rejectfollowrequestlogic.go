@@ -0,0 +1,38 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RejectFollowRequestLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRejectFollowRequestLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RejectFollowRequestLogic {
+	return &RejectFollowRequestLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RejectFollowRequestLogic) RejectFollowRequest(req *types.FollowRequestPathReq) (resp *types.BaseResp, err error) {
+	_, err = l.svcCtx.SuperRpcClient.RespondFollowRequest(l.ctx, &super.RespondFollowRequestReq{
+		ActorUserId: req.UserId,
+		RequestId:   req.RequestId,
+	})
+	r := common.HandleRPCError(err, "已拒绝关注请求")
+	return &r, nil
+}
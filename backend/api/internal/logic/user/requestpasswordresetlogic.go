@@ -0,0 +1,46 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RequestPasswordResetLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRequestPasswordResetLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RequestPasswordResetLogic {
+	return &RequestPasswordResetLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RequestPasswordResetLogic) RequestPasswordReset(req *types.RequestPasswordResetReq) (resp *types.BaseResp, err error) {
+	info := common.RequestInfoFrom(l.ctx)
+	lang := req.Lang
+	if lang == "" {
+		lang = info.AcceptLanguage
+	}
+
+	_, err = l.svcCtx.SuperRpcClient.RequestPasswordReset(l.ctx, &super.RequestPasswordResetReq{
+		Email:    req.Email,
+		ClientIp: info.ClientIP,
+		Lang:     lang,
+	})
+	// 邮箱是否注册都返回同样的提示
+	r := common.HandleRPCError(err, "如果该邮箱已注册，验证码已发送，15 分钟内有效")
+	return &r, nil
+}
@@ -2,8 +2,9 @@ package user
 
 import (
 	"context"
-	"errors"
+	"strconv"
 
+	"backend/api/internal/chathub"
 	"backend/api/internal/common"
 	"backend/api/internal/svc"
 	"backend/api/internal/types"
@@ -28,33 +29,41 @@ func NewResetPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Res
 
 func (l *ResetPasswordLogic) ResetPassword(req *types.ResetPasswordReq) (resp *types.ResetPasswordResp, err error) {
 	// 1. 验证参数
-	if req.Email == "" {
+	if req.Token == "" && (req.Email == "" || req.Code == "") {
 		return &types.ResetPasswordResp{
-			BaseResp: common.HandleError(errors.New("邮箱不能为空")),
+			BaseResp: types.BaseResp{Code: 400, Message: "请填写邮箱和验证码", Success: false},
 		}, nil
 	}
 	if req.NewPassword == "" {
 		return &types.ResetPasswordResp{
-			BaseResp: common.HandleError(errors.New("新密码不能为空")),
+			BaseResp: types.BaseResp{Code: 400, Message: "新密码不能为空", Success: false},
 		}, nil
 	}
 
-	// 2. 调用 RPC 服务重置密码
-	_, err = l.svcCtx.SuperRpcClient.ResetPassword(l.ctx, &super.ResetPasswordReq{
+	// 2. 调用 RPC 服务校验验证码并重置密码
+	info := common.RequestInfoFrom(l.ctx)
+	rpcResp, err := l.svcCtx.SuperRpcClient.ResetPassword(l.ctx, &super.ResetPasswordReq{
 		Email:       req.Email,
+		Code:        req.Code,
+		Token:       req.Token,
 		NewPassword: req.NewPassword,
+		ClientIp:    info.ClientIP,
+		UserAgent:   info.UserAgent,
 	})
-
 	if err != nil {
-		// RPC 调用失败，处理错误
-		// 这里可以根据具体错误类型（如用户不存在）返回更友好的提示，
-		// 但为了安全，有时候也可以模糊处理。这里简单透传错误信息。
 		return &types.ResetPasswordResp{
 			BaseResp: common.HandleRPCError(err, "重置密码失败"),
 		}, nil
 	}
 
+	// 3. 旧的登录令牌已随令牌版本递增失效，本实例立即生效并断开该用户的连接；
+	// 其他实例由 RPC 广播的会话撤销事件处理
+	if id, err := strconv.ParseUint(rpcResp.UserId, 10, 32); err == nil {
+		l.svcCtx.TokenGuard.Invalidate(uint(id))
+		chathub.DefaultSessions.Close(uint(id))
+	}
+
 	return &types.ResetPasswordResp{
-		BaseResp: common.HandleRPCError(nil, "重置密码成功"),
+		BaseResp: common.HandleRPCError(nil, "重置密码成功，请使用新密码重新登录"),
 	}, nil
 }
@@ -0,0 +1,50 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"backend/api/internal/chathub"
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeAllUserSessionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRevokeAllUserSessionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeAllUserSessionsLogic {
+	return &RevokeAllUserSessionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 退出所有设备（包括当前设备）：撤销全部会话并立即断开该用户在本实例上的全部 WebSocket 连接，
+// 其他实例上的连接由 RPC 广播的会话撤销事件断开
+func (l *RevokeAllUserSessionsLogic) RevokeAllUserSessions(req *types.RevokeAllUserSessionsReq) (resp *types.RevokeAllUserSessionsResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.RevokeAllUserSessions(l.ctx, &super.RevokeAllUserSessionsReq{UserId: req.UserId})
+	if err != nil {
+		return &types.RevokeAllUserSessionsResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+
+	userID, _ := strconv.ParseUint(req.UserId, 10, 32)
+	l.svcCtx.TokenGuard.Invalidate(uint(userID))
+	closed := chathub.DefaultSessions.Close(uint(userID))
+	l.Infof("[认证] 退出所有设备 用户ID=%d 会话数=%d 断开连接数=%d", userID, len(rpcResp.SessionIds), closed)
+
+	return &types.RevokeAllUserSessionsResp{
+		BaseResp: common.HandleRPCError(nil, "已退出所有设备"),
+		Data:     types.RevokeAllUserSessionsData{Revoked: len(rpcResp.SessionIds)},
+	}, nil
+}
@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strconv"
+
+	"backend/api/internal/chathub"
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeUserSessionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRevokeUserSessionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeUserSessionLogic {
+	return &RevokeUserSessionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 退出某台设备：撤销会话并立即断开该会话在本实例上的 WebSocket 连接（可用于退出当前设备），
+// 其他实例上的连接由 RPC 广播的会话撤销事件断开
+func (l *RevokeUserSessionLogic) RevokeUserSession(req *types.RevokeUserSessionReq) (resp *types.BaseResp, err error) {
+	if _, err := l.svcCtx.SuperRpcClient.RevokeUserSession(l.ctx, &super.RevokeUserSessionReq{
+		UserId:    req.UserId,
+		SessionId: req.SessionId,
+	}); err != nil {
+		r := common.HandleRPCError(err, "")
+		return &r, nil
+	}
+
+	userID, _ := strconv.ParseUint(req.UserId, 10, 32)
+	sessionID, _ := strconv.ParseUint(req.SessionId, 10, 32)
+	l.svcCtx.TokenGuard.Invalidate(uint(userID))
+	closed := chathub.DefaultSessions.Close(uint(userID), uint(sessionID))
+	l.Infof("[认证] 退出设备 用户ID=%d 会话ID=%d 断开连接数=%d", userID, sessionID, closed)
+
+	r := common.HandleRPCError(nil, "已退出该设备")
+	return &r, nil
+}
@@ -0,0 +1,55 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SendEmailVerificationLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSendEmailVerificationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendEmailVerificationLogic {
+	return &SendEmailVerificationLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 重新发送验证邮件：有待验证的新邮箱时发到新邮箱，否则发到当前邮箱
+func (l *SendEmailVerificationLogic) SendEmailVerification(req *types.SendEmailVerificationReq) (resp *types.SendEmailVerificationResp, err error) {
+	userID, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.SendEmailVerificationResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+	lang := req.Lang
+	if lang == "" {
+		lang = common.RequestInfoFrom(l.ctx).AcceptLanguage
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.SendEmailVerification(l.ctx, &super.SendEmailVerificationReq{
+		UserId: userID,
+		Lang:   lang,
+	})
+	if err != nil {
+		return &types.SendEmailVerificationResp{
+			BaseResp: common.HandleRPCError(err, "发送验证邮件失败"),
+		}, nil
+	}
+	return &types.SendEmailVerificationResp{
+		BaseResp: common.HandleRPCError(nil, "验证邮件已发送"),
+		Data:     types.SendEmailVerificationData{Email: rpcResp.Email},
+	}, nil
+}
@@ -0,0 +1,53 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type StartOidcLoginLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewStartOidcLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *StartOidcLoginLogic {
+	return &StartOidcLoginLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 发起第三方登录：返回授权页地址与 state，授权后用回调中的 code 与 state 调用 /api/user/login/oidc/:provider
+func (l *StartOidcLoginLogic) StartOidcLogin(req *types.OidcAuthorizeReq) (resp *types.OidcAuthorizeResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.StartOidcAuth(l.ctx, &super.StartOidcAuthReq{
+		Provider: req.Provider,
+		Purpose:  "login",
+	})
+	if err != nil {
+		return &types.OidcAuthorizeResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	return &types.OidcAuthorizeResp{
+		BaseResp: common.HandleRPCError(nil, "请在浏览器中完成授权"),
+		Data:     oidcAuthorizationFromRPC(rpcResp),
+	}, nil
+}
+
+// oidcAuthorizationFromRPC 第三方登录与绑定共用
+func oidcAuthorizationFromRPC(r *super.StartOidcAuthResp) types.OidcAuthorization {
+	return types.OidcAuthorization{
+		AuthorizationUrl: r.AuthorizationUrl,
+		State:            r.State,
+		ExpiresIn:        r.ExpiresIn,
+	}
+}
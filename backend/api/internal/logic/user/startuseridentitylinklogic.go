@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type StartUserIdentityLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewStartUserIdentityLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *StartUserIdentityLinkLogic {
+	return &StartUserIdentityLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 绑定第三方账号（一）：返回授权页地址与 state
+func (l *StartUserIdentityLinkLogic) StartUserIdentityLink(req *types.UserIdentityAuthorizeReq) (resp *types.OidcAuthorizeResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.StartOidcAuth(l.ctx, &super.StartOidcAuthReq{
+		Provider:    req.Provider,
+		Purpose:     "link",
+		ActorUserId: req.UserId,
+	})
+	if err != nil {
+		return &types.OidcAuthorizeResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	return &types.OidcAuthorizeResp{
+		BaseResp: common.HandleRPCError(nil, "请在浏览器中完成授权"),
+		Data:     oidcAuthorizationFromRPC(rpcResp),
+	}, nil
+}
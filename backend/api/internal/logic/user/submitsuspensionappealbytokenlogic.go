@@ -0,0 +1,50 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SubmitSuspensionAppealByTokenLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSubmitSuspensionAppealByTokenLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubmitSuspensionAppealByTokenLogic {
+	return &SubmitSuspensionAppealByTokenLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 被封禁的用户无法登录，凭登录被拒时返回的申诉令牌提交申诉
+func (l *SubmitSuspensionAppealByTokenLogic) SubmitSuspensionAppealByToken(req *types.SubmitAppealByTokenReq) (resp *types.SuspensionAppealResp, err error) {
+	if strings.TrimSpace(req.AppealToken) == "" {
+		return &types.SuspensionAppealResp{
+			BaseResp: types.BaseResp{Code: 400, Message: "缺少申诉令牌", Success: false},
+		}, nil
+	}
+	rpcResp, err := l.svcCtx.SuperRpcClient.SubmitSuspensionAppeal(l.ctx, &super.SubmitSuspensionAppealReq{
+		AppealToken: req.AppealToken,
+		Message:     req.Message,
+	})
+	if err != nil {
+		return &types.SuspensionAppealResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	return &types.SuspensionAppealResp{
+		BaseResp: common.HandleRPCError(nil, "申诉已提交，请等待处理"),
+		Data:     common.SuspensionAppealFromRPC(rpcResp.Appeal),
+	}, nil
+}
@@ -0,0 +1,43 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SubmitSuspensionAppealLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSubmitSuspensionAppealLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubmitSuspensionAppealLogic {
+	return &SubmitSuspensionAppealLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SubmitSuspensionAppealLogic) SubmitSuspensionAppeal(req *types.SubmitAppealReq) (resp *types.SuspensionAppealResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.SubmitSuspensionAppeal(l.ctx, &super.SubmitSuspensionAppealReq{
+		UserId:  req.UserId,
+		Message: req.Message,
+	})
+	if err != nil {
+		return &types.SuspensionAppealResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	return &types.SuspensionAppealResp{
+		BaseResp: common.HandleRPCError(nil, "申诉已提交，请等待处理"),
+		Data:     common.SuspensionAppealFromRPC(rpcResp.Appeal),
+	}, nil
+}
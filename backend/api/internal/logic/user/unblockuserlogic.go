@@ -0,0 +1,38 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnblockUserLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUnblockUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnblockUserLogic {
+	return &UnblockUserLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UnblockUserLogic) UnblockUser(req *types.UnblockUserReq) (resp *types.BaseResp, err error) {
+	_, err = l.svcCtx.SuperRpcClient.UnblockUser(l.ctx, &super.BlockUserReq{
+		ActorUserId:  req.UserId,
+		TargetUserId: req.TargetUserId,
+	})
+	r := common.HandleRPCError(err, "已取消拉黑")
+	return &r, nil
+}
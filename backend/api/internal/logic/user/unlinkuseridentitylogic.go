@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnlinkUserIdentityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUnlinkUserIdentityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnlinkUserIdentityLogic {
+	return &UnlinkUserIdentityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 解绑第三方账号；账号没有密码时不能解绑最后一个
+func (l *UnlinkUserIdentityLogic) UnlinkUserIdentity(req *types.UnlinkUserIdentityReq) (resp *types.BaseResp, err error) {
+	info := common.RequestInfoFrom(l.ctx)
+	if _, err := l.svcCtx.SuperRpcClient.UnlinkUserIdentity(l.ctx, &super.UnlinkUserIdentityReq{
+		ActorUserId: req.UserId,
+		IdentityId:  req.IdentityId,
+		ClientIp:    info.ClientIP,
+		UserAgent:   info.UserAgent,
+	}); err != nil {
+		r := common.HandleRPCError(err, "")
+		return &r, nil
+	}
+	r := common.HandleRPCError(nil, "已解绑")
+	return &r, nil
+}
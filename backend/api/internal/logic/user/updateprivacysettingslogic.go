@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdatePrivacySettingsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewUpdatePrivacySettingsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdatePrivacySettingsLogic {
+	return &UpdatePrivacySettingsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdatePrivacySettingsLogic) UpdatePrivacySettings(req *types.UpdatePrivacySettingsReq) (resp *types.PrivacySettingsResp, err error) {
+	rpcResp, err := l.svcCtx.SuperRpcClient.UpdatePrivacySettings(l.ctx, &super.UpdatePrivacySettingsReq{
+		UserId: req.UserId,
+		Settings: &super.PrivacySettings{
+			PrivateAccount:    req.PrivateAccount,
+			DmPermission:      req.DmPermission,
+			CommentPermission: req.CommentPermission,
+			HideFollowLists:   req.HideFollowLists,
+		},
+	})
+	if err != nil {
+		return &types.PrivacySettingsResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
+	return &types.PrivacySettingsResp{
+		BaseResp: common.HandleRPCError(nil, "隐私设置已保存"),
+		Data:     privacySettingsFromRPC(rpcResp.Settings),
+	}, nil
+}
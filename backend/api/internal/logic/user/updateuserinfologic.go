@@ -62,10 +62,17 @@ func (l *UpdateUserInfoLogic) UpdateUserInfo(req *types.UpdateUserInfoReq) (resp
 		Balance:         float64(rpcResp.User.Balance),
 		Inventory:       rpcResp.User.Inventory,
 		EquippedFrameId: rpcResp.User.EquippedFrameId,
+		EmailVerified:   rpcResp.User.EmailVerified,
+		PendingEmail:    rpcResp.User.PendingEmail,
 	}
 
+	msg := "更新用户信息成功"
+	if req.Email != "" && u.PendingEmail != "" {
+		// 新邮箱验证通过后才会替换当前邮箱
+		msg = "更新成功，验证邮件已发送至新邮箱，完成验证后邮箱才会修改"
+	}
 	return &types.UpdateUserInfoResp{
-		BaseResp: common.HandleRPCError(nil, "更新用户信息成功"),
+		BaseResp: common.HandleRPCError(nil, msg),
 		Data:     u,
 	}, nil
 }
@@ -27,10 +27,13 @@ func NewUpdateUserPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 
 func (l *UpdateUserPasswordLogic) UpdateUserPassword(req *types.UpdateUserPasswordReq) (resp *types.UpdateUserPasswordResp, err error) {
 	// 调用RPC服务
+	info := common.RequestInfoFrom(l.ctx)
 	_, err = l.svcCtx.SuperRpcClient.UpdateUserPassword(l.ctx, &super.UpdateUserPasswordReq{
 		UserId:      req.UserId,
 		OldPassword: req.OldPassword,
 		NewPassword: req.NewPassword,
+		ClientIp:    info.ClientIP,
+		UserAgent:   info.UserAgent,
 	})
 	if err != nil {
 		return &types.UpdateUserPasswordResp{
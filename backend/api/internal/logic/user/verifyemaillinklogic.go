@@ -0,0 +1,41 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VerifyEmailLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewVerifyEmailLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyEmailLinkLogic {
+	return &VerifyEmailLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 邮件中的验证链接打开的页面调用，凭链接令牌完成验证，无需登录
+func (l *VerifyEmailLinkLogic) VerifyEmailLink(req *types.VerifyEmailLinkReq) (resp *types.BaseResp, err error) {
+	if req.Token == "" {
+		r := types.BaseResp{Code: 400, Message: "验证链接无效", Success: false}
+		return &r, nil
+	}
+
+	_, err = l.svcCtx.SuperRpcClient.VerifyEmail(l.ctx, &super.VerifyEmailReq{Token: req.Token})
+	r := common.HandleRPCError(err, "邮箱验证成功")
+	return &r, nil
+}
@@ -0,0 +1,59 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VerifyEmailLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewVerifyEmailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyEmailLogic {
+	return &VerifyEmailLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *VerifyEmailLogic) VerifyEmail(req *types.VerifyEmailReq) (resp *types.VerifyEmailResp, err error) {
+	userID, err := common.ContextUserID(l.ctx)
+	if err != nil {
+		return &types.VerifyEmailResp{BaseResp: common.UnauthorizedResp()}, nil
+	}
+	if req.Code == "" && req.Token == "" {
+		return &types.VerifyEmailResp{
+			BaseResp: types.BaseResp{Code: 400, Message: "请填写验证码", Success: false},
+		}, nil
+	}
+
+	rpcResp, err := l.svcCtx.SuperRpcClient.VerifyEmail(l.ctx, &super.VerifyEmailReq{
+		UserId: userID,
+		Code:   req.Code,
+		Token:  req.Token,
+	})
+	if err != nil {
+		return &types.VerifyEmailResp{
+			BaseResp: common.HandleRPCError(err, "验证邮箱失败"),
+		}, nil
+	}
+
+	u := rpcUserToTypes(rpcResp.User)
+	u.PendingEmail = rpcResp.User.PendingEmail
+	return &types.VerifyEmailResp{
+		BaseResp: common.HandleRPCError(nil, "邮箱验证成功"),
+		Data:     u,
+	}, nil
+}
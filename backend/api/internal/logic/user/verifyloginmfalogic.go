@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"backend/api/internal/common"
+	"backend/api/internal/svc"
+	"backend/api/internal/types"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VerifyLoginMfaLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewVerifyLoginMfaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyLoginMfaLogic {
+	return &VerifyLoginMfaLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// 登录第二步：提交验证器中的验证码或恢复码，换取登录令牌
+func (l *VerifyLoginMfaLogic) VerifyLoginMfa(req *types.VerifyLoginMfaReq) (resp *types.LoginResp, err error) {
+	info := common.RequestInfoFrom(l.ctx)
+	rpcResp, err := l.svcCtx.SuperRpcClient.VerifyLoginMfa(l.ctx, &super.VerifyLoginMfaReq{
+		MfaToken:  req.MfaToken,
+		Code:      req.Code,
+		ClientIp:  info.ClientIP,
+		UserAgent: info.UserAgent,
+	})
+	if err != nil {
+		// 与密码登录共用锁定规则与封禁提示
+		return loginErrorResp(err), nil
+	}
+	return loginRespFromRPC(rpcResp), nil
+}
@@ -5,9 +5,13 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
+	"backend/api/internal/common"
+	"backend/api/internal/logic/chat"
 	"backend/api/internal/svc"
 	"backend/api/internal/types"
+	"backend/rpc/pb/super"
 
 	"github.com/google/uuid"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -29,10 +33,20 @@ func NewVoiceCallLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VoiceCa
 
 func (l *VoiceCallLogic) VoiceCall(req *types.VoiceCallReq) (resp *types.VoiceCallResp, err error) {
 	// 从上下文获取用户ID
-	_, err = l.getUserID()
+	callerID, err := l.getUserID()
 	if err != nil {
 		return nil, err
 	}
+	if req.ReceiverId == "" || req.ReceiverId == callerID {
+		return &types.VoiceCallResp{
+			BaseResp: types.BaseResp{Code: 400, Message: "无效的接收方", Success: false},
+		}, nil
+	}
+
+	caller, err := l.svcCtx.SuperRpcClient.GetUser(l.ctx, &super.GetUserReq{UserId: callerID})
+	if err != nil {
+		return &types.VoiceCallResp{BaseResp: common.HandleRPCError(err, "")}, nil
+	}
 
 	// 生成唯一的呼叫ID和频道名称
 	callID := uuid.New().String()
@@ -40,9 +54,23 @@ func (l *VoiceCallLogic) VoiceCall(req *types.VoiceCallReq) (resp *types.VoiceCa
 
 	// 创建呼叫记录
 	// 这里需要实现数据库操作，暂时返回成功
-	
-	// 发送推送通知给接收方
-	// 这里需要实现推送通知逻辑
+
+	// 接收方在线时通过 WebSocket 弹出来电界面，否则推送到手机唤醒
+	callData := map[string]string{
+		"type":          "incoming_call",
+		"caller_id":     callerID,
+		"caller_name":   caller.User.Username,
+		"caller_avatar": caller.User.Avatar,
+		"call_id":       callID,
+		"channel_name":  channelName,
+	}
+	if !chat.NewRemoteWsLogic(l.ctx, l.svcCtx).SendNotification(&chat.SendNotificationReq{
+		UserID: req.ReceiverId,
+		Type:   "notification",
+		Data:   callData,
+	}) {
+		l.pushIncomingCall(callerID, req.ReceiverId, callID, callData)
+	}
 
 	return &types.VoiceCallResp{
 		BaseResp: types.BaseResp{
@@ -60,6 +88,24 @@ func (l *VoiceCallLogic) VoiceCall(req *types.VoiceCallReq) (resp *types.VoiceCa
 	}, nil
 }
 
+// 来电推送只保留 30 秒（过时的来电没有意义），同一通话的重复推送合并为一条
+func (l *VoiceCallLogic) pushIncomingCall(callerID, receiverID, callID string, data map[string]string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := l.svcCtx.SuperRpcClient.SendDevicePush(ctx, &super.SendDevicePushReq{
+		UserId:       receiverID,
+		ActorId:      callerID,
+		Kind:         "incoming_call",
+		Body:         "语音通话",
+		Data:         data,
+		HighPriority: true,
+		CollapseKey:  "call:" + callID,
+		TtlSeconds:   30,
+	}); err != nil {
+		l.Errorf("来电推送失败 接收方=%s: %v", receiverID, err)
+	}
+}
+
 func (l *VoiceCallLogic) getUserID() (string, error) {
 	// 尝试从Context获取userId
 	uidVal := l.ctx.Value("userId")
@@ -0,0 +1,277 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"backend/api/internal/common"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"github.com/zeromicro/go-zero/rest/pathvar"
+)
+
+// Ownership 路径中 :user_id 的访问规则
+type Ownership int
+
+const (
+	// OwnerPublic 任何人可访问（公开资料、关注列表等）
+	OwnerPublic Ownership = iota + 1
+	// OwnerSelf 只能是本人
+	OwnerSelf
+	// OwnerSelfOrAdmin 本人，或管理员显式代操作（会记录日志）
+	OwnerSelfOrAdmin
+	// OwnerAdmin 只能是管理员（如直接修改会员状态）
+	OwnerAdmin
+)
+
+func (o Ownership) String() string {
+	switch o {
+	case OwnerPublic:
+		return "public"
+	case OwnerSelf:
+		return "self"
+	case OwnerSelfOrAdmin:
+		return "self_or_admin"
+	case OwnerAdmin:
+		return "admin"
+	}
+	return "unknown"
+}
+
+// RoutePolicy 一条带 :user_id 的路由及其访问规则
+type RoutePolicy struct {
+	Method    string
+	Path      string
+	Ownership Ownership
+}
+
+// UserRoutePolicies 所有带 :user_id 的路由都必须在这里声明规则；
+// 启动时 CheckRoutePolicies 会对照 routes.go 与 extraroutes.go 检查，新增路由漏配会直接启动失败。
+var UserRoutePolicies = []RoutePolicy{
+	// 用户资料
+	{http.MethodGet, "/api/user/:user_id", OwnerPublic},
+	{http.MethodGet, "/api/user/:user_id/detail", OwnerPublic},
+	{http.MethodPut, "/api/user/:user_id", OwnerSelfOrAdmin},
+	{http.MethodPut, "/api/user/:user_id/password", OwnerSelf},
+	{http.MethodDelete, "/api/user/:user_id", OwnerSelfOrAdmin},
+
+	// 会员
+	{http.MethodPost, "/api/user/:user_id/vip", OwnerAdmin},
+	{http.MethodGet, "/api/user/:user_id/vip", OwnerSelfOrAdmin},
+	{http.MethodGet, "/api/user/:user_id/vip/check", OwnerPublic},
+	{http.MethodPut, "/api/user/:user_id/vip/auto-renew", OwnerSelf},
+	{http.MethodPost, "/api/user/:user_id/vip/sync", OwnerSelfOrAdmin},
+	{http.MethodGet, "/api/user/:user_id/vip/orders", OwnerSelfOrAdmin},
+	{http.MethodGet, "/api/user/:user_id/vip/records", OwnerSelfOrAdmin},
+	{http.MethodPost, "/api/user/:user_id/vip/orders", OwnerSelf},
+	{http.MethodGet, "/api/user/:user_id/vip/active", OwnerSelfOrAdmin},
+
+	// 钱包
+	{http.MethodGet, "/api/user/:user_id/transactions", OwnerSelfOrAdmin},
+	{http.MethodPost, "/api/user/:user_id/wallet/recharge", OwnerSelf},
+
+	// AI 记忆
+	{http.MethodPost, "/api/user/:user_id/memories", OwnerSelf},
+	{http.MethodGet, "/api/user/:user_id/memories", OwnerSelf},
+	{http.MethodDelete, "/api/user/:user_id/memories", OwnerSelf},
+
+	// 登录会话（登录设备）
+	{http.MethodGet, "/api/user/:user_id/sessions", OwnerSelfOrAdmin},
+	{http.MethodDelete, "/api/user/:user_id/sessions/:session_id", OwnerSelf},
+	{http.MethodDelete, "/api/user/:user_id/sessions", OwnerSelfOrAdmin},
+	{http.MethodGet, "/api/user/:user_id/security-events", OwnerSelfOrAdmin},
+
+	// 第三方账号绑定
+	{http.MethodGet, "/api/user/:user_id/identities", OwnerSelfOrAdmin},
+	{http.MethodPost, "/api/user/:user_id/identities/:provider/authorize", OwnerSelf},
+	{http.MethodPost, "/api/user/:user_id/identities/:provider", OwnerSelf},
+	{http.MethodDelete, "/api/user/:user_id/identities/:identity_id", OwnerSelf},
+
+	// 两步验证
+	{http.MethodGet, "/api/user/:user_id/mfa", OwnerSelfOrAdmin},
+	{http.MethodPost, "/api/user/:user_id/mfa/totp", OwnerSelf},
+	{http.MethodPost, "/api/user/:user_id/mfa/totp/confirm", OwnerSelf},
+	{http.MethodPost, "/api/user/:user_id/mfa/totp/disable", OwnerSelf},
+	{http.MethodPost, "/api/user/:user_id/mfa/recovery-codes", OwnerSelf},
+
+	// 注销账号与数据导出（申请注销为 DELETE /api/user/:user_id；撤销与导出只能本人操作）
+	{http.MethodGet, "/api/user/:user_id/deletion", OwnerSelfOrAdmin},
+	{http.MethodDelete, "/api/user/:user_id/deletion", OwnerSelf},
+	{http.MethodGet, "/api/user/:user_id/export", OwnerSelf},
+
+	// 关注（:user_id 为关注者本人）
+	{http.MethodPost, "/api/user/:user_id/follow", OwnerSelf},
+	{http.MethodDelete, "/api/user/:user_id/follow", OwnerSelf},
+	{http.MethodGet, "/api/user/:user_id/following", OwnerPublic},
+	{http.MethodGet, "/api/user/:user_id/followers", OwnerPublic},
+
+	// 好友
+	{http.MethodPost, "/api/user/:user_id/friend-requests", OwnerSelf},
+	{http.MethodGet, "/api/user/:user_id/friend-requests/incoming", OwnerSelf},
+	{http.MethodGet, "/api/user/:user_id/friend-requests/outgoing", OwnerSelf},
+	{http.MethodPost, "/api/user/:user_id/friend-requests/:request_id/accept", OwnerSelf},
+	{http.MethodPost, "/api/user/:user_id/friend-requests/:request_id/reject", OwnerSelf},
+	{http.MethodGet, "/api/user/:user_id/friends", OwnerSelf},
+	{http.MethodGet, "/api/user/:user_id/friends/status/:other_user_id", OwnerSelf},
+
+	// 拉黑
+	{http.MethodPost, "/api/user/:user_id/blocks", OwnerSelf},
+	{http.MethodDelete, "/api/user/:user_id/blocks/:target_user_id", OwnerSelf},
+	{http.MethodGet, "/api/user/:user_id/blocks", OwnerSelf},
+
+	// 隐私设置与关注请求
+	{http.MethodGet, "/api/user/:user_id/privacy", OwnerSelf},
+	{http.MethodPut, "/api/user/:user_id/privacy", OwnerSelf},
+	{http.MethodGet, "/api/user/:user_id/follow-requests", OwnerSelf},
+	{http.MethodPost, "/api/user/:user_id/follow-requests/:request_id/approve", OwnerSelf},
+	{http.MethodPost, "/api/user/:user_id/follow-requests/:request_id/reject", OwnerSelf},
+
+	// 封禁与限制（处罚由管理员下发；申诉只能本人提交）
+	{http.MethodPost, "/api/admin/users/:user_id/suspension", OwnerAdmin},
+	{http.MethodDelete, "/api/admin/users/:user_id/suspension", OwnerAdmin},
+	{http.MethodGet, "/api/user/:user_id/suspension", OwnerSelfOrAdmin},
+	{http.MethodPost, "/api/user/:user_id/suspension/appeal", OwnerSelf},
+
+	// 虚拟形象、表情包
+	{http.MethodGet, "/api/avatar/:user_id", OwnerPublic},
+	{http.MethodPut, "/api/avatar/:user_id", OwnerSelf},
+	{http.MethodGet, "/api/user/:user_id/emoji/packs", OwnerPublic},
+
+	// 签到与等级
+	{http.MethodPost, "/api/user/:user_id/check-in", OwnerSelf},
+	{http.MethodGet, "/api/user/:user_id/level", OwnerPublic},
+	{http.MethodGet, "/api/user/:user_id/check-in/status", OwnerSelfOrAdmin},
+	{http.MethodGet, "/api/user/:user_id/check-in/history", OwnerSelfOrAdmin},
+	{http.MethodGet, "/api/user/:user_id/exp/logs", OwnerSelfOrAdmin},
+
+	// 端到端加密：获取对方的公钥包
+	{http.MethodGet, "/api/e2ee/keys/:user_id", OwnerPublic},
+
+	// 角色管理（分组另有 RequireSuperAdmin）
+	{http.MethodPut, "/api/admin/users/:user_id/role", OwnerAdmin},
+	{http.MethodDelete, "/api/admin/users/:user_id/role", OwnerAdmin},
+}
+
+// OwnershipMiddleware 把路径中的 :user_id 绑定到登录用户：按 UserRoutePolicies 的规则，
+// 只有本人（或被显式放行的管理员）才能访问他人的资源。需注册在 TokenGuardMiddleware 之后。
+type OwnershipMiddleware struct {
+	policies map[string][]RoutePolicy // method -> policies
+}
+
+func NewOwnershipMiddleware(policies []RoutePolicy) *OwnershipMiddleware {
+	m := &OwnershipMiddleware{policies: make(map[string][]RoutePolicy)}
+	for _, p := range policies {
+		m.policies[p.Method] = append(m.policies[p.Method], p)
+	}
+	return m
+}
+
+func (m *OwnershipMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := pathvar.Vars(r)["user_id"]
+		if !ok {
+			next(w, r)
+			return
+		}
+
+		// 未声明的路由按仅本人处理（CheckRoutePolicies 保证不会出现）
+		ownership := OwnerSelf
+		if p, found := m.match(r.Method, r.URL.Path); found {
+			ownership = p.Ownership
+		}
+		if ownership == OwnerPublic {
+			next(w, r)
+			return
+		}
+
+		actor, ok := common.ActorFrom(r.Context())
+		var err error
+		switch {
+		case !ok:
+			err = common.ErrNoUserInContext
+		case ownership == OwnerAdmin:
+			if !actor.IsAdmin() {
+				err = common.ErrNotOwner
+			}
+		default:
+			err = common.CheckOwner(r.Context(), userID, ownership == OwnerSelfOrAdmin)
+		}
+		if err != nil {
+			status := http.StatusForbidden
+			if errors.Is(err, common.ErrNoUserInContext) {
+				status = http.StatusUnauthorized
+			} else {
+				logx.WithContext(r.Context()).Infof("[权限] 拒绝访问他人资源 用户ID=%d 目标用户ID=%s 规则=%s %s %s",
+					actor.UserID, userID, ownership, r.Method, r.URL.Path)
+			}
+			httpx.WriteJsonCtx(r.Context(), w, status, common.OwnershipResp(err))
+			return
+		}
+
+		if actor.ID() != userID {
+			logx.WithContext(r.Context()).Infof("[权限] 管理员代操作 管理员ID=%d 角色=%s 目标用户ID=%s %s %s",
+				actor.UserID, actor.Role, userID, r.Method, r.URL.Path)
+		}
+		next(w, r)
+	}
+}
+
+// match 按路径段匹配路由模板（:xxx 匹配任意一段）
+func (m *OwnershipMiddleware) match(method, path string) (RoutePolicy, bool) {
+	segs := strings.Split(strings.Trim(path, "/"), "/")
+	for _, p := range m.policies[method] {
+		tpl := strings.Split(strings.Trim(p.Path, "/"), "/")
+		if len(tpl) != len(segs) {
+			continue
+		}
+		matched := true
+		for i, t := range tpl {
+			if !strings.HasPrefix(t, ":") && t != segs[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return p, true
+		}
+	}
+	return RoutePolicy{}, false
+}
+
+// CheckRoutePolicies 对照已注册的路由检查规则表：带 :user_id 的路由必须声明规则，
+// 规则表中也不能有已不存在的路由。启动时调用，返回所有问题
+func CheckRoutePolicies(routes []rest.Route, policies []RoutePolicy) error {
+	declared := make(map[string]bool, len(policies))
+	for _, p := range policies {
+		key := p.Method + " " + p.Path
+		if declared[key] {
+			return fmt.Errorf("用户路由规则重复: %s", key)
+		}
+		declared[key] = true
+	}
+
+	var problems []string
+	registered := make(map[string]bool)
+	for _, rt := range routes {
+		key := rt.Method + " " + rt.Path
+		registered[key] = true
+		if strings.Contains(rt.Path, "/:user_id") && !declared[key] {
+			problems = append(problems, "缺少访问规则: "+key)
+		}
+	}
+	for key := range declared {
+		if !registered[key] {
+			problems = append(problems, "路由不存在: "+key)
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return fmt.Errorf("用户路由访问规则与已注册路由不一致:\n  %s", strings.Join(problems, "\n  "))
+}
@@ -0,0 +1,173 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"backend/api/internal/common"
+	"backend/api/internal/config"
+	"backend/api/internal/handler"
+	"backend/api/internal/middleware"
+	"backend/api/internal/svc"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/pathvar"
+)
+
+const ownerID = "42"
+
+var (
+	owner      = common.Actor{UserID: 42, Role: utils.RoleUser}
+	otherUser  = common.Actor{UserID: 43, Role: utils.RoleUser}
+	admin      = common.Actor{UserID: 1, Role: utils.RoleAdmin}
+	superAdmin = common.Actor{UserID: 2, Role: utils.RoleSuperAdmin}
+)
+
+// registeredRoutes 与 super.go 相同方式注册 routes.go 与 extraroutes.go 中的全部路由（不启动监听）
+func registeredRoutes(t *testing.T) []rest.Route {
+	t.Helper()
+	var c config.Config
+	c.Host = "127.0.0.1"
+	c.Port = 18999
+	server := rest.MustNewServer(c.RestConf)
+	t.Cleanup(server.Stop)
+	ctx := &svc.ServiceContext{Config: c}
+	ctx.RequireAuth = middleware.NewRequireAuthMiddleware().Handle
+	ctx.RequireAdmin = middleware.NewRequireAdminMiddleware().Handle
+	ctx.RequireSuperAdmin = middleware.NewRequireSuperAdminMiddleware().Handle
+	handler.RegisterHandlers(server, ctx)
+	handler.RegisterExtraHandlers(server, ctx)
+	return server.Routes()
+}
+
+// concrete 把路由模板中的参数替换为具体值：:user_id 为资源所有者，其余参数取 7
+func concrete(tpl string) (string, map[string]string) {
+	vars := map[string]string{}
+	segs := strings.Split(tpl, "/")
+	for i, s := range segs {
+		if !strings.HasPrefix(s, ":") {
+			continue
+		}
+		v := "7"
+		if s == ":user_id" {
+			v = ownerID
+		}
+		vars[s[1:]] = v
+		segs[i] = v
+	}
+	return strings.Join(segs, "/"), vars
+}
+
+// serve 以 actor 身份（nil 为未登录）经过 OwnershipMiddleware 访问路由，返回状态码与是否放行
+func serve(m *middleware.OwnershipMiddleware, method, tpl string, actor *common.Actor) (int, bool) {
+	path, vars := concrete(tpl)
+	r := httptest.NewRequest(method, path, nil)
+	r = pathvar.WithVars(r, vars)
+	if actor != nil {
+		r = r.WithContext(common.WithActor(r.Context(), *actor))
+	}
+	called := false
+	w := httptest.NewRecorder()
+	m.Handle(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusOK)
+	})(w, r)
+	return w.Code, called
+}
+
+func TestRoutePoliciesMatchRoutes(t *testing.T) {
+	if err := middleware.CheckRoutePolicies(registeredRoutes(t), middleware.UserRoutePolicies); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestOwnershipEveryRoute 逐条检查 routes.go 中的路由：本人、他人、管理员与未登录分别能否访问
+func TestOwnershipEveryRoute(t *testing.T) {
+	declared := make(map[string]middleware.Ownership)
+	for _, p := range middleware.UserRoutePolicies {
+		declared[p.Method+" "+p.Path] = p.Ownership
+	}
+	m := middleware.NewOwnershipMiddleware(middleware.UserRoutePolicies)
+
+	// 各规则下每种身份的预期：0 表示放行
+	expect := map[middleware.Ownership]map[string]int{
+		middleware.OwnerPublic:      {"owner": 0, "other": 0, "admin": 0, "super_admin": 0, "anonymous": 0},
+		middleware.OwnerSelf:        {"owner": 0, "other": 403, "admin": 403, "super_admin": 403, "anonymous": 401},
+		middleware.OwnerSelfOrAdmin: {"owner": 0, "other": 403, "admin": 0, "super_admin": 0, "anonymous": 401},
+		middleware.OwnerAdmin:       {"owner": 403, "other": 403, "admin": 0, "super_admin": 0, "anonymous": 401},
+	}
+	actors := []struct {
+		name  string
+		actor *common.Actor
+	}{
+		{"owner", &owner},
+		{"other", &otherUser},
+		{"admin", &admin},
+		{"super_admin", &superAdmin},
+		{"anonymous", nil},
+	}
+
+	checked := 0
+	for _, rt := range registeredRoutes(t) {
+		rt := rt
+		if !strings.Contains(rt.Path, "/:user_id") {
+			// 不带 :user_id 的路由不受本中间件约束
+			if code, called := serve(m, rt.Method, rt.Path, nil); !called {
+				t.Errorf("%s %s: 不带 :user_id 的路由被拦截 (%d)", rt.Method, rt.Path, code)
+			}
+			continue
+		}
+		ownership, ok := declared[rt.Method+" "+rt.Path]
+		if !ok {
+			t.Errorf("%s %s: 未声明访问规则", rt.Method, rt.Path)
+			continue
+		}
+		checked++
+		t.Run(rt.Method+" "+rt.Path, func(t *testing.T) {
+			for _, a := range actors {
+				want := expect[ownership][a.name]
+				code, called := serve(m, rt.Method, rt.Path, a.actor)
+				switch {
+				case want == 0 && !called:
+					t.Errorf("规则 %s: %s 应放行，实际 %d", ownership, a.name, code)
+				case want != 0 && called:
+					t.Errorf("规则 %s: %s 应被拒绝（%d），实际放行", ownership, a.name, want)
+				case want != 0 && code != want:
+					t.Errorf("规则 %s: %s 状态码 %d，want %d", ownership, a.name, code, want)
+				}
+			}
+		})
+	}
+	if checked != len(middleware.UserRoutePolicies) {
+		t.Errorf("检查了 %d 条带 :user_id 的路由，规则表有 %d 条", checked, len(middleware.UserRoutePolicies))
+	}
+}
+
+// 几条敏感路由的规则单独钉住，防止规则表被误改
+func TestOwnershipSensitiveRoutes(t *testing.T) {
+	m := middleware.NewOwnershipMiddleware(middleware.UserRoutePolicies)
+	cases := []struct {
+		method, path string
+		actor        common.Actor
+		allowed      bool
+	}{
+		{http.MethodPut, "/api/user/:user_id/password", admin, false},
+		{http.MethodPost, "/api/user/:user_id/wallet/recharge", admin, false},
+		{http.MethodGet, "/api/user/:user_id/export", admin, false},
+		{http.MethodPost, "/api/user/:user_id/mfa/totp/disable", superAdmin, false},
+		{http.MethodPost, "/api/user/:user_id/vip", owner, false},
+		{http.MethodPut, "/api/admin/users/:user_id/role", owner, false},
+		{http.MethodDelete, "/api/user/:user_id", admin, true},
+		{http.MethodGet, "/api/user/:user_id/transactions", otherUser, false},
+		{http.MethodGet, "/api/user/:user_id/friends/status/:other_user_id", otherUser, false},
+	}
+	for _, tc := range cases {
+		tc := tc
+		if _, called := serve(m, tc.method, tc.path, &tc.actor); called != tc.allowed {
+			t.Errorf("%s %s as %s(%d): allowed=%v, want %v", tc.method, tc.path, tc.actor.Role, tc.actor.UserID, called, tc.allowed)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"backend/api/internal/common"
+)
+
+// RequestInfoMiddleware 记录客户端 IP、User-Agent 与 Accept-Language。
+// 只有直连的对端是受信任的反向代理时才读取 X-Forwarded-For，否则客户端可以伪造该头，
+// 绕过按 IP 的登录锁定与验证码阈值
+type RequestInfoMiddleware struct {
+	proxies []netip.Prefix
+}
+
+// NewRequestInfoMiddleware trustedProxies 为反向代理的 IP 或网段（CIDR），为空时一律使用连接的对端地址
+func NewRequestInfoMiddleware(trustedProxies []string) (*RequestInfoMiddleware, error) {
+	m := &RequestInfoMiddleware{}
+	for _, s := range trustedProxies {
+		s = strings.TrimSpace(s)
+		if strings.Contains(s, "/") {
+			p, err := netip.ParsePrefix(s)
+			if err != nil {
+				return nil, fmt.Errorf("TrustedProxies 网段无效 %q: %w", s, err)
+			}
+			m.proxies = append(m.proxies, p.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return nil, fmt.Errorf("TrustedProxies 地址无效 %q: %w", s, err)
+		}
+		addr = addr.Unmap()
+		m.proxies = append(m.proxies, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return m, nil
+}
+
+func (m *RequestInfoMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := common.WithRequestInfo(r.Context(), common.RequestInfo{
+			ClientIP:       m.clientIP(r),
+			UserAgent:      r.UserAgent(),
+			AcceptLanguage: r.Header.Get("Accept-Language"),
+		})
+		next(w, r.WithContext(ctx))
+	}
+}
+
+// clientIP 对端是受信任的代理时，沿 X-Forwarded-For 从右往左跳过受信任的代理，
+// 取第一个不受信任的地址；更靠左的部分由客户端自行填写，不可信
+func (m *RequestInfoMiddleware) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	peer, err := netip.ParseAddr(host)
+	if err != nil || !m.trusted(peer) {
+		return host
+	}
+
+	hops := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	ip := peer
+	for i := len(hops) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+		ip = addr
+		if !m.trusted(addr) {
+			break
+		}
+	}
+	return ip.Unmap().String()
+}
+
+func (m *RequestInfoMiddleware) trusted(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, p := range m.proxies {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
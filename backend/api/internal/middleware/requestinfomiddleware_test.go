@@ -0,0 +1,58 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"backend/api/internal/common"
+)
+
+func TestRequestInfoClientIP(t *testing.T) {
+	cases := []struct {
+		name    string
+		proxies []string
+		remote  string
+		xff     []string
+		want    string
+	}{
+		{"未配置代理时忽略伪造的头", nil, "203.0.113.9:5000", []string{"1.2.3.4"}, "203.0.113.9"},
+		{"对端不是代理时忽略伪造的头", []string{"10.0.0.1"}, "203.0.113.9:5000", []string{"1.2.3.4"}, "203.0.113.9"},
+		{"经代理", []string{"10.0.0.1"}, "10.0.0.1:5000", []string{"198.51.100.7"}, "198.51.100.7"},
+		{"客户端在头里预填的地址不可信", []string{"10.0.0.1"}, "10.0.0.1:5000", []string{"1.2.3.4, 198.51.100.7"}, "198.51.100.7"},
+		{"多级代理", []string{"10.0.0.0/8"}, "10.0.0.1:5000", []string{"1.2.3.4, 198.51.100.7, 10.1.2.3"}, "198.51.100.7"},
+		{"多个头合并", []string{"10.0.0.0/8"}, "10.0.0.1:5000", []string{"1.2.3.4", "198.51.100.7"}, "198.51.100.7"},
+		{"代理未带头", []string{"10.0.0.1"}, "10.0.0.1:5000", nil, "10.0.0.1"},
+		{"无法解析的地址之前停止", []string{"10.0.0.1"}, "10.0.0.1:5000", []string{"198.51.100.7, garbage"}, "10.0.0.1"},
+		{"IPv6", []string{"::1"}, "[::1]:5000", []string{"2001:db8::1"}, "2001:db8::1"},
+		{"IPv4 映射地址", []string{"127.0.0.1"}, "[::ffff:127.0.0.1]:5000", []string{"198.51.100.7"}, "198.51.100.7"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			m, err := NewRequestInfoMiddleware(tc.proxies)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			r.RemoteAddr = tc.remote
+			for _, v := range tc.xff {
+				r.Header.Add("X-Forwarded-For", v)
+			}
+			var got string
+			m.Handle(func(w http.ResponseWriter, r *http.Request) {
+				got = common.RequestInfoFrom(r.Context()).ClientIP
+			})(httptest.NewRecorder(), r)
+			if got != tc.want {
+				t.Fatalf("ClientIP = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRequestInfoRejectsInvalidProxies(t *testing.T) {
+	for _, s := range []string{"not-an-ip", "10.0.0.0/33"} {
+		if _, err := NewRequestInfoMiddleware([]string{s}); err == nil {
+			t.Errorf("%q: want error", s)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package middleware
+
+import (
+	"backend/utils"
+)
+
+// NewRequireAdminMiddleware 对应 .api 中的 middleware: RequireAdmin，管理员（admin / super_admin）可访问
+func NewRequireAdminMiddleware() *RequireRoleMiddleware {
+	return NewRequireRoleMiddleware(utils.RoleAdmin, utils.RoleSuperAdmin)
+}
@@ -0,0 +1,50 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"backend/api/internal/common"
+	"backend/api/internal/types"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// RequireAuthMiddleware 对应 .api 中的 middleware: RequireAuth，要求请求携带有效的登录令牌。
+// go-zero 内置的 jwt: Auth 只支持单个 HMAC 密钥，无法按 kid 轮换，也不支持 RS256 / EdDSA，
+// 因此令牌由全局的 TokenGuardMiddleware 用密钥环校验，这里只检查结果，
+// 并按 go-zero jwt 的方式把声明写入上下文（user_id 为 json.Number），供 common.ContextUserID 等读取
+type RequireAuthMiddleware struct{}
+
+func NewRequireAuthMiddleware() *RequireAuthMiddleware {
+	return &RequireAuthMiddleware{}
+}
+
+func (m *RequireAuthMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := r.Context().Value(claimsKey{}).(*utils.CustomClaims)
+		if !ok {
+			resp := common.UnauthorizedResp()
+			if revoked, _ := r.Context().Value(tokenRevokedKey{}).(bool); revoked {
+				resp = types.BaseResp{Code: 401, Message: "登录已失效，请重新登录", Success: false}
+			}
+			httpx.WriteJsonCtx(r.Context(), w, http.StatusUnauthorized, resp)
+			return
+		}
+
+		ctx := r.Context()
+		for k, v := range map[string]interface{}{
+			"user_id":  json.Number(strconv.FormatUint(uint64(claims.UserID), 10)),
+			"username": claims.Username,
+			"role":     claims.Role,
+			"tv":       json.Number(strconv.FormatInt(claims.TokenVersion, 10)),
+			"sid":      json.Number(strconv.FormatUint(uint64(claims.SessionID), 10)),
+		} {
+			ctx = context.WithValue(ctx, k, v)
+		}
+		next(w, r.WithContext(ctx))
+	}
+}
@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"net/http"
+
+	"backend/api/internal/common"
+	"backend/api/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// RequireRoleMiddleware 只允许指定角色访问。角色取 TokenGuardMiddleware 查询到的当前角色
+// （授予、撤销后最多 30 秒在其他实例上生效），查询失败且没有缓存时按普通用户处理（不信任令牌中的角色声明）。
+// 通过 .api 中 @server 的 middleware 声明挂到路由分组上，排在 RequireAuth 之后
+type RequireRoleMiddleware struct {
+	roles map[string]bool
+}
+
+func NewRequireRoleMiddleware(roles ...string) *RequireRoleMiddleware {
+	m := &RequireRoleMiddleware{roles: make(map[string]bool, len(roles))}
+	for _, role := range roles {
+		m.roles[role] = true
+	}
+	return m
+}
+
+func (m *RequireRoleMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		actor, ok := common.ActorFrom(r.Context())
+		if !ok {
+			httpx.WriteJsonCtx(r.Context(), w, http.StatusUnauthorized, common.UnauthorizedResp())
+			return
+		}
+		if !m.roles[actor.Role] {
+			logx.WithContext(r.Context()).Infof("[权限] 角色不足 用户ID=%d 角色=%s %s %s",
+				actor.UserID, actor.Role, r.Method, r.URL.Path)
+			httpx.WriteJsonCtx(r.Context(), w, http.StatusForbidden, types.BaseResp{
+				Code:    403,
+				Message: "权限不足",
+				Success: false,
+			})
+			return
+		}
+		next(w, r)
+	}
+}
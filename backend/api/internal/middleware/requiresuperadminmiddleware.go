@@ -0,0 +1,10 @@
+package middleware
+
+import (
+	"backend/utils"
+)
+
+// NewRequireSuperAdminMiddleware 对应 .api 中的 middleware: RequireSuperAdmin，仅超级管理员可访问
+func NewRequireSuperAdminMiddleware() *RequireRoleMiddleware {
+	return NewRequireRoleMiddleware(utils.RoleSuperAdmin)
+}
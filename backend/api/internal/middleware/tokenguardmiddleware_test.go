@@ -0,0 +1,133 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"backend/api/internal/common"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"google.golang.org/grpc"
+)
+
+// authStateClient 只实现 GetUserAuthState；err 非空时模拟 RPC 故障
+type authStateClient struct {
+	super.SuperClient
+	resp *super.GetUserAuthStateResp
+	err  error
+}
+
+func (c *authStateClient) GetUserAuthState(ctx context.Context, in *super.GetUserAuthStateReq, opts ...grpc.CallOption) (*super.GetUserAuthStateResp, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return c.resp, nil
+}
+
+func setupKeyRing(t *testing.T) {
+	t.Helper()
+	ring, err := utils.NewKeyRing(utils.JWTConf{Keys: []utils.JWTKeyConf{{
+		Kid:    "test",
+		Alg:    utils.JWTAlgHS256,
+		Secret: "0123456789abcdef0123456789abcdef",
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	prev := utils.DefaultKeyRing()
+	utils.SetKeyRing(ring)
+	t.Cleanup(func() { utils.SetKeyRing(prev) })
+}
+
+// adminRequest 以令牌中声明为 admin 的用户 7 访问管理接口，返回状态码与写入上下文的角色
+func adminRequest(t *testing.T, guard *TokenGuardMiddleware, tokenVersion int64) (int, string) {
+	t.Helper()
+	token, err := utils.GenerateToken(7, "alice", utils.RoleAdmin, tokenVersion, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := httptest.NewRequest(http.MethodGet, "/api/admin/users", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	var role string
+	guard.Handle(NewRequireAdminMiddleware().Handle(func(w http.ResponseWriter, r *http.Request) {
+		actor, _ := common.ActorFrom(r.Context())
+		role = actor.Role
+		w.WriteHeader(http.StatusOK)
+	}))(w, r)
+	return w.Code, role
+}
+
+func TestTokenGuardUsesCurrentRole(t *testing.T) {
+	setupKeyRing(t)
+	rpc := &authStateClient{resp: &super.GetUserAuthStateResp{Role: utils.RoleUser}}
+	// 令牌签发后被撤销了管理员角色
+	if code, _ := adminRequest(t, NewTokenGuardMiddleware(rpc), 0); code != http.StatusForbidden {
+		t.Fatalf("status = %d, want 403", code)
+	}
+}
+
+// 查询失败且没有缓存：签名有效的令牌也按未登录处理，不信任令牌中的角色，也不放行受限操作
+func TestTokenGuardRejectsTokenWhenStateUnavailable(t *testing.T) {
+	setupKeyRing(t)
+	guard := NewTokenGuardMiddleware(&authStateClient{err: errors.New("rpc unavailable")})
+
+	if code, _ := adminRequest(t, guard, 0); code != http.StatusUnauthorized {
+		t.Fatalf("管理接口 status = %d, want 401", code)
+	}
+
+	token, err := utils.GenerateToken(8, "bob", utils.RoleUser, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := httptest.NewRequest(http.MethodPost, "/api/posts", nil)
+	r.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	guard.Handle(NewRequireAuthMiddleware().Handle(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("需要登录的接口 status = %d, want 401", w.Code)
+	}
+	if !guard.Restricted(context.Background(), 8) {
+		t.Fatal("Restricted 在查询失败且没有缓存时应返回 true")
+	}
+
+	claims := &utils.CustomClaims{UserID: 7, Role: utils.RoleAdmin}
+	if guard.Valid(context.Background(), claims) {
+		t.Fatal("Valid 在查询失败且没有缓存时应返回 false")
+	}
+}
+
+func TestTokenGuardFallsBackToCachedState(t *testing.T) {
+	setupKeyRing(t)
+	rpc := &authStateClient{resp: &super.GetUserAuthStateResp{Role: utils.RoleAdmin, TokenVersion: 1}}
+	guard := NewTokenGuardMiddleware(rpc)
+	if code, role := adminRequest(t, guard, 1); code != http.StatusOK || role != utils.RoleAdmin {
+		t.Fatalf("status = %d role = %s", code, role)
+	}
+
+	// 缓存过期后 RPC 故障：沿用最近一次的状态
+	guard.mu.Lock()
+	s := guard.cache[7]
+	s.fetchedAt = time.Now().Add(-time.Hour)
+	guard.cache[7] = s
+	guard.mu.Unlock()
+	rpc.err = errors.New("rpc unavailable")
+
+	if code, role := adminRequest(t, guard, 1); code != http.StatusOK || role != utils.RoleAdmin {
+		t.Fatalf("status = %d role = %s, want 200 admin", code, role)
+	}
+	// 已吊销的旧版本令牌仍然拒绝
+	if code, _ := adminRequest(t, guard, 0); code != http.StatusUnauthorized {
+		t.Fatalf("status = %d, want 401", code)
+	}
+	if !guard.Valid(context.Background(), &utils.CustomClaims{UserID: 7, TokenVersion: 1}) {
+		t.Fatal("Valid 应沿用缓存的状态")
+	}
+}
@@ -2,21 +2,38 @@ package svc
 
 import (
 	"backend/api/internal/config"
+	"backend/api/internal/middleware"
 	"backend/rpc/pb/super"
 
+	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
 	Config         config.Config
 	SuperRpcClient super.SuperClient
+	// TokenGuard 拒绝已吊销的登录令牌（全局中间件）
+	TokenGuard *middleware.TokenGuardMiddleware
+	// RequireAuth 需要登录的路由分组中间件（令牌由 TokenGuard 用 JWT 密钥环校验）
+	RequireAuth rest.Middleware
+	// RequireAdmin / RequireSuperAdmin 管理接口的角色校验（路由分组中间件）
+	RequireAdmin      rest.Middleware
+	RequireSuperAdmin rest.Middleware
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	rpcClient := zrpc.MustNewClient(c.SuperRpc)
+	// 端到端加密密文不进客户端慢调用日志
+	zrpc.DontLogClientContentForMethod(super.Super_StoreEncryptedMessage_FullMethodName)
 
+	superClient := super.NewSuperClient(rpcClient.Conn())
 	return &ServiceContext{
 		Config:         c,
-		SuperRpcClient: super.NewSuperClient(rpcClient.Conn()),
+		SuperRpcClient: superClient,
+		TokenGuard:     middleware.NewTokenGuardMiddleware(superClient),
+		RequireAuth:    middleware.NewRequireAuthMiddleware().Handle,
+
+		RequireAdmin:      middleware.NewRequireAdminMiddleware().Handle,
+		RequireSuperAdmin: middleware.NewRequireSuperAdminMiddleware().Handle,
 	}
 }
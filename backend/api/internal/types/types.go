@@ -3,6 +3,27 @@
 
 package types
 
+type AccountDeletion struct {
+	Status      string `json:"status"`       // pending 等待期内可撤销 / canceled / processing / failed 删除出错，稍后重试 / completed
+	ScheduledAt string `json:"scheduled_at"` // 计划删除时间
+	RequestedAt string `json:"requested_at"`
+	CanceledAt  string `json:"canceled_at"`
+}
+
+type AccountDeletionReq struct {
+	UserId string `path:"user_id"`
+}
+
+type AckEncryptedMessagesReq struct {
+	DeviceId string   `json:"device_id"`
+	Ids      []string `json:"ids"`
+}
+
+type AckEncryptedMessagesResp struct {
+	BaseResp
+	Data int `json:"data"` // 本次确认的条数
+}
+
 type AvatarOutfit struct {
 	Id          string       `json:"id"`
 	Name        string       `json:"name"`
@@ -30,9 +51,19 @@ type BaseResp struct {
 	Success bool   `json:"success"`
 }
 
-type BroadcastNotificationReq struct {
-	Type string      `json:"type"`
-	Data interface{} `json:"data"`
+type BeginTotpEnrollmentResp struct {
+	BaseResp
+	Data TotpEnrollment `json:"data"`
+}
+
+type BlockUserReq struct {
+	UserId       string `path:"user_id"`
+	TargetUserId string `json:"target_user_id"`
+}
+
+type BlockedUser struct {
+	User      User   `json:"user"`
+	BlockedAt string `json:"blocked_at"`
 }
 
 type ChatOnlineBatchReq struct {
@@ -60,7 +91,8 @@ type CheckFollowReq struct {
 
 type CheckFollowResp struct {
 	BaseResp
-	Data bool `json:"data"`
+	Data      bool `json:"data"`
+	Requested bool `json:"requested,omitempty"` // 已向私密账号发送关注请求，尚未处理
 }
 
 type CheckInData struct {
@@ -119,7 +151,7 @@ type Comment struct {
 
 type CreateCommentReq struct {
 	PostId  string `json:"post_id"`
-	UserId  string `json:"user_id"`
+	UserId  string `json:"user_id,optional"` // 已弃用：评论者以登录令牌为准，忽略该字段
 	Content string `json:"content"`
 }
 
@@ -128,8 +160,17 @@ type CreateCommentResp struct {
 	Data Comment `json:"data"`
 }
 
+type CreateNotificationCampaignReq struct {
+	Title        string `json:"title"`
+	Content      string `json:"content"`
+	Segment      string `json:"segment"`                // all / vip / level / inactive
+	MinLevel     int    `json:"min_level,optional"`     // segment=level 时必填
+	InactiveDays int    `json:"inactive_days,optional"` // segment=inactive 时必填
+	ScheduledAt  string `json:"scheduled_at,optional"`  // "2006-01-02 15:04:05" 或 RFC3339，为空表示立即发送
+}
+
 type CreatePostReq struct {
-	UserId           string     `json:"user_id"`
+	UserId           string     `json:"user_id,optional"` // 已弃用：作者以登录令牌为准，忽略该字段
 	Content          string     `json:"content"`
 	Images           []string   `json:"images,optional"`
 	TopicTags        []TopicTag `json:"topic_tags,optional"`
@@ -172,6 +213,11 @@ type DeleteImageResp struct {
 	BaseResp
 }
 
+type DeletePostReq struct {
+	PostId string `path:"post_id"`
+	Reason string `json:"reason,optional"`
+}
+
 type DeleteUserMemoryReq struct {
 	UserId string `path:"user_id"`
 	Key    string `form:"key"`
@@ -187,6 +233,13 @@ type DeleteUserReq struct {
 
 type DeleteUserResp struct {
 	BaseResp
+	Data AccountDeletion `json:"data"`
+}
+
+type DisableTotpReq struct {
+	UserId   string `path:"user_id"`
+	Password string `json:"password"`
+	Code     string `json:"code"` // 验证码或恢复码
 }
 
 type Emoji struct {
@@ -215,6 +268,17 @@ type EmptyReq struct {
 type EmptyResp struct {
 }
 
+type EncryptedMessage struct {
+	Id                string `json:"id"`
+	SenderId          string `json:"sender_id"`
+	SenderDeviceId    string `json:"sender_device_id"`
+	RecipientId       string `json:"recipient_id"`
+	RecipientDeviceId string `json:"recipient_device_id"`
+	MessageType       int    `json:"message_type"` // 1:携带预共享密钥的首条消息 2:普通密文
+	Ciphertext        string `json:"ciphertext"`
+	CreatedAt         string `json:"created_at"`
+}
+
 type ExpLogRecord struct {
 	Id          string `json:"id"`
 	ExpChange   int    `json:"exp_change"`
@@ -232,6 +296,17 @@ type FavoriteEmojiPackResp struct {
 	BaseResp
 }
 
+type FollowRequestItem struct {
+	Id        string `json:"id"`
+	User      User   `json:"user"`
+	CreatedAt string `json:"created_at"`
+}
+
+type FollowRequestPathReq struct {
+	UserId    string `path:"user_id"`
+	RequestId string `path:"request_id"`
+}
+
 type FollowUserReq struct {
 	UserId      string `path:"user_id"`
 	FollowingId string `json:"following_id"`
@@ -239,7 +314,8 @@ type FollowUserReq struct {
 
 type FollowUserResp struct {
 	BaseResp
-	Data bool `json:"data"`
+	Data    bool `json:"data"`
+	Pending bool `json:"pending,omitempty"` // 对方是私密账号：已发送关注请求，等待批准
 }
 
 type FriendRelationData struct {
@@ -278,6 +354,11 @@ type FriendUserPathReq struct {
 	UserId string `path:"user_id"`
 }
 
+type GetAccountDeletionResp struct {
+	BaseResp
+	Data AccountDeletion `json:"data"` // 从未申请过时 status 为空
+}
+
 type GetAvatarOutfitReq struct {
 	OutfitId string `path:"outfit_id"`
 }
@@ -389,6 +470,11 @@ type GetImageListResp struct {
 	Total int         `json:"total"`
 }
 
+type GetMfaStatusResp struct {
+	BaseResp
+	Data MfaStatus `json:"data"`
+}
+
 type GetNotificationsReq struct {
 	UserId   string `form:"user_id"`
 	Page     int    `form:"page,default=1"`
@@ -401,11 +487,21 @@ type GetNotificationsResp struct {
 	Total int            `json:"total"`
 }
 
+type GetPendingEncryptedMessagesReq struct {
+	DeviceId string `form:"device_id"`
+	Limit    int    `form:"limit,default=100"`
+}
+
+type GetPendingEncryptedMessagesResp struct {
+	BaseResp
+	Data []EncryptedMessage `json:"data"`
+}
+
 type GetPostCommentsReq struct {
 	PostId       string `path:"post_id"`
 	Page         int    `form:"page,default=1"`
 	PageSize     int    `form:"page_size,default=10"`
-	ViewerUserId string `form:"viewer_user_id,optional"`
+	ViewerUserId string `form:"viewer_user_id,optional"` // 已弃用：查看者以登录令牌为准
 }
 
 type GetPostCommentsResp struct {
@@ -416,7 +512,7 @@ type GetPostCommentsResp struct {
 
 type GetPostReq struct {
 	PostId       string `path:"post_id"`
-	ViewerUserId string `form:"viewer_user_id,optional"`
+	ViewerUserId string `form:"viewer_user_id,optional"` // 已弃用：查看者以登录令牌为准
 }
 
 type GetPostResp struct {
@@ -427,7 +523,7 @@ type GetPostResp struct {
 type GetPostsReq struct {
 	Page         int    `form:"page,default=1"`
 	PageSize     int    `form:"page_size,default=10"`
-	ViewerUserId string `form:"viewer_user_id,optional"`
+	ViewerUserId string `form:"viewer_user_id,optional"` // 已弃用：查看者以登录令牌为准
 	FeedMode     string `form:"feed_mode,optional"`
 	TopicTagId   string `form:"topic_tag_id,optional"`
 	AuthorUserId string `form:"author_user_id,optional"`
@@ -439,6 +535,16 @@ type GetPostsResp struct {
 	Total int    `json:"total"`
 }
 
+type GetPreKeyBundlesReq struct {
+	UserId   string `path:"user_id"`
+	DeviceId string `form:"device_id,optional"`
+}
+
+type GetPreKeyBundlesResp struct {
+	BaseResp
+	Data []PreKeyBundle `json:"data"`
+}
+
 type GetRtcTokenReq struct {
 	ChannelName string `form:"channel_name"`
 	Role        uint8  `form:"role,optional,default=1"`
@@ -612,6 +718,12 @@ type GetVipPlansResp struct {
 	Data []VipPlan `json:"data"`
 }
 
+type GrantUserRoleReq struct {
+	UserId string `path:"user_id"`
+	Role   string `json:"role"` // admin / super_admin（也可以直接设为 user）
+	Reason string `json:"reason,optional"`
+}
+
 type ImageInfo struct {
 	Id        string `json:"id"`
 	Filename  string `json:"filename"`
@@ -620,6 +732,11 @@ type ImageInfo struct {
 	CreatedAt string `json:"created_at"`
 }
 
+type LiftSuspensionReq struct {
+	UserId string `path:"user_id"`
+	Reason string `json:"reason,optional"`
+}
+
 type LikeCommentReq struct {
 	CommentId string `path:"comment_id"`
 	UserId    string `json:"user_id"`
@@ -640,6 +757,37 @@ type LikePostResp struct {
 	Data Post `json:"data"`
 }
 
+type LinkUserIdentityReq struct {
+	UserId   string `path:"user_id"`
+	Provider string `path:"provider"`
+	State    string `json:"state"`
+	Code     string `json:"code"`
+}
+
+type ListBlockedUsersReq struct {
+	UserId   string `path:"user_id"`
+	Page     int    `form:"page,default=1"`
+	PageSize int    `form:"page_size,default=20"`
+}
+
+type ListBlockedUsersResp struct {
+	BaseResp
+	Data  []BlockedUser `json:"data"`
+	Total int64         `json:"total"`
+}
+
+type ListFollowRequestsReq struct {
+	UserId   string `path:"user_id"`
+	Page     int    `form:"page,default=1"`
+	PageSize int    `form:"page_size,default=20"`
+}
+
+type ListFollowRequestsResp struct {
+	BaseResp
+	Data  []FollowRequestItem `json:"data"`
+	Total int64               `json:"total"`
+}
+
 type ListFriendRequestsResp struct {
 	BaseResp
 	Data []FriendRequestView `json:"data"`
@@ -650,6 +798,74 @@ type ListFriendsResp struct {
 	Data []User `json:"data"`
 }
 
+type ListMfaPoliciesResp struct {
+	BaseResp
+	Data []MfaPolicy `json:"data"`
+}
+
+type ListNotificationCampaignsReq struct {
+	Page     int `form:"page,default=1"`
+	PageSize int `form:"page_size,default=20"`
+}
+
+type ListNotificationCampaignsResp struct {
+	BaseResp
+	Data  []NotificationCampaign `json:"data"`
+	Total int                    `json:"total"`
+}
+
+type ListOidcProvidersResp struct {
+	BaseResp
+	Data []OidcProvider `json:"data"`
+}
+
+type ListRoleAuditLogsReq struct {
+	UserId   string `form:"user_id,optional"`
+	Page     int    `form:"page,default=1"`
+	PageSize int    `form:"page_size,default=20"`
+}
+
+type ListRoleAuditLogsResp struct {
+	BaseResp
+	Data RoleAuditLogList `json:"data"`
+}
+
+type ListSecurityEventsReq struct {
+	UserId   string `path:"user_id"`
+	Page     int    `form:"page,default=1"`
+	PageSize int    `form:"page_size,default=20"`
+}
+
+type ListSecurityEventsResp struct {
+	BaseResp
+	Data SecurityEventList `json:"data"`
+}
+
+type ListSuspensionAppealsReq struct {
+	Status   string `form:"status,optional"`
+	Page     int    `form:"page,default=1"`
+	PageSize int    `form:"page_size,default=20"`
+}
+
+type ListSuspensionAppealsResp struct {
+	BaseResp
+	Data SuspensionAppealList `json:"data"`
+}
+
+type ListUserIdentitiesResp struct {
+	BaseResp
+	Data UserIdentityList `json:"data"`
+}
+
+type ListUserSessionsReq struct {
+	UserId string `path:"user_id"`
+}
+
+type ListUserSessionsResp struct {
+	BaseResp
+	Data []UserSession `json:"data"`
+}
+
 type LlmChatReq struct {
 	Model    string       `json:"model"`
 	Messages []LlmMessage `json:"messages"`
@@ -679,34 +895,174 @@ type LlmModelsResp struct {
 }
 
 type LoginData struct {
-	User  User   `json:"user"`
-	Token string `json:"token"`
+	User                User   `json:"user"`
+	Token               string `json:"token"`         // 短期访问令牌
+	RefreshToken        string `json:"refresh_token"` // 刷新令牌，每次刷新后轮换，旧的立即作废
+	ExpiresIn           int64  `json:"expires_in"`    // 访问令牌有效期（秒）
+	MfaRequired         bool   `json:"mfa_required,omitempty"`
+	MfaToken            string `json:"mfa_token,omitempty"`
+	MfaExpiresIn        int64  `json:"mfa_expires_in,omitempty"` // mfa_token 有效期（秒）
+	MfaSetupRequired    bool   `json:"mfa_setup_required,omitempty"`
+	DeletionScheduledAt string `json:"deletion_scheduled_at,omitempty"`
+	Registered          bool   `json:"registered,omitempty"`
 }
 
 type LoginReq struct {
-	Username string `json:"username,optional"`
-	Email    string `json:"email,optional"`
-	Password string `json:"password"`
+	Username     string `json:"username,optional"`
+	Email        string `json:"email,optional"`
+	Password     string `json:"password"`
+	CaptchaToken string `json:"captcha_token,optional"` // 响应中 captcha_required 为 true 后需要带上
 }
 
 type LoginResp struct {
 	BaseResp
-	Data LoginData `json:"data"`
+	Data            LoginData         `json:"data"`
+	CaptchaRequired bool              `json:"captcha_required,omitempty"` // 失败次数较多，下次登录需要人机验证
+	RetryAfter      int64             `json:"retry_after,omitempty"`      // 失败次数过多被锁定时，剩余秒数
+	Suspension      *SuspensionNotice `json:"suspension,omitempty"`       // 账号被封禁时的处罚信息与申诉令牌
+}
+
+type MfaCodeReq struct {
+	UserId string `path:"user_id"`
+	Code   string `json:"code"`
+}
+
+type MfaPolicy struct {
+	Role      string `json:"role"`
+	Required  bool   `json:"required"`
+	UpdatedBy string `json:"updated_by"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type MfaStatus struct {
+	Enabled                bool   `json:"enabled"`
+	Required               bool   `json:"required"` // 当前角色是否要求开启
+	RecoveryCodesRemaining int    `json:"recovery_codes_remaining"`
+	EnabledAt              string `json:"enabled_at"`
+}
+
+type MfaUserReq struct {
+	UserId string `path:"user_id"`
+}
+
+type MuteNotificationReq struct {
+	TargetType string `json:"target_type"` // post / user
+	TargetId   string `json:"target_id"`
 }
 
 type Notification struct {
+	Id           string              `json:"id"`
+	UserId       string              `json:"user_id"`
+	SenderId     string              `json:"sender_id"`
+	SenderName   string              `json:"sender_name"`
+	SenderAvatar string              `json:"sender_avatar"`
+	Type         int                 `json:"type"` // 1:点赞帖子 2:评论 3:关注 4:系统 5:点赞评论 6:私信 7:好友申请
+	PostId       string              `json:"post_id,optional"`
+	Content      string              `json:"content,optional"`
+	IsRead       bool                `json:"is_read"`
+	CreatedAt    string              `json:"created_at"`
+	Kind         string              `json:"kind"`        // 类型标识，如 follow、like_post
+	Title        string              `json:"title"`       // 按类型模板渲染的标题
+	TargetType   string              `json:"target_type"` // 跳转目标类型：post / comment / user / friend_request
+	TargetId     string              `json:"target_id"`
+	Route        string              `json:"route"`       // 客户端路由名
+	ActorCount   int                 `json:"actor_count"` // 聚合通知的发起人总数
+	Actors       []NotificationActor `json:"actors"`      // 最近的几位发起人（新的在前）
+	UpdatedAt    string              `json:"updated_at"`  // 有新发起人加入时刷新，列表按此排序
+}
+
+type NotificationActor struct {
+	Id     string `json:"id"`
+	Name   string `json:"name"`
+	Avatar string `json:"avatar"`
+}
+
+type NotificationCampaign struct {
 	Id           string `json:"id"`
-	UserId       string `json:"user_id"`
-	SenderId     string `json:"sender_id"`
-	SenderName   string `json:"sender_name"`
-	SenderAvatar string `json:"sender_avatar"`
-	Type         int    `json:"type"` // 1:点赞 2:评论 3:关注 4:系统
-	PostId       string `json:"post_id,optional"`
-	Content      string `json:"content,optional"`
-	IsRead       bool   `json:"is_read"`
+	CreatorId    string `json:"creator_id"`
+	Title        string `json:"title"`   // 活动名称（仅后台展示）
+	Content      string `json:"content"` // 用户收到的通知内容
+	Segment      string `json:"segment"` // all / vip / level / inactive
+	MinLevel     int    `json:"min_level"`
+	InactiveDays int    `json:"inactive_days"`
+	ScheduledAt  string `json:"scheduled_at"` // 为空表示立即发送
+	Status       string `json:"status"`       // scheduled / sending / completed / cancelled / failed
+	Error        string `json:"error"`
+	Recipients   int    `json:"recipients"` // 命中人群的用户数
+	Delivered    int    `json:"delivered"`  // 写入通知中心的条数
+	Read         int    `json:"read"`       // 其中已读的条数
+	StartedAt    string `json:"started_at"`
+	CompletedAt  string `json:"completed_at"`
 	CreatedAt    string `json:"created_at"`
 }
 
+type NotificationCampaignPathReq struct {
+	Id string `path:"id"`
+}
+
+type NotificationCampaignResp struct {
+	BaseResp
+	Data NotificationCampaign `json:"data"`
+}
+
+type NotificationKindPreference struct {
+	Kind  string `json:"kind"`           // 通知类型标识，如 like_comment
+	Label string `json:"label,optional"` // 展示名称（只读）
+	InApp bool   `json:"in_app"`         // 写入通知中心
+	Push  bool   `json:"push"`           // 实时推送
+	Email bool   `json:"email"`          // 邮件
+}
+
+type NotificationMute struct {
+	TargetType string `json:"target_type"` // post / user
+	TargetId   string `json:"target_id"`
+	CreatedAt  string `json:"created_at"`
+}
+
+type NotificationPreferences struct {
+	Kinds      []NotificationKindPreference `json:"kinds"`
+	Timezone   string                       `json:"timezone"` // IANA 时区名，如 Asia/Shanghai
+	QuietHours NotificationQuietHours       `json:"quiet_hours"`
+	Mutes      []NotificationMute           `json:"mutes"`
+}
+
+type NotificationPreferencesResp struct {
+	BaseResp
+	Data NotificationPreferences `json:"data"`
+}
+
+type NotificationQuietHours struct {
+	Enabled bool   `json:"enabled"`
+	Start   string `json:"start,optional"` // HH:MM（用户时区）
+	End     string `json:"end,optional"`   // HH:MM，可跨零点，如 22:00-08:00
+}
+
+type OidcAuthorization struct {
+	AuthorizationUrl string `json:"authorization_url"` // 在浏览器中打开，授权后提供方带 code 与 state 跳回客户端
+	State            string `json:"state"`
+	ExpiresIn        int64  `json:"expires_in"` // state 有效期（秒）
+}
+
+type OidcAuthorizeReq struct {
+	Provider string `path:"provider"`
+}
+
+type OidcAuthorizeResp struct {
+	BaseResp
+	Data OidcAuthorization `json:"data"`
+}
+
+type OidcLoginReq struct {
+	Provider string `path:"provider"`
+	State    string `json:"state"`
+	Code     string `json:"code"`
+}
+
+type OidcProvider struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+}
+
 type OutfitConfig struct {
 	Clothes     string   `json:"clothes"`
 	Accessories []string `json:"accessories"`
@@ -734,6 +1090,32 @@ type Post struct {
 	HandDrawCard     string     `json:"hand_draw_card,optional"`
 	HandDrawThumbUrl string     `json:"hand_draw_thumb_url,optional"`
 	ModerationStatus string     `json:"moderation_status,optional"`
+	Edited           bool       `json:"edited"`             // 发布后是否编辑过
+	EditedAt         string     `json:"edited_at,optional"` // 最近一次编辑时间
+}
+
+type PreKeyBundle struct {
+	UserId        string        `json:"user_id"`
+	DeviceId      string        `json:"device_id"`
+	IdentityKey   string        `json:"identity_key"`
+	SignedPreKey  SignedPreKey  `json:"signed_pre_key"`
+	OneTimePreKey *SignedPreKey `json:"one_time_pre_key,omitempty"` // 一次性公钥耗尽时为空
+}
+
+type PrivacySettings struct {
+	PrivateAccount    bool   `json:"private_account"`
+	DmPermission      string `json:"dm_permission"`
+	CommentPermission string `json:"comment_permission"`
+	HideFollowLists   bool   `json:"hide_follow_lists"`
+}
+
+type PrivacySettingsReq struct {
+	UserId string `path:"user_id"`
+}
+
+type PrivacySettingsResp struct {
+	BaseResp
+	Data PrivacySettings `json:"data"`
 }
 
 type PublicClientConfigResp struct {
@@ -780,8 +1162,23 @@ type RechargeResp struct {
 	Data Transaction `json:"data"`
 }
 
+type RecoveryCodes struct {
+	RecoveryCodes []string `json:"recovery_codes"` // 只返回这一次，请提示用户妥善保存
+}
+
+type RecoveryCodesResp struct {
+	BaseResp
+	Data RecoveryCodes `json:"data"`
+}
+
 type RefreshTokenData struct {
-	Token string `json:"token"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+type RefreshTokenReq struct {
+	RefreshToken string `json:"refresh_token"`
 }
 
 type RefreshTokenResp struct {
@@ -789,10 +1186,18 @@ type RefreshTokenResp struct {
 	Data RefreshTokenData `json:"data"`
 }
 
+type RegisterDeviceReq struct {
+	Token      string `json:"token"`             // FCM registration token 或 APNs device token
+	Platform   string `json:"platform"`          // android / ios / web
+	Provider   string `json:"provider,optional"` // fcm / apns；为空时 ios 用 apns，其余用 fcm
+	AppVersion string `json:"app_version,optional"`
+}
+
 type RegisterReq struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
 	Email    string `json:"email"`
+	Lang     string `json:"lang,optional"` // 验证邮件语言 zh / en，为空时按 Accept-Language
 }
 
 type RegisterResp struct {
@@ -810,8 +1215,15 @@ type ReportPostResp struct {
 	BaseResp
 }
 
+type RequestPasswordResetReq struct {
+	Email string `json:"email"`
+	Lang  string `json:"lang,optional"` // 邮件语言 zh / en，为空时按 Accept-Language
+}
+
 type ResetPasswordReq struct {
-	Email       string `json:"email"`
+	Email       string `json:"email,optional"`
+	Code        string `json:"code,optional"`
+	Token       string `json:"token,optional"`
 	NewPassword string `json:"new_password"`
 }
 
@@ -819,12 +1231,85 @@ type ResetPasswordResp struct {
 	BaseResp
 }
 
+type ReviewSuspensionAppealReq struct {
+	Id      string `path:"id"`
+	Approve bool   `json:"approve"`
+	Note    string `json:"note,optional"`
+}
+
+type RevokeAllUserSessionsData struct {
+	Revoked int `json:"revoked"` // 撤销的会话数
+}
+
+type RevokeAllUserSessionsReq struct {
+	UserId string `path:"user_id"`
+}
+
+type RevokeAllUserSessionsResp struct {
+	BaseResp
+	Data RevokeAllUserSessionsData `json:"data"`
+}
+
+type RevokeUserRoleReq struct {
+	UserId string `path:"user_id"`
+	Reason string `json:"reason,optional"`
+}
+
+type RevokeUserSessionReq struct {
+	UserId    string `path:"user_id"`
+	SessionId string `path:"session_id"`
+}
+
+type RoleAuditLog struct {
+	Id          string `json:"id"`
+	UserId      string `json:"user_id"`
+	ActorUserId string `json:"actor_user_id"`
+	Action      string `json:"action"` // grant / revoke
+	FromRole    string `json:"from_role"`
+	ToRole      string `json:"to_role"`
+	Reason      string `json:"reason"`
+	ClientIp    string `json:"client_ip"`
+	CreatedAt   string `json:"created_at"`
+}
+
+type RoleAuditLogList struct {
+	Logs  []RoleAuditLog `json:"logs"`
+	Total int64          `json:"total"`
+}
+
+type SecurityEvent struct {
+	Id        string `json:"id"`
+	Kind      string `json:"kind"` // login_success / login_failure / login_locked / password_change / password_reset / new_device / mfa_enabled / mfa_disabled / mfa_recovery_code_used / mfa_recovery_codes_reset / account_deletion_requested / account_deletion_canceled / identity_linked / identity_unlinked
+	ClientIp  string `json:"client_ip"`
+	UserAgent string `json:"user_agent"`
+	Detail    string `json:"detail"`
+	CreatedAt string `json:"created_at"`
+}
+
+type SecurityEventList struct {
+	Events []SecurityEvent `json:"events"`
+	Total  int64           `json:"total"`
+}
+
 type SendBatchNotificationReq struct {
 	UserIDs []string    `json:"user_ids"`
 	Type    string      `json:"type"`
 	Data    interface{} `json:"data"`
 }
 
+type SendEmailVerificationData struct {
+	Email string `json:"email"` // 验证邮件的收件邮箱
+}
+
+type SendEmailVerificationReq struct {
+	Lang string `json:"lang,optional"`
+}
+
+type SendEmailVerificationResp struct {
+	BaseResp
+	Data SendEmailVerificationData `json:"data"`
+}
+
 type SendFriendRequestReq struct {
 	UserId   string `path:"user_id"`
 	ToUserId string `json:"to_user_id,optional"`
@@ -842,6 +1327,89 @@ type SendNotificationReq struct {
 	Data   interface{} `json:"data"`
 }
 
+type SetMfaPolicyReq struct {
+	Role     string `path:"role"`
+	Required bool   `json:"required"`
+}
+
+type SetMfaPolicyResp struct {
+	BaseResp
+	Data MfaPolicy `json:"data"`
+}
+
+type SignedPreKey struct {
+	KeyId     uint32 `json:"key_id"`
+	PublicKey string `json:"public_key"`
+	Signature string `json:"signature"`
+}
+
+type SubmitAppealByTokenReq struct {
+	AppealToken string `json:"appeal_token"`
+	Message     string `json:"message"`
+}
+
+type SubmitAppealReq struct {
+	UserId  string `path:"user_id"`
+	Message string `json:"message"`
+}
+
+type SuspendUserReq struct {
+	UserId          string `path:"user_id"`
+	Kind            string `json:"kind"`
+	Reason          string `json:"reason"`
+	DurationSeconds int64  `json:"duration_seconds,optional"` // 0 或不传表示永久
+	HideContent     bool   `json:"hide_content,optional"`
+}
+
+type Suspension struct {
+	Id          string `json:"id"`
+	UserId      string `json:"user_id"`
+	Kind        string `json:"kind"` // suspend / restrict
+	Reason      string `json:"reason"`
+	HideContent bool   `json:"hide_content"`
+	ExpiresAt   string `json:"expires_at"` // 为空表示永久
+	CreatedBy   string `json:"created_by"`
+	CreatedAt   string `json:"created_at"`
+	LiftedAt    string `json:"lifted_at"`
+	LiftReason  string `json:"lift_reason"`
+	Active      bool   `json:"active"`
+}
+
+type SuspensionAppeal struct {
+	Id           string      `json:"id"`
+	SuspensionId string      `json:"suspension_id"`
+	User         User        `json:"user"`
+	Message      string      `json:"message"`
+	Status       string      `json:"status"` // pending / accepted / rejected
+	ReviewNote   string      `json:"review_note"`
+	ReviewedBy   string      `json:"reviewed_by"`
+	ReviewedAt   string      `json:"reviewed_at"`
+	CreatedAt    string      `json:"created_at"`
+	Suspension   *Suspension `json:"suspension,omitempty"`
+}
+
+type SuspensionAppealList struct {
+	Appeals []SuspensionAppeal `json:"appeals"`
+	Total   int64              `json:"total"`
+}
+
+type SuspensionAppealResp struct {
+	BaseResp
+	Data SuspensionAppeal `json:"data"`
+}
+
+type SuspensionNotice struct {
+	Reason               string `json:"reason"`
+	ExpiresAt            string `json:"expires_at"` // 为空表示永久
+	AppealToken          string `json:"appeal_token,omitempty"`
+	AppealTokenExpiresAt string `json:"appeal_token_expires_at,omitempty"`
+}
+
+type SuspensionResp struct {
+	BaseResp
+	Data *Suspension `json:"data"` // 没有生效中的处罚时为 null
+}
+
 type SyncUserVipStatusData struct {
 	IsVip     bool   `json:"is_vip"`
 	ExpiresAt string `json:"expires_at"`
@@ -862,6 +1430,11 @@ type TopicTag struct {
 	Color string `json:"color"`
 }
 
+type TotpEnrollment struct {
+	Secret     string `json:"secret"`      // Base32 密钥，供无法扫码时手动输入
+	OtpauthUri string `json:"otpauth_uri"` // otpauth:// 链接，客户端生成二维码
+}
+
 type Transaction struct {
 	Id          string  `json:"id"`
 	UserId      string  `json:"user_id"`
@@ -872,16 +1445,61 @@ type Transaction struct {
 	CreatedAt   string  `json:"created_at"`
 }
 
+type UnblockUserReq struct {
+	UserId       string `path:"user_id"`
+	TargetUserId string `path:"target_user_id"`
+}
+
 type UnfollowUserReq struct {
 	UserId      string `path:"user_id"`
 	FollowingId string `json:"following_id"`
 }
 
+type UnlinkUserIdentityReq struct {
+	UserId     string `path:"user_id"`
+	IdentityId string `path:"identity_id"`
+}
+
+type UnmuteNotificationReq struct {
+	TargetType string `path:"target_type"`
+	TargetId   string `path:"target_id"`
+}
+
+type UnregisterDeviceReq struct {
+	Token string `json:"token"`
+}
+
 type UpdateAutoRenewReq struct {
 	UserId    string `path:"user_id"`
 	AutoRenew bool   `json:"auto_renew"`
 }
 
+type UpdateNotificationPreferencesReq struct {
+	Kinds      []NotificationKindPreference `json:"kinds,optional"`       // 只需包含要修改的类型
+	Timezone   string                       `json:"timezone,optional"`    // 为空表示不修改
+	QuietHours *NotificationQuietHours      `json:"quiet_hours,optional"` // 为空表示不修改
+}
+
+type UpdatePostReq struct {
+	PostId    string     `path:"post_id"`
+	Content   string     `json:"content,optional"`
+	Images    []string   `json:"images,optional"`
+	TopicTags []TopicTag `json:"topic_tags,optional"`
+}
+
+type UpdatePostResp struct {
+	BaseResp
+	Data Post `json:"data"`
+}
+
+type UpdatePrivacySettingsReq struct {
+	UserId            string `path:"user_id"`
+	PrivateAccount    bool   `json:"private_account"`
+	DmPermission      string `json:"dm_permission,optional"`
+	CommentPermission string `json:"comment_permission,optional"`
+	HideFollowLists   bool   `json:"hide_follow_lists"`
+}
+
 type UpdateUserAvatarReq struct {
 	UserId        string       `path:"user_id"`
 	BaseConfig    BaseConfig   `json:"base_config"`
@@ -940,6 +1558,22 @@ type UploadImageResp struct {
 	Data ImageInfo `json:"data"`
 }
 
+type UploadPreKeyBundleData struct {
+	RemainingOneTimePreKeys int `json:"remaining_one_time_pre_keys"`
+}
+
+type UploadPreKeyBundleReq struct {
+	DeviceId       string         `json:"device_id"`
+	IdentityKey    string         `json:"identity_key"`
+	SignedPreKey   SignedPreKey   `json:"signed_pre_key"`
+	OneTimePreKeys []SignedPreKey `json:"one_time_pre_keys,optional"`
+}
+
+type UploadPreKeyBundleResp struct {
+	BaseResp
+	Data UploadPreKeyBundleData `json:"data"`
+}
+
 type UpsertUserMemoryReq struct {
 	UserId string `path:"user_id"`
 	Key    string `json:"key"`
@@ -968,6 +1602,8 @@ type User struct {
 	Balance         float64 `json:"balance"`
 	Inventory       string  `json:"inventory"`
 	EquippedFrameId string  `json:"equipped_frame_id"`
+	EmailVerified   bool    `json:"email_verified"`
+	PendingEmail    string  `json:"pending_email,omitempty"` // 修改邮箱时待验证的新邮箱，仅本人可见
 }
 
 type UserAvatar struct {
@@ -977,6 +1613,34 @@ type UserAvatar struct {
 	OwnedOutfits  []string     `json:"owned_outfits"`
 }
 
+type UserIdentitiesReq struct {
+	UserId string `path:"user_id"`
+}
+
+type UserIdentity struct {
+	Id          string `json:"id"`
+	Provider    string `json:"provider"`
+	Email       string `json:"email"`
+	DisplayName string `json:"display_name"`
+	CreatedAt   string `json:"created_at"`
+	LastLoginAt string `json:"last_login_at"`
+}
+
+type UserIdentityAuthorizeReq struct {
+	UserId   string `path:"user_id"`
+	Provider string `path:"provider"`
+}
+
+type UserIdentityList struct {
+	Identities  []UserIdentity `json:"identities"`
+	HasPassword bool           `json:"has_password"` // 为 false 时不能解绑最后一个第三方账号
+}
+
+type UserIdentityResp struct {
+	BaseResp
+	Data UserIdentity `json:"data"`
+}
+
 type UserLevelInfo struct {
 	Level        int     `json:"level"`
 	Experience   int     `json:"experience"`
@@ -996,12 +1660,56 @@ type UserMemory struct {
 	UpdatedAt string `json:"updated_at"`
 }
 
+type UserRoleData struct {
+	UserId       string `json:"user_id"`
+	Role         string `json:"role"`
+	PreviousRole string `json:"previous_role"`
+}
+
+type UserRoleResp struct {
+	BaseResp
+	Data UserRoleData `json:"data"`
+}
+
+type UserSession struct {
+	Id         string `json:"id"`
+	UserAgent  string `json:"user_agent"`
+	ClientIp   string `json:"client_ip"`
+	CreatedAt  string `json:"created_at"`
+	LastUsedAt string `json:"last_used_at"`
+	ExpiresAt  string `json:"expires_at"`
+	Current    bool   `json:"current"` // 是否为发起本次请求的会话
+}
+
+type UserSuspensionReq struct {
+	UserId string `path:"user_id"`
+}
+
 type UserVipStatusData struct {
 	IsVip     bool   `json:"is_vip"`
 	ExpiresAt string `json:"expires_at"`
 	AutoRenew bool   `json:"auto_renew"`
 }
 
+type VerifyEmailLinkReq struct {
+	Token string `json:"token"`
+}
+
+type VerifyEmailReq struct {
+	Code  string `json:"code,optional"`
+	Token string `json:"token,optional"`
+}
+
+type VerifyEmailResp struct {
+	BaseResp
+	Data User `json:"data"`
+}
+
+type VerifyLoginMfaReq struct {
+	MfaToken string `json:"mfa_token"`
+	Code     string `json:"code"` // 验证器中的 6 位验证码，或一个恢复码
+}
+
 type VipOrder struct {
 	Id        string  `json:"id"`
 	UserId    string  `json:"user_id"`
@@ -1068,3 +1776,18 @@ type VoiceCancelReq struct {
 type VoiceRejectReq struct {
 	CallId string `json:"call_id"`
 }
+
+type WsTicketData struct {
+	Ticket    string `json:"ticket"`
+	Endpoint  string `json:"endpoint"`
+	ExpiresIn int64  `json:"expires_in"` // 秒
+}
+
+type WsTicketReq struct {
+	Endpoint string `json:"endpoint,optional"` // 要连接的 WS 路径，如 /ws、/ws/chat；默认 /ws
+}
+
+type WsTicketResp struct {
+	BaseResp
+	Data WsTicketData `json:"data"`
+}
@@ -0,0 +1,171 @@
+// Package wsresume 为 WebSocket 下行事件分配按用户递增的序号，并保留一小段缓冲，
+// 供移动端切换网络重连时通过 ?resume_from=<seq> 补齐断线期间错过的事件。
+package wsresume
+
+import (
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxEvents 每个用户最多保留的事件条数
+	DefaultMaxEvents = 256
+	// DefaultRetention 事件保留时长；超过后视为已淘汰，重连需全量同步
+	DefaultRetention = 5 * time.Minute
+)
+
+type event struct {
+	seq  uint64
+	at   time.Time
+	data []byte
+}
+
+type userLog struct {
+	mu      sync.Mutex
+	lastSeq uint64
+	events  []event
+}
+
+// Stream 一个 WebSocket 端点的事件日志（/ws/chat 与 /ws/remote 各一份，序号互不相干）。
+type Stream struct {
+	mu        sync.Mutex
+	logs      map[string]*userLog
+	maxEvents int
+	retention time.Duration
+}
+
+func NewStream(maxEvents int, retention time.Duration) *Stream {
+	s := &Stream{
+		logs:      make(map[string]*userLog),
+		maxEvents: maxEvents,
+		retention: retention,
+	}
+	go s.sweepLoop()
+	return s
+}
+
+var (
+	Chat   = NewStream(DefaultMaxEvents, DefaultRetention)
+	Remote = NewStream(DefaultMaxEvents, DefaultRetention)
+)
+
+func (s *Stream) log(userID string) *userLog {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	l, ok := s.logs[userID]
+	if !ok {
+		l = &userLog{}
+		s.logs[userID] = l
+	}
+	return l
+}
+
+// prune 丢弃超龄或超量的旧事件；调用方需持有 l.mu。
+func (s *Stream) prune(l *userLog, now time.Time) {
+	drop := 0
+	for drop < len(l.events) && now.Sub(l.events[drop].at) > s.retention {
+		drop++
+	}
+	if over := len(l.events) - drop - s.maxEvents; over > 0 {
+		drop += over
+	}
+	if drop > 0 {
+		l.events = append(l.events[:0], l.events[drop:]...)
+	}
+}
+
+// Publish 为 payload（JSON 对象）分配下一个序号并写入缓冲，返回带 "seq" 字段的帧。
+// 用户不在线时同样入缓冲，重连后可补发。
+func (s *Stream) Publish(userID string, payload []byte) []byte {
+	l := s.log(userID)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lastSeq++
+	data := stamp(payload, l.lastSeq)
+	now := time.Now()
+	l.events = append(l.events, event{seq: l.lastSeq, at: now, data: data})
+	s.prune(l, now)
+	return data
+}
+
+// Attach 在该用户日志锁内调用 fn，fn 负责回放 missed 并登记新连接；
+// 这样登记之后发布的事件不会早于回放内容写出（极端并发下可能重复，客户端按 seq 去重）。
+// resumeFrom 为 0 表示全新连接，不回放；ok=false 表示缺口已被淘汰（或服务端重启后序号已重置），需要全量同步。
+func (s *Stream) Attach(userID string, resumeFrom uint64, fn func(missed [][]byte, latest uint64, ok bool)) {
+	l := s.log(userID)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	s.prune(l, time.Now())
+
+	if resumeFrom == 0 {
+		fn(nil, l.lastSeq, true)
+		return
+	}
+	if resumeFrom > l.lastSeq {
+		fn(nil, l.lastSeq, false)
+		return
+	}
+	oldest := l.lastSeq + 1
+	if len(l.events) > 0 {
+		oldest = l.events[0].seq
+	}
+	if resumeFrom+1 < oldest {
+		fn(nil, l.lastSeq, false)
+		return
+	}
+	missed := make([][]byte, 0, l.lastSeq-resumeFrom)
+	for _, e := range l.events {
+		if e.seq > resumeFrom {
+			missed = append(missed, e.data)
+		}
+	}
+	fn(missed, l.lastSeq, true)
+}
+
+// sweepLoop 定期释放超龄事件，避免离线用户的缓冲常驻内存。
+// 用户条目本身保留（只剩一个计数器），保证序号在进程生命周期内单调递增。
+func (s *Stream) sweepLoop() {
+	ticker := time.NewTicker(s.retention)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		s.mu.Lock()
+		logs := make([]*userLog, 0, len(s.logs))
+		for _, l := range s.logs {
+			logs = append(logs, l)
+		}
+		s.mu.Unlock()
+		for _, l := range logs {
+			l.mu.Lock()
+			s.prune(l, now)
+			if len(l.events) == 0 {
+				l.events = nil
+			}
+			l.mu.Unlock()
+		}
+	}
+}
+
+// ParseResumeFrom 解析 ?resume_from=；缺省或非法时返回 0（不回放）。
+func ParseResumeFrom(v string) uint64 {
+	n, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// stamp 在 JSON 对象开头插入 "seq" 字段，避免对任意 payload 反序列化再编码。
+func stamp(payload []byte, seq uint64) []byte {
+	prefix := `{"seq":` + strconv.FormatUint(seq, 10)
+	if len(payload) < 2 || payload[0] != '{' {
+		return []byte(prefix + `}`)
+	}
+	body := payload[1:]
+	out := make([]byte, 0, len(prefix)+len(payload)+1)
+	out = append(out, prefix...)
+	if len(body) > 0 && body[0] != '}' {
+		out = append(out, ',')
+	}
+	return append(out, body...)
+}
@@ -0,0 +1,19 @@
+// Package wsticket WebSocket 连接用的一次性短期票据。
+// 浏览器 WebSocket 无法设置 Authorization 头，以前只能把 5 天有效的 JWT 放进 ?token=，
+// 会落进代理与访问日志；改为先用 JWT 换票据，再以 ?ticket= 连接。
+// 票据由 RPC 的 IssueWsTicket / RedeemWsTicket 保存在数据库中并在兑换时删除，
+// 多实例部署时签发与连接可以落在不同的 API 实例，不需要会话保持；这里只保留可签发票据的端点列表。
+package wsticket
+
+// Endpoints 可签发票据的 WebSocket 路径
+var Endpoints = []string{"/ws", "/ws/chat", "/ws/presence", "/ws/remote", "/ws/world"}
+
+// ValidEndpoint 是否为可签发票据的 WebSocket 路径
+func ValidEndpoint(endpoint string) bool {
+	for _, e := range Endpoints {
+		if e == endpoint {
+			return true
+		}
+	}
+	return false
+}
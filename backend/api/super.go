@@ -7,10 +7,14 @@ import (
 
 	"backend/api/internal/config"
 	"backend/api/internal/handler"
+	"backend/api/internal/logic/notification"
+	"backend/api/internal/middleware"
 	"backend/api/internal/svc"
+	"backend/utils"
 
 	"github.com/spf13/viper"
 	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
 	"github.com/zeromicro/go-zero/rest"
 )
 
@@ -18,8 +22,8 @@ var configFile = flag.String("f", "etc/super.yaml", "the config file")
 
 // applyUnifiedConfigOverrides 尝试从 backend/config/config.yaml 读取统一配置并覆盖部分字段。
 // 例如 Ollama、REST 超时、以及客户端用的 app_client.public_api_base_url（不必写进 etc/super.yaml）。
-// 注意：如果找不到/读取失败，会静默跳过，保持原有 go-zero 配置行为不变。
-func applyUnifiedConfigOverrides(c *config.Config) {
+// 注意：如果找不到/读取失败，会静默跳过，保持原有 go-zero 配置行为不变（此时返回 nil）。
+func applyUnifiedConfigOverrides(c *config.Config) *viper.Viper {
 	v := viper.New()
 	v.SetConfigName("config")
 	v.SetConfigType("yaml")
@@ -32,7 +36,7 @@ func applyUnifiedConfigOverrides(c *config.Config) {
 	v.AddConfigPath("../../config")
 
 	if err := v.ReadInConfig(); err != nil {
-		return
+		return nil
 	}
 
 	if base := v.GetString("ollama.base_url"); base != "" {
@@ -48,6 +52,7 @@ func applyUnifiedConfigOverrides(c *config.Config) {
 	if u := v.GetString("app_client.public_api_base_url"); u != "" {
 		c.ClientPublicApiBaseUrl = u
 	}
+	return v
 }
 
 func main() {
@@ -55,7 +60,9 @@ func main() {
 
 	var c config.Config
 	conf.MustLoad(*configFile, &c)
-	applyUnifiedConfigOverrides(&c)
+	unified := applyUnifiedConfigOverrides(&c)
+	// JWT 密钥环：统一配置的 jwt 段或环境变量（MOE_JWT_KEYS / MOE_JWT_SECRET），缺失时拒绝启动
+	logx.Must(utils.InitKeyRing(unified))
 
 	// 使用go-zero内置的CORS支持，允许所有来源（开发环境）
 	server := rest.MustNewServer(c.RestConf, rest.WithCustomCors(
@@ -71,7 +78,17 @@ func main() {
 	defer server.Stop()
 
 	ctx := svc.NewServiceContext(c)
+	requestInfo, err := middleware.NewRequestInfoMiddleware(c.TrustedProxies)
+	logx.Must(err)
+	server.Use(requestInfo.Handle)
+	server.Use(ctx.TokenGuard.Handle)
+	server.Use(middleware.NewOwnershipMiddleware(middleware.UserRoutePolicies).Handle)
 	handler.RegisterHandlers(server, ctx)
+	handler.RegisterExtraHandlers(server, ctx)
+	// 带 :user_id 的路由必须在规则表中声明访问规则，漏配时拒绝启动
+	logx.Must(middleware.CheckRoutePolicies(server.Routes(), middleware.UserRoutePolicies))
+	// 订阅 RPC 层新通知，实时推送给本实例上在线的用户
+	notification.StartNotificationPush(ctx)
 
 	fmt.Printf("Starting server at %s:%d...\n", c.Host, c.Port)
 	server.Start()
@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// 注销申请状态
+const (
+	AccountDeletionPending    = "pending"    // 宽限期内，可撤销
+	AccountDeletionCanceled   = "canceled"   // 用户在宽限期内撤销
+	AccountDeletionProcessing = "processing" // 后台正在清除数据
+	AccountDeletionFailed     = "failed"     // 清除失败，稍后自动重试
+	AccountDeletionCompleted  = "completed"  // 已清除
+)
+
+// AccountDeletion 注销账号申请：宽限期结束后由后台任务清除用户数据。
+// 财务记录（交易、VIP 订单）保留用于对账，只保留用户 ID，用户行匿名化后软删除
+type AccountDeletion struct {
+	ID          uint       `gorm:"primarykey" json:"id"`
+	UserID      uint       `gorm:"not null;index" json:"user_id"`
+	RequestedBy uint       `gorm:"not null" json:"requested_by"` // 发起人，管理员代为注销时与 UserID 不同
+	Status      string     `gorm:"size:16;not null;index:idx_account_deletion_due,priority:1" json:"status"`
+	ScheduledAt time.Time  `gorm:"index:idx_account_deletion_due,priority:2" json:"scheduled_at"` // 宽限期结束、开始清除的时间
+	ClientIP    string     `gorm:"size:64" json:"client_ip"`
+	CanceledAt  *time.Time `json:"canceled_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	Attempts    int        `gorm:"not null;default:0" json:"attempts"`
+	LastError   string     `gorm:"size:512" json:"last_error"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+)
+
+// E2eeDevice 端到端加密设备公钥目录：每个用户的每台设备一条，服务端只保存公钥
+type E2eeDevice struct {
+	ID                    uint      `gorm:"primarykey" json:"id"`
+	UserID                uint      `gorm:"not null;uniqueIndex:uk_e2ee_user_device,priority:1" json:"user_id"`
+	DeviceID              string    `gorm:"size:64;not null;uniqueIndex:uk_e2ee_user_device,priority:2" json:"device_id"`
+	IdentityKey           string    `gorm:"type:text;not null" json:"identity_key"`   // Ed25519 身份公钥（base64）
+	SignedPreKeyID        uint32    `gorm:"not null" json:"signed_pre_key_id"`        // 签名预共享公钥编号
+	SignedPreKey          string    `gorm:"type:text;not null" json:"signed_pre_key"` // 签名预共享公钥（base64）
+	SignedPreKeySignature string    `gorm:"type:text;not null" json:"signed_pre_key_signature"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+// E2eeOneTimePreKey 一次性预共享公钥：被他人领取后即删除，保证每个会话只用一次
+type E2eeOneTimePreKey struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	UserID    uint      `gorm:"not null;index:idx_e2ee_otk_owner,priority:1" json:"user_id"`
+	DeviceID  string    `gorm:"size:64;not null;index:idx_e2ee_otk_owner,priority:2" json:"device_id"`
+	KeyID     uint32    `gorm:"not null" json:"key_id"`
+	PublicKey string    `gorm:"type:text;not null" json:"public_key"`
+	Signature string    `gorm:"type:text;not null" json:"signature"` // 身份私钥签名
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// E2eePreKeyClaim 领取他人一次性预共享公钥的记录，用于限制反复领取耗尽对方的公钥；只保留限流窗口内的记录
+type E2eePreKeyClaim struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	ClaimerID uint      `gorm:"not null;index:idx_e2ee_claim_claimer,priority:1" json:"claimer_id"`
+	UserID    uint      `gorm:"not null;index" json:"user_id"` // 公钥所属用户
+	DeviceID  string    `gorm:"size:64;not null" json:"device_id"`
+	CreatedAt time.Time `gorm:"index:idx_e2ee_claim_claimer,priority:2" json:"created_at"`
+}
+
+// E2eeMessage 端到端加密消息信封：Ciphertext 对服务端不透明，仅存储与转发
+type E2eeMessage struct {
+	ID                uint       `gorm:"primarykey" json:"id"`
+	SenderID          uint       `gorm:"not null;index" json:"sender_id"`
+	SenderDeviceID    string     `gorm:"size:64" json:"sender_device_id"`
+	RecipientID       uint       `gorm:"not null;index:idx_e2ee_msg_recipient,priority:1" json:"recipient_id"`
+	RecipientDeviceID string     `gorm:"size:64;index:idx_e2ee_msg_recipient,priority:2" json:"recipient_device_id"` // 接收设备：密文按设备分别加密发送，投递状态按设备确认
+	MessageType       int        `gorm:"not null" json:"message_type"`                                               // 1:携带预共享密钥的首条消息 2:普通密文
+	Ciphertext        string     `gorm:"type:longtext;not null" json:"-"`
+	DeliveredAt       *time.Time `gorm:"index" json:"delivered_at,omitempty"` // 接收设备确认后写入
+	CreatedAt         time.Time  `json:"created_at"`
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+)
+
+// 发件箱状态
+const (
+	EmailStatusPending = "pending" // 等待投递（含失败后等待重试）
+	EmailStatusSending = "sending" // 投递中
+	EmailStatusSent    = "sent"
+	EmailStatusFailed  = "failed" // 重试用尽或收件人被拒绝
+)
+
+// EmailOutbox 待发送的邮件；业务方只写入发件箱，由后台按退避策略投递与重试
+type EmailOutbox struct {
+	ID            uint       `gorm:"primarykey" json:"id"`
+	Recipient     string     `gorm:"size:255;not null;index" json:"recipient"`
+	Template      string     `gorm:"size:64;not null" json:"template"` // 模板名，便于统计与排查
+	Lang          string     `gorm:"size:8;not null" json:"lang"`
+	Subject       string     `gorm:"size:255;not null" json:"subject"`
+	TextBody      string     `gorm:"type:text" json:"-"`
+	HTMLBody      string     `gorm:"type:text" json:"-"`
+	Status        string     `gorm:"size:16;not null;default:pending;index:idx_email_outbox_due,priority:1" json:"status"`
+	NextAttemptAt time.Time  `gorm:"index:idx_email_outbox_due,priority:2" json:"next_attempt_at"`
+	Attempts      int        `gorm:"not null;default:0" json:"attempts"`
+	LastError     string     `gorm:"size:512" json:"last_error"`
+	SentAt        *time.Time `json:"sent_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
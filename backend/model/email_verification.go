@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+)
+
+// 邮箱验证用途
+const (
+	EmailVerifyPurposeRegister = "register" // 注册后验证当前邮箱
+	EmailVerifyPurposeChange   = "change"   // 修改邮箱：验证通过后把 Email 换成新邮箱
+)
+
+// EmailVerification 邮箱验证凭证：6 位验证码与链接令牌只保存哈希
+type EmailVerification struct {
+	ID        uint       `gorm:"primarykey" json:"id"`
+	UserID    uint       `gorm:"not null;index:idx_email_verification_user,priority:1" json:"user_id"`
+	Email     string     `gorm:"size:100;not null" json:"email"` // 要验证的邮箱
+	Purpose   string     `gorm:"size:16;not null" json:"purpose"`
+	CodeSalt  string     `gorm:"size:32;not null" json:"-"`
+	CodeHash  string     `gorm:"size:64;not null" json:"-"`
+	LinkHash  string     `gorm:"size:64;not null;uniqueIndex" json:"-"`
+	Attempts  int        `gorm:"not null;default:0" json:"attempts"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"`
+	RevokedAt *time.Time `json:"revoked_at"`
+	CreatedAt time.Time  `gorm:"index:idx_email_verification_user,priority:2" json:"created_at"`
+}
@@ -14,3 +14,11 @@ type Follow struct {
 	CreatedAt   time.Time      `json:"created_at"`
 	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
 }
+
+// FollowRequest 关注私密账号时的待批准请求；批准后转为 Follow，拒绝或撤回时删除
+type FollowRequest struct {
+	ID          uint      `gorm:"primarykey" json:"id"`
+	FollowerID  uint      `gorm:"not null;uniqueIndex:uk_follow_request" json:"follower_id"`
+	FollowingID uint      `gorm:"not null;index;uniqueIndex:uk_follow_request" json:"following_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,12 @@
+package model
+
+import (
+	"time"
+)
+
+// LoginLock 每个账号一行，只用作行锁：登录时先锁住该行，再统计失败次数、校验密码并记录失败，
+// 同一账号的并发尝试因此串行执行，不能同时越过锁定阈值
+type LoginLock struct {
+	UserID    uint      `gorm:"primarykey;autoIncrement:false" json:"user_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+)
+
+// UserTOTP 用户的 TOTP 两步验证密钥。EnabledAt 为空表示正在绑定（扫码后尚未输入验证码确认）
+type UserTOTP struct {
+	ID     uint   `gorm:"primarykey" json:"id"`
+	UserID uint   `gorm:"not null;uniqueIndex" json:"user_id"`
+	Secret string `gorm:"size:64;not null" json:"-"` // base32，生成验证码需要原文，不能只存哈希
+	// LastUsedStep 最近一次验证通过的时间步，同一个验证码不能重复使用
+	LastUsedStep int64      `gorm:"not null;default:0" json:"-"`
+	EnabledAt    *time.Time `json:"enabled_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
+// MFARecoveryCode 两步验证恢复码，只存 sha256，每个只能用一次
+type MFARecoveryCode struct {
+	ID        uint       `gorm:"primarykey" json:"id"`
+	UserID    uint       `gorm:"not null;index" json:"user_id"`
+	CodeHash  string     `gorm:"size:64;not null" json:"-"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// MFAChallenge 密码验证通过、等待第二步验证的登录。令牌只存 sha256，通过后即作废
+type MFAChallenge struct {
+	ID        uint       `gorm:"primarykey" json:"id"`
+	UserID    uint       `gorm:"not null;index" json:"user_id"`
+	TokenHash string     `gorm:"size:64;not null;uniqueIndex" json:"-"`
+	ClientIP  string     `gorm:"size:64" json:"client_ip"`
+	UserAgent string     `gorm:"size:255" json:"user_agent"`
+	Attempts  int        `gorm:"not null;default:0" json:"attempts"` // 已输错的次数
+	ExpiresAt time.Time  `gorm:"not null;index" json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// MFAPolicy 按角色强制两步验证。要求开启的角色在未绑定前不能使用该角色的管理权限
+type MFAPolicy struct {
+	Role      string    `gorm:"primarykey;size:20" json:"role"`
+	Required  bool      `gorm:"not null;default:false" json:"required"`
+	UpdatedBy uint      `json:"updated_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
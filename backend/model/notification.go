@@ -6,20 +6,51 @@ import (
 	"gorm.io/gorm"
 )
 
+// 通知类型（notifications.type），取值与客户端 NotificationModel 常量一致；
+// 展示模板、跳转目标等元数据见 rpc/internal/notify
+const (
+	NotificationTypeLikePost       = 1
+	NotificationTypeComment        = 2
+	NotificationTypeFollow         = 3
+	NotificationTypeSystem         = 4
+	NotificationTypeLikeComment    = 5
+	NotificationTypePrivateMessage = 6
+	NotificationTypeFriendRequest  = 7
+	NotificationTypeIncomingCall   = 8 // 仅用于设备推送，不写入通知中心
+	NotificationTypeFollowRequest  = 9
+)
+
 // Notification 通知模型
 type Notification struct {
-	ID        uint           `gorm:"primarykey" json:"id"`
-	UserID    uint           `gorm:"not null;index" json:"user_id"`   // 接收通知的用户ID (被评论/点赞的人)
-	SenderID  uint           `gorm:"not null;index" json:"sender_id"` // 发送通知的用户ID (评论/点赞的人)
-	Type      int            `gorm:"not null" json:"type"`            // 1:点赞帖子 2:评论 3:关注 4:系统 5:点赞评论 6:私信
-	PostID    uint           `gorm:"index" json:"post_id"`            // 相关帖子ID
-	Content   string         `gorm:"type:text" json:"content"`        // 通知内容 (评论内容摘要)
-	IsRead    bool           `gorm:"default:false" json:"is_read"`    // 是否已读
-	CreatedAt time.Time      `json:"created_at"`
-	UpdatedAt time.Time      `json:"updated_at"`
-	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+	ID         uint           `gorm:"primarykey" json:"id"`
+	UserID     uint           `gorm:"not null;index" json:"user_id"`         // 接收通知的用户ID (被评论/点赞的人)
+	SenderID   uint           `gorm:"not null;index" json:"sender_id"`       // 发送通知的用户ID (评论/点赞的人)
+	Type       int            `gorm:"not null" json:"type"`                  // 1:点赞帖子 2:评论 3:关注 4:系统 5:点赞评论 6:私信 7:好友申请 8:来电
+	PostID     uint           `gorm:"index" json:"post_id"`                  // 相关帖子ID
+	TargetID   uint           `gorm:"default:0" json:"target_id"`            // 跳转目标ID，含义由通知类型决定（评论、好友申请、用户等）
+	Content    string         `gorm:"type:text" json:"content"`              // 通知内容 (评论内容摘要)
+	IsRead     bool           `gorm:"default:false" json:"is_read"`          // 是否已读
+	ActorCount int            `gorm:"not null;default:1" json:"actor_count"` // 聚合通知的发起人数（SenderID 为最近一位），非聚合类型恒为 1
+	CampaignID uint           `gorm:"index;default:0" json:"campaign_id"`    // 来自推送活动时为活动ID，用于统计送达与已读
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `gorm:"index" json:"updated_at"` // 有新发起人加入时刷新，列表按此排序
+	DeletedAt  gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// 仍在接收新发起人的聚合分组的键（接收者:类型:目标），唯一索引保证并发的首次互动只建一组；
+	// 分组读过、过期或删除后由下一次新建分组清空，非聚合类型为空
+	AggregateKey *string `gorm:"size:64;uniqueIndex" json:"-"`
 
 	// 关联
 	Sender User `gorm:"foreignKey:SenderID" json:"sender"`
 	Post   Post `gorm:"foreignKey:PostID" json:"post"`
 }
+
+// NotificationActor 聚合通知的发起人（每人一行），用于精确计数与展示最近几位
+type NotificationActor struct {
+	ID             uint      `gorm:"primarykey" json:"id"`
+	NotificationID uint      `gorm:"not null;uniqueIndex:idx_notification_actor" json:"notification_id"`
+	ActorID        uint      `gorm:"not null;uniqueIndex:idx_notification_actor" json:"actor_id"`
+	CreatedAt      time.Time `json:"created_at"`
+
+	Actor User `gorm:"foreignKey:ActorID" json:"actor"`
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+)
+
+// 推送活动的目标人群
+const (
+	CampaignSegmentAll      = "all"      // 全部用户
+	CampaignSegmentVip      = "vip"      // 有效期内的 VIP
+	CampaignSegmentLevel    = "level"    // 等级 ≥ MinLevel
+	CampaignSegmentInactive = "inactive" // 超过 InactiveDays 天未登录
+)
+
+// 推送活动状态
+const (
+	CampaignStatusScheduled = "scheduled" // 等待发送（未到定时时间或尚未被调度）
+	CampaignStatusSending   = "sending"   // 正在分批写入
+	CampaignStatusCompleted = "completed" // 已发送完毕
+	CampaignStatusCancelled = "cancelled" // 被取消；发送中取消时已写入的通知保留
+	CampaignStatusFailed    = "failed"    // 发送中出错，见 Error；已写入的通知保留
+)
+
+// NotificationCampaign 管理员发起的系统通知推送活动：按人群分批写入每个用户的通知中心
+type NotificationCampaign struct {
+	ID           uint       `gorm:"primarykey" json:"id"`
+	CreatorID    uint       `gorm:"not null;index" json:"creator_id"`  // 发起的管理员
+	Title        string     `gorm:"size:100;not null" json:"title"`    // 活动名称（仅后台展示）
+	Content      string     `gorm:"type:text;not null" json:"content"` // 用户收到的通知内容
+	Segment      string     `gorm:"size:20;not null" json:"segment"`   // 目标人群：all / vip / level / inactive
+	MinLevel     int        `gorm:"default:0" json:"min_level"`        // segment=level 时的最低等级
+	InactiveDays int        `gorm:"default:0" json:"inactive_days"`    // segment=inactive 时的未登录天数
+	ScheduledAt  *time.Time `gorm:"index" json:"scheduled_at"`         // 定时发送时间，为空表示立即发送
+	Status       string     `gorm:"size:20;not null;index" json:"status"`
+	Error        string     `gorm:"size:255" json:"error"`
+
+	// 发送进度：按用户 ID 递增分批，Cursor 为已处理到的最大用户 ID，中断后从这里继续
+	Cursor     uint `gorm:"default:0" json:"-"`
+	Recipients int  `gorm:"default:0" json:"recipients"` // 命中人群的用户数
+	Delivered  int  `gorm:"default:0" json:"delivered"`  // 实际写入通知中心的条数（关闭了系统通知的用户不写入）
+
+	StartedAt   *time.Time `json:"started_at"`
+	CompletedAt *time.Time `json:"completed_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
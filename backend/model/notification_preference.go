@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+)
+
+// 默认时区（用户未设置时免打扰时段按此计算）
+const DefaultTimezone = "Asia/Shanghai"
+
+// NotificationPreference 用户通知偏好（每人一行，未创建时全部按通知类型的默认渠道）
+type NotificationPreference struct {
+	ID       uint   `gorm:"primarykey" json:"id"`
+	UserID   uint   `gorm:"not null;uniqueIndex" json:"user_id"`
+	Channels string `gorm:"type:text" json:"channels"` // JSON：{"like_comment":{"in_app":true,"push":false,"email":false}}，未出现的类型用默认渠道
+	Timezone string `gorm:"size:64" json:"timezone"`   // IANA 时区名，如 Asia/Shanghai
+	// 免打扰时段（用户时区的 HH:MM，可跨零点，如 22:00-08:00）：期间照常写入通知中心，但不实时推送、不发邮件
+	QuietEnabled bool      `gorm:"default:false" json:"quiet_enabled"`
+	QuietStart   string    `gorm:"size:5" json:"quiet_start"`
+	QuietEnd     string    `gorm:"size:5" json:"quiet_end"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// 屏蔽目标类型
+const (
+	MuteTargetPost = "post" // 屏蔽某条动态下的点赞、评论等通知
+	MuteTargetUser = "user" // 屏蔽某个用户引发的全部通知
+)
+
+// NotificationMute 用户屏蔽的通知来源
+type NotificationMute struct {
+	ID         uint      `gorm:"primarykey" json:"id"`
+	UserID     uint      `gorm:"not null;uniqueIndex:idx_notification_mute" json:"user_id"`
+	TargetType string    `gorm:"size:16;not null;uniqueIndex:idx_notification_mute" json:"target_type"` // post / user
+	TargetID   uint      `gorm:"not null;uniqueIndex:idx_notification_mute" json:"target_id"`
+	CreatedAt  time.Time `json:"created_at"`
+}
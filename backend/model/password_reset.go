@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+)
+
+// PasswordResetToken 找回密码的一次性凭证：邮件中的 6 位验证码与链接令牌只保存哈希。
+// 邮箱未注册时也记录一行（UserID 为 0，不发邮件），使限流结果与邮箱是否注册无关，避免被用来探测账号。
+type PasswordResetToken struct {
+	ID        uint       `gorm:"primarykey" json:"id"`
+	UserID    uint       `gorm:"not null;index" json:"user_id"`
+	Email     string     `gorm:"size:100;not null;index:idx_password_reset_email,priority:1" json:"email"`
+	CodeSalt  string     `gorm:"size:32;not null" json:"-"`
+	CodeHash  string     `gorm:"size:64;not null" json:"-"`             // sha256(salt + 验证码)
+	LinkHash  string     `gorm:"size:64;not null;uniqueIndex" json:"-"` // sha256(链接令牌)
+	Attempts  int        `gorm:"not null;default:0" json:"attempts"`    // 验证码输错次数
+	ExpiresAt time.Time  `json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"`
+	RevokedAt *time.Time `json:"revoked_at"` // 重新申请或重置成功后，其余未使用的凭证作废
+	RequestIP string     `gorm:"size:64;index:idx_password_reset_ip,priority:1" json:"request_ip"`
+	CreatedAt time.Time  `gorm:"index:idx_password_reset_email,priority:2;index:idx_password_reset_ip,priority:2" json:"created_at"`
+}
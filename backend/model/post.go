@@ -20,6 +20,8 @@ type Post struct {
 	ModerationStatus string `gorm:"size:32;default:ok;index" json:"moderation_status"`
 	Likes     int            `gorm:"default:0" json:"likes"`            // 点赞数
 	Comments  int            `gorm:"default:0" json:"comments"`         // 评论数
+	// 最近一次编辑时间；为空表示未编辑过
+	EditedAt  *time.Time     `json:"edited_at"`
 	CreatedAt time.Time      `json:"created_at"`
 	UpdatedAt time.Time      `json:"updated_at"`
 	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
@@ -0,0 +1,16 @@
+package model
+
+import (
+	"time"
+)
+
+// PostRevision 帖子被编辑前的版本，每次编辑保存一条，按 ID 先后即编辑顺序
+type PostRevision struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	PostID    uint      `gorm:"not null;index" json:"post_id"`
+	EditorID  uint      `gorm:"not null" json:"editor_id"`
+	Content   string    `gorm:"type:text" json:"content"`
+	Images    string    `gorm:"type:text" json:"images"`     // 同 Post.Images，JSON 数组
+	TopicTags string    `gorm:"type:text" json:"topic_tags"` // 话题名称 JSON 数组
+	CreatedAt time.Time `json:"created_at"`                  // 被替换下来的时间
+}
@@ -0,0 +1,42 @@
+package model
+
+import "time"
+
+// 私信、评论权限的可选范围
+const (
+	AudienceEveryone  = "everyone"
+	AudienceFollowers = "followers" // 关注了我的用户
+	AudienceFriends   = "friends"   // 好友（好友申请已通过）
+	AudienceNobody    = "nobody"
+)
+
+// PrivacySettings 用户隐私设置（每人一行，未创建时为默认值：公开账号、所有人可私信与评论、关注列表公开）
+type PrivacySettings struct {
+	ID     uint `gorm:"primarykey" json:"id"`
+	UserID uint `gorm:"not null;uniqueIndex" json:"user_id"`
+	// PrivateAccount 私密账号：关注需要本人批准，动态与关注列表只有已批准的粉丝可见
+	PrivateAccount    bool      `gorm:"not null;default:false;index" json:"private_account"`
+	DMPermission      string    `gorm:"size:16;not null;default:everyone" json:"dm_permission"`      // 谁可以私信我
+	CommentPermission string    `gorm:"size:16;not null;default:everyone" json:"comment_permission"` // 谁可以评论我的动态
+	HideFollowLists   bool      `gorm:"not null;default:false" json:"hide_follow_lists"`             // 他人不能查看我的关注与粉丝列表
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// DefaultPrivacySettings 未设置过隐私选项的用户
+func DefaultPrivacySettings(userID uint) PrivacySettings {
+	return PrivacySettings{
+		UserID:            userID,
+		DMPermission:      AudienceEveryone,
+		CommentPermission: AudienceEveryone,
+	}
+}
+
+// ValidAudience 是否为可用的权限范围
+func ValidAudience(s string) bool {
+	switch s {
+	case AudienceEveryone, AudienceFollowers, AudienceFriends, AudienceNobody:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+)
+
+// 角色变更动作
+const (
+	RoleAuditGrant  = "grant"  // 授予更高的角色
+	RoleAuditRevoke = "revoke" // 撤销为更低的角色
+)
+
+// RoleAuditLog 角色变更审计记录，只追加不修改
+type RoleAuditLog struct {
+	ID          uint      `gorm:"primarykey" json:"id"`
+	UserID      uint      `gorm:"not null;index" json:"user_id"`       // 被修改角色的用户
+	ActorUserID uint      `gorm:"not null;index" json:"actor_user_id"` // 操作的管理员
+	Action      string    `gorm:"size:16;not null" json:"action"`
+	FromRole    string    `gorm:"size:20;not null" json:"from_role"`
+	ToRole      string    `gorm:"size:20;not null" json:"to_role"`
+	Reason      string    `gorm:"size:255" json:"reason"`
+	ClientIP    string    `gorm:"size:64" json:"client_ip"`
+	CreatedAt   time.Time `gorm:"index" json:"created_at"`
+}
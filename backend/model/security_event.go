@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+)
+
+// 安全事件类型
+const (
+	SecurityEventLoginSuccess   = "login_success"
+	SecurityEventLoginFailure   = "login_failure"
+	SecurityEventLoginLocked    = "login_locked" // 失败次数过多，账号或 IP 开始被锁定
+	SecurityEventPasswordChange = "password_change"
+	SecurityEventPasswordReset  = "password_reset"
+	SecurityEventNewDevice      = "new_device" // 首次在该设备（User-Agent）上登录
+	SecurityEventMFAEnabled     = "mfa_enabled"
+	SecurityEventMFADisabled    = "mfa_disabled"
+	SecurityEventRecoveryUsed   = "mfa_recovery_code_used"   // 用恢复码完成了两步验证
+	SecurityEventRecoveryReset  = "mfa_recovery_codes_reset" // 重新生成了恢复码
+	SecurityEventDeletionReq    = "account_deletion_requested"
+	SecurityEventDeletionCancel = "account_deletion_canceled"
+	SecurityEventIdentityLink   = "identity_linked"   // 绑定了第三方账号，详情为提供方
+	SecurityEventIdentityUnlink = "identity_unlinked" // 解绑了第三方账号，详情为提供方
+)
+
+// SecurityEvent 账号安全审计记录，只追加不修改；登录失败次数也据此统计（限流、锁定）。
+// 登录不存在的账号时 UserID 为 0，只用于按 IP 统计
+type SecurityEvent struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	UserID    uint      `gorm:"not null;index:idx_security_event_user,priority:1" json:"user_id"`
+	Kind      string    `gorm:"size:32;not null;index:idx_security_event_user,priority:2;index:idx_security_event_ip,priority:2" json:"kind"`
+	ClientIP  string    `gorm:"size:64;index:idx_security_event_ip,priority:1" json:"client_ip"`
+	UserAgent string    `gorm:"size:255" json:"user_agent"`
+	Detail    string    `gorm:"size:255" json:"detail"`
+	CreatedAt time.Time `gorm:"index:idx_security_event_user,priority:3;index:idx_security_event_ip,priority:3" json:"created_at"`
+}
@@ -22,11 +22,15 @@ type User struct {
 	IsVip           bool           `gorm:"default:false" json:"is_vip"`
 	VipStartAt      *time.Time     `json:"vip_start_at,omitempty"`
 	VipEndAt        *time.Time     `json:"vip_end_at,omitempty"`
-	AutoRenew       bool           `gorm:"default:false" json:"auto_renew"`   // 自动续费
-	Balance         float64        `gorm:"default:0" json:"balance"`          // 钱包余额
-	Inventory       string         `gorm:"type:text" json:"inventory"`        // JSON: ["item1", "item2"]
-	EquippedFrameId string         `gorm:"size:100" json:"equipped_frame_id"` // 佩戴的头像框ID
-	Role            string         `gorm:"size:20;default:user" json:"role"`  // 用户角色：user/admin/super_admin
+	AutoRenew       bool           `gorm:"default:false" json:"auto_renew"`       // 自动续费
+	Balance         float64        `gorm:"default:0" json:"balance"`              // 钱包余额
+	Inventory       string         `gorm:"type:text" json:"inventory"`            // JSON: ["item1", "item2"]
+	EquippedFrameId string         `gorm:"size:100" json:"equipped_frame_id"`     // 佩戴的头像框ID
+	Role            string         `gorm:"size:20;default:user" json:"role"`      // 用户角色：user/admin/super_admin
+	LastActiveAt    *time.Time     `gorm:"index" json:"last_active_at,omitempty"` // 最近登录时间，用于筛选不活跃用户
+	TokenVersion    int64          `gorm:"not null;default:0" json:"-"`           // 令牌版本，递增后此前签发的登录令牌全部失效（如重置密码）
+	EmailVerifiedAt *time.Time     `json:"email_verified_at,omitempty"`           // 邮箱验证时间，为空表示未验证
+	PendingEmail    string         `gorm:"size:100" json:"pending_email"`         // 修改邮箱时待验证的新邮箱，验证通过后才替换 Email
 	CreatedAt       time.Time      `json:"created_at"`
 	UpdatedAt       time.Time      `json:"updated_at"`
 	DeletedAt       gorm.DeletedAt `gorm:"index" json:"-"`
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// UserBlock 拉黑关系：双方互不可见动态与评论，不能私信、关注、发好友申请，在线匹配不会配对
+type UserBlock struct {
+	ID        uint      `gorm:"primarykey" json:"id"`
+	BlockerID uint      `gorm:"not null;uniqueIndex:idx_user_block_pair,priority:1" json:"blocker_id"`       // 拉黑者
+	BlockedID uint      `gorm:"not null;index;uniqueIndex:idx_user_block_pair,priority:2" json:"blocked_id"` // 被拉黑者
+	CreatedAt time.Time `json:"created_at"`
+}
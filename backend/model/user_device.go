@@ -0,0 +1,18 @@
+package model
+
+import (
+	"time"
+)
+
+// UserDevice 用户登录过的移动设备及其系统推送令牌（离线私信、来电唤醒）
+type UserDevice struct {
+	ID         uint      `gorm:"primarykey" json:"id"`
+	UserID     uint      `gorm:"not null;index" json:"user_id"`
+	Token      string    `gorm:"size:255;not null;uniqueIndex" json:"token"` // FCM registration token 或 APNs device token
+	Platform   string    `gorm:"size:16;not null" json:"platform"`           // android / ios / web
+	Provider   string    `gorm:"size:16;not null" json:"provider"`           // fcm / apns
+	AppVersion string    `gorm:"size:32" json:"app_version"`
+	LastSeenAt time.Time `json:"last_seen_at"` // 最近一次注册（App 启动时刷新）
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+)
+
+// 第三方授权用途
+const (
+	OidcPurposeLogin = "login" // 未登录：第三方登录，首次登录自动注册
+	OidcPurposeLink  = "link"  // 已登录：把第三方账号绑定到当前用户
+)
+
+// UserIdentity 绑定到用户的第三方账号（OIDC 提供方 + sub）。同一第三方账号只能绑定一个用户，
+// 一个用户在同一提供方只能绑定一个账号
+type UserIdentity struct {
+	ID          uint       `gorm:"primarykey" json:"id"`
+	UserID      uint       `gorm:"not null;uniqueIndex:idx_user_identity_user_provider,priority:1" json:"user_id"`
+	Provider    string     `gorm:"size:32;not null;uniqueIndex:idx_user_identity_user_provider,priority:2;uniqueIndex:idx_user_identity_subject,priority:1" json:"provider"`
+	Subject     string     `gorm:"size:255;not null;uniqueIndex:idx_user_identity_subject,priority:2" json:"subject"`
+	Email       string     `gorm:"size:100" json:"email"` // 绑定或最近一次登录时提供方返回的邮箱，仅用于展示
+	DisplayName string     `gorm:"size:100" json:"display_name"`
+	LastLoginAt *time.Time `json:"last_login_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// OidcAuthRequest 发起第三方授权时保存的 state、nonce 与 PKCE code_verifier，回调时一次性取出
+type OidcAuthRequest struct {
+	ID           uint      `gorm:"primarykey" json:"id"`
+	StateHash    string    `gorm:"size:64;not null;uniqueIndex" json:"-"` // sha256(state)
+	Provider     string    `gorm:"size:32;not null" json:"provider"`
+	Purpose      string    `gorm:"size:16;not null" json:"purpose"`
+	UserID       uint      `gorm:"index" json:"user_id"` // 绑定时为发起绑定的用户，登录时为 0
+	Nonce        string    `gorm:"size:64;not null" json:"-"`
+	CodeVerifier string    `gorm:"size:128;not null" json:"-"`
+	ExpiresAt    time.Time `gorm:"index" json:"expires_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"time"
+)
+
+// 会话撤销原因
+const (
+	SessionRevokeLogout        = "logout"         // 用户退出该设备
+	SessionRevokeLogoutAll     = "logout_all"     // 退出所有设备
+	SessionRevokeReuseDetected = "reuse_detected" // 已轮换的刷新令牌被再次使用，疑似被盗
+	SessionRevokePasswordReset = "password_reset" // 重置密码
+	SessionRevokeSuspended     = "suspended"      // 账号被封禁
+)
+
+// UserSession 一次登录（一台设备）对应的会话，持有轮换中的刷新令牌。
+// 访问令牌带 sid 声明；会话撤销后，该会话签发的访问令牌由 API 层的 TokenGuard 拒绝
+type UserSession struct {
+	ID           uint       `gorm:"primarykey" json:"id"`
+	UserID       uint       `gorm:"not null;index" json:"user_id"`
+	UserAgent    string     `gorm:"size:255" json:"user_agent"`
+	ClientIP     string     `gorm:"size:64" json:"client_ip"`
+	LastUsedAt   time.Time  `json:"last_used_at"` // 最近一次登录或刷新
+	ExpiresAt    time.Time  `json:"expires_at"`   // 刷新令牌过期时间，每次轮换顺延
+	RevokedAt    *time.Time `gorm:"index" json:"revoked_at"`
+	RevokeReason string     `gorm:"size:32" json:"revoke_reason"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// RefreshToken 会话签发过的刷新令牌，只保存哈希。轮换后旧令牌标记 UsedAt 但保留到会话结束，
+// 用于识别重放：已用过的令牌再次出现，说明令牌泄露，整个会话随之撤销
+type RefreshToken struct {
+	ID        uint       `gorm:"primarykey" json:"id"`
+	SessionID uint       `gorm:"not null;index" json:"session_id"`
+	TokenHash string     `gorm:"size:64;not null;uniqueIndex" json:"-"` // sha256(刷新令牌)
+	UsedAt    *time.Time `json:"used_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// WsTicket WebSocket 连接用的一次性短期票据，兑换时删除；存数据库而不是进程内存，签发与连接可以落在不同的 API 实例
+type WsTicket struct {
+	ID         uint      `gorm:"primarykey" json:"id"`
+	TicketHash string    `gorm:"size:64;not null;uniqueIndex" json:"-"` // sha256(票据)
+	UserID     uint      `gorm:"not null;index" json:"user_id"`
+	SessionID  uint      `json:"session_id"`
+	Endpoint   string    `gorm:"size:32;not null" json:"endpoint"`
+	ExpiresAt  time.Time `gorm:"index" json:"expires_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"time"
+)
+
+// 处罚类型
+const (
+	// SuspensionKindSuspend 封禁：不能登录，已签发的令牌失效，WebSocket 连接断开
+	SuspensionKindSuspend = "suspend"
+	// SuspensionKindRestrict 限制：可以登录浏览，但不能发动态、评论、私信与匹配聊天
+	SuspensionKindRestrict = "restrict"
+)
+
+// 申诉状态
+const (
+	AppealStatusPending  = "pending"
+	AppealStatusAccepted = "accepted" // 申诉通过，处罚已解除
+	AppealStatusRejected = "rejected"
+)
+
+// UserSuspension 管理员对用户的处罚记录。同一用户同时最多一条生效中的处罚，新的处罚会替换旧的；
+// 解除或到期后保留记录用于审计
+type UserSuspension struct {
+	ID          uint       `gorm:"primarykey" json:"id"`
+	UserID      uint       `gorm:"not null;index:idx_user_suspension_active,priority:1" json:"user_id"`
+	Kind        string     `gorm:"size:16;not null" json:"kind"`
+	Reason      string     `gorm:"size:500;not null" json:"reason"`
+	HideContent bool       `gorm:"not null;default:false" json:"hide_content"` // 处罚期间对他人隐藏该用户的动态与评论
+	ExpiresAt   *time.Time `gorm:"index" json:"expires_at"`                    // 为空表示永久
+	CreatedBy   uint       `gorm:"not null" json:"created_by"`
+	LiftedAt    *time.Time `gorm:"index:idx_user_suspension_active,priority:2" json:"lifted_at"`
+	LiftedBy    uint       `json:"lifted_by"` // 到期自动失效时不写；被新的处罚替换时为新处罚的操作人
+	LiftReason  string     `gorm:"size:255" json:"lift_reason"`
+	// AppealTokenHash 被封禁用户登录时下发的申诉令牌（sha256），用于未登录状态下提交申诉
+	AppealTokenHash      string     `gorm:"size:64;index" json:"-"`
+	AppealTokenExpiresAt *time.Time `json:"-"`
+	CreatedAt            time.Time  `json:"created_at"`
+	UpdatedAt            time.Time  `json:"updated_at"`
+}
+
+// Active 处罚在 now 时是否生效
+func (s *UserSuspension) Active(now time.Time) bool {
+	return s.LiftedAt == nil && (s.ExpiresAt == nil || s.ExpiresAt.After(now))
+}
+
+// SuspensionAppeal 用户对处罚的申诉，每条处罚同时只能有一条待处理的申诉
+type SuspensionAppeal struct {
+	ID           uint       `gorm:"primarykey" json:"id"`
+	SuspensionID uint       `gorm:"not null;index" json:"suspension_id"`
+	UserID       uint       `gorm:"not null;index" json:"user_id"`
+	Message      string     `gorm:"size:2000;not null" json:"message"`
+	Status       string     `gorm:"size:16;not null;default:pending;index" json:"status"`
+	ReviewedBy   uint       `json:"reviewed_by"`
+	ReviewNote   string     `gorm:"size:500" json:"review_note"`
+	ReviewedAt   *time.Time `json:"reviewed_at"`
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
@@ -0,0 +1,211 @@
+// Package campaign 系统通知推送活动的后台发送：到点的活动按目标人群分批写入通知中心。
+package campaign
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/notify"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+const (
+	// 轮询到期活动的间隔；新建的立即发送活动通过 Kick 不必等待
+	pollInterval = 30 * time.Second
+	// 每批写入的用户数
+	batchSize = 500
+	// sending 状态超过这么久没有进度视为发送进程已退出，其他实例可以接手续发
+	staleAfter = 5 * time.Minute
+)
+
+type Runner struct {
+	db       *gorm.DB
+	notifier *notify.Service
+	kick     chan struct{}
+}
+
+func NewRunner(db *gorm.DB, notifier *notify.Service) *Runner {
+	return &Runner{db: db, notifier: notifier, kick: make(chan struct{}, 1)}
+}
+
+// Start 启动后台发送循环
+func (r *Runner) Start() {
+	go r.loop()
+}
+
+// Kick 立即检查一次到期活动（不阻塞）
+func (r *Runner) Kick() {
+	select {
+	case r.kick <- struct{}{}:
+	default:
+	}
+}
+
+func (r *Runner) loop() {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		r.runDue()
+		select {
+		case <-ticker.C:
+		case <-r.kick:
+		}
+	}
+}
+
+// runDue 依次发送所有到期（或发送中断）的活动
+func (r *Runner) runDue() {
+	now := time.Now()
+	var ids []uint
+	if err := r.db.Model(&model.NotificationCampaign{}).
+		Where("(status = ? AND (scheduled_at IS NULL OR scheduled_at <= ?)) OR (status = ? AND updated_at < ?)",
+			model.CampaignStatusScheduled, now, model.CampaignStatusSending, now.Add(-staleAfter)).
+		Order("id").
+		Pluck("id", &ids).Error; err != nil {
+		logx.Errorf("查询待发送的推送活动失败: %v", err)
+		return
+	}
+	for _, id := range ids {
+		if err := r.send(id); err != nil {
+			logx.Errorf("推送活动 %d 发送失败: %v", id, err)
+			r.db.Model(&model.NotificationCampaign{}).Where("id = ? AND status = ?", id, model.CampaignStatusSending).Updates(map[string]interface{}{
+				"status": model.CampaignStatusFailed,
+				"error":  truncate(err.Error(), 255),
+			})
+		}
+	}
+}
+
+// send 认领并发送一个活动；已被其他实例认领时直接返回
+func (r *Runner) send(id uint) error {
+	now := time.Now()
+	res := r.db.Model(&model.NotificationCampaign{}).
+		Where("id = ? AND ((status = ? AND (scheduled_at IS NULL OR scheduled_at <= ?)) OR (status = ? AND updated_at < ?))",
+			id, model.CampaignStatusScheduled, now, model.CampaignStatusSending, now.Add(-staleAfter)).
+		Updates(map[string]interface{}{
+			"status":     model.CampaignStatusSending,
+			"updated_at": now,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil
+	}
+
+	var c model.NotificationCampaign
+	if err := r.db.First(&c, id).Error; err != nil {
+		return err
+	}
+	segment, err := SegmentQuery(r.db, &c)
+	if err != nil {
+		return err
+	}
+
+	// 首次发送时记录开始时间与命中人数；续发沿用
+	if c.StartedAt == nil {
+		var total int64
+		if err := segment().Count(&total).Error; err != nil {
+			return err
+		}
+		c.StartedAt = &now
+		c.Recipients = int(total)
+		if err := r.db.Model(&c).Updates(map[string]interface{}{
+			"started_at": now,
+			"recipients": total,
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	ctx := context.Background()
+	for {
+		// 每批之前确认活动仍在发送：发送中被取消时停止，已写入的通知保留
+		var cur model.NotificationCampaign
+		if err := r.db.Select("status").First(&cur, c.ID).Error; err != nil {
+			return err
+		}
+		if cur.Status != model.CampaignStatusSending {
+			logx.Infof("推送活动 %d 状态为 %s，停止发送", c.ID, cur.Status)
+			return nil
+		}
+
+		var ids []uint
+		if err := segment().Where("users.id > ?", c.Cursor).Order("users.id").Limit(batchSize).Pluck("users.id", &ids).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			break
+		}
+
+		// 中断后从 Cursor 续发，写入与推进游标之间崩溃时这一批可能重复（至少一次）
+		n, err := r.notifier.NotifyBatch(ctx, notify.KindSystem, ids, c.CreatorID, notify.BatchPayload{
+			Content:    c.Content,
+			CampaignID: c.ID,
+		})
+		if err != nil {
+			return err
+		}
+		c.Cursor = ids[len(ids)-1]
+		if err := r.db.Model(&c).Updates(map[string]interface{}{
+			"cursor":     c.Cursor,
+			"delivered":  gorm.Expr("delivered + ?", n),
+			"updated_at": time.Now(),
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	// 最后一批期间被取消的活动保持 cancelled
+	return r.db.Model(&model.NotificationCampaign{}).Where("id = ? AND status = ?", c.ID, model.CampaignStatusSending).
+		Updates(map[string]interface{}{
+			"status":       model.CampaignStatusCompleted,
+			"completed_at": time.Now(),
+		}).Error
+}
+
+// SegmentQuery 返回目标人群的用户查询（每次调用生成新的查询，可继续追加条件）
+func SegmentQuery(db *gorm.DB, c *model.NotificationCampaign) (func() *gorm.DB, error) {
+	now := time.Now()
+	switch c.Segment {
+	case model.CampaignSegmentAll:
+		return func() *gorm.DB {
+			return db.Model(&model.User{})
+		}, nil
+	case model.CampaignSegmentVip:
+		return func() *gorm.DB {
+			return db.Model(&model.User{}).
+				Where("is_vip = ? AND (vip_end_at IS NULL OR vip_end_at > ?)", true, now)
+		}, nil
+	case model.CampaignSegmentLevel:
+		if c.MinLevel < 1 {
+			return nil, errors.New("min_level 必须大于 0")
+		}
+		return func() *gorm.DB {
+			levels := db.Model(&model.UserLevel{}).Select("user_id").Where("level >= ?", c.MinLevel)
+			return db.Model(&model.User{}).Where("users.id IN (?)", levels)
+		}, nil
+	case model.CampaignSegmentInactive:
+		if c.InactiveDays < 1 {
+			return nil, errors.New("inactive_days 必须大于 0")
+		}
+		cutoff := now.AddDate(0, 0, -c.InactiveDays)
+		return func() *gorm.DB {
+			// 从未登录过的老用户按注册时间算
+			return db.Model(&model.User{}).
+				Where("last_active_at < ? OR (last_active_at IS NULL AND created_at < ?)", cutoff, cutoff)
+		}, nil
+	}
+	return nil, errors.New("未知的目标人群: " + c.Segment)
+}
+
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
@@ -0,0 +1,76 @@
+package campaign
+
+import (
+	"fmt"
+	"testing"
+
+	"backend/model"
+	"backend/rpc/internal/notify"
+	"backend/rpc/internal/testdb"
+
+	"gorm.io/gorm"
+)
+
+func newTestRunner(t *testing.T, users int) (*Runner, *gorm.DB) {
+	t.Helper()
+	db := testdb.New(t, &model.User{}, &model.NotificationCampaign{}, &model.Notification{},
+		&model.NotificationPreference{})
+	rows := make([]model.User, users)
+	for i := range rows {
+		rows[i] = model.User{
+			Username: fmt.Sprintf("user%d", i),
+			Email:    fmt.Sprintf("user%d@example.com", i),
+			MoeNo:    fmt.Sprintf("%010d", i+1),
+		}
+	}
+	if err := db.CreateInBatches(&rows, 200).Error; err != nil {
+		t.Fatal(err)
+	}
+	return NewRunner(db, notify.NewService(db, nil, nil)), db
+}
+
+func TestRunnerCompletesCampaign(t *testing.T) {
+	r, db := newTestRunner(t, batchSize+10)
+	c := &model.NotificationCampaign{Title: "t", Content: "hello", Segment: model.CampaignSegmentAll, Status: model.CampaignStatusScheduled}
+	if err := db.Create(c).Error; err != nil {
+		t.Fatal(err)
+	}
+	r.runDue()
+
+	db.First(c, c.ID)
+	if c.Status != model.CampaignStatusCompleted || c.Delivered != batchSize+10 || c.CompletedAt == nil {
+		t.Fatalf("campaign = %+v", c)
+	}
+}
+
+// 发送中被取消：当前批次写完后停止，不再发下一批，状态保持 cancelled
+func TestRunnerStopsWhenCancelledBetweenBatches(t *testing.T) {
+	r, db := newTestRunner(t, batchSize+10)
+	c := &model.NotificationCampaign{Title: "t", Content: "hello", Segment: model.CampaignSegmentAll, Status: model.CampaignStatusScheduled}
+	if err := db.Create(c).Error; err != nil {
+		t.Fatal(err)
+	}
+	// 第一批通知写入时由管理员取消
+	cancelled := false
+	if err := db.Callback().Create().After("gorm:create").Register("test:cancel_campaign", func(tx *gorm.DB) {
+		if tx.Statement.Table != "notifications" || cancelled {
+			return
+		}
+		cancelled = true
+		tx.Session(&gorm.Session{NewDB: true}).Model(&model.NotificationCampaign{}).
+			Where("id = ?", c.ID).Update("status", model.CampaignStatusCancelled)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	r.runDue()
+
+	db.First(c, c.ID)
+	if c.Status != model.CampaignStatusCancelled || c.CompletedAt != nil {
+		t.Fatalf("status = %s completed_at = %v, want cancelled", c.Status, c.CompletedAt)
+	}
+	var n int64
+	db.Model(&model.Notification{}).Count(&n)
+	if n != batchSize || c.Delivered != batchSize {
+		t.Fatalf("notifications = %d delivered = %d, want %d", n, c.Delivered, batchSize)
+	}
+}
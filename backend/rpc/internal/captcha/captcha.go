@@ -0,0 +1,65 @@
+package captcha
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Conf 人机验证配置。hCaptcha、reCAPTCHA、Cloudflare Turnstile 的服务端校验接口格式相同
+// （表单提交 secret、response、remoteip，返回 {"success": true}），填对应的 VerifyUrl 即可。
+// 不配置时登录失败多次后仍会提示客户端展示人机验证，但服务端不做校验，只靠失败锁定防爆破
+type Conf struct {
+	VerifyUrl string `json:",optional"` // 如 https://hcaptcha.com/siteverify
+	Secret    string `json:",optional"`
+}
+
+// Verifier 校验客户端提交的人机验证令牌
+type Verifier struct {
+	conf   Conf
+	client *http.Client
+}
+
+func NewVerifier(c Conf) *Verifier {
+	return &Verifier{conf: c, client: &http.Client{Timeout: 5 * time.Second}}
+}
+
+// Enabled 是否配置了校验服务
+func (v *Verifier) Enabled() bool {
+	return v != nil && v.conf.VerifyUrl != "" && v.conf.Secret != ""
+}
+
+// Verify 令牌是否有效；校验服务不可用时返回错误
+func (v *Verifier) Verify(ctx context.Context, token, clientIP string) (bool, error) {
+	if strings.TrimSpace(token) == "" {
+		return false, nil
+	}
+	form := url.Values{"secret": {v.conf.Secret}, "response": {token}}
+	if clientIP != "" {
+		form.Set("remoteip", clientIP)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.conf.VerifyUrl, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("人机验证服务返回 %d", resp.StatusCode)
+	}
+	var out struct {
+		Success bool `json:"success"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return false, fmt.Errorf("解析人机验证结果失败: %w", err)
+	}
+	return out.Success, nil
+}
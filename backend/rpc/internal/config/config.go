@@ -1,9 +1,32 @@
 package config
 
-import "github.com/zeromicro/go-zero/zrpc"
+import (
+	"backend/rpc/internal/captcha"
+	"backend/rpc/internal/mail"
+	"backend/rpc/internal/oidc"
+	"backend/rpc/internal/push"
+
+	"github.com/zeromicro/go-zero/zrpc"
+)
 
 type Config struct {
 	zrpc.RpcServerConf
 	// HandDrawRequireModeration 为 true 时，含手绘的帖子创建后为 pending，需在库或管理端改为 ok
 	HandDrawRequireModeration bool `json:",optional"`
+	// Push 移动端系统推送（FCM / APNs），用于离线私信与来电；不配置则只有 WebSocket 实时推送
+	Push push.Conf `json:",optional"`
+	// Mail 邮件发送（找回密码、邮箱验证）；不配置则相关功能提示暂不可用
+	Mail mail.Conf `json:",optional"`
+	// PasswordResetUrl 找回密码页面地址，邮件中的链接为 <PasswordResetUrl>?token=xxx；为空时邮件只含验证码
+	PasswordResetUrl string `json:",optional"`
+	// EmailVerifyUrl 邮箱验证页面地址，邮件中的链接为 <EmailVerifyUrl>?token=xxx；为空时邮件只含验证码
+	EmailVerifyUrl string `json:",optional"`
+	// RequireVerifiedEmail 为 true 时购买 VIP、钱包充值需要先验证邮箱
+	RequireVerifiedEmail bool `json:",default=true"`
+	// Captcha 登录失败多次后要求的人机验证；不配置则只提示客户端，不做服务端校验
+	Captcha captcha.Conf `json:",optional"`
+	// Oidc 第三方登录（OpenID Connect）提供方；不配置则只能用账号密码登录
+	Oidc oidc.Conf `json:",optional"`
+	// ImageDir 用户上传图片的根目录（与 API 的 Image.LocalDir 相同），注销账号时删除该用户的图片；为空时不删除文件
+	ImageDir string `json:",optional"`
 }
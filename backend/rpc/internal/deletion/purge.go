@@ -0,0 +1,213 @@
+package deletion
+
+import (
+	"fmt"
+	"time"
+
+	"backend/model"
+
+	"gorm.io/gorm"
+)
+
+// DeletedUsername 注销后用户行的用户名；原用户名、邮箱、Moe 号随之释放，可以重新注册
+func DeletedUsername(userID uint) string {
+	return fmt.Sprintf("deleted_%d", userID)
+}
+
+// PurgeUser 在事务中清除用户数据：
+//   - 动态、评论、点赞、关注、好友申请、通知、私信、记忆、等级签到、会话与安全记录等直接删除（不走软删除）；
+//     被删掉的评论、点赞从其他人的帖子计数中扣除
+//   - 交易与 VIP 订单保留用于对账，只去掉用户填写的描述
+//   - 用户行匿名化后软删除，作为财务记录的关联对象保留
+//
+// 上传的图片文件由调用方在事务提交后删除
+func PurgeUser(tx *gorm.DB, userID uint) error {
+	var user model.User
+	if err := tx.Unscoped().First(&user, userID).Error; err != nil {
+		return err
+	}
+	// 新会话：下面每一步都从 db 开始构造查询，条件不会互相叠加
+	db := tx.Unscoped().Session(&gorm.Session{})
+	posts := db.Model(&model.Post{}).Select("id").Where("user_id = ?", userID)
+	postComments := db.Model(&model.Comment{}).Select("id").Where("post_id IN (?)", posts)
+	userComments := db.Model(&model.Comment{}).Select("id").Where("user_id = ?", userID)
+
+	steps := []func() error{
+		// 1. 其他人的帖子、评论上的计数
+		func() error { return decrementCommentCounts(db, userID) },
+		func() error { return decrementLikeCounts(db, userID) },
+
+		// 2. 用户的帖子及其下的评论、点赞、标签、编辑历史、举报、通知
+		func() error {
+			return db.Where("target_type = ? AND target_id IN (?)", "comment", postComments).Delete(&model.Like{}).Error
+		},
+		func() error {
+			return db.Where("target_type = ? AND target_id IN (?)", "comment", userComments).Delete(&model.Like{}).Error
+		},
+		func() error {
+			return db.Where("target_type = ? AND target_id IN (?)", "post", posts).Delete(&model.Like{}).Error
+		},
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.Like{}).Error },
+		func() error { return db.Where("post_id IN (?)", posts).Delete(&model.Comment{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.Comment{}).Error },
+		func() error { return db.Where("post_id IN (?)", posts).Delete(&model.PostTopic{}).Error },
+		func() error { return db.Where("post_id IN (?)", posts).Delete(&model.PostRevision{}).Error },
+		func() error {
+			return db.Where("post_id IN (?) OR reporter_user_id = ?", posts, userID).Delete(&model.PostReport{}).Error
+		},
+		func() error {
+			notifications := db.Model(&model.Notification{}).Select("id").
+				Where("user_id = ? OR sender_id = ? OR post_id IN (?)", userID, userID, posts)
+			return db.Where("notification_id IN (?) OR actor_id = ?", notifications, userID).Delete(&model.NotificationActor{}).Error
+		},
+		func() error {
+			return db.Where("user_id = ? OR sender_id = ? OR post_id IN (?)", userID, userID, posts).Delete(&model.Notification{}).Error
+		},
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.Post{}).Error },
+
+		// 3. 社交关系、私信与个人设置
+		func() error {
+			return db.Where("follower_id = ? OR following_id = ?", userID, userID).Delete(&model.Follow{}).Error
+		},
+		func() error {
+			return db.Where("follower_id = ? OR following_id = ?", userID, userID).Delete(&model.FollowRequest{}).Error
+		},
+		func() error {
+			return db.Where("from_user_id = ? OR to_user_id = ?", userID, userID).Delete(&model.FriendRequest{}).Error
+		},
+		func() error {
+			return db.Where("blocker_id = ? OR blocked_id = ?", userID, userID).Delete(&model.UserBlock{}).Error
+		},
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.PrivacySettings{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.SuspensionAppeal{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.UserSuspension{}).Error },
+		func() error {
+			return db.Where("sender_id = ? OR recipient_id = ?", userID, userID).Delete(&model.E2eeMessage{}).Error
+		},
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.E2eeOneTimePreKey{}).Error },
+		func() error {
+			return db.Where("claimer_id = ? OR user_id = ?", userID, userID).Delete(&model.E2eePreKeyClaim{}).Error
+		},
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.E2eeDevice{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.NotificationPreference{}).Error },
+		func() error {
+			return db.Where("user_id = ? OR (target_type = ? AND target_id = ?)", userID, "user", userID).Delete(&model.NotificationMute{}).Error
+		},
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.UserDevice{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.UserMemory{}).Error },
+		func() error {
+			return db.Where("user_id = ?", fmt.Sprint(userID)).Delete(&model.UserAvatar{}).Error
+		},
+		func() error {
+			return db.Where("user_id = ?", fmt.Sprint(userID)).Delete(&model.UserEmojiPack{}).Error
+		},
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.UserCheckIn{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.ExpLog{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.UserLevel{}).Error },
+
+		// 4. 登录与账号安全
+		func() error {
+			sessions := db.Model(&model.UserSession{}).Select("id").Where("user_id = ?", userID)
+			return db.Where("session_id IN (?)", sessions).Delete(&model.RefreshToken{}).Error
+		},
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.UserSession{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.WsTicket{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.UserTOTP{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.MFARecoveryCode{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.MFAChallenge{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.EmailVerification{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.PasswordResetToken{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.SecurityEvent{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.LoginLock{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.UserIdentity{}).Error },
+		func() error { return db.Where("user_id = ?", userID).Delete(&model.OidcAuthRequest{}).Error },
+		func() error {
+			recipients := []string{user.Email}
+			if user.PendingEmail != "" {
+				recipients = append(recipients, user.PendingEmail)
+			}
+			return db.Where("recipient IN ?", recipients).Delete(&model.EmailOutbox{}).Error
+		},
+
+		// 5. 财务记录保留，去掉用户填写的描述
+		func() error {
+			return db.Model(&model.Transaction{}).Where("user_id = ?", userID).UpdateColumn("description", "").Error
+		},
+
+		// 6. 用户行匿名化，释放唯一字段
+		func() error {
+			return db.Model(&model.User{}).Where("id = ?", userID).UpdateColumns(map[string]interface{}{
+				"username":          DeletedUsername(userID),
+				"email":             DeletedUsername(userID) + "@deleted.invalid",
+				"moe_no":            gorm.Expr("NULL"),
+				"password":          "",
+				"avatar":            "",
+				"signature":         "",
+				"gender":            "",
+				"birthday":          nil,
+				"inventory":         "",
+				"equipped_frame_id": "",
+				"pending_email":     "",
+				"email_verified_at": nil,
+				"last_active_at":    nil,
+				"is_vip":            false,
+				"auto_renew":        false,
+				"token_version":     gorm.Expr("token_version + 1"),
+				"deleted_at":        time.Now(),
+			}).Error
+		},
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decrementCommentCounts 用户在其他人帖子下的评论删除后，扣减帖子的评论数
+func decrementCommentCounts(db *gorm.DB, userID uint) error {
+	var rows []struct {
+		PostID uint
+		N      int
+	}
+	if err := db.Model(&model.Comment{}).Select("post_id, COUNT(*) AS n").
+		Where("user_id = ? AND deleted_at IS NULL", userID).Group("post_id").Scan(&rows).Error; err != nil {
+		return err
+	}
+	for _, r := range rows {
+		if err := db.Model(&model.Post{}).Where("id = ? AND user_id <> ?", r.PostID, userID).
+			UpdateColumn("comments", gorm.Expr("CASE WHEN comments > ? THEN comments - ? ELSE 0 END", r.N, r.N)).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decrementLikeCounts 用户的点赞删除后，扣减帖子、评论的点赞数
+func decrementLikeCounts(db *gorm.DB, userID uint) error {
+	var rows []struct {
+		TargetType string
+		TargetID   uint
+	}
+	if err := db.Model(&model.Like{}).Select("target_type, target_id").
+		Where("user_id = ? AND deleted_at IS NULL", userID).Scan(&rows).Error; err != nil {
+		return err
+	}
+	for _, r := range rows {
+		var target interface{}
+		switch r.TargetType {
+		case "post":
+			target = &model.Post{}
+		case "comment":
+			target = &model.Comment{}
+		default:
+			continue
+		}
+		if err := db.Model(target).Where("id = ?", r.TargetID).
+			UpdateColumn("likes", gorm.Expr("CASE WHEN likes > 0 THEN likes - 1 ELSE 0 END")).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package deletion
+
+import (
+	"errors"
+	"testing"
+
+	"backend/model"
+	"backend/rpc/internal/testdb"
+
+	"gorm.io/gorm"
+)
+
+// purgeModels PurgeUser 会读写的全部表
+var purgeModels = []interface{}{
+	&model.User{}, &model.Post{}, &model.Comment{}, &model.Like{}, &model.PostTopic{}, &model.PostRevision{},
+	&model.PostReport{}, &model.Notification{}, &model.NotificationActor{}, &model.Follow{}, &model.FollowRequest{},
+	&model.FriendRequest{}, &model.UserBlock{}, &model.PrivacySettings{}, &model.SuspensionAppeal{},
+	&model.UserSuspension{}, &model.E2eeMessage{}, &model.E2eeOneTimePreKey{}, &model.E2eePreKeyClaim{},
+	&model.E2eeDevice{}, &model.NotificationPreference{}, &model.NotificationMute{}, &model.UserDevice{},
+	&model.UserMemory{}, &model.UserAvatar{}, &model.UserEmojiPack{}, &model.UserCheckIn{}, &model.ExpLog{},
+	&model.UserLevel{}, &model.RefreshToken{}, &model.UserSession{}, &model.WsTicket{}, &model.UserTOTP{}, &model.MFARecoveryCode{},
+	&model.MFAChallenge{}, &model.EmailVerification{}, &model.PasswordResetToken{}, &model.SecurityEvent{},
+	&model.LoginLock{}, &model.UserIdentity{}, &model.OidcAuthRequest{}, &model.EmailOutbox{}, &model.Transaction{},
+}
+
+func create(t *testing.T, db *gorm.DB, v interface{}) {
+	t.Helper()
+	if err := db.Create(v).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func count(t *testing.T, db *gorm.DB, m interface{}, query string, args ...interface{}) int64 {
+	t.Helper()
+	var n int64
+	if err := db.Unscoped().Model(m).Where(query, args...).Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestPurgeUser(t *testing.T) {
+	db := testdb.New(t, purgeModels...)
+	alice := &model.User{Username: "alice", Email: "alice@example.com", MoeNo: "1000000001", Password: "correct-horse"}
+	bob := &model.User{Username: "bob", Email: "bob@example.com", MoeNo: "1000000002", Password: "correct-horse"}
+	create(t, db, alice)
+	create(t, db, bob)
+
+	// bob 的帖子：alice 评论、点赞过，bob 自己也评论过
+	bobPost := &model.Post{UserID: bob.ID, Content: "bob", Comments: 2, Likes: 1}
+	create(t, db, bobPost)
+	create(t, db, &model.Comment{PostID: bobPost.ID, UserID: alice.ID, Content: "alice"})
+	bobComment := &model.Comment{PostID: bobPost.ID, UserID: bob.ID, Content: "bob", Likes: 1}
+	create(t, db, bobComment)
+	create(t, db, &model.Like{UserID: alice.ID, TargetType: "post", TargetID: bobPost.ID})
+	create(t, db, &model.Like{UserID: alice.ID, TargetType: "comment", TargetID: bobComment.ID})
+	// 计数已经不准的帖子：扣减后不会变成负数
+	stalePost := &model.Post{UserID: bob.ID, Content: "stale"}
+	create(t, db, stalePost)
+	create(t, db, &model.Comment{PostID: stalePost.ID, UserID: alice.ID, Content: "alice"})
+
+	// alice 的帖子及其下 bob 的评论、点赞
+	alicePost := &model.Post{UserID: alice.ID, Content: "alice"}
+	create(t, db, alicePost)
+	create(t, db, &model.Comment{PostID: alicePost.ID, UserID: bob.ID, Content: "bob"})
+	create(t, db, &model.Like{UserID: bob.ID, TargetType: "post", TargetID: alicePost.ID})
+
+	create(t, db, &model.Follow{FollowerID: alice.ID, FollowingID: bob.ID})
+	create(t, db, &model.Transaction{UserID: alice.ID, Amount: 10, Type: "recharge", Status: "success", Description: "给 bob 的礼物"})
+
+	if err := db.Transaction(func(tx *gorm.DB) error { return PurgeUser(tx, alice.ID) }); err != nil {
+		t.Fatal(err)
+	}
+
+	// 内容直接删除
+	if n := count(t, db, &model.Post{}, "user_id = ?", alice.ID); n != 0 {
+		t.Fatalf("alice 的帖子剩 %d 条", n)
+	}
+	if n := count(t, db, &model.Comment{}, "user_id = ? OR post_id = ?", alice.ID, alicePost.ID); n != 0 {
+		t.Fatalf("alice 的评论及其帖子下的评论剩 %d 条", n)
+	}
+	if n := count(t, db, &model.Like{}, "user_id = ? OR (target_type = ? AND target_id = ?)", alice.ID, "post", alicePost.ID); n != 0 {
+		t.Fatalf("相关点赞剩 %d 条", n)
+	}
+	if n := count(t, db, &model.Follow{}, "follower_id = ? OR following_id = ?", alice.ID, alice.ID); n != 0 {
+		t.Fatalf("关注关系剩 %d 条", n)
+	}
+	if n := count(t, db, &model.Comment{}, "id = ?", bobComment.ID); n != 1 {
+		t.Fatal("bob 在自己帖子下的评论被删除")
+	}
+
+	// 其他人帖子、评论上的计数
+	var post, stale model.Post
+	db.First(&post, bobPost.ID)
+	db.First(&stale, stalePost.ID)
+	if post.Comments != 1 || post.Likes != 0 {
+		t.Fatalf("bob 的帖子 comments=%d likes=%d, want 1 0", post.Comments, post.Likes)
+	}
+	if stale.Comments != 0 {
+		t.Fatalf("计数不准的帖子 comments=%d, want 0", stale.Comments)
+	}
+	var comment model.Comment
+	db.First(&comment, bobComment.ID)
+	if comment.Likes != 0 {
+		t.Fatalf("bob 的评论 likes=%d, want 0", comment.Likes)
+	}
+
+	// 财务记录保留并去掉描述
+	var txs []model.Transaction
+	db.Where("user_id = ?", alice.ID).Find(&txs)
+	if len(txs) != 1 || txs[0].Description != "" || txs[0].Amount != 10 {
+		t.Fatalf("交易记录 = %+v", txs)
+	}
+
+	// 用户行匿名化后软删除，原用户名、邮箱、Moe 号可以重新注册
+	var purged model.User
+	if err := db.Unscoped().First(&purged, alice.ID).Error; err != nil {
+		t.Fatal(err)
+	}
+	if purged.Username != DeletedUsername(alice.ID) || purged.Email == "alice@example.com" || purged.MoeNo != "" ||
+		purged.Password != "" || !purged.DeletedAt.Valid {
+		t.Fatalf("注销后的用户行 = %+v", purged)
+	}
+	if err := db.First(&model.User{}, alice.ID).Error; !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("注销后的用户仍能查到: %v", err)
+	}
+	create(t, db, &model.User{Username: "alice", Email: "alice@example.com", MoeNo: "1000000001", Password: "correct-horse"})
+}
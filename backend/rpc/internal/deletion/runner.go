@@ -0,0 +1,151 @@
+// Package deletion 注销账号的后台清除：宽限期结束的申请在这里删除用户数据、匿名化保留的记录。
+package deletion
+
+import (
+	"os"
+	"time"
+
+	"backend/model"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+const (
+	// GracePeriod 申请注销到清除数据之间的宽限期，期间可以撤销
+	GracePeriod = 7 * 24 * time.Hour
+	// 轮询到期申请的间隔
+	pollInterval = 10 * time.Minute
+	// processing 状态超过这么久视为清除进程已退出，其他实例可以接手
+	staleAfter = 30 * time.Minute
+	// 清除失败后的重试间隔
+	retryAfter = time.Hour
+)
+
+type Runner struct {
+	db *gorm.DB
+	// imageDir 用户上传图片的根目录（与 API 的 Image.LocalDir 相同）；为空时不删除图片文件
+	imageDir string
+	kick     chan struct{}
+}
+
+func NewRunner(db *gorm.DB, imageDir string) *Runner {
+	return &Runner{db: db, imageDir: imageDir, kick: make(chan struct{}, 1)}
+}
+
+// Start 启动后台清除循环
+func (r *Runner) Start() {
+	if r.imageDir == "" {
+		logx.Infof("[注销] 未配置 ImageDir，注销账号时不会删除用户上传的图片文件")
+	}
+	go r.loop()
+}
+
+// Kick 立即检查一次到期申请（不阻塞）
+func (r *Runner) Kick() {
+	select {
+	case r.kick <- struct{}{}:
+	default:
+	}
+}
+
+func (r *Runner) loop() {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+	for {
+		r.RunDue()
+		select {
+		case <-ticker.C:
+		case <-r.kick:
+		}
+	}
+}
+
+// dueQuery 到期待清除、清除中断或失败待重试的申请
+func dueQuery(db *gorm.DB, now time.Time) *gorm.DB {
+	return db.Where("((status = ? AND scheduled_at <= ?) OR (status = ? AND updated_at < ?) OR (status = ? AND updated_at < ?))",
+		model.AccountDeletionPending, now,
+		model.AccountDeletionProcessing, now.Add(-staleAfter),
+		model.AccountDeletionFailed, now.Add(-retryAfter))
+}
+
+// RunDue 依次清除所有到期的注销申请，返回完成的个数
+func (r *Runner) RunDue() int {
+	var ids []uint
+	if err := dueQuery(r.db.Model(&model.AccountDeletion{}), time.Now()).
+		Order("id").Pluck("id", &ids).Error; err != nil {
+		logx.Errorf("[注销] 查询到期的注销申请失败: %v", err)
+		return 0
+	}
+	done := 0
+	for _, id := range ids {
+		if err := r.process(id); err != nil {
+			logx.Errorf("[注销] 注销申请 %d 清除失败: %v", id, err)
+			r.db.Model(&model.AccountDeletion{}).Where("id = ?", id).Updates(map[string]interface{}{
+				"status":     model.AccountDeletionFailed,
+				"last_error": truncate(err.Error(), 512),
+				"updated_at": time.Now(),
+			})
+			continue
+		}
+		done++
+	}
+	return done
+}
+
+// process 认领并执行一个注销申请；已被其他实例认领或已撤销时直接返回
+func (r *Runner) process(id uint) error {
+	now := time.Now()
+	res := dueQuery(r.db.Model(&model.AccountDeletion{}).Where("id = ?", id), now).
+		Updates(map[string]interface{}{
+			"status":     model.AccountDeletionProcessing,
+			"attempts":   gorm.Expr("attempts + 1"),
+			"updated_at": now,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return nil
+	}
+
+	var d model.AccountDeletion
+	if err := r.db.First(&d, id).Error; err != nil {
+		return err
+	}
+	if err := r.db.Transaction(func(tx *gorm.DB) error {
+		return PurgeUser(tx, d.UserID)
+	}); err != nil {
+		return err
+	}
+
+	// 数据库清除成功后再删文件；删除失败只记录日志，不影响注销结果
+	dirs, err := utils.UserImageDirs(r.imageDir, d.UserID)
+	if err != nil {
+		logx.Errorf("[注销] 查找用户 %d 的图片目录失败: %v", d.UserID, err)
+	}
+	for _, dir := range dirs {
+		if err := os.RemoveAll(dir); err != nil {
+			logx.Errorf("[注销] 删除用户 %d 的图片目录 %s 失败: %v", d.UserID, dir, err)
+		}
+	}
+
+	completed := time.Now()
+	if err := r.db.Model(&d).Updates(map[string]interface{}{
+		"status":       model.AccountDeletionCompleted,
+		"completed_at": completed,
+		"last_error":   "",
+	}).Error; err != nil {
+		return err
+	}
+	logx.Infof("[注销] 已清除用户数据 用户ID=%d 申请ID=%d 图片目录=%d", d.UserID, d.ID, len(dirs))
+	return nil
+}
+
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
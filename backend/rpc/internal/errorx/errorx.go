@@ -1,6 +1,7 @@
 package errorx
 
 import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -20,6 +21,8 @@ func New(httpCode int, message string) error {
 		code = codes.NotFound
 	case 409:
 		code = codes.AlreadyExists
+	case 429:
+		code = codes.ResourceExhausted
 	case 500:
 		code = codes.Internal
 	default:
@@ -53,3 +56,21 @@ func Unauthenticated(message string) error {
 func AlreadyExists(message string) error {
 	return status.Error(codes.AlreadyExists, message)
 }
+
+// TooManyRequests 创建一个请求过于频繁错误（429）
+func TooManyRequests(message string) error {
+	return status.Error(codes.ResourceExhausted, message)
+}
+
+// WithInfo 给 gRPC 错误附加 errdetails.ErrorInfo（原因与键值对），API 层用 common.RPCErrorInfo 读取
+func WithInfo(err error, reason string, metadata map[string]string) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	detailed, derr := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Metadata: metadata})
+	if derr != nil {
+		return err
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,44 @@
+package logic
+
+import (
+	"errors"
+
+	"backend/model"
+	"backend/rpc/pb/super"
+
+	"gorm.io/gorm"
+)
+
+// pendingDeletion 用户在宽限期内的注销申请；没有时返回 nil
+func pendingDeletion(db *gorm.DB, userID uint) (*model.AccountDeletion, error) {
+	var d model.AccountDeletion
+	err := db.Where("user_id = ? AND status = ?", userID, model.AccountDeletionPending).Order("id desc").First(&d).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func modelAccountDeletionToProto(d *model.AccountDeletion) *super.AccountDeletion {
+	out := &super.AccountDeletion{
+		Status:      d.Status,
+		ScheduledAt: d.ScheduledAt.Format("2006-01-02 15:04:05"),
+		RequestedAt: d.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if d.CanceledAt != nil {
+		out.CanceledAt = d.CanceledAt.Format("2006-01-02 15:04:05")
+	}
+	return out
+}
+
+// deletionScheduledAt 登录时提示的注销时间；没有待执行的申请时为空
+func deletionScheduledAt(db *gorm.DB, userID uint) (string, error) {
+	d, err := pendingDeletion(db, userID)
+	if err != nil || d == nil {
+		return "", err
+	}
+	return d.ScheduledAt.Format("2006-01-02 15:04:05"), nil
+}
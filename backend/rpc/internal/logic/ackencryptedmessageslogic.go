@@ -0,0 +1,28 @@
+package logic
+
+import (
+	"context"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AckEncryptedMessagesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAckEncryptedMessagesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AckEncryptedMessagesLogic {
+	return &AckEncryptedMessagesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *AckEncryptedMessagesLogic) AckEncryptedMessages(in *super.AckEncryptedMessagesReq) (*super.AckEncryptedMessagesResp, error) {
+	return NewE2eeLogic(l.ctx, l.svcCtx).AckEncryptedMessages(in)
+}
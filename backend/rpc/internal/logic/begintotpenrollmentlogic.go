@@ -0,0 +1,64 @@
+package logic
+
+import (
+	"context"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BeginTotpEnrollmentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBeginTotpEnrollmentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BeginTotpEnrollmentLogic {
+	return &BeginTotpEnrollmentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 生成新的 TOTP 密钥（未启用），用户在验证器中添加后调用 ConfirmTotpEnrollment 启用。
+// 重复调用会替换上一次未确认的密钥
+func (l *BeginTotpEnrollmentLogic) BeginTotpEnrollment(in *super.BeginTotpEnrollmentReq) (*super.BeginTotpEnrollmentResp, error) {
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	user, err := loadUserByID(db, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if totp, err := enabledTOTP(db, user.ID); err != nil {
+		l.Error("查询两步验证状态失败:", err)
+		return nil, errorx.Internal("开启两步验证失败，请稍后重试")
+	} else if totp != nil {
+		return nil, errorx.AlreadyExists("已开启两步验证")
+	}
+
+	secret, err := newTOTPSecret()
+	if err != nil {
+		l.Error("生成 TOTP 密钥失败:", err)
+		return nil, errorx.Internal("开启两步验证失败，请稍后重试")
+	}
+	quiet := quietSession(db)
+	if err := quiet.Where("user_id = ? AND enabled_at IS NULL", user.ID).Delete(&model.UserTOTP{}).Error; err != nil {
+		l.Error("清理未确认的 TOTP 密钥失败:", err)
+		return nil, errorx.Internal("开启两步验证失败，请稍后重试")
+	}
+	if err := quiet.Create(&model.UserTOTP{UserID: user.ID, Secret: secret}).Error; err != nil {
+		l.Error("保存 TOTP 密钥失败:", err)
+		return nil, errorx.Internal("开启两步验证失败，请稍后重试")
+	}
+
+	account := user.Username
+	if user.Email != "" {
+		account = user.Email
+	}
+	return &super.BeginTotpEnrollmentResp{Secret: secret, OtpauthUri: totpURI(account, secret)}, nil
+}
@@ -0,0 +1,66 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/pb/super"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// 与线上一致使用 Info 级 SQL 日志，确认 TOTP 密钥与恢复码不会出现在日志中
+func TestTotpEnrollmentKeepsSecretsOutOfSQLLog(t *testing.T) {
+	var sqlLog bytes.Buffer
+	db := testdb.New(t, &model.User{}, &model.UserTOTP{}, &model.MFARecoveryCode{}, &model.SecurityEvent{})
+	user := model.User{Username: "alice", Password: "password"}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatal(err)
+	}
+	db = db.Session(&gorm.Session{Logger: logger.New(log.New(&sqlLog, "", 0), logger.Config{LogLevel: logger.Info})})
+	svcCtx := &svc.ServiceContext{DB: db}
+	ctx := context.Background()
+	userID := strconv.FormatUint(uint64(user.ID), 10)
+
+	begin, err := NewBeginTotpEnrollmentLogic(ctx, svcCtx).BeginTotpEnrollment(&super.BeginTotpEnrollmentReq{UserId: userID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := totpEncoding.DecodeString(begin.Secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	confirm, err := NewConfirmTotpEnrollmentLogic(ctx, svcCtx).ConfirmTotpEnrollment(&super.ConfirmTotpEnrollmentReq{
+		UserId: userID,
+		Code:   totpCodeAt(key, time.Now().Unix()/totpPeriod),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if sqlLog.Len() == 0 {
+		t.Fatal("SQL 日志为空，测试没有覆盖到 Info 级日志")
+	}
+	if strings.Contains(sqlLog.String(), begin.Secret) {
+		t.Fatal("TOTP 密钥出现在 SQL 日志中")
+	}
+	for _, c := range confirm.RecoveryCodes {
+		if strings.Contains(sqlLog.String(), sha256Hex(normalizeRecoveryCode(c))) {
+			t.Fatal("恢复码哈希出现在 SQL 日志中")
+		}
+	}
+
+	totp, err := enabledTOTP(db, user.ID)
+	if err != nil || totp == nil {
+		t.Fatalf("enabledTOTP = %v, %v", totp, err)
+	}
+}
@@ -0,0 +1,88 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type BlockUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBlockUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BlockUserLogic {
+	return &BlockUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// parseBlockPair 解析拉黑 / 取消拉黑的双方
+func parseBlockPair(in *super.BlockUserReq) (me, target uint, err error) {
+	me, err = parseActorUint(in.GetActorUserId())
+	if err != nil || me == 0 {
+		return 0, 0, errorx.Unauthenticated("请先登录")
+	}
+	target, err = parseActorUint(in.GetTargetUserId())
+	if err != nil || target == 0 {
+		return 0, 0, errorx.InvalidArgument("无效的用户 ID")
+	}
+	if target == me {
+		return 0, 0, errorx.InvalidArgument("不能拉黑自己")
+	}
+	return me, target, nil
+}
+
+// 拉黑相关服务：拉黑后双方互相取消关注，好友关系与未处理的好友申请一并删除
+func (l *BlockUserLogic) BlockUser(in *super.BlockUserReq) (*super.BlockUserResp, error) {
+	me, target, err := parseBlockPair(in)
+	if err != nil {
+		return nil, err
+	}
+
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Select("id").First(&model.User{}, target).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errorx.NotFound("用户不存在")
+			}
+			return err
+		}
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&model.UserBlock{BlockerID: me, BlockedID: target}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("(follower_id = ? AND following_id = ?) OR (follower_id = ? AND following_id = ?)",
+			me, target, target, me).Delete(&model.Follow{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("(follower_id = ? AND following_id = ?) OR (follower_id = ? AND following_id = ?)",
+			me, target, target, me).Delete(&model.FollowRequest{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("(from_user_id = ? AND to_user_id = ?) OR (from_user_id = ? AND to_user_id = ?)",
+			me, target, target, me).Delete(&model.FriendRequest{}).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[拉黑] 拉黑失败 用户ID=%d 对方=%d 错误=%v", me, target, err)
+		return nil, errorx.Internal("拉黑失败")
+	}
+
+	l.Infof("[拉黑] 用户ID=%d 拉黑了 %d", me, target)
+	return &super.BlockUserResp{Ok: true}, nil
+}
@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"gorm.io/gorm"
+)
+
+// campaignReadCounts 各活动已读的通知条数
+func campaignReadCounts(db *gorm.DB, ids []uint) (map[uint]int, error) {
+	out := make(map[uint]int, len(ids))
+	if len(ids) == 0 {
+		return out, nil
+	}
+	var rows []struct {
+		CampaignID uint
+		Read       int
+	}
+	if err := db.Model(&model.Notification{}).
+		Select("campaign_id, COUNT(*) AS `read`").
+		Where("campaign_id IN ? AND is_read = ?", ids, true).
+		Group("campaign_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, r := range rows {
+		out[r.CampaignID] = r.Read
+	}
+	return out, nil
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
+
+func campaignToRpc(c *model.NotificationCampaign, read int) *super.NotificationCampaign {
+	return &super.NotificationCampaign{
+		Id:           strconv.FormatUint(uint64(c.ID), 10),
+		CreatorId:    strconv.FormatUint(uint64(c.CreatorID), 10),
+		Title:        c.Title,
+		Content:      c.Content,
+		Segment:      c.Segment,
+		MinLevel:     int32(c.MinLevel),
+		InactiveDays: int32(c.InactiveDays),
+		ScheduledAt:  formatOptionalTime(c.ScheduledAt),
+		Status:       c.Status,
+		Error:        c.Error,
+		Recipients:   int32(c.Recipients),
+		Delivered:    int32(c.Delivered),
+		Read:         int32(read),
+		StartedAt:    formatOptionalTime(c.StartedAt),
+		CompletedAt:  formatOptionalTime(c.CompletedAt),
+		CreatedAt:    c.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// loadCampaign 读取活动并附上已读统计
+func loadCampaign(ctx context.Context, svcCtx *svc.ServiceContext, idStr string) (*super.NotificationCampaign, error) {
+	id, err := strconv.ParseUint(idStr, 10, 32)
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的活动ID")
+	}
+	db := svcCtx.DB.WithContext(ctx)
+	var c model.NotificationCampaign
+	if err := db.First(&c, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("活动不存在")
+		}
+		return nil, errorx.Internal("查询活动失败")
+	}
+	reads, err := campaignReadCounts(db, []uint{c.ID})
+	if err != nil {
+		return nil, errorx.Internal("查询活动统计失败")
+	}
+	return campaignToRpc(&c, reads[c.ID]), nil
+}
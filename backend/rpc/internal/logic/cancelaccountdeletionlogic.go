@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelAccountDeletionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCancelAccountDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelAccountDeletionLogic {
+	return &CancelAccountDeletionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 宽限期内撤销注销申请；后台已开始清除时不能撤销
+func (l *CancelAccountDeletionLogic) CancelAccountDeletion(in *super.CancelAccountDeletionReq) (*super.CancelAccountDeletionResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+	now := time.Now()
+	res := l.svcCtx.DB.WithContext(l.ctx).Model(&model.AccountDeletion{}).
+		Where("user_id = ? AND status = ?", userID, model.AccountDeletionPending).
+		Updates(map[string]interface{}{
+			"status":      model.AccountDeletionCanceled,
+			"canceled_at": now,
+		})
+	if res.Error != nil {
+		l.Error("撤销注销申请失败:", res.Error)
+		return nil, errorx.Internal("撤销注销失败，请稍后重试")
+	}
+	if res.RowsAffected == 0 {
+		return nil, errorx.InvalidArgument("没有可撤销的注销申请")
+	}
+
+	if err := recordSecurityEvent(l.svcCtx.DB, uint(userID), model.SecurityEventDeletionCancel, in.ClientIp, in.UserAgent, ""); err != nil {
+		l.Error("记录撤销注销事件失败: ", err)
+	}
+	l.Infof("[注销] 已撤销注销申请 用户ID=%d", userID)
+	return &super.CancelAccountDeletionResp{}, nil
+}
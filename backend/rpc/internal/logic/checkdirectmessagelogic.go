@@ -0,0 +1,46 @@
+package logic
+
+import (
+	"context"
+
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+)
+
+type CheckDirectMessageLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckDirectMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckDirectMessageLogic {
+	return &CheckDirectMessageLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CheckDirectMessage 聊天服务转发私信前调用：拉黑或不在接收方的私信权限范围内时返回 403
+func (l *CheckDirectMessageLogic) CheckDirectMessage(in *super.CheckDirectMessageReq) (*super.CheckDirectMessageResp, error) {
+	sender, err := parseActorUint(in.GetSenderId())
+	if err != nil || sender == 0 {
+		return nil, errorx.InvalidArgument("无效的用户 ID")
+	}
+	recipient, err := parseActorUint(in.GetRecipientId())
+	if err != nil || recipient == 0 {
+		return nil, errorx.InvalidArgument("无效的用户 ID")
+	}
+	if err := checkDirectMessage(l.svcCtx.DB.WithContext(l.ctx), sender, recipient); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[私信] 查询私信权限失败 发送方=%d 接收方=%d 错误=%v", sender, recipient, err)
+		return nil, errorx.Internal("查询失败")
+	}
+	return &super.CheckDirectMessageResp{}, nil
+}
@@ -37,7 +37,17 @@ func (l *CheckFollowLogic) CheckFollow(in *super.CheckFollowReq) (*super.CheckFo
 
 	l.Debug("检查关注状态成功:", in.FollowerId, "关注了", in.FollowingId, "?", count > 0)
 
+	// 未关注时再看是否有待批准的关注请求
+	var requested int64
+	if count == 0 {
+		if err := l.svcCtx.DB.Model(&model.FollowRequest{}).Where("follower_id = ? AND following_id = ?", in.FollowerId, in.FollowingId).Count(&requested).Error; err != nil {
+			l.Error("检查关注请求失败:", err)
+			return nil, err
+		}
+	}
+
 	return &super.CheckFollowResp{
 		IsFollowing: count > 0,
+		Requested:   requested > 0,
 	}, nil
 }
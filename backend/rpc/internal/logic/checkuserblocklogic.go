@@ -0,0 +1,43 @@
+package logic
+
+import (
+	"context"
+
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckUserBlockLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckUserBlockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckUserBlockLogic {
+	return &CheckUserBlockLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *CheckUserBlockLogic) CheckUserBlock(in *super.CheckUserBlockReq) (*super.CheckUserBlockResp, error) {
+	a, err := parseActorUint(in.GetUserId())
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的用户 ID")
+	}
+	b, err := parseActorUint(in.GetOtherUserId())
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的用户 ID")
+	}
+	blocked, err := utils.Blocked(l.svcCtx.DB.WithContext(l.ctx), a, b)
+	if err != nil {
+		l.Errorf("[拉黑] 查询拉黑关系失败 用户ID=%d 对方=%d 错误=%v", a, b, err)
+		return nil, errorx.Internal("查询失败")
+	}
+	return &super.CheckUserBlockResp{Blocked: blocked}, nil
+}
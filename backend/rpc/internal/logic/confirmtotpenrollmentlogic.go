@@ -0,0 +1,83 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ConfirmTotpEnrollmentLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewConfirmTotpEnrollmentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConfirmTotpEnrollmentLogic {
+	return &ConfirmTotpEnrollmentLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 用验证器当前显示的验证码确认密钥并启用两步验证，返回一组恢复码（只返回这一次）
+func (l *ConfirmTotpEnrollmentLogic) ConfirmTotpEnrollment(in *super.ConfirmTotpEnrollmentReq) (*super.RecoveryCodesResp, error) {
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	user, err := loadUserByID(db, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	code := strings.TrimSpace(in.Code)
+	if code == "" {
+		return nil, errorx.InvalidArgument("请填写验证码")
+	}
+
+	var codes []string
+	err = quietSession(db).Transaction(func(tx *gorm.DB) error {
+		var t model.UserTOTP
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", user.ID).First(&t).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errorx.InvalidArgument("请先获取两步验证密钥")
+			}
+			return err
+		}
+		if t.EnabledAt != nil {
+			return errorx.AlreadyExists("已开启两步验证")
+		}
+		step, ok := verifyTOTP(t.Secret, code, time.Now(), 0)
+		if !ok {
+			return errorx.InvalidArgument("验证码错误")
+		}
+		now := time.Now()
+		if err := tx.Model(&t).Updates(map[string]any{"enabled_at": now, "last_used_step": step}).Error; err != nil {
+			return err
+		}
+		codes, err = replaceRecoveryCodes(tx, user.ID)
+		return err
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[认证] 开启两步验证失败 用户ID=%d 错误=%v", user.ID, err)
+		return nil, errorx.Internal("开启两步验证失败，请稍后重试")
+	}
+
+	if err := recordSecurityEvent(l.svcCtx.DB, user.ID, model.SecurityEventMFAEnabled, in.ClientIp, in.UserAgent, ""); err != nil {
+		l.Error("记录开启两步验证事件失败: ", err)
+	}
+	l.Infof("[认证] 已开启两步验证 用户ID=%d", user.ID)
+	return &super.RecoveryCodesResp{RecoveryCodes: codes}, nil
+}
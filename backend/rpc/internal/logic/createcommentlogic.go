@@ -5,10 +5,12 @@ import (
 	"strconv"
 
 	"backend/model"
+	"backend/rpc/internal/notify"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/super"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
 )
 
 type CreateCommentLogic struct {
@@ -47,6 +49,14 @@ func (l *CreateCommentLogic) CreateComment(in *super.CreateCommentReq) (*super.C
 		return nil, err
 	}
 
+	// 拉黑、私密账号与作者设置的评论权限
+	if err := checkCanComment(l.svcCtx.DB, post.UserID, uint(userID)); err != nil {
+		if _, ok := status.FromError(err); !ok {
+			l.Error("校验评论权限失败:", err)
+		}
+		return nil, err
+	}
+
 	// 验证用户是否存在
 	var user model.User
 	if err := l.svcCtx.DB.First(&user, userID).Error; err != nil {
@@ -73,19 +83,12 @@ func (l *CreateCommentLogic) CreateComment(in *super.CreateCommentReq) (*super.C
 		// 不返回错误，因为评论已经创建成功
 	}
 
-	// 创建通知 (如果不是自己评论自己)
-	if uint(userID) != post.UserID {
-		notification := model.Notification{
-			UserID:   post.UserID,
-			SenderID: uint(userID),
-			Type:     2, // 2:评论
-			PostID:   uint(postID),
-			Content:  in.Content,
-			IsRead:   false,
-		}
-		if err := l.svcCtx.DB.Create(&notification).Error; err != nil {
-			l.Error("创建通知失败:", err)
-		}
+	// 创建通知 (自己评论自己不通知)
+	if _, err := l.svcCtx.Notifier.Notify(l.ctx, notify.KindComment, post.UserID, uint(userID), notify.Payload{
+		PostID:  uint(postID),
+		Content: in.Content,
+	}); err != nil {
+		l.Error("创建通知失败:", err)
 	}
 
 	// 重新查询评论（获取最新数据）并加载用户信息
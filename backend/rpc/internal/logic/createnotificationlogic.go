@@ -5,6 +5,8 @@ import (
 	"strconv"
 
 	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/notify"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/super"
 
@@ -44,36 +46,43 @@ func (l *CreateNotificationLogic) CreateNotification(in *super.CreateNotificatio
 		}
 	}
 
-	content := in.Content
-	if len(content) > 200 {
-		content = content[:200]
+	var targetID uint64
+	if in.TargetId != "" {
+		targetID, err = strconv.ParseUint(in.TargetId, 10, 32)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	notification := model.Notification{
-		UserID:   uint(userID),
-		SenderID: uint(senderID),
-		Type:     int(in.Type),
-		PostID:   uint(postID),
-		Content:  content,
-		IsRead:   false,
+	// kind 优先，兼容只传 type 的旧调用方
+	kind := notify.ByName(in.Kind)
+	if kind == nil {
+		kind = notify.ByType(int(in.Type))
+	}
+	if kind == nil {
+		return nil, errorx.InvalidArgument("未知的通知类型")
 	}
 
-	if err := l.svcCtx.DB.Create(&notification).Error; err != nil {
+	notification, err := l.svcCtx.Notifier.Notify(l.ctx, kind, uint(userID), uint(senderID), notify.Payload{
+		PostID:   uint(postID),
+		TargetID: uint(targetID),
+		Content:  in.Content,
+	})
+	if err != nil {
 		l.Error("创建通知失败:", err)
 		return nil, err
 	}
+	// 通知自己或命中去重时不写入
+	if notification == nil {
+		return &super.CreateNotificationResp{}, nil
+	}
+
+	actors, err := notify.LoadActors(l.svcCtx.DB, []model.Notification{*notification})
+	if err != nil {
+		l.Error("查询通知发起人失败:", err)
+	}
 
 	return &super.CreateNotificationResp{
-		Notification: &super.Notification{
-			Id:        strconv.FormatUint(uint64(notification.ID), 10),
-			UserId:    strconv.FormatUint(uint64(notification.UserID), 10),
-			SenderId:  strconv.FormatUint(uint64(notification.SenderID), 10),
-			Type:      int32(notification.Type),
-			PostId:    strconv.FormatUint(uint64(notification.PostID), 10),
-			Content:   notification.Content,
-			IsRead:    notification.IsRead,
-			CreatedAt: notification.CreatedAt.Format("2006-01-02 15:04:05"),
-		},
+		Notification: notify.ToRpc(notification, actors[notification.ID]),
 	}, nil
-
 }
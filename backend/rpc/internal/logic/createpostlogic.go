@@ -11,6 +11,7 @@ import (
 	"backend/rpc/pb/super"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
@@ -55,6 +56,15 @@ func (l *CreatePostLogic) CreatePost(in *super.CreatePostReq) (*super.CreatePost
 		return nil, errorx.New(500, "服务器内部错误")
 	}
 	
+	// 被封禁或限制的账号不能发动态
+	if err := checkCanPublish(l.svcCtx.DB, user.ID); err != nil {
+		if _, ok := status.FromError(err); !ok {
+			l.Error("查询处罚失败: ", err)
+			return nil, errorx.New(500, "服务器内部错误")
+		}
+		return nil, err
+	}
+
 	// 4. 构建帖子数据（手绘默认与图文一致直接公开；需人工审图时在 super.yaml 设 HandDrawRequireModeration: true）
 	modStatus := "ok"
 	if in.HandDrawCard != "" && l.svcCtx.Config.HandDrawRequireModeration {
@@ -151,6 +161,8 @@ func (l *CreatePostLogic) CreatePost(in *super.CreatePostReq) (*super.CreatePost
 			HandDrawCard:      post.HandDrawCard,
 			HandDrawThumbUrl:  post.HandDrawThumbURL,
 			ModerationStatus:  moderationStatusOrDefault(post.ModerationStatus),
+			Edited:            post.EditedAt != nil,
+			EditedAt:          formatOptionalTime(post.EditedAt),
 		},
 	}, nil
 }
@@ -38,6 +38,9 @@ func (l *CreateVipOrderLogic) CreateVipOrder(in *super.CreateVipOrderReq) (*supe
 			l.Error("查找用户失败: ", err)
 			return errorx.NotFound("用户不存在")
 		}
+		if err := requireVerifiedEmail(l.svcCtx, &user); err != nil {
+			return err
+		}
 
 		// 验证套餐是否存在
 		var plan model.VipPlan
@@ -0,0 +1,129 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/notify"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DeletePostLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeletePostLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeletePostLogic {
+	return &DeletePostLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DeletePost 作者或管理员删除帖子：帖子、评论与点赞软删除并清零计数，话题关联、相关通知与屏蔽一并清理
+func (l *DeletePostLogic) DeletePost(in *super.DeletePostReq) (*super.DeletePostResp, error) {
+	postID, err := strconv.ParseUint(strings.TrimSpace(in.PostId), 10, 32)
+	if err != nil || postID == 0 {
+		return nil, errorx.InvalidArgument("无效的帖子ID")
+	}
+	actorID, err := parseActorUint(in.ActorUserId)
+	if err != nil || actorID == 0 {
+		return nil, errorx.Unauthenticated("未登录")
+	}
+	reason := truncateRunes(strings.TrimSpace(in.Reason), 200)
+
+	var post model.Post
+	err = l.svcCtx.DB.Select("id", "user_id").First(&post, postID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errorx.NotFound("帖子不存在")
+	}
+	if err != nil {
+		l.Errorf("[帖子] 查询帖子失败 帖子ID=%d 错误=%v", postID, err)
+		return nil, errorx.Internal("服务器内部错误")
+	}
+	// 删除别人的帖子需要 post:moderate 权限（含两步验证策略），由 requirePermission 统一校验
+	byAdmin := post.UserID != actorID
+	if byAdmin {
+		if _, err := requirePermission(l.ctx, l.svcCtx, in.ActorUserId, utils.PermPostModerate); err != nil {
+			return nil, err
+		}
+	}
+
+	err = l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&post, postID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorx.NotFound("帖子不存在")
+		}
+		if err != nil {
+			return err
+		}
+		comments := tx.Model(&model.Comment{}).Select("id").Where("post_id = ?", post.ID)
+		notifications := tx.Unscoped().Model(&model.Notification{}).Select("id").Where("post_id = ?", post.ID)
+		steps := []func() error{
+			// 评论及其点赞
+			func() error {
+				return tx.Where("target_type = ? AND target_id IN (?)", "comment", comments).Delete(&model.Like{}).Error
+			},
+			func() error {
+				return tx.Model(&model.Comment{}).Where("post_id = ?", post.ID).UpdateColumn("likes", 0).Error
+			},
+			func() error { return tx.Where("post_id = ?", post.ID).Delete(&model.Comment{}).Error },
+			// 帖子的点赞与计数
+			func() error {
+				return tx.Where("target_type = ? AND target_id = ?", "post", post.ID).Delete(&model.Like{}).Error
+			},
+			func() error {
+				return tx.Model(&post).UpdateColumns(map[string]interface{}{"likes": 0, "comments": 0}).Error
+			},
+			// 话题关联：复合主键，物理删除
+			func() error { return tx.Unscoped().Where("post_id = ?", post.ID).Delete(&model.PostTopic{}).Error },
+			// 点赞、评论通知与对该帖子的屏蔽
+			func() error {
+				return tx.Where("notification_id IN (?)", notifications).Delete(&model.NotificationActor{}).Error
+			},
+			func() error { return tx.Where("post_id = ?", post.ID).Delete(&model.Notification{}).Error },
+			func() error {
+				return tx.Where("target_type = ? AND target_id = ?", "post", post.ID).Delete(&model.NotificationMute{}).Error
+			},
+			func() error { return tx.Delete(&post).Error },
+		}
+		for _, step := range steps {
+			if err := step(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[帖子] 删除帖子失败 操作者ID=%d 帖子ID=%d 错误=%v", actorID, postID, err)
+		return nil, errorx.Internal("删除帖子失败")
+	}
+
+	if byAdmin {
+		content := "你的一条帖子已被管理员删除"
+		if reason != "" {
+			content += "，原因：" + reason
+		}
+		if _, err := l.svcCtx.Notifier.Notify(l.ctx, notify.KindSystem, post.UserID, actorID, notify.Payload{Content: content}); err != nil {
+			l.Errorf("[帖子] 创建删除通知失败 用户ID=%d 错误=%v", post.UserID, err)
+		}
+	}
+	l.Infof("[帖子] 删除帖子 操作者ID=%d 帖子ID=%d 作者ID=%d 管理员删除=%v 原因=%q", actorID, post.ID, post.UserID, byAdmin, reason)
+	return &super.DeletePostResp{}, nil
+}
@@ -0,0 +1,70 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"backend/model"
+	"backend/rpc/internal/notify"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 作者可以删除自己的帖子；删除别人的帖子需要 post:moderate 权限，并通知作者
+func TestDeletePostPermissions(t *testing.T) {
+	db := testdb.New(t, &model.User{}, &model.Post{}, &model.Comment{}, &model.Like{}, &model.PostTopic{},
+		&model.Notification{}, &model.NotificationActor{}, &model.NotificationMute{}, &model.NotificationPreference{},
+		&model.UserBlock{}, &model.MFAPolicy{}, &model.UserTOTP{})
+	svcCtx := &svc.ServiceContext{DB: db, Notifier: notify.NewService(db, nil, nil)}
+	author := &model.User{Username: "author", Email: "author@example.com", MoeNo: "1000000001", Password: "correct-horse", Role: utils.RoleUser}
+	other := &model.User{Username: "other", Email: "other@example.com", MoeNo: "1000000002", Password: "correct-horse", Role: utils.RoleUser}
+	admin := &model.User{Username: "admin", Email: "admin@example.com", MoeNo: "1000000003", Password: "correct-horse", Role: utils.RoleAdmin}
+	for _, u := range []*model.User{author, other, admin} {
+		if err := db.Create(u).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	newPost := func() uint {
+		p := &model.Post{UserID: author.ID, Content: "hello"}
+		if err := db.Create(p).Error; err != nil {
+			t.Fatal(err)
+		}
+		return p.ID
+	}
+	deletePost := func(actor, post uint) error {
+		_, err := NewDeletePostLogic(context.Background(), svcCtx).DeletePost(&super.DeletePostReq{
+			ActorUserId: strconv.FormatUint(uint64(actor), 10),
+			PostId:      strconv.FormatUint(uint64(post), 10),
+			Reason:      "违规",
+		})
+		return err
+	}
+
+	post := newPost()
+	if err := deletePost(other.ID, post); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("普通用户删除别人的帖子: err = %v, want PermissionDenied", err)
+	}
+	if err := deletePost(author.ID, post); err != nil {
+		t.Fatalf("作者删除自己的帖子: %v", err)
+	}
+
+	post = newPost()
+	if err := deletePost(admin.ID, post); err != nil {
+		t.Fatalf("管理员删除帖子: %v", err)
+	}
+	var n int64
+	db.Model(&model.Post{}).Count(&n)
+	if n != 0 {
+		t.Fatalf("posts = %d, want 0", n)
+	}
+	db.Model(&model.Notification{}).Where("user_id = ? AND type = ?", author.ID, model.NotificationTypeSystem).Count(&n)
+	if n != 1 {
+		t.Fatalf("作者收到的删除通知 = %d, want 1", n)
+	}
+}
@@ -2,13 +2,21 @@ package logic
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"backend/model"
+	"backend/rpc/internal/deletion"
 	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/logutil"
+	"backend/rpc/internal/mail"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/super"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type DeleteUserLogic struct {
@@ -25,22 +33,85 @@ func NewDeleteUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Delete
 	}
 }
 
+// 申请注销账号：进入 7 天宽限期，期间可以撤销；到期后由后台任务（deletion 包）清除数据。
+// 重复申请时返回已有的申请，不重新计时
 func (l *DeleteUserLogic) DeleteUser(in *super.DeleteUserReq) (*super.DeleteUserResp, error) {
 	// 1. 查找用户，确认用户存在
-	var user model.User
-	result := l.svcCtx.DB.First(&user, in.UserId)
-	if result.Error != nil {
-		l.Error("查找用户失败: ", result.Error)
-		return nil, errorx.NotFound("用户不存在")
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	user, err := loadUserByID(db, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	actorID := user.ID
+	if in.ActorUserId != "" {
+		id, err := strconv.ParseUint(in.ActorUserId, 10, 32)
+		if err != nil {
+			return nil, errorx.InvalidArgument("无效的操作者ID")
+		}
+		actorID = uint(id)
 	}
 
-	// 2. 删除用户
-	err := l.svcCtx.DB.Delete(&user).Error
+	// 2. 创建注销申请（按用户加锁，避免并发申请创建两条）
+	var d *model.AccountDeletion
+	created := false
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&model.User{}, user.ID).Error; err != nil {
+			return err
+		}
+		existing, err := pendingDeletion(tx, user.ID)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			d = existing
+			return nil
+		}
+		var processing int64
+		if err := tx.Model(&model.AccountDeletion{}).
+			Where("user_id = ? AND status IN ?", user.ID, []string{model.AccountDeletionProcessing, model.AccountDeletionFailed}).
+			Count(&processing).Error; err != nil {
+			return err
+		}
+		if processing > 0 {
+			return errorx.AlreadyExists("账号正在注销中")
+		}
+		d = &model.AccountDeletion{
+			UserID:      user.ID,
+			RequestedBy: actorID,
+			Status:      model.AccountDeletionPending,
+			ScheduledAt: time.Now().Add(deletion.GracePeriod),
+			ClientIP:    in.ClientIp,
+		}
+		created = true
+		return tx.Create(d).Error
+	})
 	if err != nil {
-		l.Error("删除用户失败: ", err)
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Error("申请注销账号失败: ", err)
 		return nil, errorx.Internal("删除用户失败，请稍后重试")
 	}
+	if !created {
+		return &super.DeleteUserResp{Deletion: modelAccountDeletionToProto(d)}, nil
+	}
+
+	// 3. 记录安全事件并邮件通知，账号被盗用时用户能及时撤销
+	if err := recordSecurityEvent(l.svcCtx.DB, user.ID, model.SecurityEventDeletionReq, in.ClientIp, in.UserAgent, ""); err != nil {
+		l.Error("记录注销申请事件失败: ", err)
+	}
+	if l.svcCtx.Mail.Enabled() && user.Email != "" {
+		if _, err := l.svcCtx.Mail.Enqueue(l.ctx, user.Email, mail.TemplateAccountDeletion, "", mail.DeletionData{
+			Username:    user.Username,
+			ScheduledAt: d.ScheduledAt.Format("2006-01-02 15:04"),
+		}); err != nil {
+			l.Errorf("[注销] 写入通知邮件失败 用户ID=%d 错误=%v", user.ID, err)
+		} else {
+			l.svcCtx.Mail.Kick()
+		}
+	}
 
-	// 3. 构建响应
-	return &super.DeleteUserResp{}, nil
+	l.Infof("[注销] 已申请注销 用户ID=%d 发起人ID=%d 邮箱=%s 清除时间=%s",
+		user.ID, actorID, logutil.MaskEmail(user.Email), d.ScheduledAt.Format("2006-01-02 15:04:05"))
+	return &super.DeleteUserResp{Deletion: modelAccountDeletionToProto(d)}, nil
 }
@@ -0,0 +1,82 @@
+package logic
+
+import (
+	"context"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DisableTotpLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDisableTotpLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DisableTotpLogic {
+	return &DisableTotpLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 关闭两步验证：需要登录密码与验证码（或恢复码）；角色被要求两步验证时不允许关闭
+func (l *DisableTotpLogic) DisableTotp(in *super.DisableTotpReq) (*super.DisableTotpResp, error) {
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	user, err := loadUserByID(db, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if !user.CheckPassword(in.Password) {
+		return nil, errorx.InvalidArgument("密码不正确")
+	}
+	if required, err := mfaRequiredForRole(db, user.Role); err != nil {
+		l.Error("查询两步验证策略失败:", err)
+		return nil, errorx.Internal("关闭两步验证失败，请稍后重试")
+	} else if required {
+		return nil, errorx.New(403, "当前角色要求开启两步验证，不能关闭")
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		totp, err := enabledTOTP(tx.Clauses(clause.Locking{Strength: "UPDATE"}), user.ID)
+		if err != nil {
+			return err
+		}
+		if totp == nil {
+			return errorx.InvalidArgument("未开启两步验证")
+		}
+		method, err := verifySecondFactor(tx, totp, in.Code)
+		if err != nil {
+			return err
+		}
+		if method == "" {
+			return errorx.InvalidArgument("验证码错误")
+		}
+		if err := tx.Where("user_id = ?", user.ID).Delete(&model.MFARecoveryCode{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(totp).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[认证] 关闭两步验证失败 用户ID=%d 错误=%v", user.ID, err)
+		return nil, errorx.Internal("关闭两步验证失败，请稍后重试")
+	}
+
+	if err := recordSecurityEvent(l.svcCtx.DB, user.ID, model.SecurityEventMFADisabled, in.ClientIp, in.UserAgent, ""); err != nil {
+		l.Error("记录关闭两步验证事件失败: ", err)
+	}
+	l.Infof("[认证] 已关闭两步验证 用户ID=%d", user.ID)
+	return &super.DisableTotpResp{}, nil
+}
@@ -0,0 +1,420 @@
+package logic
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	// 单次上传的一次性预共享公钥上限
+	e2eeMaxOneTimeKeysPerUpload = 100
+	// 单条密文上限（base64 后），超出直接拒绝
+	e2eeMaxCiphertextLen    = 64 * 1024
+	e2eeDefaultPendingLimit = 100
+	e2eeMaxPendingLimit     = 500
+	// 领取他人一次性公钥的限流：窗口内同一用户对同一设备、对所有设备的领取次数上限。
+	// 超出时只返回签名预共享公钥（X3DH 不带一次性公钥也能建立会话），不消耗对方的公钥
+	e2eePreKeyClaimWindow      = time.Hour
+	e2eePreKeyClaimsPerDevice  = 3
+	e2eePreKeyClaimsPerClaimer = 50
+
+	e2eeMessageTypePreKey = 1
+	e2eeMessageTypeCipher = 2
+)
+
+var e2eeDevicePattern = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+// E2eeLogic 端到端加密：公钥目录与密文信封。服务端不持有任何私钥，也不解析密文。
+type E2eeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewE2eeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *E2eeLogic {
+	return &E2eeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// quietDB 全局 gorm 日志为 Info 级会把 SQL 参数打进日志；密文相关读写走静默会话，错误仍由调用方返回。
+func (l *E2eeLogic) quietDB() *gorm.DB {
+	return l.svcCtx.DB.Session(&gorm.Session{Logger: logger.Default.LogMode(logger.Silent)})
+}
+
+func decodeE2eeKey(s string, size int) ([]byte, bool) {
+	b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil || len(b) != size {
+		return nil, false
+	}
+	return b, true
+}
+
+// verifySignedPreKey 校验预共享公钥为 32 字节，且签名由身份私钥签出。
+func verifySignedPreKey(identity ed25519.PublicKey, k *super.SignedPreKey) bool {
+	if k == nil {
+		return false
+	}
+	pub, ok := decodeE2eeKey(k.GetPublicKey(), 32)
+	if !ok {
+		return false
+	}
+	sig, ok := decodeE2eeKey(k.GetSignature(), ed25519.SignatureSize)
+	if !ok {
+		return false
+	}
+	return ed25519.Verify(identity, pub, sig)
+}
+
+func e2eeMessageProto(m *model.E2eeMessage) *super.EncryptedMessage {
+	return &super.EncryptedMessage{
+		Id:                strconv.FormatUint(uint64(m.ID), 10),
+		SenderId:          strconv.FormatUint(uint64(m.SenderID), 10),
+		SenderDeviceId:    m.SenderDeviceID,
+		RecipientId:       strconv.FormatUint(uint64(m.RecipientID), 10),
+		RecipientDeviceId: m.RecipientDeviceID,
+		MessageType:       int32(m.MessageType),
+		Ciphertext:        m.Ciphertext,
+		CreatedAt:         m.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (l *E2eeLogic) UploadPreKeyBundle(in *super.UploadPreKeyBundleReq) (*super.UploadPreKeyBundleResp, error) {
+	me, err := parseActorUint(in.GetActorUserId())
+	if err != nil || me == 0 {
+		return nil, errorx.Unauthenticated("请先登录")
+	}
+	deviceID := strings.TrimSpace(in.GetDeviceId())
+	if !e2eeDevicePattern.MatchString(deviceID) {
+		return nil, errorx.InvalidArgument("无效的设备 ID")
+	}
+	identityRaw, ok := decodeE2eeKey(in.GetIdentityKey(), ed25519.PublicKeySize)
+	if !ok {
+		return nil, errorx.InvalidArgument("身份公钥格式错误")
+	}
+	identity := ed25519.PublicKey(identityRaw)
+	if !verifySignedPreKey(identity, in.GetSignedPreKey()) {
+		return nil, errorx.InvalidArgument("签名预共享公钥校验失败")
+	}
+	if len(in.GetOneTimePreKeys()) > e2eeMaxOneTimeKeysPerUpload {
+		return nil, errorx.InvalidArgument("一次性预共享公钥数量过多")
+	}
+	for _, k := range in.GetOneTimePreKeys() {
+		if !verifySignedPreKey(identity, k) {
+			return nil, errorx.InvalidArgument("一次性预共享公钥校验失败")
+		}
+	}
+
+	var remaining int64
+	err = l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		var dev model.E2eeDevice
+		err := tx.Where("user_id = ? AND device_id = ?", me, deviceID).First(&dev).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if err == nil && dev.IdentityKey != in.GetIdentityKey() {
+			// 身份公钥变更（重装/重置）：旧的一次性公钥已无法配对，全部作废
+			if err := tx.Where("user_id = ? AND device_id = ?", me, deviceID).Delete(&model.E2eeOneTimePreKey{}).Error; err != nil {
+				return err
+			}
+		}
+
+		spk := in.GetSignedPreKey()
+		dev.UserID = me
+		dev.DeviceID = deviceID
+		dev.IdentityKey = in.GetIdentityKey()
+		dev.SignedPreKeyID = spk.GetKeyId()
+		dev.SignedPreKey = spk.GetPublicKey()
+		dev.SignedPreKeySignature = spk.GetSignature()
+		if err := tx.Save(&dev).Error; err != nil {
+			return err
+		}
+
+		if len(in.GetOneTimePreKeys()) > 0 {
+			rows := make([]model.E2eeOneTimePreKey, 0, len(in.GetOneTimePreKeys()))
+			for _, k := range in.GetOneTimePreKeys() {
+				rows = append(rows, model.E2eeOneTimePreKey{
+					UserID:    me,
+					DeviceID:  deviceID,
+					KeyID:     k.GetKeyId(),
+					PublicKey: k.GetPublicKey(),
+					Signature: k.GetSignature(),
+				})
+			}
+			if err := tx.Create(&rows).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&model.E2eeOneTimePreKey{}).
+			Where("user_id = ? AND device_id = ?", me, deviceID).
+			Count(&remaining).Error
+	})
+	if err != nil {
+		l.Errorf("[E2EE] 上传公钥失败 用户ID=%d 设备=%s 错误=%v", me, deviceID, err)
+		return nil, errorx.Internal("保存公钥失败")
+	}
+
+	l.Infof("[E2EE] 公钥已更新 用户ID=%d 设备=%s 剩余一次性公钥=%d", me, deviceID, remaining)
+	return &super.UploadPreKeyBundleResp{RemainingOneTimePreKeys: int32(remaining)}, nil
+}
+
+// claimOneTimePreKey claimer 领取 userID 设备的一枚一次性公钥；超出限流或公钥已耗尽时返回 nil。
+// 先写领取记录再计数，并发请求不会一起越过上限；没有领到时撤回记录
+func (l *E2eeLogic) claimOneTimePreKey(claimer, userID uint, deviceID string) *model.E2eeOneTimePreKey {
+	claim := model.E2eePreKeyClaim{ClaimerID: claimer, UserID: userID, DeviceID: deviceID}
+	if err := l.svcCtx.DB.Create(&claim).Error; err != nil {
+		l.Errorf("[E2EE] 记录公钥领取失败 领取者=%d 用户ID=%d 设备=%s 错误=%v", claimer, userID, deviceID, err)
+		return nil
+	}
+	var k *model.E2eeOneTimePreKey
+	if l.preKeyClaimAllowed(&claim) {
+		k = l.takeOneTimePreKey(userID, deviceID)
+	}
+	if k == nil {
+		l.svcCtx.DB.Delete(&claim)
+	}
+	return k
+}
+
+// preKeyClaimAllowed 窗口内（含本次）的领取次数是否在上限内
+func (l *E2eeLogic) preKeyClaimAllowed(claim *model.E2eePreKeyClaim) bool {
+	since := claim.CreatedAt.Add(-e2eePreKeyClaimWindow)
+	var perDevice, total int64
+	recent := func() *gorm.DB {
+		return l.svcCtx.DB.Model(&model.E2eePreKeyClaim{}).Where("claimer_id = ? AND created_at > ?", claim.ClaimerID, since)
+	}
+	if err := recent().Count(&total).Error; err != nil {
+		return false
+	}
+	if err := recent().Where("user_id = ? AND device_id = ?", claim.UserID, claim.DeviceID).Count(&perDevice).Error; err != nil {
+		return false
+	}
+	if perDevice > e2eePreKeyClaimsPerDevice || total > e2eePreKeyClaimsPerClaimer {
+		l.Infof("[E2EE] 领取一次性公钥过于频繁，只返回签名预共享公钥 领取者=%d 用户ID=%d 设备=%s",
+			claim.ClaimerID, claim.UserID, claim.DeviceID)
+		return false
+	}
+	return true
+}
+
+// takeOneTimePreKey 原子领取一枚一次性公钥；并发领取时以删除成功者为准。
+func (l *E2eeLogic) takeOneTimePreKey(userID uint, deviceID string) *model.E2eeOneTimePreKey {
+	for i := 0; i < 3; i++ {
+		var k model.E2eeOneTimePreKey
+		if err := l.svcCtx.DB.Where("user_id = ? AND device_id = ?", userID, deviceID).Order("id asc").First(&k).Error; err != nil {
+			return nil
+		}
+		res := l.svcCtx.DB.Delete(&model.E2eeOneTimePreKey{}, k.ID)
+		if res.Error != nil {
+			return nil
+		}
+		if res.RowsAffected == 1 {
+			return &k
+		}
+	}
+	return nil
+}
+
+func (l *E2eeLogic) GetPreKeyBundles(in *super.GetPreKeyBundlesReq) (*super.GetPreKeyBundlesResp, error) {
+	me, err := parseActorUint(in.GetActorUserId())
+	if err != nil || me == 0 {
+		return nil, errorx.Unauthenticated("请先登录")
+	}
+	target, err := parseActorUint(in.GetUserId())
+	if err != nil || target == 0 {
+		return nil, errorx.InvalidArgument("无效的用户 ID")
+	}
+
+	q := l.svcCtx.DB.Where("user_id = ?", target)
+	if deviceID := strings.TrimSpace(in.GetDeviceId()); deviceID != "" {
+		q = q.Where("device_id = ?", deviceID)
+	}
+	var devices []model.E2eeDevice
+	if err := q.Order("id asc").Find(&devices).Error; err != nil {
+		return nil, errorx.Internal("加载公钥失败")
+	}
+	if len(devices) == 0 {
+		return nil, errorx.NotFound("对方尚未开启加密聊天")
+	}
+	if target != me {
+		// 顺带清理本人过了限流窗口的领取记录
+		if err := l.svcCtx.DB.Where("claimer_id = ? AND created_at < ?", me, time.Now().Add(-e2eePreKeyClaimWindow)).
+			Delete(&model.E2eePreKeyClaim{}).Error; err != nil {
+			l.Errorf("[E2EE] 清理公钥领取记录失败 用户ID=%d 错误=%v", me, err)
+		}
+	}
+
+	bundles := make([]*super.PreKeyBundle, 0, len(devices))
+	for _, d := range devices {
+		b := &super.PreKeyBundle{
+			UserId:      strconv.FormatUint(uint64(d.UserID), 10),
+			DeviceId:    d.DeviceID,
+			IdentityKey: d.IdentityKey,
+			SignedPreKey: &super.SignedPreKey{
+				KeyId:     d.SignedPreKeyID,
+				PublicKey: d.SignedPreKey,
+				Signature: d.SignedPreKeySignature,
+			},
+		}
+		// 自己拉取自己的设备列表（多端同步）时不消耗一次性公钥
+		if target != me {
+			if k := l.claimOneTimePreKey(me, d.UserID, d.DeviceID); k != nil {
+				b.OneTimePreKey = &super.SignedPreKey{
+					KeyId:     k.KeyID,
+					PublicKey: k.PublicKey,
+					Signature: k.Signature,
+				}
+			}
+		}
+		bundles = append(bundles, b)
+	}
+
+	return &super.GetPreKeyBundlesResp{Bundles: bundles}, nil
+}
+
+func (l *E2eeLogic) StoreEncryptedMessage(in *super.StoreEncryptedMessageReq) (*super.StoreEncryptedMessageResp, error) {
+	me, err := parseActorUint(in.GetActorUserId())
+	if err != nil || me == 0 {
+		return nil, errorx.Unauthenticated("请先登录")
+	}
+	recipient, err := parseActorUint(in.GetRecipientId())
+	if err != nil || recipient == 0 {
+		return nil, errorx.InvalidArgument("无效的接收者")
+	}
+	if t := in.GetMessageType(); t != e2eeMessageTypePreKey && t != e2eeMessageTypeCipher {
+		return nil, errorx.InvalidArgument("无效的消息类型")
+	}
+	ciphertext := strings.TrimSpace(in.GetCiphertext())
+	if ciphertext == "" || len(ciphertext) > e2eeMaxCiphertextLen {
+		return nil, errorx.InvalidArgument("密文为空或过长")
+	}
+
+	// 拉黑与对方的私信权限
+	if err := checkDirectMessage(l.svcCtx.DB, me, recipient); err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[E2EE] 校验私信权限失败 发送者=%d 接收者=%d 错误=%v", me, recipient, err)
+		return nil, errorx.Internal("查询私信权限失败")
+	}
+
+	// 发送设备必须是发送者本人登记过公钥的设备，接收方据此选择会话解密
+	senderDevice := strings.TrimSpace(in.GetSenderDeviceId())
+	if !e2eeDevicePattern.MatchString(senderDevice) {
+		return nil, errorx.InvalidArgument("无效的发送设备")
+	}
+	var n int64
+	if err := l.svcCtx.DB.Model(&model.E2eeDevice{}).
+		Where("user_id = ? AND device_id = ?", me, senderDevice).Count(&n).Error; err != nil {
+		return nil, errorx.Internal("查询发送设备失败")
+	}
+	if n == 0 {
+		return nil, errorx.InvalidArgument("发送设备尚未登记公钥")
+	}
+
+	// 每台设备的会话密钥不同，密文必须指定接收设备；投递状态也按设备各自确认
+	recipientDevice := strings.TrimSpace(in.GetRecipientDeviceId())
+	if !e2eeDevicePattern.MatchString(recipientDevice) {
+		return nil, errorx.InvalidArgument("请指定接收设备")
+	}
+	if err := l.svcCtx.DB.Model(&model.E2eeDevice{}).
+		Where("user_id = ? AND device_id = ?", recipient, recipientDevice).Count(&n).Error; err != nil {
+		return nil, errorx.Internal("查询接收设备失败")
+	}
+	if n == 0 {
+		return nil, errorx.NotFound("接收设备不存在")
+	}
+
+	msg := model.E2eeMessage{
+		SenderID:          me,
+		SenderDeviceID:    senderDevice,
+		RecipientID:       recipient,
+		RecipientDeviceID: recipientDevice,
+		MessageType:       int(in.GetMessageType()),
+		Ciphertext:        ciphertext,
+	}
+	if err := l.quietDB().Create(&msg).Error; err != nil {
+		l.Errorf("[E2EE] 保存密文失败 发送者=%d 接收者=%d 错误=%v", me, recipient, err)
+		return nil, errorx.Internal("保存消息失败")
+	}
+
+	return &super.StoreEncryptedMessageResp{Message: e2eeMessageProto(&msg)}, nil
+}
+
+func (l *E2eeLogic) ListPendingEncryptedMessages(in *super.ListPendingEncryptedMessagesReq) (*super.ListPendingEncryptedMessagesResp, error) {
+	me, err := parseActorUint(in.GetActorUserId())
+	if err != nil || me == 0 {
+		return nil, errorx.Unauthenticated("请先登录")
+	}
+	deviceID := strings.TrimSpace(in.GetDeviceId())
+	if !e2eeDevicePattern.MatchString(deviceID) {
+		return nil, errorx.InvalidArgument("无效的设备 ID")
+	}
+	limit := int(in.GetLimit())
+	if limit <= 0 {
+		limit = e2eeDefaultPendingLimit
+	}
+	if limit > e2eeMaxPendingLimit {
+		limit = e2eeMaxPendingLimit
+	}
+
+	var list []model.E2eeMessage
+	if err := l.quietDB().
+		Where("recipient_id = ? AND recipient_device_id = ? AND delivered_at IS NULL", me, deviceID).
+		Order("id asc").Limit(limit).Find(&list).Error; err != nil {
+		return nil, errorx.Internal("加载消息失败")
+	}
+
+	out := make([]*super.EncryptedMessage, 0, len(list))
+	for i := range list {
+		out = append(out, e2eeMessageProto(&list[i]))
+	}
+	return &super.ListPendingEncryptedMessagesResp{Messages: out}, nil
+}
+
+func (l *E2eeLogic) AckEncryptedMessages(in *super.AckEncryptedMessagesReq) (*super.AckEncryptedMessagesResp, error) {
+	me, err := parseActorUint(in.GetActorUserId())
+	if err != nil || me == 0 {
+		return nil, errorx.Unauthenticated("请先登录")
+	}
+	deviceID := strings.TrimSpace(in.GetDeviceId())
+	if !e2eeDevicePattern.MatchString(deviceID) {
+		return nil, errorx.InvalidArgument("无效的设备 ID")
+	}
+	ids := make([]uint, 0, len(in.GetIds()))
+	for _, s := range in.GetIds() {
+		if id, err := parseActorUint(s); err == nil && id > 0 {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return &super.AckEncryptedMessagesResp{}, nil
+	}
+
+	res := l.svcCtx.DB.Model(&model.E2eeMessage{}).
+		Where("id IN ? AND recipient_id = ? AND recipient_device_id = ? AND delivered_at IS NULL", ids, me, deviceID).
+		Update("delivered_at", time.Now())
+	if res.Error != nil {
+		return nil, errorx.Internal("确认消息失败")
+	}
+	return &super.AckEncryptedMessagesResp{Acked: int32(res.RowsAffected)}, nil
+}
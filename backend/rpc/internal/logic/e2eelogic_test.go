@@ -0,0 +1,210 @@
+package logic
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"strconv"
+	"testing"
+
+	"backend/model"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/pb/super"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newE2eeTestContext(t *testing.T) *svc.ServiceContext {
+	t.Helper()
+	db := testdb.New(t, &model.E2eeDevice{}, &model.E2eeOneTimePreKey{}, &model.E2eePreKeyClaim{}, &model.E2eeMessage{},
+		&model.User{}, &model.UserSuspension{}, &model.UserBlock{}, &model.PrivacySettings{}, &model.Follow{})
+	return &svc.ServiceContext{DB: db}
+}
+
+// signedPreKey 用身份私钥签一枚随机公钥
+func signedPreKey(t *testing.T, identity ed25519.PrivateKey, id uint32) *super.SignedPreKey {
+	t.Helper()
+	pub := make([]byte, 32)
+	if _, err := rand.Read(pub); err != nil {
+		t.Fatal(err)
+	}
+	return &super.SignedPreKey{
+		KeyId:     id,
+		PublicKey: base64.StdEncoding.EncodeToString(pub),
+		Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(identity, pub)),
+	}
+}
+
+// uploadDevice 为用户登记一台设备及 oneTimeKeys 枚一次性公钥
+func uploadDevice(t *testing.T, svcCtx *svc.ServiceContext, userID uint, deviceID string, oneTimeKeys int) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &super.UploadPreKeyBundleReq{
+		ActorUserId:  strconv.FormatUint(uint64(userID), 10),
+		DeviceId:     deviceID,
+		IdentityKey:  base64.StdEncoding.EncodeToString(pub),
+		SignedPreKey: signedPreKey(t, priv, 1),
+	}
+	for i := 0; i < oneTimeKeys; i++ {
+		req.OneTimePreKeys = append(req.OneTimePreKeys, signedPreKey(t, priv, uint32(100+i)))
+	}
+	if _, err := NewE2eeLogic(context.Background(), svcCtx).UploadPreKeyBundle(req); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func fetchBundle(t *testing.T, svcCtx *svc.ServiceContext, actor, target uint) *super.PreKeyBundle {
+	t.Helper()
+	resp, err := NewE2eeLogic(context.Background(), svcCtx).GetPreKeyBundles(&super.GetPreKeyBundlesReq{
+		ActorUserId: strconv.FormatUint(uint64(actor), 10),
+		UserId:      strconv.FormatUint(uint64(target), 10),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Bundles) != 1 {
+		t.Fatalf("bundles = %d, want 1", len(resp.Bundles))
+	}
+	return resp.Bundles[0]
+}
+
+// 同一用户反复拉取只能领走有限几枚一次性公钥，之后只拿到签名预共享公钥，对方剩余的公钥不再减少
+func TestGetPreKeyBundlesThrottlesOneTimeKeyClaims(t *testing.T) {
+	svcCtx := newE2eeTestContext(t)
+	const victim, attacker, other = 1, 2, 3
+	uploadDevice(t, svcCtx, victim, "phone", 20)
+
+	for i := 0; i < e2eePreKeyClaimsPerDevice+5; i++ {
+		b := fetchBundle(t, svcCtx, attacker, victim)
+		if b.SignedPreKey == nil || b.SignedPreKey.PublicKey == "" {
+			t.Fatalf("第 %d 次: 缺少签名预共享公钥", i+1)
+		}
+		if got, want := b.OneTimePreKey != nil, i < e2eePreKeyClaimsPerDevice; got != want {
+			t.Fatalf("第 %d 次: 领到一次性公钥 = %v, want %v", i+1, got, want)
+		}
+	}
+	var remaining int64
+	svcCtx.DB.Model(&model.E2eeOneTimePreKey{}).Where("user_id = ?", victim).Count(&remaining)
+	if remaining != 20-e2eePreKeyClaimsPerDevice {
+		t.Fatalf("remaining = %d, want %d", remaining, 20-e2eePreKeyClaimsPerDevice)
+	}
+
+	// 限流按领取者计算，其他用户不受影响；本人拉取自己的设备不消耗公钥
+	if b := fetchBundle(t, svcCtx, other, victim); b.OneTimePreKey == nil {
+		t.Fatal("其他用户没有领到一次性公钥")
+	}
+	fetchBundle(t, svcCtx, victim, victim)
+	svcCtx.DB.Model(&model.E2eeOneTimePreKey{}).Where("user_id = ?", victim).Count(&remaining)
+	if remaining != 20-e2eePreKeyClaimsPerDevice-1 {
+		t.Fatalf("remaining = %d, want %d", remaining, 20-e2eePreKeyClaimsPerDevice-1)
+	}
+}
+
+func storeMessage(svcCtx *svc.ServiceContext, sender uint, senderDevice string, recipient uint, recipientDevice string) error {
+	_, err := NewE2eeLogic(context.Background(), svcCtx).StoreEncryptedMessage(&super.StoreEncryptedMessageReq{
+		ActorUserId:       strconv.FormatUint(uint64(sender), 10),
+		SenderDeviceId:    senderDevice,
+		RecipientId:       strconv.FormatUint(uint64(recipient), 10),
+		RecipientDeviceId: recipientDevice,
+		MessageType:       e2eeMessageTypeCipher,
+		Ciphertext:        "Y2lwaGVydGV4dA==",
+	})
+	return err
+}
+
+func TestStoreEncryptedMessageRequiresSenderDevice(t *testing.T) {
+	svcCtx := newE2eeTestContext(t)
+	const alice, bob = 1, 2
+	uploadDevice(t, svcCtx, alice, "alice-phone", 0)
+	uploadDevice(t, svcCtx, bob, "bob-phone", 0)
+
+	cases := []struct {
+		name   string
+		device string
+		want   codes.Code
+	}{
+		{"本人登记的设备", "alice-phone", codes.OK},
+		{"未填写", "", codes.InvalidArgument},
+		{"未登记的设备", "alice-laptop", codes.InvalidArgument},
+		{"他人的设备", "bob-phone", codes.InvalidArgument},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := status.Code(storeMessage(svcCtx, alice, tc.device, bob, "bob-phone")); got != tc.want {
+				t.Fatalf("code = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func pendingIDs(t *testing.T, svcCtx *svc.ServiceContext, userID uint, deviceID string) []string {
+	t.Helper()
+	resp, err := NewE2eeLogic(context.Background(), svcCtx).ListPendingEncryptedMessages(&super.ListPendingEncryptedMessagesReq{
+		ActorUserId: strconv.FormatUint(uint64(userID), 10),
+		DeviceId:    deviceID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]string, 0, len(resp.Messages))
+	for _, m := range resp.Messages {
+		ids = append(ids, m.Id)
+	}
+	return ids
+}
+
+// 密文必须指定接收设备；一台设备的确认不影响发给其他设备的消息
+func TestEncryptedMessagesAreDeliveredPerDevice(t *testing.T) {
+	svcCtx := newE2eeTestContext(t)
+	const alice, bob = 1, 2
+	uploadDevice(t, svcCtx, alice, "alice-phone", 0)
+	uploadDevice(t, svcCtx, bob, "bob-phone", 0)
+	uploadDevice(t, svcCtx, bob, "bob-laptop", 0)
+
+	if got := status.Code(storeMessage(svcCtx, alice, "alice-phone", bob, "")); got != codes.InvalidArgument {
+		t.Fatalf("未指定接收设备: code = %v, want InvalidArgument", got)
+	}
+	if got := status.Code(storeMessage(svcCtx, alice, "alice-phone", bob, "bob-tablet")); got != codes.NotFound {
+		t.Fatalf("未登记的接收设备: code = %v, want NotFound", got)
+	}
+	if err := storeMessage(svcCtx, alice, "alice-phone", bob, "bob-phone"); err != nil {
+		t.Fatal(err)
+	}
+	phone := pendingIDs(t, svcCtx, bob, "bob-phone")
+	if len(phone) != 1 {
+		t.Fatalf("bob-phone 待收 %d 条, want 1", len(phone))
+	}
+	if ids := pendingIDs(t, svcCtx, bob, "bob-laptop"); len(ids) != 0 {
+		t.Fatalf("bob-laptop 待收 %v, want none", ids)
+	}
+
+	ack := func(deviceID string) int32 {
+		resp, err := NewE2eeLogic(context.Background(), svcCtx).AckEncryptedMessages(&super.AckEncryptedMessagesReq{
+			ActorUserId: strconv.FormatUint(uint64(bob), 10),
+			DeviceId:    deviceID,
+			Ids:         phone,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.Acked
+	}
+	if n := ack("bob-laptop"); n != 0 {
+		t.Fatalf("bob-laptop 确认了 %d 条, want 0", n)
+	}
+	if ids := pendingIDs(t, svcCtx, bob, "bob-phone"); len(ids) != 1 {
+		t.Fatalf("bob-laptop 确认后 bob-phone 待收 %d 条, want 1", len(ids))
+	}
+	if n := ack("bob-phone"); n != 1 {
+		t.Fatalf("bob-phone 确认了 %d 条, want 1", n)
+	}
+	if ids := pendingIDs(t, svcCtx, bob, "bob-phone"); len(ids) != 0 {
+		t.Fatalf("确认后 bob-phone 仍待收 %v", ids)
+	}
+}
@@ -0,0 +1,103 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/logutil"
+	"backend/rpc/internal/mail"
+	"backend/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+const (
+	// 验证码与链接的有效期
+	emailVerifyTTL = 30 * time.Minute
+	// 同一凭证验证码最多输错次数，超过后需重新发送
+	emailVerifyMaxAttempts = 5
+	// 同一用户两次发送的最小间隔与每小时上限
+	emailVerifyInterval = time.Minute
+	emailVerifyPerHour  = 5
+)
+
+// sendEmailVerification 为用户生成邮箱验证凭证（只存哈希）并把验证码、链接发到 email，
+// 同时作废该用户此前未使用的验证凭证。按用户限流
+func sendEmailVerification(ctx context.Context, svcCtx *svc.ServiceContext, user *model.User, email, purpose, lang string) error {
+	logger := logx.WithContext(ctx)
+	if !svcCtx.Mail.Enabled() {
+		return errorx.Internal("邮件服务暂不可用，请联系管理员")
+	}
+
+	db := svcCtx.DB.WithContext(ctx)
+	now := time.Now()
+	var recent, hourly int64
+	if err := db.Model(&model.EmailVerification{}).
+		Where("user_id = ? AND created_at > ?", user.ID, now.Add(-emailVerifyInterval)).
+		Count(&recent).Error; err != nil {
+		logger.Errorf("[认证] 邮箱验证限流查询失败: %v", err)
+		return errorx.Internal("服务器内部错误")
+	}
+	if recent > 0 {
+		return errorx.TooManyRequests("验证邮件已发送，请稍后再试")
+	}
+	if err := db.Model(&model.EmailVerification{}).
+		Where("user_id = ? AND created_at > ?", user.ID, now.Add(-time.Hour)).
+		Count(&hourly).Error; err != nil {
+		logger.Errorf("[认证] 邮箱验证限流查询失败: %v", err)
+		return errorx.Internal("服务器内部错误")
+	}
+	if hourly >= emailVerifyPerHour {
+		return errorx.TooManyRequests("发送过于频繁，请一小时后再试")
+	}
+
+	code, salt, linkToken, err := newOneTimeSecrets()
+	if err != nil {
+		logger.Errorf("[认证] 邮箱验证失败：生成验证码失败 错误=%v", err)
+		return errorx.Internal("服务器内部错误")
+	}
+	row := model.EmailVerification{
+		UserID:    user.ID,
+		Email:     email,
+		Purpose:   purpose,
+		CodeSalt:  salt,
+		CodeHash:  sha256Hex(salt + code),
+		LinkHash:  sha256Hex(linkToken),
+		ExpiresAt: now.Add(emailVerifyTTL),
+	}
+	if err := quietSession(db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.EmailVerification{}).
+			Where("user_id = ? AND used_at IS NULL AND revoked_at IS NULL", user.ID).
+			Update("revoked_at", now).Error; err != nil {
+			return err
+		}
+		return tx.Create(&row).Error
+	}); err != nil {
+		logger.Errorf("[认证] 邮箱验证失败：保存验证码失败 用户ID=%d 错误=%v", user.ID, err)
+		return errorx.Internal("服务器内部错误")
+	}
+
+	if _, err := svcCtx.Mail.Enqueue(ctx, email, mail.TemplateVerifyEmail, lang, mail.CodeData{
+		Username:      user.Username,
+		Code:          code,
+		Link:          linkWithToken(svcCtx.Config.EmailVerifyUrl, linkToken),
+		ExpireMinutes: int(emailVerifyTTL / time.Minute),
+	}); err != nil {
+		logger.Errorf("[认证] 邮箱验证失败：写入邮件失败 用户ID=%d 错误=%v", user.ID, err)
+		return errorx.Internal("发送邮件失败，请稍后重试")
+	}
+
+	logger.Infof("[认证] 已发送邮箱验证邮件 用户ID=%d 用途=%s 邮箱=%s", user.ID, purpose, logutil.MaskEmail(email))
+	return nil
+}
+
+// requireVerifiedEmail 功能开关：配置要求时，未验证邮箱的用户不能使用（购买 VIP、充值等）
+func requireVerifiedEmail(svcCtx *svc.ServiceContext, user *model.User) error {
+	if svcCtx.Config.RequireVerifiedEmail && user.EmailVerifiedAt == nil {
+		return errorx.New(403, "请先验证邮箱")
+	}
+	return nil
+}
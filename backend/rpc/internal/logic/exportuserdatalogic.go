@@ -0,0 +1,266 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+const (
+	// 每个分块的大小，远小于 gRPC 默认 4MB 的消息上限
+	exportChunkSize = 512 << 10
+	// 分批查询的行数
+	exportBatchSize = 200
+)
+
+type ExportUserDataLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExportUserDataLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportUserDataLogic {
+	return &ExportUserDataLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 导出用户的全部数据，每张表一个 JSON 数组文件（data/<name>.json），由 API 层打包为 ZIP 并附上上传的图片。
+// 敏感字段（密码哈希、两步验证密钥、令牌哈希）不导出；私信为端到端加密的密文
+func (l *ExportUserDataLogic) ExportUserData(in *super.ExportUserDataReq, stream super.Super_ExportUserDataServer) error {
+	db := l.svcCtx.DB.WithContext(stream.Context())
+	user, err := loadUserByID(db, in.UserId)
+	if err != nil {
+		return err
+	}
+	uid, sid := user.ID, fmt.Sprint(user.ID)
+
+	w := &exportWriter{stream: stream}
+	sections := []func() error{
+		func() error { return w.writeJSON("data/profile.json", user) },
+		func() error {
+			return exportTable[model.Post](w, "posts", db.Preload("TopicTags").Where("user_id = ?", uid), nil)
+		},
+		func() error {
+			return exportTable[model.PostRevision](w, "post_revisions", db.Where("editor_id = ?", uid), nil)
+		},
+		func() error { return exportTable[model.Comment](w, "comments", db.Where("user_id = ?", uid), nil) },
+		func() error { return exportTable[model.Like](w, "likes", db.Where("user_id = ?", uid), nil) },
+		func() error { return exportTable[model.Follow](w, "following", db.Where("follower_id = ?", uid), nil) },
+		func() error { return exportTable[model.Follow](w, "followers", db.Where("following_id = ?", uid), nil) },
+		func() error {
+			return exportTable[model.FriendRequest](w, "friend_requests", db.Where("from_user_id = ? OR to_user_id = ?", uid, uid), nil)
+		},
+		func() error {
+			return exportTable[model.FollowRequest](w, "follow_requests", db.Where("follower_id = ? OR following_id = ?", uid, uid), nil)
+		},
+		func() error {
+			return exportTable[model.UserBlock](w, "blocked_users", db.Where("blocker_id = ?", uid), nil)
+		},
+		func() error {
+			return exportTable[model.PrivacySettings](w, "privacy_settings", db.Where("user_id = ?", uid), nil)
+		},
+		func() error {
+			return exportTable[model.UserSuspension](w, "suspensions", db.Where("user_id = ?", uid), nil)
+		},
+		func() error {
+			return exportTable[model.SuspensionAppeal](w, "suspension_appeals", db.Where("user_id = ?", uid), nil)
+		},
+		func() error {
+			return exportTable(w, "notifications", db.Where("user_id = ?", uid), func(n *model.Notification) interface{} {
+				return exportNotification{Notification: *n}
+			})
+		},
+		func() error {
+			return exportTable[model.NotificationPreference](w, "notification_preferences", db.Where("user_id = ?", uid), nil)
+		},
+		func() error {
+			return exportTable[model.NotificationMute](w, "notification_mutes", db.Where("user_id = ?", uid), nil)
+		},
+		func() error {
+			return exportTable[model.E2eeMessage](w, "encrypted_messages", db.Where("sender_id = ? OR recipient_id = ?", uid, uid), nil)
+		},
+		func() error {
+			return exportTable[model.E2eeDevice](w, "encryption_devices", db.Where("user_id = ?", uid), nil)
+		},
+		func() error { return exportTable[model.UserMemory](w, "memories", db.Where("user_id = ?", uid), nil) },
+		func() error {
+			return exportTable[model.Transaction](w, "transactions", db.Where("user_id = ?", uid), nil)
+		},
+		func() error {
+			return exportTable[model.VipOrder](w, "vip_orders", db.Preload("Plan").Where("user_id = ?", uid), nil)
+		},
+		func() error {
+			return exportTable[model.VipRecord](w, "vip_records", db.Preload("Plan").Where("user_id = ?", uid), nil)
+		},
+		func() error {
+			return exportTable(w, "level", db.Where("user_id = ?", uid), func(v *model.UserLevel) interface{} {
+				return exportUserLevel{UserLevel: *v}
+			})
+		},
+		func() error {
+			return exportTable(w, "check_ins", db.Where("user_id = ?", uid), func(v *model.UserCheckIn) interface{} {
+				return exportCheckIn{UserCheckIn: *v}
+			})
+		},
+		func() error {
+			return exportTable(w, "exp_logs", db.Where("user_id = ?", uid), func(v *model.ExpLog) interface{} {
+				return exportExpLog{ExpLog: *v}
+			})
+		},
+		func() error { return exportTable[model.UserAvatar](w, "avatar", db.Where("user_id = ?", sid), nil) },
+		func() error {
+			return exportTable[model.UserEmojiPack](w, "emoji_packs", db.Where("user_id = ?", sid), nil)
+		},
+		func() error { return exportTable[model.UserDevice](w, "devices", db.Where("user_id = ?", uid), nil) },
+		func() error { return exportTable[model.UserSession](w, "sessions", db.Where("user_id = ?", uid), nil) },
+		func() error {
+			return exportTable[model.UserIdentity](w, "linked_identities", db.Where("user_id = ?", uid), nil)
+		},
+		func() error {
+			return exportTable[model.SecurityEvent](w, "security_events", db.Where("user_id = ?", uid), nil)
+		},
+	}
+	for _, section := range sections {
+		if err := section(); err != nil {
+			if stream.Context().Err() != nil {
+				return stream.Context().Err()
+			}
+			l.Errorf("[导出] 导出个人数据失败 用户ID=%d 错误=%v", uid, err)
+			return errorx.Internal("导出数据失败，请稍后重试")
+		}
+	}
+	l.Infof("[导出] 已导出个人数据 用户ID=%d 大小=%d", uid, w.total)
+	return nil
+}
+
+// exportWriter 把写入的内容按 exportChunkSize 切块发送，name 为当前文件名
+type exportWriter struct {
+	stream super.Super_ExportUserDataServer
+	name   string
+	buf    []byte
+	total  int
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= exportChunkSize {
+		if err := w.send(w.buf[:exportChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[exportChunkSize:]
+	}
+	return len(p), nil
+}
+
+// open 结束上一个文件并开始新文件
+func (w *exportWriter) open(name string) error {
+	if err := w.flush(); err != nil {
+		return err
+	}
+	w.name = name
+	return nil
+}
+
+func (w *exportWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	err := w.send(w.buf)
+	w.buf = w.buf[:0]
+	return err
+}
+
+func (w *exportWriter) send(data []byte) error {
+	w.total += len(data)
+	return w.stream.Send(&super.ExportChunk{Name: w.name, Data: append([]byte(nil), data...)})
+}
+
+func (w *exportWriter) writeJSON(name string, v interface{}) error {
+	if err := w.open(name); err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(b); err != nil {
+		return err
+	}
+	return w.flush()
+}
+
+// 导出时去掉未加载的关联对象（否则会输出空的 sender / post / user），只保留关联 ID
+type exportNotification struct {
+	model.Notification
+	Sender *struct{} `json:"sender,omitempty"`
+	Post   *struct{} `json:"post,omitempty"`
+}
+
+type exportUserLevel struct {
+	model.UserLevel
+	User *struct{} `json:"user,omitempty"`
+}
+
+type exportCheckIn struct {
+	model.UserCheckIn
+	User *struct{} `json:"user,omitempty"`
+}
+
+type exportExpLog struct {
+	model.ExpLog
+	User *struct{} `json:"user,omitempty"`
+}
+
+// exportTable 分批查询并写成 data/<name>.json 数组，避免大表一次载入内存；view 不为空时按它转换每一行
+func exportTable[T any](w *exportWriter, name string, q *gorm.DB, view func(*T) interface{}) error {
+	if err := w.open("data/" + name + ".json"); err != nil {
+		return err
+	}
+	if _, err := w.Write([]byte("[")); err != nil {
+		return err
+	}
+	first := true
+	var rows []T
+	res := q.Order("id").FindInBatches(&rows, exportBatchSize, func(tx *gorm.DB, batch int) error {
+		for i := range rows {
+			var v interface{} = &rows[i]
+			if view != nil {
+				v = view(&rows[i])
+			}
+			b, err := json.MarshalIndent(v, "  ", "  ")
+			if err != nil {
+				return err
+			}
+			sep := ",\n  "
+			if first {
+				sep, first = "\n  ", false
+			}
+			if _, err := w.Write(append([]byte(sep), b...)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if res.Error != nil {
+		return res.Error
+	}
+	end := "\n]\n"
+	if first {
+		end = "]\n"
+	}
+	if _, err := w.Write([]byte(end)); err != nil {
+		return err
+	}
+	return w.flush()
+}
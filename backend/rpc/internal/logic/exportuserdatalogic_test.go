@@ -0,0 +1,104 @@
+package logic
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"backend/model"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/pb/super"
+
+	"google.golang.org/grpc"
+)
+
+// exportStream 收集导出的分块，按文件名拼接
+type exportStream struct {
+	grpc.ServerStream
+	files map[string][]byte
+	order []string
+}
+
+func (s *exportStream) Context() context.Context { return context.Background() }
+
+func (s *exportStream) Send(c *super.ExportChunk) error {
+	if _, ok := s.files[c.Name]; !ok {
+		s.order = append(s.order, c.Name)
+	}
+	s.files[c.Name] = append(s.files[c.Name], c.Data...)
+	return nil
+}
+
+func TestExportUserData(t *testing.T) {
+	db := testdb.New(t, &model.User{}, &model.Post{}, &model.TopicTag{}, &model.PostTopic{}, &model.PostRevision{},
+		&model.Comment{}, &model.Like{}, &model.Follow{}, &model.FriendRequest{}, &model.FollowRequest{},
+		&model.UserBlock{}, &model.PrivacySettings{}, &model.UserSuspension{}, &model.SuspensionAppeal{},
+		&model.Notification{}, &model.NotificationPreference{}, &model.NotificationMute{}, &model.E2eeMessage{},
+		&model.E2eeDevice{}, &model.UserMemory{}, &model.Transaction{}, &model.VipPlan{}, &model.VipOrder{},
+		&model.VipRecord{}, &model.UserLevel{}, &model.UserCheckIn{}, &model.ExpLog{}, &model.UserAvatar{},
+		&model.UserEmojiPack{}, &model.UserDevice{}, &model.UserSession{}, &model.UserIdentity{}, &model.SecurityEvent{})
+	alice := &model.User{Username: "alice", Email: "alice@example.com", MoeNo: "1000000001", Password: "correct-horse"}
+	bob := &model.User{Username: "bob", Email: "bob@example.com", MoeNo: "1000000002", Password: "correct-horse"}
+	for _, u := range []*model.User{alice, bob} {
+		if err := db.Create(u).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 超过一批的动态，检查分批查询拼出的数组完整
+	const alicePosts = exportBatchSize + 5
+	for i := 0; i < alicePosts; i++ {
+		db.Create(&model.Post{UserID: alice.ID, Content: "alice " + strconv.Itoa(i)})
+	}
+	db.Create(&model.Post{UserID: bob.ID, Content: "bob"})
+	db.Create(&model.Transaction{UserID: alice.ID, Amount: 10, Type: "recharge", Status: "success"})
+
+	stream := &exportStream{files: make(map[string][]byte)}
+	err := NewExportUserDataLogic(context.Background(), &svc.ServiceContext{DB: db}).
+		ExportUserData(&super.ExportUserDataReq{UserId: strconv.FormatUint(uint64(alice.ID), 10)}, stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if stream.order[0] != "data/profile.json" {
+		t.Fatalf("第一个文件 = %s, want data/profile.json", stream.order[0])
+	}
+	var profile map[string]interface{}
+	if err := json.Unmarshal(stream.files["data/profile.json"], &profile); err != nil {
+		t.Fatal(err)
+	}
+	if profile["username"] != "alice" {
+		t.Fatalf("profile = %v", profile)
+	}
+	var stored model.User
+	db.First(&stored, alice.ID)
+	for name, data := range stream.files {
+		if bytes.Contains(data, []byte(stored.Password)) {
+			t.Fatalf("%s 中包含密码哈希", name)
+		}
+	}
+
+	var posts []model.Post
+	if err := json.Unmarshal(stream.files["data/posts.json"], &posts); err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != alicePosts {
+		t.Fatalf("posts = %d, want %d", len(posts), alicePosts)
+	}
+	for _, p := range posts {
+		if p.UserID != alice.ID {
+			t.Fatalf("导出了其他用户的动态 %+v", p)
+		}
+	}
+	var txs []model.Transaction
+	if err := json.Unmarshal(stream.files["data/transactions.json"], &txs); err != nil || len(txs) != 1 {
+		t.Fatalf("transactions = %v, err = %v", txs, err)
+	}
+	// 没有数据的表也输出空数组
+	var comments []model.Comment
+	if err := json.Unmarshal(stream.files["data/comments.json"], &comments); err != nil || len(comments) != 0 {
+		t.Fatalf("comments = %s, err = %v", stream.files["data/comments.json"], err)
+	}
+}
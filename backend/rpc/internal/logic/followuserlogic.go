@@ -5,10 +5,14 @@ import (
 	"strconv"
 
 	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/notify"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/super"
+	"backend/utils"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm/clause"
 )
 
 type FollowUserLogic struct {
@@ -42,42 +46,59 @@ func (l *FollowUserLogic) FollowUser(in *super.FollowUserReq) (*super.FollowUser
 		return nil, err
 	}
 
-	// 检查是否已经关注（包括被软删除的记录）
-	var existingFollow model.Follow
-	result := l.svcCtx.DB.Unscoped().Where("follower_id = ? AND following_id = ?", followerID, followingID).First(&existingFollow)
+	// 存在拉黑关系时不能关注
+	blocked, err := utils.Blocked(l.svcCtx.DB, uint(followerID), uint(followingID))
+	if err != nil {
+		l.Error("查询拉黑关系失败:", err)
+		return nil, err
+	}
+	if blocked {
+		return nil, errorx.New(403, "无法关注该用户")
+	}
 
-	if result.Error == nil {
-		// 记录存在
-		if existingFollow.DeletedAt.Time.IsZero() {
-			// 已经关注，直接返回成功
-			return &super.FollowUserResp{
-				Success: true,
-			}, nil
-		} else {
-			// 记录被软删除，恢复关注
-			// 使用 Unscoped() + Updates(map) 强制将 deleted_at 设为 NULL
-			if err := l.svcCtx.DB.Unscoped().Model(&existingFollow).Updates(map[string]interface{}{"deleted_at": nil}).Error; err != nil {
-				l.Error("恢复关注关系失败:", err)
-				return nil, err
-			}
-			l.Debug("恢复软删除的关注关系成功:", followerID, "->", followingID)
-		}
-	} else {
-		// 创建新关注关系
-		follow := model.Follow{
-			FollowerID:  uint(followerID),
-			FollowingID: uint(followingID),
-		}
+	// 已经关注，直接返回成功
+	following, err := isFollowing(l.svcCtx.DB, uint(followerID), uint(followingID))
+	if err != nil {
+		l.Error("查询关注关系失败:", err)
+		return nil, err
+	}
+	if following {
+		return &super.FollowUserResp{
+			Success: true,
+		}, nil
+	}
 
-		// 保存到数据库
-		if err := l.svcCtx.DB.Create(&follow).Error; err != nil {
-			l.Error("创建关注关系失败:", err)
+	// 私密账号：发送关注请求，对方批准后才建立关注关系
+	settings, err := loadPrivacySettings(l.svcCtx.DB, uint(followingID))
+	if err != nil {
+		l.Error("查询隐私设置失败:", err)
+		return nil, err
+	}
+	if settings.PrivateAccount && followerID != followingID {
+		req := model.FollowRequest{FollowerID: uint(followerID), FollowingID: uint(followingID)}
+		if err := l.svcCtx.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&req).Error; err != nil {
+			l.Error("创建关注请求失败:", err)
 			return nil, err
 		}
+		if _, err := l.svcCtx.Notifier.Notify(l.ctx, notify.KindFollowRequest, uint(followingID), uint(followerID), notify.Payload{}); err != nil {
+			l.Error("创建关注请求通知失败:", err)
+		}
+		return &super.FollowUserResp{
+			Success: true,
+			Pending: true,
+		}, nil
 	}
 
-	// 触发关注通知
-	// TODO: 实现关注通知逻辑
+	// 创建关注关系（取关后重新关注时恢复原记录）
+	if err := upsertFollow(l.svcCtx.DB, uint(followerID), uint(followingID)); err != nil {
+		l.Error("创建关注关系失败:", err)
+		return nil, err
+	}
+
+	// 触发关注通知（取关后重新关注时，未读的旧通知会被去重）
+	if _, err := l.svcCtx.Notifier.Notify(l.ctx, notify.KindFollow, uint(followingID), uint(followerID), notify.Payload{}); err != nil {
+		l.Error("创建关注通知失败:", err)
+	}
 
 	l.Debug("关注用户成功:", followerID, "关注了", followingID)
 
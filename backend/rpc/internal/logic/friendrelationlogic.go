@@ -9,6 +9,7 @@ import (
 
 	"backend/model"
 	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/notify"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/super"
 	"backend/utils"
@@ -100,6 +101,11 @@ func (l *FriendRelationLogic) SendFriendRequest(in *super.SendFriendRequestReq)
 	if err := db.First(&target, toID).Error; err != nil {
 		return nil, errorx.NotFound("用户不存在")
 	}
+	if blocked, err := utils.Blocked(db, me, toID); err != nil {
+		return nil, errorx.Internal("查询失败")
+	} else if blocked {
+		return nil, errorx.New(403, "无法向该用户发送好友申请")
+	}
 
 	var fr model.FriendRequest
 	err = db.Where("from_user_id = ? AND to_user_id = ?", me, toID).First(&fr).Error
@@ -114,6 +120,7 @@ func (l *FriendRelationLogic) SendFriendRequest(in *super.SendFriendRequestReq)
 			if err := db.Save(&fr).Error; err != nil {
 				return nil, errorx.Internal("保存失败")
 			}
+			l.notifyFriendRequest(fr)
 			return &super.SendFriendRequestResp{Data: friendRequestViewProto(db, fr)}, nil
 		}
 	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
@@ -125,10 +132,19 @@ func (l *FriendRelationLogic) SendFriendRequest(in *super.SendFriendRequestReq)
 		return nil, errorx.Internal("创建申请失败")
 	}
 	_ = db.First(&fr, fr.ID).Error
+	l.notifyFriendRequest(fr)
 
 	return &super.SendFriendRequestResp{Data: friendRequestViewProto(db, fr)}, nil
 }
 
+func (l *FriendRelationLogic) notifyFriendRequest(fr model.FriendRequest) {
+	if _, err := l.svcCtx.Notifier.Notify(l.ctx, notify.KindFriendRequest, fr.ToUserID, fr.FromUserID, notify.Payload{
+		TargetID: fr.ID,
+	}); err != nil {
+		l.Errorf("创建好友申请通知失败: %v", err)
+	}
+}
+
 func (l *FriendRelationLogic) ListIncomingFriendRequests(in *super.ListIncomingFriendRequestsReq) (*super.ListIncomingFriendRequestsResp, error) {
 	me, err := parseActorUint(in.GetActorUserId())
 	if err != nil || me == 0 {
@@ -0,0 +1,44 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAccountDeletionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetAccountDeletionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAccountDeletionLogic {
+	return &GetAccountDeletionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 最近一次注销申请（含已撤销的）
+func (l *GetAccountDeletionLogic) GetAccountDeletion(in *super.GetAccountDeletionReq) (*super.GetAccountDeletionResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+	var rows []model.AccountDeletion
+	if err := l.svcCtx.DB.WithContext(l.ctx).Where("user_id = ?", userID).Order("id desc").Limit(1).Find(&rows).Error; err != nil {
+		l.Error("查询注销申请失败:", err)
+		return nil, errorx.Internal("查询注销申请失败")
+	}
+	if len(rows) == 0 {
+		return &super.GetAccountDeletionResp{}, nil
+	}
+	return &super.GetAccountDeletionResp{Deletion: modelAccountDeletionToProto(&rows[0])}, nil
+}
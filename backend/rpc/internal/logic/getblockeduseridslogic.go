@@ -0,0 +1,44 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetBlockedUserIdsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetBlockedUserIdsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetBlockedUserIdsLogic {
+	return &GetBlockedUserIdsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetBlockedUserIdsLogic) GetBlockedUserIds(in *super.GetBlockedUserIdsReq) (*super.GetBlockedUserIdsResp, error) {
+	uid, err := parseActorUint(in.GetUserId())
+	if err != nil || uid == 0 {
+		return nil, errorx.InvalidArgument("无效的用户 ID")
+	}
+	ids, err := utils.BlockedUserIDs(l.svcCtx.DB.WithContext(l.ctx), uid)
+	if err != nil {
+		l.Errorf("[拉黑] 查询拉黑关系失败 用户ID=%d 错误=%v", uid, err)
+		return nil, errorx.Internal("查询失败")
+	}
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, strconv.FormatUint(uint64(id), 10))
+	}
+	return &super.GetBlockedUserIdsResp{UserIds: out}, nil
+}
@@ -34,6 +34,17 @@ func (l *GetFollowingsLogic) GetFollowings(in *super.GetFollowingsReq) (*super.G
 		l.Error("解析用户ID失败:", err)
 		return &super.GetFollowingsResp{}, err
 	}
+
+	// 隐藏了关注列表或私密账号时，只有本人（私密账号还有已批准的粉丝）可以查看
+	var viewerID uint
+	if in.ViewerUserId != "" {
+		if v, err := strconv.ParseUint(in.ViewerUserId, 10, 32); err == nil {
+			viewerID = uint(v)
+		}
+	}
+	if err := checkFollowListVisible(l.svcCtx.DB, uint(userID), viewerID); err != nil {
+		return nil, err
+	}
 	
 	// 计算分页参数
 	page := in.Page
@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetMfaStatusLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetMfaStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetMfaStatusLogic {
+	return &GetMfaStatusLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetMfaStatusLogic) GetMfaStatus(in *super.GetMfaStatusReq) (*super.GetMfaStatusResp, error) {
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	user, err := loadUserByID(db, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	required, err := mfaRequiredForRole(db, user.Role)
+	if err != nil {
+		l.Error("查询两步验证策略失败:", err)
+		return nil, errorx.Internal("查询两步验证状态失败")
+	}
+	totp, err := enabledTOTP(db, user.ID)
+	if err != nil {
+		l.Error("查询两步验证状态失败:", err)
+		return nil, errorx.Internal("查询两步验证状态失败")
+	}
+
+	resp := &super.GetMfaStatusResp{Required: required}
+	if totp != nil {
+		remaining, err := remainingRecoveryCodes(db, user.ID)
+		if err != nil {
+			l.Error("查询恢复码失败:", err)
+			return nil, errorx.Internal("查询两步验证状态失败")
+		}
+		resp.Enabled = true
+		resp.RecoveryCodesRemaining = int32(remaining)
+		resp.EnabledAt = totp.EnabledAt.Format("2006-01-02 15:04:05")
+	}
+	return resp, nil
+}
@@ -0,0 +1,37 @@
+package logic
+
+import (
+	"context"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetNotificationCampaignLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetNotificationCampaignLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetNotificationCampaignLogic {
+	return &GetNotificationCampaignLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetNotificationCampaignLogic) GetNotificationCampaign(in *super.GetNotificationCampaignReq) (*super.NotificationCampaignResp, error) {
+	if _, err := requirePermission(l.ctx, l.svcCtx, in.ActorUserId, utils.PermNotificationSend); err != nil {
+		return nil, err
+	}
+
+	c, err := loadCampaign(l.ctx, l.svcCtx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &super.NotificationCampaignResp{Campaign: c}, nil
+}
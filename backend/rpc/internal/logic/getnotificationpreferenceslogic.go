@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/notify"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetNotificationPreferencesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetNotificationPreferencesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetNotificationPreferencesLogic {
+	return &GetNotificationPreferencesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetNotificationPreferencesLogic) GetNotificationPreferences(in *super.GetNotificationPreferencesReq) (*super.NotificationPreferencesResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+
+	prefs, err := loadNotificationPreferences(l.ctx, l.svcCtx, uint(userID))
+	if err != nil {
+		l.Error("查询通知偏好失败:", err)
+		return nil, errorx.Internal("查询通知偏好失败")
+	}
+	return &super.NotificationPreferencesResp{Preferences: prefs}, nil
+}
+
+// loadNotificationPreferences 读取偏好与屏蔽列表并转换为接口结构
+func loadNotificationPreferences(ctx context.Context, svcCtx *svc.ServiceContext, userID uint) (*super.NotificationPreferences, error) {
+	db := svcCtx.DB.WithContext(ctx)
+	prefs, err := notify.LoadPreferences(db, userID)
+	if err != nil {
+		return nil, err
+	}
+	var mutes []model.NotificationMute
+	if err := db.Where("user_id = ?", userID).Order("id desc").Find(&mutes).Error; err != nil {
+		return nil, err
+	}
+	return notify.PreferencesToRpc(prefs, mutes), nil
+}
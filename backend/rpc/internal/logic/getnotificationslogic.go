@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"backend/model"
+	"backend/rpc/internal/notify"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/super"
 
@@ -54,8 +55,8 @@ func (l *GetNotificationsLogic) GetNotifications(in *super.GetNotificationsReq)
 		return nil, err
 	}
 
-	// 获取列表
-	if err := db.Order("created_at desc").
+	// 获取列表：聚合通知有新发起人时会刷新 updated_at 顶到最前
+	if err := db.Order("updated_at desc").Order("id desc").
 		Offset(offset).Limit(pageSize).
 		Preload("Sender").
 		Find(&notifications).Error; err != nil {
@@ -63,28 +64,16 @@ func (l *GetNotificationsLogic) GetNotifications(in *super.GetNotificationsReq)
 		return nil, err
 	}
 
+	actors, err := notify.LoadActors(l.svcCtx.DB, notifications)
+	if err != nil {
+		l.Error("查询通知发起人失败:", err)
+		return nil, err
+	}
+
 	// 转换格式
 	var rpcNotifications []*super.Notification
-	for _, n := range notifications {
-		senderName := "未知用户"
-		if n.Sender.Username != "" {
-			senderName = n.Sender.Username
-		} else if n.Sender.Email != "" {
-			senderName = n.Sender.Email
-		}
-
-		rpcNotifications = append(rpcNotifications, &super.Notification{
-			Id:           strconv.FormatUint(uint64(n.ID), 10),
-			UserId:       strconv.FormatUint(uint64(n.UserID), 10),
-			SenderId:     strconv.FormatUint(uint64(n.SenderID), 10),
-			SenderName:   senderName,
-			SenderAvatar: n.Sender.Avatar,
-			Type:         int32(n.Type),
-			PostId:       strconv.FormatUint(uint64(n.PostID), 10),
-			Content:      n.Content,
-			IsRead:       n.IsRead,
-			CreatedAt:    n.CreatedAt.Format("2006-01-02 15:04:05"),
-		})
+	for i := range notifications {
+		rpcNotifications = append(rpcNotifications, notify.ToRpc(&notifications[i], actors[notifications[i].ID]))
 	}
 
 	return &super.GetNotificationsResp{
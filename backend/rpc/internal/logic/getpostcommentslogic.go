@@ -7,8 +7,10 @@ import (
 	"backend/model"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/super"
+	"backend/utils"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
 )
 
 type GetPostCommentsLogic struct {
@@ -51,18 +53,39 @@ func (l *GetPostCommentsLogic) GetPostComments(in *super.GetPostCommentsReq) (*s
 		offset = 0
 	}
 
-	// 查询评论列表
+	var viewerUID uint
+	if in.ViewerUserId != "" {
+		if v, e := strconv.ParseUint(in.ViewerUserId, 10, 32); e == nil {
+			viewerUID = uint(v)
+		}
+	}
+
+	// 私密账号的动态，评论同样只有本人与已批准的粉丝可见
+	var post model.Post
+	if err := l.svcCtx.DB.Select("id", "user_id").Where("id = ?", postID).Limit(1).Find(&post).Error; err != nil {
+		l.Error("查询帖子失败:", err)
+		return nil, err
+	}
+	if post.ID != 0 {
+		if err := checkPostVisible(l.svcCtx.DB, post.UserID, viewerUID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 查询评论列表（不含与查看者存在拉黑关系的用户的评论）
 	var comments []model.Comment
 	var total int64
+	listQuery := l.svcCtx.DB.Model(&model.Comment{}).Where("post_id = ?", postID).
+		Scopes(utils.ExcludeBlockedScope(viewerUID, "user_id"), hiddenContentScope(viewerUID, "user_id"))
 
 	// 计算总数
-	if err := l.svcCtx.DB.Model(&model.Comment{}).Where("post_id = ?", postID).Count(&total).Error; err != nil {
+	if err := listQuery.Session(&gorm.Session{}).Count(&total).Error; err != nil {
 		l.Error("计算评论总数失败:", err)
 		return nil, err
 	}
 
 	// 查询评论列表，按创建时间倒序排列
-	if err := l.svcCtx.DB.Where("post_id = ?", postID).
+	if err := listQuery.
 		Order("created_at DESC").
 		Offset(int(offset)).
 		Limit(int(pageSize)).
@@ -85,12 +108,6 @@ func (l *GetPostCommentsLogic) GetPostComments(in *super.GetPostCommentsReq) (*s
 		}
 	}
 
-	var viewerUID uint
-	if in.ViewerUserId != "" {
-		if v, e := strconv.ParseUint(in.ViewerUserId, 10, 32); e == nil {
-			viewerUID = uint(v)
-		}
-	}
 	commentIDs := make([]uint, 0, len(comments))
 	for _, c := range comments {
 		commentIDs = append(commentIDs, c.ID)
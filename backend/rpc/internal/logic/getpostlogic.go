@@ -9,8 +9,10 @@ import (
 	"backend/rpc/internal/errorx"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/super"
+	"backend/utils"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
@@ -64,6 +66,22 @@ func (l *GetPostLogic) GetPost(in *super.GetPostReq) (*super.GetPostResp, error)
 	if ms == "pending" && post.UserID != viewerUID {
 		return nil, errorx.New(404, "帖子不存在")
 	}
+	// 与作者存在拉黑关系时不可见
+	if blocked, err := utils.Blocked(l.svcCtx.DB, viewerUID, post.UserID); err != nil || blocked {
+		if err != nil {
+			l.Error("查询拉黑关系失败: ", err)
+			return nil, errorx.New(500, "服务器内部错误")
+		}
+		return nil, errorx.New(404, "帖子不存在")
+	}
+	// 私密账号的动态仅本人与已批准的粉丝可见
+	if err := checkPostVisible(l.svcCtx.DB, post.UserID, viewerUID); err != nil {
+		if _, ok := status.FromError(err); !ok {
+			l.Error("查询隐私设置失败: ", err)
+			return nil, errorx.New(500, "服务器内部错误")
+		}
+		return nil, err
+	}
 	
 	// 查询用户信息
 	var user model.User
@@ -124,6 +142,8 @@ func (l *GetPostLogic) GetPost(in *super.GetPostReq) (*super.GetPostResp, error)
 			HandDrawCard:      post.HandDrawCard,
 			HandDrawThumbUrl:  post.HandDrawThumbURL,
 			ModerationStatus:  ms,
+			Edited:            post.EditedAt != nil,
+			EditedAt:          formatOptionalTime(post.EditedAt),
 		},
 	}, nil
 }
@@ -9,6 +9,7 @@ import (
 	"backend/model"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/super"
+	"backend/utils"
 
 	"github.com/zeromicro/go-zero/core/logx"
 	"gorm.io/gorm"
@@ -70,7 +71,9 @@ func (l *GetPostsLogic) GetPosts(in *super.GetPostsReq) (*super.GetPostsResp, er
 		}
 	}
 
-	listQuery := l.svcCtx.DB.Model(&model.Post{}).Scopes(moderationVisibleScope(viewerUID))
+	listQuery := l.svcCtx.DB.Model(&model.Post{}).
+		Scopes(moderationVisibleScope(viewerUID), utils.ExcludeBlockedScope(viewerUID, "user_id"),
+			privateAccountScope(viewerUID, "user_id"), hiddenContentScope(viewerUID, "user_id"))
 
 	if topicTagID > 0 {
 		sub := l.svcCtx.DB.Model(&model.PostTopic{}).Select("post_id").Where("topic_tag_id = ?", topicTagID)
@@ -175,6 +178,8 @@ func (l *GetPostsLogic) GetPosts(in *super.GetPostsReq) (*super.GetPostsResp, er
 			HandDrawCard:       post.HandDrawCard,
 			HandDrawThumbUrl:   post.HandDrawThumbURL,
 			ModerationStatus:   moderationStatusOrDefault(post.ModerationStatus),
+			Edited:             post.EditedAt != nil,
+			EditedAt:           formatOptionalTime(post.EditedAt),
 		}
 
 		resp.Posts = append(resp.Posts, rpcPost)
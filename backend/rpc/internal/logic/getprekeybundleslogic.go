@@ -0,0 +1,28 @@
+package logic
+
+import (
+	"context"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPreKeyBundlesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetPreKeyBundlesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPreKeyBundlesLogic {
+	return &GetPreKeyBundlesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *GetPreKeyBundlesLogic) GetPreKeyBundles(in *super.GetPreKeyBundlesReq) (*super.GetPreKeyBundlesResp, error) {
+	return NewE2eeLogic(l.ctx, l.svcCtx).GetPreKeyBundles(in)
+}
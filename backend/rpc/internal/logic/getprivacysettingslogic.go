@@ -0,0 +1,40 @@
+package logic
+
+import (
+	"context"
+
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPrivacySettingsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetPrivacySettingsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPrivacySettingsLogic {
+	return &GetPrivacySettingsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 隐私设置相关服务
+func (l *GetPrivacySettingsLogic) GetPrivacySettings(in *super.GetPrivacySettingsReq) (*super.PrivacySettingsResp, error) {
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	user, err := loadUserByID(db, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	s, err := loadPrivacySettings(db, user.ID)
+	if err != nil {
+		l.Errorf("[隐私] 查询隐私设置失败 用户ID=%d 错误=%v", user.ID, err)
+		return nil, errorx.Internal("查询隐私设置失败")
+	}
+	return &super.PrivacySettingsResp{Settings: modelPrivacySettingsToProto(&s)}, nil
+}
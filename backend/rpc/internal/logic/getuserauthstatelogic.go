@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type GetUserAuthStateLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetUserAuthStateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserAuthStateLogic {
+	return &GetUserAuthStateLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 登录令牌状态、角色与最近撤销的会话；用户不存在（含已注销）时返回 NotFound，其令牌一律视为失效
+func (l *GetUserAuthStateLogic) GetUserAuthState(in *super.GetUserAuthStateReq) (*super.GetUserAuthStateResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+
+	var user model.User
+	if err := l.svcCtx.DB.WithContext(l.ctx).Select("id", "token_version", "role").First(&user, userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("用户不存在")
+		}
+		l.Error("查询令牌状态失败:", err)
+		return nil, errorx.Internal("查询令牌状态失败")
+	}
+
+	// 撤销时间早于一个访问令牌有效期的会话，其访问令牌都已过期，无需再下发
+	var revoked []uint
+	if err := l.svcCtx.DB.WithContext(l.ctx).Model(&model.UserSession{}).
+		Where("user_id = ? AND revoked_at > ?", userID, time.Now().Add(-utils.AccessTokenTTL)).
+		Pluck("id", &revoked).Error; err != nil {
+		l.Error("查询已撤销会话失败:", err)
+		return nil, errorx.Internal("查询令牌状态失败")
+	}
+	resp := &super.GetUserAuthStateResp{TokenVersion: user.TokenVersion, Role: user.Role}
+	suspension, err := activeSuspension(l.svcCtx.DB.WithContext(l.ctx), user.ID)
+	if err != nil {
+		l.Error("查询处罚失败:", err)
+		return nil, errorx.Internal("查询令牌状态失败")
+	}
+	if suspension != nil {
+		resp.Suspended = suspension.Kind == model.SuspensionKindSuspend
+		resp.Restricted = suspension.Kind == model.SuspensionKindRestrict
+	}
+	for _, id := range revoked {
+		resp.RevokedSessionIds = append(resp.RevokedSessionIds, strconv.FormatUint(uint64(id), 10))
+	}
+	return resp, nil
+}
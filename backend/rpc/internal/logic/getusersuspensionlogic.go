@@ -0,0 +1,40 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUserSuspensionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetUserSuspensionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUserSuspensionLogic {
+	return &GetUserSuspensionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetUserSuspension 用户当前生效中的处罚，没有时 suspension 为空
+func (l *GetUserSuspensionLogic) GetUserSuspension(in *super.GetUserSuspensionReq) (*super.SuspensionResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil || userID == 0 {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+	s, err := activeSuspension(l.svcCtx.DB.WithContext(l.ctx), uint(userID))
+	if err != nil {
+		l.Errorf("[处罚] 查询处罚失败 用户ID=%d 错误=%v", userID, err)
+		return nil, errorx.Internal("查询处罚失败")
+	}
+	return &super.SuspensionResp{Suspension: modelSuspensionToProto(s)}, nil
+}
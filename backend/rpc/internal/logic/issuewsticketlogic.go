@@ -0,0 +1,72 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// WebSocket 连接票据的有效期
+const wsTicketTTL = 30 * time.Second
+
+type IssueWsTicketLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewIssueWsTicketLogic(ctx context.Context, svcCtx *svc.ServiceContext) *IssueWsTicketLogic {
+	return &IssueWsTicketLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// IssueWsTicket 签发绑定用户、登录会话与端点的一次性票据，只保存哈希；顺带清理过期未兑换的票据
+func (l *IssueWsTicketLogic) IssueWsTicket(in *super.IssueWsTicketReq) (*super.IssueWsTicketResp, error) {
+	userID, err := parseActorUint(in.UserId)
+	if err != nil || userID == 0 {
+		return nil, errorx.Unauthenticated("请先登录")
+	}
+	var sessionID uint
+	if s := strings.TrimSpace(in.SessionId); s != "" {
+		if sessionID, err = parseActorUint(s); err != nil {
+			return nil, errorx.InvalidArgument("无效的会话 ID")
+		}
+	}
+	endpoint := strings.TrimSpace(in.Endpoint)
+	if endpoint == "" || len(endpoint) > 32 {
+		return nil, errorx.InvalidArgument("无效的 WebSocket 端点")
+	}
+
+	ticket, err := newRefreshToken()
+	if err != nil {
+		l.Errorf("[WebSocket] 生成票据失败 错误=%v", err)
+		return nil, errorx.Internal("签发票据失败")
+	}
+	now := time.Now()
+	db := quietSession(l.svcCtx.DB.WithContext(l.ctx))
+	if err := db.Where("expires_at < ?", now).Delete(&model.WsTicket{}).Error; err != nil {
+		l.Errorf("[WebSocket] 清理过期票据失败 错误=%v", err)
+	}
+	err = db.Create(&model.WsTicket{
+		TicketHash: sha256Hex(ticket),
+		UserID:     userID,
+		SessionID:  sessionID,
+		Endpoint:   endpoint,
+		ExpiresAt:  now.Add(wsTicketTTL),
+	}).Error
+	if err != nil {
+		l.Errorf("[WebSocket] 保存票据失败 用户ID=%d 错误=%v", userID, err)
+		return nil, errorx.Internal("签发票据失败")
+	}
+	return &super.IssueWsTicketResp{Ticket: ticket, ExpiresIn: int64(wsTicketTTL / time.Second)}, nil
+}
@@ -0,0 +1,89 @@
+package logic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/pb/super"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func issueWsTicket(t *testing.T, svcCtx *svc.ServiceContext, endpoint string) string {
+	t.Helper()
+	resp, err := NewIssueWsTicketLogic(context.Background(), svcCtx).IssueWsTicket(&super.IssueWsTicketReq{
+		UserId:    "7",
+		SessionId: "3",
+		Endpoint:  endpoint,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.ExpiresIn != int64(wsTicketTTL/time.Second) {
+		t.Fatalf("expires_in = %d", resp.ExpiresIn)
+	}
+	return resp.Ticket
+}
+
+func redeemWsTicket(svcCtx *svc.ServiceContext, ticket, endpoint string) (*super.RedeemWsTicketResp, error) {
+	return NewRedeemWsTicketLogic(context.Background(), svcCtx).RedeemWsTicket(&super.RedeemWsTicketReq{
+		Ticket:   ticket,
+		Endpoint: endpoint,
+	})
+}
+
+// 票据存在数据库中：一个实例签发，另一个实例兑换；只能兑换一次
+func TestWsTicketRedeemedOnceAcrossInstances(t *testing.T) {
+	db := testdb.New(t, &model.WsTicket{})
+	issuer, redeemer := &svc.ServiceContext{DB: db}, &svc.ServiceContext{DB: db}
+
+	ticket := issueWsTicket(t, issuer, "/ws/chat")
+	resp, err := redeemWsTicket(redeemer, ticket, "/ws/chat")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.UserId != "7" || resp.SessionId != "3" {
+		t.Fatalf("resp = %+v", resp)
+	}
+	if _, err := redeemWsTicket(issuer, ticket, "/ws/chat"); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("再次兑换: err = %v, want InvalidArgument", err)
+	}
+}
+
+func TestWsTicketRejected(t *testing.T) {
+	db := testdb.New(t, &model.WsTicket{})
+	svcCtx := &svc.ServiceContext{DB: db}
+
+	// 端点不符：票据同样作废
+	ticket := issueWsTicket(t, svcCtx, "/ws/chat")
+	if _, err := redeemWsTicket(svcCtx, ticket, "/ws/world"); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("端点不符: err = %v, want InvalidArgument", err)
+	}
+	if _, err := redeemWsTicket(svcCtx, ticket, "/ws/chat"); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("端点不符后再兑换: err = %v, want InvalidArgument", err)
+	}
+
+	ticket = issueWsTicket(t, svcCtx, "/ws")
+	db.Model(&model.WsTicket{}).Where("1 = 1").Update("expires_at", time.Now().Add(-time.Second))
+	if _, err := redeemWsTicket(svcCtx, ticket, "/ws"); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("已过期: err = %v, want InvalidArgument", err)
+	}
+	if _, err := redeemWsTicket(svcCtx, "unknown", "/ws"); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("不存在: err = %v, want InvalidArgument", err)
+	}
+
+	// 签发时清理过期未兑换的票据
+	issueWsTicket(t, svcCtx, "/ws")
+	db.Model(&model.WsTicket{}).Where("1 = 1").Update("expires_at", time.Now().Add(-time.Second))
+	issueWsTicket(t, svcCtx, "/ws")
+	var n int64
+	db.Model(&model.WsTicket{}).Count(&n)
+	if n != 1 {
+		t.Fatalf("tickets = %d, want 1", n)
+	}
+}
@@ -0,0 +1,57 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+)
+
+type LiftSuspensionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLiftSuspensionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LiftSuspensionLogic {
+	return &LiftSuspensionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// LiftSuspension 提前解除用户生效中的处罚；解除封禁后用户需要重新登录
+func (l *LiftSuspensionLogic) LiftSuspension(in *super.LiftSuspensionReq) (*super.SuspensionResp, error) {
+	actorID, err := requirePermission(l.ctx, l.svcCtx, in.ActorUserId, utils.PermUserModerate)
+	if err != nil {
+		return nil, err
+	}
+	targetID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil || targetID == 0 {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+	reason := strings.TrimSpace(in.Reason)
+	if len([]rune(reason)) > 200 {
+		return nil, errorx.InvalidArgument("原因不能超过200个字符")
+	}
+
+	s, err := liftSuspension(l.svcCtx.DB.WithContext(l.ctx), uint(targetID), actorID, reason)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[处罚] 解除处罚失败 操作者ID=%d 用户ID=%d 错误=%v", actorID, targetID, err)
+		return nil, errorx.Internal("解除处罚失败")
+	}
+
+	l.Infof("[处罚] 解除处罚 操作者ID=%d 用户ID=%d 处罚ID=%d 原因=%q", actorID, targetID, s.ID, reason)
+	return &super.SuspensionResp{Suspension: modelSuspensionToProto(s)}, nil
+}
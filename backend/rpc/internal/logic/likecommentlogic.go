@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"backend/model"
+	"backend/rpc/internal/notify"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/super"
 
@@ -99,6 +100,17 @@ func (l *LikeCommentLogic) LikeComment(in *super.LikeCommentReq) (*super.LikeCom
 		return nil, err
 	}
 
+	// 评论点赞通知（取消点赞不通知）
+	if !hasLiked {
+		if _, err := l.svcCtx.Notifier.Notify(l.ctx, notify.KindLikeComment, comment.UserID, uint(userID), notify.Payload{
+			PostID:   comment.PostID,
+			TargetID: comment.ID,
+			Content:  comment.Content,
+		}); err != nil {
+			l.Error("创建评论点赞通知失败:", err)
+		}
+	}
+
 	// 重新查询评论（获取最新数据）并加载用户信息
 	if err := l.svcCtx.DB.Preload("User").First(&comment, commentID).Error; err != nil {
 		l.Error("重新查询评论失败:", err)
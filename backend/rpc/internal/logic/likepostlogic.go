@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"backend/model"
+	"backend/rpc/internal/notify"
 	"backend/rpc/internal/svc"
 	"backend/rpc/pb/super"
 
@@ -100,6 +101,15 @@ func (l *LikePostLogic) LikePost(in *super.LikePostReq) (*super.LikePostResp, er
 		return nil, err
 	}
 
+	// 点赞通知（取消点赞不通知）
+	if !hasLiked {
+		if _, err := l.svcCtx.Notifier.Notify(l.ctx, notify.KindLikePost, post.UserID, uint(userID), notify.Payload{
+			PostID: uint(postID),
+		}); err != nil {
+			l.Error("创建点赞通知失败:", err)
+		}
+	}
+
 	// 重新查询帖子（获取最新数据）并加载用户信息
 	if err := l.svcCtx.DB.Preload("User").First(&post, postID).Error; err != nil {
 		l.Error("重新查询帖子失败:", err)
@@ -148,6 +158,8 @@ func (l *LikePostLogic) LikePost(in *super.LikePostReq) (*super.LikePostResp, er
 		HandDrawCard:      post.HandDrawCard,
 		HandDrawThumbUrl:  post.HandDrawThumbURL,
 		ModerationStatus:  moderationStatusOrDefault(post.ModerationStatus),
+		Edited:            post.EditedAt != nil,
+		EditedAt:          formatOptionalTime(post.EditedAt),
 	}
 
 	return &super.LikePostResp{
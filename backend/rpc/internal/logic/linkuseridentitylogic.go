@@ -0,0 +1,97 @@
+package logic
+
+import (
+	"context"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type LinkUserIdentityLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewLinkUserIdentityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LinkUserIdentityLogic {
+	return &LinkUserIdentityLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// LinkUserIdentity 把第三方账号绑定到当前用户。该第三方账号已绑定其他用户，或当前用户在该提供方已绑定过账号时拒绝
+func (l *LinkUserIdentityLogic) LinkUserIdentity(in *super.LinkUserIdentityReq) (*super.UserIdentityResp, error) {
+	actorID, err := parseActorUint(in.ActorUserId)
+	if err != nil || actorID == 0 {
+		return nil, errorx.Unauthenticated("请先登录")
+	}
+	p, err := oidcProvider(l.svcCtx, in.Provider)
+	if err != nil {
+		return nil, err
+	}
+	db := l.svcCtx.DB
+	req, err := consumeOidcAuthRequest(db, p.Name(), in.State, model.OidcPurposeLink)
+	if err != nil {
+		return nil, err
+	}
+	// state 由另一个用户发起：可能是诱导当前用户提交了别人的回调，拒绝
+	if req.UserID != actorID {
+		l.Infof("[第三方登录] 绑定被拒绝：授权发起人不一致 用户ID=%d 发起人=%d", actorID, req.UserID)
+		return nil, errorx.InvalidArgument("授权已失效，请重新发起")
+	}
+	claims, err := exchangeOidcCode(l.ctx, p, req, in.Code)
+	if err != nil {
+		l.Infof("[第三方登录] 绑定失败：换取令牌失败 用户ID=%d 提供方=%s 错误=%v", actorID, p.Name(), err)
+		return nil, err
+	}
+
+	identity := model.UserIdentity{
+		UserID:      actorID,
+		Provider:    p.Name(),
+		Subject:     claims.Subject,
+		Email:       claims.Email,
+		DisplayName: oidcDisplayName(claims),
+	}
+	err = db.Transaction(func(tx *gorm.DB) error {
+		var existing []model.UserIdentity
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("provider = ? AND (subject = ? OR user_id = ?)", p.Name(), claims.Subject, actorID).
+			Find(&existing).Error
+		if err != nil {
+			return err
+		}
+		for _, e := range existing {
+			switch {
+			case e.Subject == claims.Subject && e.UserID == actorID:
+				return errorx.AlreadyExists("已绑定该第三方账号")
+			case e.Subject == claims.Subject:
+				return errorx.AlreadyExists("该第三方账号已绑定其他用户")
+			default:
+				return errorx.AlreadyExists("已绑定过该登录方式，请先解绑")
+			}
+		}
+		return tx.Create(&identity).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[第三方登录] 绑定失败 用户ID=%d 提供方=%s 错误=%v", actorID, p.Name(), err)
+		return nil, errorx.Internal("绑定失败，请稍后重试")
+	}
+
+	if err := recordSecurityEvent(db, actorID, model.SecurityEventIdentityLink, in.ClientIp, in.UserAgent, p.Name()); err != nil {
+		l.Errorf("[第三方登录] 记录绑定事件失败 用户ID=%d 错误=%v", actorID, err)
+	}
+	l.Infof("[第三方登录] 绑定成功 用户ID=%d 提供方=%s", actorID, p.Name())
+	return &super.UserIdentityResp{Identity: modelIdentityToProto(&identity)}, nil
+}
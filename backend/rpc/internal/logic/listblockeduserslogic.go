@@ -0,0 +1,81 @@
+package logic
+
+import (
+	"context"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListBlockedUsersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListBlockedUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListBlockedUsersLogic {
+	return &ListBlockedUsersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListBlockedUsersLogic) ListBlockedUsers(in *super.ListBlockedUsersReq) (*super.ListBlockedUsersResp, error) {
+	me, err := parseActorUint(in.GetActorUserId())
+	if err != nil || me == 0 {
+		return nil, errorx.Unauthenticated("请先登录")
+	}
+	page, pageSize := in.Page, in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	q := db.Model(&model.UserBlock{}).Where("blocker_id = ?", me)
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		l.Errorf("[拉黑] 查询黑名单失败 用户ID=%d 错误=%v", me, err)
+		return nil, errorx.Internal("加载失败")
+	}
+	var blocks []model.UserBlock
+	if err := q.Order("id desc").Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Find(&blocks).Error; err != nil {
+		l.Errorf("[拉黑] 查询黑名单失败 用户ID=%d 错误=%v", me, err)
+		return nil, errorx.Internal("加载失败")
+	}
+
+	ids := make([]uint, 0, len(blocks))
+	for _, b := range blocks {
+		ids = append(ids, b.BlockedID)
+	}
+	userMap := make(map[uint]*model.User, len(ids))
+	if len(ids) > 0 {
+		var users []model.User
+		if err := db.Where("id IN ?", ids).Find(&users).Error; err != nil {
+			return nil, errorx.Internal("加载失败")
+		}
+		for i := range users {
+			userMap[users[i].ID] = &users[i]
+		}
+	}
+
+	resp := &super.ListBlockedUsersResp{Users: make([]*super.BlockedUser, 0, len(blocks)), Total: total}
+	for _, b := range blocks {
+		u, ok := userMap[b.BlockedID]
+		if !ok {
+			continue
+		}
+		resp.Users = append(resp.Users, &super.BlockedUser{
+			User:      modelUserToProto(u),
+			BlockedAt: b.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,83 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFollowRequestsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListFollowRequestsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFollowRequestsLogic {
+	return &ListFollowRequestsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListFollowRequestsLogic) ListFollowRequests(in *super.ListFollowRequestsReq) (*super.ListFollowRequestsResp, error) {
+	me, err := parseActorUint(in.GetActorUserId())
+	if err != nil || me == 0 {
+		return nil, errorx.Unauthenticated("请先登录")
+	}
+	page, pageSize := in.Page, in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	q := db.Model(&model.FollowRequest{}).Where("following_id = ?", me)
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		l.Errorf("[关注请求] 查询失败 用户ID=%d 错误=%v", me, err)
+		return nil, errorx.Internal("加载失败")
+	}
+	var reqs []model.FollowRequest
+	if err := q.Order("id desc").Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Find(&reqs).Error; err != nil {
+		l.Errorf("[关注请求] 查询失败 用户ID=%d 错误=%v", me, err)
+		return nil, errorx.Internal("加载失败")
+	}
+
+	ids := make([]uint, 0, len(reqs))
+	for _, r := range reqs {
+		ids = append(ids, r.FollowerID)
+	}
+	userMap := make(map[uint]*model.User, len(ids))
+	if len(ids) > 0 {
+		var users []model.User
+		if err := db.Where("id IN ?", ids).Find(&users).Error; err != nil {
+			return nil, errorx.Internal("加载失败")
+		}
+		for i := range users {
+			userMap[users[i].ID] = &users[i]
+		}
+	}
+
+	resp := &super.ListFollowRequestsResp{Requests: make([]*super.FollowRequestView, 0, len(reqs)), Total: total}
+	for _, r := range reqs {
+		u, ok := userMap[r.FollowerID]
+		if !ok {
+			continue
+		}
+		resp.Requests = append(resp.Requests, &super.FollowRequestView{
+			Id:        strconv.FormatUint(uint64(r.ID), 10),
+			User:      modelUserToProto(u),
+			CreatedAt: r.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return resp, nil
+}
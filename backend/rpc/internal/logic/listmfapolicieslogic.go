@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"context"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListMfaPoliciesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListMfaPoliciesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListMfaPoliciesLogic {
+	return &ListMfaPoliciesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 列出每个角色的两步验证要求；没有记录的角色视为不要求
+func (l *ListMfaPoliciesLogic) ListMfaPolicies(in *super.ListMfaPoliciesReq) (*super.ListMfaPoliciesResp, error) {
+	if _, err := requirePermission(l.ctx, l.svcCtx, in.ActorUserId, utils.PermSecurityPolicy); err != nil {
+		return nil, err
+	}
+	var rows []model.MFAPolicy
+	if err := l.svcCtx.DB.WithContext(l.ctx).Find(&rows).Error; err != nil {
+		l.Error("查询两步验证策略失败:", err)
+		return nil, errorx.Internal("查询两步验证策略失败")
+	}
+	byRole := make(map[string]*model.MFAPolicy, len(rows))
+	for i := range rows {
+		byRole[rows[i].Role] = &rows[i]
+	}
+
+	out := make([]*super.MfaPolicy, 0, 3)
+	for _, role := range []string{utils.RoleUser, utils.RoleAdmin, utils.RoleSuperAdmin} {
+		p, ok := byRole[role]
+		if !ok {
+			p = &model.MFAPolicy{Role: role}
+		}
+		out = append(out, modelMFAPolicyToProto(p))
+	}
+	return &super.ListMfaPoliciesResp{Policies: out}, nil
+}
@@ -0,0 +1,70 @@
+package logic
+
+import (
+	"context"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListNotificationCampaignsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListNotificationCampaignsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListNotificationCampaignsLogic {
+	return &ListNotificationCampaignsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListNotificationCampaignsLogic) ListNotificationCampaigns(in *super.ListNotificationCampaignsReq) (*super.ListNotificationCampaignsResp, error) {
+	if _, err := requirePermission(l.ctx, l.svcCtx, in.ActorUserId, utils.PermNotificationSend); err != nil {
+		return nil, err
+	}
+
+	page := int(in.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(in.PageSize)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	db := l.svcCtx.DB.WithContext(l.ctx).Model(&model.NotificationCampaign{})
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		l.Error("查询推送活动总数失败:", err)
+		return nil, errorx.Internal("查询推送活动失败")
+	}
+	var campaigns []model.NotificationCampaign
+	if err := db.Order("id desc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&campaigns).Error; err != nil {
+		l.Error("查询推送活动列表失败:", err)
+		return nil, errorx.Internal("查询推送活动失败")
+	}
+
+	ids := make([]uint, 0, len(campaigns))
+	for _, c := range campaigns {
+		ids = append(ids, c.ID)
+	}
+	reads, err := campaignReadCounts(l.svcCtx.DB.WithContext(l.ctx), ids)
+	if err != nil {
+		l.Error("查询推送活动统计失败:", err)
+		return nil, errorx.Internal("查询推送活动失败")
+	}
+
+	out := make([]*super.NotificationCampaign, 0, len(campaigns))
+	for i := range campaigns {
+		out = append(out, campaignToRpc(&campaigns[i], reads[campaigns[i].ID]))
+	}
+	return &super.ListNotificationCampaignsResp{Campaigns: out, Total: int32(total)}, nil
+}
@@ -0,0 +1,33 @@
+package logic
+
+import (
+	"context"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListOidcProvidersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListOidcProvidersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListOidcProvidersLogic {
+	return &ListOidcProvidersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListOidcProviders 已配置的第三方登录方式，按配置顺序返回
+func (l *ListOidcProvidersLogic) ListOidcProviders(in *super.ListOidcProvidersReq) (*super.ListOidcProvidersResp, error) {
+	resp := &super.ListOidcProvidersResp{}
+	for _, p := range l.svcCtx.Oidc.List() {
+		resp.Providers = append(resp.Providers, &super.OidcProvider{Name: p.Name(), DisplayName: p.DisplayName()})
+	}
+	return resp, nil
+}
@@ -0,0 +1,28 @@
+package logic
+
+import (
+	"context"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListPendingEncryptedMessagesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListPendingEncryptedMessagesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListPendingEncryptedMessagesLogic {
+	return &ListPendingEncryptedMessagesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListPendingEncryptedMessagesLogic) ListPendingEncryptedMessages(in *super.ListPendingEncryptedMessagesReq) (*super.ListPendingEncryptedMessagesResp, error) {
+	return NewE2eeLogic(l.ctx, l.svcCtx).ListPendingEncryptedMessages(in)
+}
@@ -0,0 +1,78 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListRoleAuditLogsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListRoleAuditLogsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListRoleAuditLogsLogic {
+	return &ListRoleAuditLogsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListRoleAuditLogsLogic) ListRoleAuditLogs(in *super.ListRoleAuditLogsReq) (*super.ListRoleAuditLogsResp, error) {
+	if _, err := requirePermission(l.ctx, l.svcCtx, in.ActorUserId, utils.PermAuditRead); err != nil {
+		return nil, err
+	}
+
+	page := int(in.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(in.PageSize)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	db := l.svcCtx.DB.WithContext(l.ctx).Model(&model.RoleAuditLog{})
+	if in.UserId != "" {
+		userID, err := strconv.ParseUint(in.UserId, 10, 32)
+		if err != nil {
+			return nil, errorx.InvalidArgument("无效的用户ID")
+		}
+		db = db.Where("user_id = ?", userID)
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		l.Error("查询角色审计记录总数失败:", err)
+		return nil, errorx.Internal("查询审计记录失败")
+	}
+	var logs []model.RoleAuditLog
+	if err := db.Order("id desc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&logs).Error; err != nil {
+		l.Error("查询角色审计记录失败:", err)
+		return nil, errorx.Internal("查询审计记录失败")
+	}
+
+	out := make([]*super.RoleAuditLog, 0, len(logs))
+	for _, g := range logs {
+		out = append(out, &super.RoleAuditLog{
+			Id:          strconv.FormatUint(uint64(g.ID), 10),
+			UserId:      strconv.FormatUint(uint64(g.UserID), 10),
+			ActorUserId: strconv.FormatUint(uint64(g.ActorUserID), 10),
+			Action:      g.Action,
+			FromRole:    g.FromRole,
+			ToRole:      g.ToRole,
+			Reason:      g.Reason,
+			ClientIp:    g.ClientIP,
+			CreatedAt:   g.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return &super.ListRoleAuditLogsResp{Logs: out, Total: total}, nil
+}
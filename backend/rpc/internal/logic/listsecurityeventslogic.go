@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListSecurityEventsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListSecurityEventsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListSecurityEventsLogic {
+	return &ListSecurityEventsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListSecurityEventsLogic) ListSecurityEvents(in *super.ListSecurityEventsReq) (*super.ListSecurityEventsResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+	page := int(in.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(in.PageSize)
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	db := l.svcCtx.DB.WithContext(l.ctx).Model(&model.SecurityEvent{}).Where("user_id = ?", userID)
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		l.Error("查询安全事件总数失败:", err)
+		return nil, errorx.Internal("查询安全事件失败")
+	}
+	var events []model.SecurityEvent
+	if err := db.Order("id desc").Offset((page - 1) * pageSize).Limit(pageSize).Find(&events).Error; err != nil {
+		l.Error("查询安全事件失败:", err)
+		return nil, errorx.Internal("查询安全事件失败")
+	}
+
+	out := make([]*super.SecurityEvent, 0, len(events))
+	for i := range events {
+		out = append(out, modelSecurityEventToProto(&events[i]))
+	}
+	return &super.ListSecurityEventsResp{Events: out, Total: total}, nil
+}
@@ -0,0 +1,93 @@
+package logic
+
+import (
+	"context"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListSuspensionAppealsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListSuspensionAppealsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListSuspensionAppealsLogic {
+	return &ListSuspensionAppealsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *ListSuspensionAppealsLogic) ListSuspensionAppeals(in *super.ListSuspensionAppealsReq) (*super.ListSuspensionAppealsResp, error) {
+	if _, err := requirePermission(l.ctx, l.svcCtx, in.ActorUserId, utils.PermUserModerate); err != nil {
+		return nil, err
+	}
+	switch in.Status {
+	case "", model.AppealStatusPending, model.AppealStatusAccepted, model.AppealStatusRejected:
+	default:
+		return nil, errorx.InvalidArgument("状态必须是 pending、accepted 或 rejected")
+	}
+	page, pageSize := in.Page, in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	q := db.Model(&model.SuspensionAppeal{})
+	if in.Status != "" {
+		q = q.Where("status = ?", in.Status)
+	}
+	var total int64
+	if err := q.Count(&total).Error; err != nil {
+		l.Errorf("[处罚] 查询申诉失败 错误=%v", err)
+		return nil, errorx.Internal("加载失败")
+	}
+	var appeals []model.SuspensionAppeal
+	if err := q.Order("id desc").Offset(int((page - 1) * pageSize)).Limit(int(pageSize)).Find(&appeals).Error; err != nil {
+		l.Errorf("[处罚] 查询申诉失败 错误=%v", err)
+		return nil, errorx.Internal("加载失败")
+	}
+
+	userIDs := make([]uint, 0, len(appeals))
+	suspensionIDs := make([]uint, 0, len(appeals))
+	for _, a := range appeals {
+		userIDs = append(userIDs, a.UserID)
+		suspensionIDs = append(suspensionIDs, a.SuspensionID)
+	}
+	users := make(map[uint]*model.User, len(userIDs))
+	suspensions := make(map[uint]*model.UserSuspension, len(suspensionIDs))
+	if len(appeals) > 0 {
+		var us []model.User
+		if err := db.Where("id IN ?", userIDs).Find(&us).Error; err != nil {
+			return nil, errorx.Internal("加载失败")
+		}
+		for i := range us {
+			users[us[i].ID] = &us[i]
+		}
+		var ss []model.UserSuspension
+		if err := db.Where("id IN ?", suspensionIDs).Find(&ss).Error; err != nil {
+			return nil, errorx.Internal("加载失败")
+		}
+		for i := range ss {
+			suspensions[ss[i].ID] = &ss[i]
+		}
+	}
+
+	resp := &super.ListSuspensionAppealsResp{Appeals: make([]*super.SuspensionAppeal, 0, len(appeals)), Total: total}
+	for i := range appeals {
+		a := &appeals[i]
+		resp.Appeals = append(resp.Appeals, modelAppealToProto(a, users[a.UserID], suspensions[a.SuspensionID]))
+	}
+	return resp, nil
+}
@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type ListUserIdentitiesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListUserIdentitiesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListUserIdentitiesLogic {
+	return &ListUserIdentitiesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListUserIdentities 用户绑定的第三方账号，以及是否设置了密码（决定能否解绑最后一个）
+func (l *ListUserIdentitiesLogic) ListUserIdentities(in *super.ListUserIdentitiesReq) (*super.ListUserIdentitiesResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil || userID == 0 {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+	db := l.svcCtx.DB
+	var user model.User
+	if err := db.Select("id", "password").First(&user, userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("用户不存在")
+		}
+		l.Errorf("[第三方登录] 查询用户失败 用户ID=%d 错误=%v", userID, err)
+		return nil, errorx.Internal("查询绑定账号失败")
+	}
+	var list []model.UserIdentity
+	if err := db.Where("user_id = ?", userID).Order("id").Find(&list).Error; err != nil {
+		l.Errorf("[第三方登录] 查询绑定账号失败 用户ID=%d 错误=%v", userID, err)
+		return nil, errorx.Internal("查询绑定账号失败")
+	}
+	resp := &super.ListUserIdentitiesResp{HasPassword: user.Password != ""}
+	for i := range list {
+		resp.Identities = append(resp.Identities, modelIdentityToProto(&list[i]))
+	}
+	return resp, nil
+}
@@ -0,0 +1,50 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListUserSessionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListUserSessionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListUserSessionsLogic {
+	return &ListUserSessionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 当前有效（未撤销、未过期）的会话，最近使用的在前
+func (l *ListUserSessionsLogic) ListUserSessions(in *super.ListUserSessionsReq) (*super.ListUserSessionsResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+
+	var sessions []model.UserSession
+	if err := l.svcCtx.DB.WithContext(l.ctx).
+		Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, time.Now()).
+		Order("last_used_at DESC").Find(&sessions).Error; err != nil {
+		l.Errorf("查询登录会话失败 用户ID=%d: %v", userID, err)
+		return nil, errorx.Internal("获取登录设备失败")
+	}
+
+	out := make([]*super.UserSession, 0, len(sessions))
+	for i := range sessions {
+		out = append(out, modelSessionToProto(&sessions[i]))
+	}
+	return &super.ListUserSessionsResp{Sessions: out}, nil
+}
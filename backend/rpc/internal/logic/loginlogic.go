@@ -3,7 +3,10 @@ package logic
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"backend/model"
 	"backend/rpc/internal/errorx"
@@ -71,39 +74,225 @@ func (l *LoginLogic) Login(in *super.LoginReq) (*super.LoginResp, error) {
 
 	attempt := logutil.LoginAttemptTag(email, username)
 
-	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) || strings.Contains(err.Error(), "record not found") {
-			l.Infof("[认证] 登录失败：账号不存在（未注册） %s", attempt)
-			return nil, errorx.New(401, "账户未注册")
-		}
+	notFound := err != nil && (errors.Is(err, gorm.ErrRecordNotFound) || strings.Contains(err.Error(), "record not found"))
+	if err != nil && !notFound {
 		l.Errorf("[认证] 登录失败：查询用户时数据库异常 %s 错误=%v", attempt, err)
 		return nil, errorx.New(401, "用户名或密码错误")
 	}
 
-	if !user.CheckPassword(in.Password) {
-		l.Infof("[认证] 登录失败：密码不正确 用户ID=%d 用户名=%s Moe号=%s %s",
-			user.ID, user.Username, user.MoeNo, attempt)
-		return nil, errorx.New(401, "用户名或密码错误")
+	// 2. 防爆破：按账号与 IP 统计失败次数，锁定期间不校验密码，达到阈值后要求人机验证
+	now := time.Now()
+	ipFailures, err := ipLoginFailures(l.svcCtx.DB, in.ClientIp, now)
+	if err != nil {
+		l.Errorf("[认证] 查询 IP 登录失败次数异常 IP=%s 错误=%v", in.ClientIp, err)
+	}
+	var accountFailures loginFailures
+	if !notFound {
+		if accountFailures, err = accountLoginFailures(l.svcCtx.DB, user.ID, now); err != nil {
+			l.Errorf("[认证] 查询账号登录失败次数异常 用户ID=%d 错误=%v", user.ID, err)
+		}
+	}
+	guard := newLoginGuard(accountFailures, ipFailures, now)
+	if guard.retry > 0 {
+		l.Infof("[认证] 登录被拒绝：失败次数过多，锁定中 用户ID=%d IP=%s 账号失败=%d IP失败=%d 剩余=%s %s",
+			user.ID, in.ClientIp, accountFailures.count, ipFailures.count, guard.retry.Round(time.Second), attempt)
+		return nil, guard.lockedError()
+	}
+	// 人机验证在加锁前完成，避免持锁等待外部服务
+	captchaPassed := false
+	if guard.captcha && l.svcCtx.Captcha.Enabled() {
+		ok, err := l.svcCtx.Captcha.Verify(l.ctx, in.CaptchaToken, in.ClientIp)
+		if err != nil {
+			l.Errorf("[认证] 人机验证服务异常 IP=%s 错误=%v", in.ClientIp, err)
+			return nil, guard.withLoginInfo(errorx.Internal("人机验证暂不可用，请稍后重试"))
+		}
+		if !ok {
+			l.Infof("[认证] 登录失败：人机验证未通过 IP=%s %s", in.ClientIp, attempt)
+			return nil, guard.withLoginInfo(errorx.Unauthenticated("请完成人机验证"))
+		}
+		captchaPassed = true
+	}
+
+	if notFound {
+		l.Infof("[认证] 登录失败：账号不存在（未注册） %s", attempt)
+		guard = l.recordFailure(l.svcCtx.DB, 0, in, "账号不存在", accountFailures, ipFailures, now)
+		return nil, guard.withLoginInfo(errorx.New(401, "账户未注册"))
+	}
+
+	// 3. 校验密码：持有账号的登录行锁重新统计失败次数，并发的猜测逐个计数，不能同时越过锁定阈值。
+	// 登录失败同样提交事务，失败记录不能随回滚丢失
+	var loginErr error
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockLoginAccount(tx, user.ID); err != nil {
+			return err
+		}
+		var err error
+		now := time.Now()
+		if accountFailures, err = accountLoginFailures(tx, user.ID, now); err != nil {
+			return err
+		}
+		if ipFailures, err = ipLoginFailures(tx, in.ClientIp, now); err != nil {
+			return err
+		}
+		guard := newLoginGuard(accountFailures, ipFailures, now)
+		switch {
+		case guard.retry > 0:
+			l.Infof("[认证] 登录被拒绝：失败次数过多，锁定中 用户ID=%d IP=%s 账号失败=%d IP失败=%d 剩余=%s %s",
+				user.ID, in.ClientIp, accountFailures.count, ipFailures.count, guard.retry.Round(time.Second), attempt)
+			loginErr = guard.lockedError()
+		case guard.captcha && l.svcCtx.Captcha.Enabled() && !captchaPassed:
+			// 等锁期间其他尝试的失败使本次达到了人机验证阈值
+			l.Infof("[认证] 登录失败：需要人机验证 IP=%s %s", in.ClientIp, attempt)
+			loginErr = guard.withLoginInfo(errorx.Unauthenticated("请完成人机验证"))
+		case !user.CheckPassword(in.Password):
+			l.Infof("[认证] 登录失败：密码不正确 用户ID=%d 用户名=%s Moe号=%s %s",
+				user.ID, user.Username, user.MoeNo, attempt)
+			guard = l.recordFailure(tx, user.ID, in, "密码不正确", accountFailures, ipFailures, now)
+			loginErr = guard.withLoginInfo(errorx.New(401, "用户名或密码错误"))
+		}
+		return nil
+	})
+	if err != nil {
+		l.Errorf("[认证] 登录失败：校验登录失败次数时数据库异常 用户ID=%d 错误=%v", user.ID, err)
+		return nil, errorx.New(500, "登录失败，请稍后重试")
+	}
+	if loginErr != nil {
+		return nil, loginErr
 	}
 
+	return l.continueLogin(&user, in, attempt)
+}
+
+// continueLogin 第一步身份验证（密码或第三方登录）通过后：已开启两步验证时返回一次性验证令牌，否则直接完成登录
+func (l *LoginLogic) continueLogin(user *model.User, in *super.LoginReq, attempt string) (*super.LoginResp, error) {
 	if _, err := utils.EnsureUserMoeNo(l.svcCtx.DB, user.ID); err != nil {
 		l.Errorf("[认证] 登录过程异常：补全 Moe 号失败 用户ID=%d 错误=%v", user.ID, err)
 	}
 
-	// 3. 生成JWT令牌
-	token, err := utils.GenerateToken(user.ID, user.Username)
+	// 已开启两步验证：不签发令牌，返回一次性验证令牌，由 VerifyLoginMfa 完成登录
+	totp, err := enabledTOTP(l.svcCtx.DB, user.ID)
+	if err != nil {
+		l.Errorf("[认证] 登录失败：查询两步验证状态失败 用户ID=%d 错误=%v", user.ID, err)
+		return nil, errorx.New(500, "登录失败，请稍后重试")
+	}
+	if totp != nil {
+		mfaToken, err := newMFAChallenge(l.svcCtx.DB, user.ID, in.ClientIp, in.UserAgent)
+		if err != nil {
+			l.Errorf("[认证] 登录失败：创建两步验证失败 用户ID=%d 错误=%v", user.ID, err)
+			return nil, errorx.New(500, "登录失败，请稍后重试")
+		}
+		l.Infof("[认证] 身份验证通过，等待两步验证 用户ID=%d %s", user.ID, attempt)
+		return &super.LoginResp{
+			MfaRequired:  true,
+			MfaToken:     mfaToken,
+			MfaExpiresIn: int64(mfaChallengeTTL / time.Second),
+		}, nil
+	}
+
+	return l.finishLogin(user, in, attempt)
+}
+
+// finishLogin 身份验证全部通过后创建登录会话并签发令牌：短期访问令牌（带 sid）+ 可轮换的刷新令牌
+func (l *LoginLogic) finishLogin(user *model.User, in *super.LoginReq, attempt string) (*super.LoginResp, error) {
+	// 被封禁的账号在身份验证通过后拒绝登录，并下发申诉令牌；被限制的账号可以正常登录
+	suspension, err := activeSuspension(l.svcCtx.DB, user.ID)
+	if err != nil {
+		l.Errorf("[认证] 登录失败：查询处罚失败 用户ID=%d 错误=%v", user.ID, err)
+		return nil, errorx.New(500, "登录失败，请稍后重试")
+	}
+	if suspension != nil && suspension.Kind == model.SuspensionKindSuspend {
+		l.Infof("[认证] 登录被拒绝：账号已被封禁 用户ID=%d 处罚ID=%d %s", user.ID, suspension.ID, attempt)
+		return nil, suspendedLoginError(l.svcCtx.DB, suspension)
+	}
+
+	if err := purgeEndedSessions(l.svcCtx.DB, user.ID); err != nil {
+		l.Errorf("[认证] 清理过期会话失败 用户ID=%d 错误=%v", user.ID, err)
+	}
+	session, refresh, err := startSession(l.svcCtx.DB, user.ID, in.ClientIp, in.UserAgent)
+	if err != nil {
+		l.Errorf("[认证] 登录失败：创建会话失败 用户ID=%d 错误=%v", user.ID, err)
+		return nil, errorx.New(500, "登录失败，请稍后重试")
+	}
+	token, err := utils.GenerateToken(user.ID, user.Username, user.Role, user.TokenVersion, session.ID)
 	if err != nil {
 		l.Errorf("[认证] 登录失败：生成登录令牌失败 用户ID=%d 错误=%v", user.ID, err)
 		return nil, errorx.New(500, "登录失败，请稍后重试")
 	}
 
-	_ = l.svcCtx.DB.First(&user, user.ID).Error
+	// 记录活跃时间（推送活动按此筛选不活跃用户）；UpdateColumn 不触发密码哈希钩子
+	if err := l.svcCtx.DB.Model(&model.User{}).Where("id = ?", user.ID).UpdateColumn("last_active_at", time.Now()).Error; err != nil {
+		l.Errorf("[认证] 更新活跃时间失败 用户ID=%d 错误=%v", user.ID, err)
+	}
+
+	_ = l.svcCtx.DB.First(user, user.ID).Error
+
+	l.recordSuccess(user.ID, in)
 
 	l.Infof("[认证] 登录成功 用户ID=%d 用户名=%s Moe号=%s 邮箱=%s %s",
 		user.ID, user.Username, user.MoeNo, logutil.MaskEmail(user.Email), attempt)
 
-	return &super.LoginResp{
-		User:  modelUserToProto(&user),
-		Token: token,
-	}, nil
+	resp := &super.LoginResp{
+		User:         modelUserToProto(user),
+		Token:        token,
+		RefreshToken: refresh,
+		SessionId:    strconv.FormatUint(uint64(session.ID), 10),
+		ExpiresIn:    accessTokenExpiresIn(),
+	}
+	// 角色要求两步验证但尚未开启：允许登录，提示客户端引导绑定（绑定前无法使用需要权限的接口）
+	if required, err := mfaRequiredForRole(l.svcCtx.DB, user.Role); err != nil {
+		l.Errorf("[认证] 查询两步验证策略失败 角色=%s 错误=%v", user.Role, err)
+	} else if required {
+		enabled, err := enabledTOTP(l.svcCtx.DB, user.ID)
+		resp.MfaSetupRequired = err == nil && enabled == nil
+	}
+	// 宽限期内仍可登录，客户端据此提示撤销注销
+	if resp.DeletionScheduledAt, err = deletionScheduledAt(l.svcCtx.DB, user.ID); err != nil {
+		l.Errorf("[认证] 查询注销申请失败 用户ID=%d 错误=%v", user.ID, err)
+	}
+	return resp, nil
+}
+
+// recordFailure 记录一次登录失败，返回计入本次失败后的限制状态；本次失败触发锁定时另记一条 login_locked。
+// 账号存在时 db 为持有登录行锁的事务
+func (l *LoginLogic) recordFailure(db *gorm.DB, userID uint, in *super.LoginReq, detail string, account, ip loginFailures, now time.Time) loginGuard {
+	if err := recordSecurityEvent(db, userID, model.SecurityEventLoginFailure, in.ClientIp, in.UserAgent, detail); err != nil {
+		l.Errorf("[认证] 记录登录失败事件异常 用户ID=%d 错误=%v", userID, err)
+		return newLoginGuard(account, ip, now)
+	}
+	if userID != 0 {
+		account.count, account.last = account.count+1, now
+	}
+	if in.ClientIp != "" {
+		ip.count, ip.last = ip.count+1, now
+	}
+	guard := newLoginGuard(account, ip, now)
+	if guard.retry > 0 {
+		l.Infof("[认证] 登录失败次数过多，开始锁定 用户ID=%d IP=%s 账号失败=%d IP失败=%d 时长=%s",
+			userID, in.ClientIp, account.count, ip.count, guard.retry.Round(time.Second))
+		if userID != 0 {
+			detail := fmt.Sprintf("连续失败 %d 次，锁定 %d 分钟", account.count, int((guard.retry+time.Minute-1)/time.Minute))
+			if err := recordSecurityEvent(db, userID, model.SecurityEventLoginLocked, in.ClientIp, in.UserAgent, detail); err != nil {
+				l.Errorf("[认证] 记录锁定事件异常 用户ID=%d 错误=%v", userID, err)
+			}
+		}
+	}
+	return guard
+}
+
+// recordSuccess 记录登录成功；用户首次在该设备上登录时另记一条 new_device
+func (l *LoginLogic) recordSuccess(userID uint, in *super.LoginReq) {
+	db := l.svcCtx.DB
+	if err := purgeSecurityEvents(db, userID); err != nil {
+		l.Errorf("[认证] 清理过期安全事件失败 用户ID=%d 错误=%v", userID, err)
+	}
+	if newDevice, err := isNewDevice(db, userID, in.UserAgent); err != nil {
+		l.Errorf("[认证] 判断新设备失败 用户ID=%d 错误=%v", userID, err)
+	} else if newDevice {
+		if err := recordSecurityEvent(db, userID, model.SecurityEventNewDevice, in.ClientIp, in.UserAgent, ""); err != nil {
+			l.Errorf("[认证] 记录新设备事件失败 用户ID=%d 错误=%v", userID, err)
+		}
+	}
+	if err := recordSecurityEvent(db, userID, model.SecurityEventLoginSuccess, in.ClientIp, in.UserAgent, ""); err != nil {
+		l.Errorf("[认证] 记录登录成功事件失败 用户ID=%d 错误=%v", userID, err)
+	}
 }
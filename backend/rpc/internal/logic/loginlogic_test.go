@@ -0,0 +1,96 @@
+package logic
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"backend/model"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/pb/super"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newLoginTestContext(t *testing.T) (*svc.ServiceContext, *model.User) {
+	t.Helper()
+	db := testdb.New(t, &model.User{}, &model.SecurityEvent{}, &model.LoginLock{})
+	user := &model.User{Username: "alice", Email: "alice@example.com", Password: "correct-horse"}
+	if err := db.Create(user).Error; err != nil {
+		t.Fatal(err)
+	}
+	return &svc.ServiceContext{DB: db}, user
+}
+
+func countFailures(t *testing.T, svcCtx *svc.ServiceContext, userID uint) int64 {
+	t.Helper()
+	var n int64
+	if err := svcCtx.DB.Model(&model.SecurityEvent{}).
+		Where("user_id = ? AND kind = ?", userID, model.SecurityEventLoginFailure).
+		Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func login(svcCtx *svc.ServiceContext, password string) error {
+	_, err := NewLoginLogic(context.Background(), svcCtx).Login(&super.LoginReq{
+		Username: "alice",
+		Password: password,
+		ClientIp: "198.51.100.7",
+	})
+	return err
+}
+
+func TestLoginLocksAfterRepeatedFailures(t *testing.T) {
+	svcCtx, user := newLoginTestContext(t)
+	for i := 0; i < loginAccountLockAfter; i++ {
+		if err := login(svcCtx, "wrong"); status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("第 %d 次: err = %v, want Unauthenticated", i+1, err)
+		}
+	}
+	// 锁定期间密码正确也拒绝，且不再计数
+	if err := login(svcCtx, "correct-horse"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("err = %v, want ResourceExhausted", err)
+	}
+	if n := countFailures(t, svcCtx, user.ID); n != loginAccountLockAfter {
+		t.Fatalf("failures = %d, want %d", n, loginAccountLockAfter)
+	}
+}
+
+// 并发的猜测在账号行锁上排队，校验密码的次数不能超过锁定阈值
+func TestLoginConcurrentGuessesCannotExceedLockout(t *testing.T) {
+	svcCtx, user := newLoginTestContext(t)
+	const attempts = 12
+	var wg sync.WaitGroup
+	codesSeen := make(chan codes.Code, attempts)
+	for i := 0; i < attempts; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			codesSeen <- status.Code(login(svcCtx, "wrong"))
+		}()
+	}
+	wg.Wait()
+	close(codesSeen)
+
+	var wrong, locked int
+	for c := range codesSeen {
+		switch c {
+		case codes.Unauthenticated:
+			wrong++
+		case codes.ResourceExhausted:
+			locked++
+		default:
+			t.Errorf("unexpected code %v", c)
+		}
+	}
+	if wrong != loginAccountLockAfter || locked != attempts-loginAccountLockAfter {
+		t.Fatalf("wrong=%d locked=%d, want %d and %d", wrong, locked, loginAccountLockAfter, attempts-loginAccountLockAfter)
+	}
+	if n := countFailures(t, svcCtx, user.ID); n != loginAccountLockAfter {
+		t.Fatalf("failures = %d, want %d", n, loginAccountLockAfter)
+	}
+}
@@ -0,0 +1,162 @@
+package logic
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/pb/super"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// 密码验证通过后，第二步验证的有效期与最多输错次数
+	mfaChallengeTTL         = 5 * time.Minute
+	mfaChallengeMaxAttempts = 5
+	// 每次生成的恢复码个数
+	mfaRecoveryCodeCount = 10
+)
+
+// 第二步验证方式
+const (
+	mfaMethodTOTP     = "totp"
+	mfaMethodRecovery = "recovery_code"
+)
+
+var recoveryCodeEncoding = base32.NewEncoding("abcdefghijkmnpqrstuvwxyz23456789").WithPadding(base32.NoPadding)
+
+// newRecoveryCodes 生成一组恢复码（xxxxx-xxxxx，50 位随机数），明文只返回给用户这一次
+func newRecoveryCodes() ([]string, error) {
+	codes := make([]string, 0, mfaRecoveryCodeCount)
+	for i := 0; i < mfaRecoveryCodeCount; i++ {
+		buf := make([]byte, 7)
+		if _, err := rand.Read(buf); err != nil {
+			return nil, err
+		}
+		s := recoveryCodeEncoding.EncodeToString(buf)[:10]
+		codes = append(codes, s[:5]+"-"+s[5:])
+	}
+	return codes, nil
+}
+
+// normalizeRecoveryCode 忽略大小写、空格与连字符
+func normalizeRecoveryCode(code string) string {
+	return strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(code)))
+}
+
+// replaceRecoveryCodes 作废用户原有的恢复码并生成新的一组
+func replaceRecoveryCodes(tx *gorm.DB, userID uint) ([]string, error) {
+	codes, err := newRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	tx = quietSession(tx)
+	if err := tx.Where("user_id = ?", userID).Delete(&model.MFARecoveryCode{}).Error; err != nil {
+		return nil, err
+	}
+	rows := make([]model.MFARecoveryCode, 0, len(codes))
+	for _, c := range codes {
+		rows = append(rows, model.MFARecoveryCode{UserID: userID, CodeHash: sha256Hex(normalizeRecoveryCode(c))})
+	}
+	if err := tx.Create(&rows).Error; err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// remainingRecoveryCodes 未使用的恢复码个数
+func remainingRecoveryCodes(db *gorm.DB, userID uint) (int64, error) {
+	var n int64
+	err := db.Model(&model.MFARecoveryCode{}).Where("user_id = ? AND used_at IS NULL", userID).Count(&n).Error
+	return n, err
+}
+
+// enabledTOTP 用户已启用的 TOTP 密钥；未开启时返回 nil
+func enabledTOTP(db *gorm.DB, userID uint) (*model.UserTOTP, error) {
+	var t model.UserTOTP
+	err := quietSession(db).Where("user_id = ? AND enabled_at IS NOT NULL", userID).First(&t).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// verifySecondFactor 校验 6 位 TOTP 验证码或恢复码，通过后验证码不能再次使用、恢复码作废。
+// 返回使用的验证方式，未通过时为空
+func verifySecondFactor(tx *gorm.DB, t *model.UserTOTP, code string) (string, error) {
+	tx = quietSession(tx)
+	code = strings.TrimSpace(code)
+	if len(code) == totpDigits {
+		step, ok := verifyTOTP(t.Secret, code, time.Now(), t.LastUsedStep)
+		if !ok {
+			return "", nil
+		}
+		// 并发提交同一个验证码时只有一个能更新成功
+		res := tx.Model(&model.UserTOTP{}).Where("id = ? AND last_used_step < ?", t.ID, step).
+			Update("last_used_step", step)
+		if res.Error != nil || res.RowsAffected == 0 {
+			return "", res.Error
+		}
+		t.LastUsedStep = step
+		return mfaMethodTOTP, nil
+	}
+
+	hash := sha256Hex(normalizeRecoveryCode(code))
+	res := tx.Model(&model.MFARecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", t.UserID, hash).
+		Limit(1).Update("used_at", time.Now())
+	if res.Error != nil || res.RowsAffected == 0 {
+		return "", res.Error
+	}
+	return mfaMethodRecovery, nil
+}
+
+// mfaRequiredForRole 该角色是否被要求开启两步验证
+func mfaRequiredForRole(db *gorm.DB, role string) (bool, error) {
+	var p model.MFAPolicy
+	err := db.Where("role = ?", role).Limit(1).Find(&p).Error
+	return p.Required, err
+}
+
+// newMFAChallenge 密码验证通过后创建第二步验证，返回一次性令牌
+func newMFAChallenge(db *gorm.DB, userID uint, clientIP, userAgent string) (string, error) {
+	token, err := newRefreshToken()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now()
+	// 顺带清理该用户已过期的记录
+	if err := db.Where("user_id = ? AND expires_at < ?", userID, now).Delete(&model.MFAChallenge{}).Error; err != nil {
+		return "", err
+	}
+	err = db.Create(&model.MFAChallenge{
+		UserID:    userID,
+		TokenHash: sha256Hex(token),
+		ClientIP:  clientIP,
+		UserAgent: truncateUserAgent(userAgent),
+		ExpiresAt: now.Add(mfaChallengeTTL),
+	}).Error
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func modelMFAPolicyToProto(p *model.MFAPolicy) *super.MfaPolicy {
+	out := &super.MfaPolicy{Role: p.Role, Required: p.Required}
+	if p.UpdatedBy != 0 {
+		out.UpdatedBy = strconv.FormatUint(uint64(p.UpdatedBy), 10)
+	}
+	if !p.UpdatedAt.IsZero() {
+		out.UpdatedAt = p.UpdatedAt.Format("2006-01-02 15:04:05")
+	}
+	return out
+}
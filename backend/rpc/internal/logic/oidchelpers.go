@@ -0,0 +1,148 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/oidc"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// 发起授权到提交回调的最长时间
+	oidcStateTTL = 10 * time.Minute
+	// 访问提供方（换取令牌、拉取公钥）的超时
+	oidcExchangeTimeout = 15 * time.Second
+	// 自动注册时由第三方昵称生成的用户名最长字符数（不含去重后缀）
+	oidcUsernameMaxLen = 20
+)
+
+// oidcProvider 按标识取已配置的提供方
+func oidcProvider(svcCtx *svc.ServiceContext, name string) (*oidc.Provider, error) {
+	p := svcCtx.Oidc.Get(strings.TrimSpace(name))
+	if p == nil {
+		return nil, errorx.NotFound("不支持该登录方式")
+	}
+	return p, nil
+}
+
+// consumeOidcAuthRequest 取出并删除发起授权时保存的请求；state 只能使用一次，提供方与用途必须一致
+func consumeOidcAuthRequest(db *gorm.DB, provider, state, purpose string) (*model.OidcAuthRequest, error) {
+	invalid := errorx.InvalidArgument("授权已失效，请重新发起")
+	if strings.TrimSpace(state) == "" {
+		return nil, invalid
+	}
+	var req model.OidcAuthRequest
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("state_hash = ?", sha256Hex(state)).First(&req).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return invalid
+		}
+		if err != nil {
+			return err
+		}
+		return tx.Delete(&req).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	if req.Provider != provider || req.Purpose != purpose || time.Now().After(req.ExpiresAt) {
+		return nil, invalid
+	}
+	return &req, nil
+}
+
+// exchangeOidcCode 用授权码换取第三方用户信息；授权码或令牌被拒时提示重新授权，提供方故障时提示稍后重试
+func exchangeOidcCode(ctx context.Context, p *oidc.Provider, req *model.OidcAuthRequest, code string) (*oidc.Claims, error) {
+	if strings.TrimSpace(code) == "" {
+		return nil, errorx.InvalidArgument("缺少授权码")
+	}
+	ctx, cancel := context.WithTimeout(ctx, oidcExchangeTimeout)
+	defer cancel()
+	claims, err := p.Exchange(ctx, code, req.CodeVerifier, req.Nonce)
+	if errors.Is(err, oidc.ErrRejected) {
+		return nil, errorx.Unauthenticated("第三方授权无效或已过期，请重新授权")
+	}
+	if err != nil {
+		return nil, errorx.Internal("第三方登录暂不可用，请稍后重试")
+	}
+	return claims, nil
+}
+
+// oidcDisplayName 第三方账号的展示名：昵称、用户名、邮箱依次取第一个非空的
+func oidcDisplayName(c *oidc.Claims) string {
+	for _, s := range []string{c.Name, c.PreferredUsername, c.Email} {
+		if s = strings.TrimSpace(s); s != "" {
+			return truncateRunes(s, 100)
+		}
+	}
+	return ""
+}
+
+// oidcUsername 自动注册时的用户名：由第三方昵称或邮箱前缀生成，只保留字母、数字、下划线与连字符，
+// 重名时追加随机数字
+func oidcUsername(db *gorm.DB, c *oidc.Claims) (string, error) {
+	base := ""
+	for _, s := range []string{c.PreferredUsername, c.Name, strings.SplitN(c.Email, "@", 2)[0]} {
+		base = strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' {
+				return r
+			}
+			return -1
+		}, s)
+		if base != "" {
+			break
+		}
+	}
+	if base == "" {
+		base = "moe"
+	}
+	base = truncateRunes(base, oidcUsernameMaxLen)
+	candidate := base
+	for i := 0; i < 10; i++ {
+		var n int64
+		if err := db.Unscoped().Model(&model.User{}).Where("username = ?", candidate).Count(&n).Error; err != nil {
+			return "", err
+		}
+		if n == 0 {
+			return candidate, nil
+		}
+		suffix, err := utils.RandomMoeNo()
+		if err != nil {
+			return "", err
+		}
+		candidate = base + "_" + suffix[:6]
+	}
+	return "", errors.New("could not pick a unique username")
+}
+
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
+
+func modelIdentityToProto(i *model.UserIdentity) *super.UserIdentity {
+	return &super.UserIdentity{
+		Id:          strconv.FormatUint(uint64(i.ID), 10),
+		Provider:    i.Provider,
+		Email:       i.Email,
+		DisplayName: i.DisplayName,
+		CreatedAt:   i.CreatedAt.Format("2006-01-02 15:04:05"),
+		LastLoginAt: formatOptionalTime(i.LastLoginAt),
+	}
+}
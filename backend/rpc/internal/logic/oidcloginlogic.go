@@ -0,0 +1,157 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/logutil"
+	"backend/rpc/internal/oidc"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type OidcLoginLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewOidcLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *OidcLoginLogic {
+	return &OidcLoginLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// OidcLogin 第三方登录：已绑定的第三方账号直接登录；未绑定时按提供方验证过的邮箱自动注册并分配 Moe 号。
+// 邮箱已被其他账号使用时不自动绑定（否则控制了第三方账号就能接管同邮箱的账号），需要用户登录后在设置中绑定
+func (l *OidcLoginLogic) OidcLogin(in *super.OidcLoginReq) (*super.LoginResp, error) {
+	p, err := oidcProvider(l.svcCtx, in.Provider)
+	if err != nil {
+		return nil, err
+	}
+	db := l.svcCtx.DB
+	req, err := consumeOidcAuthRequest(db, p.Name(), in.State, model.OidcPurposeLogin)
+	if err != nil {
+		l.Infof("[认证] 第三方登录失败：授权请求无效 提供方=%s 错误=%v", p.Name(), err)
+		return nil, err
+	}
+	claims, err := exchangeOidcCode(l.ctx, p, req, in.Code)
+	if err != nil {
+		l.Infof("[认证] 第三方登录失败：换取令牌失败 提供方=%s 错误=%v", p.Name(), err)
+		return nil, err
+	}
+	attempt := "方式=" + p.Name()
+
+	var user model.User
+	registered := false
+	var identity model.UserIdentity
+	err = db.Where("provider = ? AND subject = ?", p.Name(), claims.Subject).First(&identity).Error
+	switch {
+	case err == nil:
+		if err := db.First(&user, identity.UserID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errorx.Unauthenticated("账号不存在")
+			}
+			l.Errorf("[认证] 第三方登录失败：查询用户失败 用户ID=%d 错误=%v", identity.UserID, err)
+			return nil, errorx.Internal("登录失败，请稍后重试")
+		}
+		now := time.Now()
+		err = db.Model(&identity).Updates(map[string]interface{}{
+			"email":         claims.Email,
+			"display_name":  oidcDisplayName(claims),
+			"last_login_at": now,
+		}).Error
+		if err != nil {
+			l.Errorf("[认证] 更新第三方账号信息失败 用户ID=%d 提供方=%s 错误=%v", user.ID, p.Name(), err)
+		}
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		u, err := l.register(p.Name(), claims)
+		if err != nil {
+			return nil, err
+		}
+		user, registered = *u, true
+	default:
+		l.Errorf("[认证] 第三方登录失败：查询绑定关系失败 提供方=%s 错误=%v", p.Name(), err)
+		return nil, errorx.Internal("登录失败，请稍后重试")
+	}
+
+	resp, err := NewLoginLogic(l.ctx, l.svcCtx).continueLogin(&user, &super.LoginReq{
+		ClientIp:  in.ClientIp,
+		UserAgent: in.UserAgent,
+	}, attempt)
+	if err != nil {
+		return nil, err
+	}
+	resp.Registered = registered
+	return resp, nil
+}
+
+// register 用第三方账号自动注册：没有密码（之后可通过找回密码设置），邮箱视为已验证
+func (l *OidcLoginLogic) register(provider string, c *oidc.Claims) (*model.User, error) {
+	db := l.svcCtx.DB
+	email := normalizeEmail(c.Email)
+	if email == "" || !c.EmailVerified {
+		l.Infof("[认证] 第三方登录自动注册失败：没有已验证的邮箱 提供方=%s", provider)
+		return nil, errorx.InvalidArgument("该第三方账号没有已验证的邮箱，无法自动注册")
+	}
+	// 已注销（软删除）的账号仍占用邮箱与用户名的唯一索引，一并检查
+	var n int64
+	if err := db.Unscoped().Model(&model.User{}).Where("LOWER(TRIM(email)) = ?", email).Count(&n).Error; err != nil {
+		l.Errorf("[认证] 第三方登录自动注册失败：检查邮箱时数据库异常 错误=%v", err)
+		return nil, errorx.Internal("登录失败，请稍后重试")
+	}
+	if n > 0 {
+		l.Infof("[认证] 第三方登录自动注册失败：邮箱已被注册 提供方=%s 邮箱=%s", provider, logutil.MaskEmail(email))
+		return nil, errorx.AlreadyExists("该邮箱已注册，请先用密码登录，再在账号设置中绑定")
+	}
+	username, err := oidcUsername(db, c)
+	if err != nil {
+		l.Errorf("[认证] 第三方登录自动注册失败：生成用户名失败 错误=%v", err)
+		return nil, errorx.Internal("注册失败，请稍后重试")
+	}
+
+	now := time.Now()
+	user := model.User{
+		Username:        username,
+		Email:           email,
+		Avatar:          "https://picsum.photos/150", // 与注册一致的默认头像
+		EmailVerifiedAt: &now,
+	}
+	if c.Picture != "" {
+		user.Avatar = c.Picture
+	}
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&user).Error; err != nil {
+			return err
+		}
+		if _, err := utils.EnsureUserMoeNo(tx, user.ID); err != nil {
+			return err
+		}
+		return tx.Create(&model.UserIdentity{
+			UserID:      user.ID,
+			Provider:    provider,
+			Subject:     c.Subject,
+			Email:       email,
+			DisplayName: oidcDisplayName(c),
+			LastLoginAt: &now,
+		}).Error
+	})
+	if err != nil {
+		l.Errorf("[认证] 第三方登录自动注册失败：写入用户失败 提供方=%s 用户名=%s 错误=%v", provider, username, err)
+		return nil, errorx.Internal("注册失败，请稍后重试")
+	}
+	_ = db.First(&user, user.ID).Error
+
+	l.Infof("[认证] 第三方登录自动注册成功 用户ID=%d 用户名=%s Moe号=%s 提供方=%s 邮箱=%s",
+		user.ID, user.Username, user.MoeNo, provider, logutil.MaskEmail(user.Email))
+	return &user, nil
+}
@@ -0,0 +1,159 @@
+package logic
+
+import (
+	"context"
+	"testing"
+
+	"backend/model"
+	"backend/rpc/internal/oidc"
+	"backend/rpc/internal/oidc/oidctest"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newOidcTestContext(t *testing.T) (*svc.ServiceContext, *oidctest.Server) {
+	t.Helper()
+	ring, err := utils.NewKeyRing(utils.JWTConf{Keys: []utils.JWTKeyConf{{
+		Kid:    "test",
+		Alg:    utils.JWTAlgHS256,
+		Secret: "0123456789abcdef0123456789abcdef",
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	prev := utils.DefaultKeyRing()
+	utils.SetKeyRing(ring)
+	t.Cleanup(func() { utils.SetKeyRing(prev) })
+
+	db := testdb.New(t, &model.User{}, &model.UserIdentity{}, &model.OidcAuthRequest{},
+		&model.SecurityEvent{}, &model.UserSession{}, &model.RefreshToken{}, &model.UserTOTP{},
+		&model.MFAChallenge{}, &model.MFAPolicy{}, &model.UserSuspension{}, &model.AccountDeletion{})
+	srv := oidctest.NewServer(t)
+	return &svc.ServiceContext{
+		DB:   db,
+		Oidc: oidc.NewRegistry(oidc.Conf{Providers: []oidc.ProviderConf{srv.ProviderConf("test")}}),
+	}, srv
+}
+
+// oidcLogin 走完整的第三方登录：发起授权、在提供方同意、提交授权码
+func oidcLogin(t *testing.T, svcCtx *svc.ServiceContext, srv *oidctest.Server) (*super.LoginResp, error) {
+	t.Helper()
+	ctx := context.Background()
+	start, err := NewStartOidcAuthLogic(ctx, svcCtx).StartOidcAuth(&super.StartOidcAuthReq{
+		Provider: "test",
+		Purpose:  model.OidcPurposeLogin,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err := srv.Authorize(start.AuthorizationUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewOidcLoginLogic(ctx, svcCtx).OidcLogin(&super.OidcLoginReq{
+		Provider: "test",
+		State:    start.State,
+		Code:     code,
+		ClientIp: "198.51.100.7",
+	})
+}
+
+func countRows(t *testing.T, svcCtx *svc.ServiceContext, m interface{}) int64 {
+	t.Helper()
+	var n int64
+	if err := svcCtx.DB.Unscoped().Model(m).Count(&n).Error; err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestOidcLoginAutoRegisters(t *testing.T) {
+	svcCtx, srv := newOidcTestContext(t)
+	srv.SetUser(oidctest.User{Subject: "sub-1", Email: "Neko@Example.com", EmailVerified: true, Name: "猫 neko"})
+
+	first, err := oidcLogin(t, svcCtx, srv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !first.Registered || first.Token == "" || first.RefreshToken == "" {
+		t.Fatalf("首次登录: registered=%v token=%q refresh=%q", first.Registered, first.Token, first.RefreshToken)
+	}
+	var user model.User
+	if err := svcCtx.DB.First(&user).Error; err != nil {
+		t.Fatal(err)
+	}
+	if user.Email != "neko@example.com" || user.Username != "猫neko" || user.EmailVerifiedAt == nil || len(user.MoeNo) != 10 {
+		t.Fatalf("自动注册的用户 = %+v", user)
+	}
+	var identity model.UserIdentity
+	if err := svcCtx.DB.First(&identity).Error; err != nil {
+		t.Fatal(err)
+	}
+	if identity.UserID != user.ID || identity.Provider != "test" || identity.Subject != "sub-1" {
+		t.Fatalf("绑定关系 = %+v", identity)
+	}
+
+	// 再次登录直接进入同一个账号
+	second, err := oidcLogin(t, svcCtx, srv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Registered || second.User.Id != first.User.Id {
+		t.Fatalf("再次登录: registered=%v user=%s, want user %s", second.Registered, second.User.Id, first.User.Id)
+	}
+	if n := countRows(t, svcCtx, &model.User{}); n != 1 {
+		t.Fatalf("users = %d, want 1", n)
+	}
+}
+
+// 邮箱已被其他账号（包括已注销的）使用时不自动注册，也不自动绑定
+func TestOidcLoginRefusesRegisteredEmail(t *testing.T) {
+	tests := []struct {
+		name    string
+		deleted bool
+	}{
+		{"正常账号", false},
+		{"已注销账号", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svcCtx, srv := newOidcTestContext(t)
+			existing := &model.User{Username: "alice", Email: "alice@example.com", Password: "correct-horse"}
+			if err := svcCtx.DB.Create(existing).Error; err != nil {
+				t.Fatal(err)
+			}
+			if tt.deleted {
+				if err := svcCtx.DB.Delete(existing).Error; err != nil {
+					t.Fatal(err)
+				}
+			}
+			srv.SetUser(oidctest.User{Subject: "sub-1", Email: " Alice@Example.com", EmailVerified: true, Name: "Alice"})
+
+			if _, err := oidcLogin(t, svcCtx, srv); status.Code(err) != codes.AlreadyExists {
+				t.Fatalf("err = %v, want AlreadyExists", err)
+			}
+			if n := countRows(t, svcCtx, &model.UserIdentity{}); n != 0 {
+				t.Fatalf("identities = %d, want 0", n)
+			}
+			if n := countRows(t, svcCtx, &model.User{}); n != 1 {
+				t.Fatalf("users = %d, want 1", n)
+			}
+		})
+	}
+}
+
+func TestOidcLoginRequiresVerifiedEmail(t *testing.T) {
+	svcCtx, srv := newOidcTestContext(t)
+	srv.SetUser(oidctest.User{Subject: "sub-1", Email: "neko@example.com", EmailVerified: false})
+	if _, err := oidcLogin(t, svcCtx, srv); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("err = %v, want InvalidArgument", err)
+	}
+	if n := countRows(t, svcCtx, &model.User{}); n != 0 {
+		t.Fatalf("users = %d, want 0", n)
+	}
+}
@@ -0,0 +1,69 @@
+package logic
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// 邮件验证码 / 链接令牌的公共工具，重置密码与邮箱验证共用。
+// 库里只保存 sha256(盐+验证码) 与 sha256(链接令牌)，明文只出现在邮件中。
+
+// newOneTimeSecrets 生成 6 位验证码、验证码盐与链接令牌
+func newOneTimeSecrets() (code, salt, linkToken string, err error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", "", "", err
+	}
+	saltBytes := make([]byte, 16)
+	if _, err := rand.Read(saltBytes); err != nil {
+		return "", "", "", err
+	}
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return "", "", "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), hex.EncodeToString(saltBytes), base64.RawURLEncoding.EncodeToString(tokenBytes), nil
+}
+
+// quietSession 全局 gorm 日志为 Info 级会把 SQL 参数打进日志：盐加哈希足以穷举出 6 位验证码，TOTP 密钥更是明文。
+// 写入、核验这类凭证的语句走静默会话，错误仍由调用方记录
+func quietSession(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{Logger: logger.Default.LogMode(logger.Silent)})
+}
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// oneTimeCodeMatches 常量时间比较验证码哈希
+func oneTimeCodeMatches(salt, hash, code string) bool {
+	return subtle.ConstantTimeCompare([]byte(sha256Hex(salt+code)), []byte(hash)) == 1
+}
+
+// linkWithToken 邮件中的跳转链接；未配置页面地址时为空
+func linkWithToken(base, token string) string {
+	if base == "" {
+		return ""
+	}
+	sep := "?"
+	if strings.Contains(base, "?") {
+		sep = "&"
+	}
+	return base + sep + "token=" + url.QueryEscape(token)
+}
+
+// normalizeEmail 与登录时的邮箱匹配方式一致（忽略大小写与首尾空格）
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
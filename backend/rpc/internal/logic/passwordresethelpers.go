@@ -0,0 +1,17 @@
+package logic
+
+import (
+	"time"
+)
+
+const (
+	// 验证码与链接的有效期
+	passwordResetTTL = 15 * time.Minute
+	// 同一凭证验证码最多输错次数，超过后需重新申请
+	passwordResetMaxAttempts = 5
+	// 同一邮箱两次申请的最小间隔与每小时上限
+	passwordResetEmailInterval = time.Minute
+	passwordResetEmailPerHour  = 5
+	// 同一 IP 每小时的申请上限
+	passwordResetIPPerHour = 20
+)
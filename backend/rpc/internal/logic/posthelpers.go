@@ -1,9 +1,11 @@
 package logic
 
 import (
+	"sort"
 	"strings"
 
 	"backend/model"
+	"backend/rpc/pb/super"
 
 	"gorm.io/gorm"
 )
@@ -40,3 +42,74 @@ func moderationStatusOrDefault(s string) string {
 	}
 	return s
 }
+
+// normalizeTopicTags 去掉空白与重复的话题名，保留第一次出现时的颜色
+func normalizeTopicTags(in []*super.TopicTag) []model.TopicTag {
+	out := make([]model.TopicTag, 0, len(in))
+	seen := make(map[string]bool)
+	for _, t := range in {
+		if t == nil {
+			continue
+		}
+		name := strings.TrimSpace(t.Name)
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		out = append(out, model.TopicTag{Name: name, Color: strings.TrimSpace(t.Color)})
+	}
+	return out
+}
+
+func topicTagNames(tags []model.TopicTag) []string {
+	names := make([]string, 0, len(tags))
+	for _, t := range tags {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+// sameTopicNames 两组话题名是否相同（不计顺序）
+func sameTopicNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// postTopicTags 帖子当前关联的话题
+func postTopicTags(db *gorm.DB, postID uint) ([]model.TopicTag, error) {
+	var tags []model.TopicTag
+	err := db.Joins("JOIN post_topics ON post_topics.topic_tag_id = topic_tags.id AND post_topics.deleted_at IS NULL").
+		Where("post_topics.post_id = ?", postID).Order("topic_tags.id").Find(&tags).Error
+	return tags, err
+}
+
+// replacePostTopics 用 tags 整体替换帖子的话题；话题不存在时创建。
+// 关联表以 (post_id, topic_tag_id) 为主键，旧关联需物理删除，否则重新关联同一话题会主键冲突
+func replacePostTopics(tx *gorm.DB, postID uint, tags []model.TopicTag) ([]model.TopicTag, error) {
+	if err := tx.Unscoped().Where("post_id = ?", postID).Delete(&model.PostTopic{}).Error; err != nil {
+		return nil, err
+	}
+	out := make([]model.TopicTag, 0, len(tags))
+	for _, t := range tags {
+		var tag model.TopicTag
+		if err := tx.Where("name = ?", t.Name).FirstOrCreate(&tag, model.TopicTag{Name: t.Name, Color: t.Color}).Error; err != nil {
+			return nil, err
+		}
+		if err := tx.Create(&model.PostTopic{PostID: postID, TopicTagID: tag.ID}).Error; err != nil {
+			return nil, err
+		}
+		out = append(out, tag)
+	}
+	return out, nil
+}
@@ -0,0 +1,214 @@
+package logic
+
+import (
+	"errors"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"gorm.io/gorm"
+)
+
+// loadPrivacySettings 用户的隐私设置，未设置过时返回默认值
+func loadPrivacySettings(db *gorm.DB, userID uint) (model.PrivacySettings, error) {
+	s := model.DefaultPrivacySettings(userID)
+	err := db.Where("user_id = ?", userID).Limit(1).Find(&s).Error
+	return s, err
+}
+
+// isFollowing follower 是否已关注 following（不含待批准的请求）
+func isFollowing(db *gorm.DB, follower, following uint) (bool, error) {
+	var n int64
+	err := db.Model(&model.Follow{}).Where("follower_id = ? AND following_id = ?", follower, following).Count(&n).Error
+	return n > 0, err
+}
+
+// areFriends 两人的好友申请是否已通过（任一方发起）
+func areFriends(db *gorm.DB, a, b uint) (bool, error) {
+	var n int64
+	err := db.Model(&model.FriendRequest{}).
+		Where("status = ? AND ((from_user_id = ? AND to_user_id = ?) OR (from_user_id = ? AND to_user_id = ?))", "accepted", a, b, b, a).
+		Count(&n).Error
+	return n > 0, err
+}
+
+// audienceAllows actor 是否在 owner 设置的权限范围 audience 内；本人总是允许
+func audienceAllows(db *gorm.DB, audience string, owner, actor uint) (bool, error) {
+	if owner == actor {
+		return true, nil
+	}
+	switch audience {
+	case model.AudienceFollowers:
+		if actor == 0 {
+			return false, nil
+		}
+		return isFollowing(db, actor, owner)
+	case model.AudienceFriends:
+		if actor == 0 {
+			return false, nil
+		}
+		return areFriends(db, actor, owner)
+	case model.AudienceNobody:
+		return false, nil
+	}
+	return true, nil
+}
+
+// canViewPrivateContent viewer 能否查看 owner 的动态：公开账号所有人可见，私密账号仅本人与已批准的粉丝可见
+func canViewPrivateContent(db *gorm.DB, s model.PrivacySettings, viewer uint) (bool, error) {
+	if !s.PrivateAccount || viewer == s.UserID {
+		return true, nil
+	}
+	if viewer == 0 {
+		return false, nil
+	}
+	return isFollowing(db, viewer, s.UserID)
+}
+
+// privateAccountScope 列表查询中排除 viewer 无权查看的私密账号所发的内容，column 为作者 ID 列
+func privateAccountScope(viewer uint, column string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		private := db.Session(&gorm.Session{NewDB: true}).Model(&model.PrivacySettings{}).
+			Select("user_id").Where("private_account = ?", true)
+		if viewer != 0 {
+			following := db.Session(&gorm.Session{NewDB: true}).Model(&model.Follow{}).
+				Select("following_id").Where("follower_id = ?", viewer)
+			private = private.Where("user_id <> ? AND user_id NOT IN (?)", viewer, following)
+		}
+		return db.Where(column+" NOT IN (?)", private)
+	}
+}
+
+// checkPostVisible viewer 能否查看 author 的动态（单条动态、评论列表）：作者被处罚并隐藏内容时按不存在处理
+func checkPostVisible(db *gorm.DB, author, viewer uint) error {
+	hidden, err := contentHidden(db, author, viewer)
+	if err != nil {
+		return err
+	}
+	if hidden {
+		return errorx.NotFound("动态不存在")
+	}
+	s, err := loadPrivacySettings(db, author)
+	if err != nil {
+		return err
+	}
+	ok, err := canViewPrivateContent(db, s, viewer)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(403, "该用户的动态仅粉丝可见")
+	}
+	return nil
+}
+
+// checkDirectMessage 发送方能否私信接收方：发送方被封禁或限制、存在拉黑关系或不在对方的私信权限范围内时返回 403
+func checkDirectMessage(db *gorm.DB, sender, recipient uint) error {
+	if err := checkCanPublish(db, sender); err != nil {
+		return err
+	}
+	blocked, err := utils.Blocked(db, sender, recipient)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return errorx.New(403, "无法给该用户发送消息")
+	}
+	s, err := loadPrivacySettings(db, recipient)
+	if err != nil {
+		return err
+	}
+	ok, err := audienceAllows(db, s.DMPermission, recipient, sender)
+	if err != nil || ok {
+		return err
+	}
+	switch s.DMPermission {
+	case model.AudienceFollowers:
+		return errorx.New(403, "对方只接收粉丝的私信，关注后再试")
+	case model.AudienceFriends:
+		return errorx.New(403, "对方只接收好友的私信")
+	}
+	return errorx.New(403, "对方已关闭私信")
+}
+
+// checkCanComment commenter 能否评论 author 的动态：封禁或限制、拉黑、私密账号、评论权限
+func checkCanComment(db *gorm.DB, author, commenter uint) error {
+	if err := checkCanPublish(db, commenter); err != nil {
+		return err
+	}
+	blocked, err := utils.Blocked(db, author, commenter)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return errorx.New(403, "无法评论该动态")
+	}
+	s, err := loadPrivacySettings(db, author)
+	if err != nil {
+		return err
+	}
+	if ok, err := canViewPrivateContent(db, s, commenter); err != nil || !ok {
+		if err != nil {
+			return err
+		}
+		return errorx.New(403, "该用户的动态仅粉丝可见")
+	}
+	ok, err := audienceAllows(db, s.CommentPermission, author, commenter)
+	if err != nil || ok {
+		return err
+	}
+	switch s.CommentPermission {
+	case model.AudienceFollowers:
+		return errorx.New(403, "作者只允许粉丝评论")
+	case model.AudienceFriends:
+		return errorx.New(403, "作者只允许好友评论")
+	}
+	return errorx.New(403, "作者关闭了评论")
+}
+
+// checkFollowListVisible viewer 能否查看 owner 的关注 / 粉丝列表
+func checkFollowListVisible(db *gorm.DB, owner, viewer uint) error {
+	if owner == viewer {
+		return nil
+	}
+	s, err := loadPrivacySettings(db, owner)
+	if err != nil {
+		return err
+	}
+	if s.HideFollowLists {
+		return errorx.New(403, "对方隐藏了关注列表")
+	}
+	ok, err := canViewPrivateContent(db, s, viewer)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errorx.New(403, "私密账号，关注后才能查看")
+	}
+	return nil
+}
+
+// upsertFollow 建立关注关系；取关后再次关注时恢复软删除的记录
+func upsertFollow(db *gorm.DB, follower, following uint) error {
+	var existing model.Follow
+	err := db.Unscoped().Where("follower_id = ? AND following_id = ?", follower, following).First(&existing).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return db.Create(&model.Follow{FollowerID: follower, FollowingID: following}).Error
+	}
+	if err != nil || !existing.DeletedAt.Valid {
+		return err
+	}
+	// 使用 Unscoped() + Updates(map) 强制将 deleted_at 设为 NULL
+	return db.Unscoped().Model(&existing).Updates(map[string]interface{}{"deleted_at": nil}).Error
+}
+
+func modelPrivacySettingsToProto(s *model.PrivacySettings) *super.PrivacySettings {
+	return &super.PrivacySettings{
+		PrivateAccount:    s.PrivateAccount,
+		DmPermission:      s.DMPermission,
+		CommentPermission: s.CommentPermission,
+		HideFollowLists:   s.HideFollowLists,
+	}
+}
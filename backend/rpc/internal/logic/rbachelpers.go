@@ -0,0 +1,50 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/utils"
+
+	"gorm.io/gorm"
+)
+
+// requirePermission 按权限矩阵（utils.RolePermissions）校验操作者的当前角色，返回其用户 ID
+func requirePermission(ctx context.Context, svcCtx *svc.ServiceContext, actorUserID string, perm utils.Permission) (uint, error) {
+	id, err := strconv.ParseUint(actorUserID, 10, 32)
+	if err != nil || id == 0 {
+		return 0, errorx.Unauthenticated("未登录")
+	}
+	var user model.User
+	if err := svcCtx.DB.WithContext(ctx).Select("id", "role").First(&user, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, errorx.Unauthenticated("用户不存在")
+		}
+		return 0, errorx.Internal("查询用户失败")
+	}
+	if !utils.HasPermission(user.Role, perm) {
+		if utils.IsAdminRole(user.Role) {
+			return 0, errorx.New(403, "需要超级管理员权限")
+		}
+		return 0, errorx.New(403, "需要管理员权限")
+	}
+	// 角色被要求两步验证时，未开启的账号不能使用需要权限的接口
+	required, err := mfaRequiredForRole(svcCtx.DB.WithContext(ctx), user.Role)
+	if err != nil {
+		return 0, errorx.Internal("查询两步验证策略失败")
+	}
+	if required {
+		totp, err := enabledTOTP(svcCtx.DB.WithContext(ctx), user.ID)
+		if err != nil {
+			return 0, errorx.Internal("查询两步验证状态失败")
+		}
+		if totp == nil {
+			return 0, errorx.New(403, "当前角色要求开启两步验证，请先绑定")
+		}
+	}
+	return uint(id), nil
+}
@@ -53,6 +53,12 @@ func (l *RechargeLogic) Recharge(in *super.RechargeReq) (*super.RechargeResp, er
 		if err := tx.Set("gorm:query_option", "FOR UPDATE").First(&user, uint(userID)).Error; err != nil {
 			return errorx.New(404, "用户不存在")
 		}
+		// 充值（正数）需要已验证邮箱；负数为消费扣款，不受限制
+		if in.Amount > 0 {
+			if err := requireVerifiedEmail(l.svcCtx, &user); err != nil {
+				return err
+			}
+		}
 
 		// 4. 更新用户余额
 		amount := float64(in.Amount)
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RedeemWsTicketLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRedeemWsTicketLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RedeemWsTicketLogic {
+	return &RedeemWsTicketLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RedeemWsTicket 取出并删除票据：同一张票据在多个实例上同时兑换时只有删除成功的一方通过
+func (l *RedeemWsTicketLogic) RedeemWsTicket(in *super.RedeemWsTicketReq) (*super.RedeemWsTicketResp, error) {
+	invalid := errorx.InvalidArgument("票据无效或已过期")
+	if strings.TrimSpace(in.Ticket) == "" {
+		return nil, invalid
+	}
+	var t model.WsTicket
+	err := quietSession(l.svcCtx.DB.WithContext(l.ctx)).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("ticket_hash = ?", sha256Hex(in.Ticket)).First(&t).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return invalid
+		}
+		if err != nil {
+			return err
+		}
+		res := tx.Delete(&model.WsTicket{}, t.ID)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return invalid
+		}
+		return nil
+	})
+	if errors.Is(err, invalid) {
+		return nil, invalid
+	}
+	if err != nil {
+		l.Errorf("[WebSocket] 兑换票据失败 错误=%v", err)
+		return nil, errorx.Internal("兑换票据失败")
+	}
+	if t.Endpoint != in.Endpoint || time.Now().After(t.ExpiresAt) {
+		return nil, invalid
+	}
+	return &super.RedeemWsTicketResp{
+		UserId:    strconv.FormatUint(uint64(t.UserID), 10),
+		SessionId: strconv.FormatUint(uint64(t.SessionID), 10),
+	}, nil
+}
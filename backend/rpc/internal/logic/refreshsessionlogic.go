@@ -0,0 +1,129 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RefreshSessionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRefreshSessionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RefreshSessionLogic {
+	return &RefreshSessionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 刷新令牌轮换：旧令牌标记为已用并签发新令牌，会话有效期顺延。
+// 已用过的令牌再次出现时撤销整个会话（该会话的所有设备都需重新登录），提交后再返回错误
+func (l *RefreshSessionLogic) RefreshSession(in *super.RefreshSessionReq) (*super.RefreshSessionResp, error) {
+	presented := strings.TrimSpace(in.RefreshToken)
+	if presented == "" {
+		return nil, errorx.Unauthenticated("缺少刷新令牌")
+	}
+	expired := errorx.Unauthenticated("登录已失效，请重新登录")
+
+	var (
+		session model.UserSession
+		user    model.User
+		refresh string
+		reused  bool
+		now     = time.Now()
+	)
+	err := l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		var rt model.RefreshToken
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ?", sha256Hex(presented)).First(&rt).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return expired
+			}
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&session, rt.SessionID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return expired
+			}
+			return err
+		}
+		if session.RevokedAt != nil || now.After(session.ExpiresAt) {
+			return expired
+		}
+		if rt.UsedAt != nil {
+			reused = true
+			_, err := revokeSessions(tx, session.UserID, model.SessionRevokeReuseDetected, session.ID)
+			return err
+		}
+
+		if err := tx.Select("id", "username", "role", "token_version").First(&user, session.UserID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return expired
+			}
+			return err
+		}
+		// 封禁时会话已被撤销，这里兜底：封禁期间不再签发令牌
+		if suspension, err := activeSuspension(tx, user.ID); err != nil {
+			return err
+		} else if suspension != nil && suspension.Kind == model.SuspensionKindSuspend {
+			return expired
+		}
+		if err := tx.Model(&rt).Update("used_at", now).Error; err != nil {
+			return err
+		}
+		var err error
+		if refresh, err = issueRefreshToken(tx, session.ID); err != nil {
+			return err
+		}
+		updates := map[string]interface{}{"last_used_at": now, "expires_at": now.Add(refreshTokenTTL)}
+		if in.ClientIp != "" {
+			updates["client_ip"] = in.ClientIp
+		}
+		if in.UserAgent != "" {
+			updates["user_agent"] = truncateUserAgent(in.UserAgent)
+		}
+		return tx.Model(&session).Updates(updates).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[认证] 刷新令牌失败：数据库异常 会话ID=%d 错误=%v", session.ID, err)
+		return nil, errorx.Internal("刷新登录状态失败，请稍后重试")
+	}
+	if reused {
+		kickSessions(l.svcCtx, session.UserID, session.ID)
+		l.Errorf("[认证] 检测到刷新令牌重放，已撤销会话 用户ID=%d 会话ID=%d IP=%s", session.UserID, session.ID, in.ClientIp)
+		return nil, expired
+	}
+
+	token, err := utils.GenerateToken(user.ID, user.Username, user.Role, user.TokenVersion, session.ID)
+	if err != nil {
+		l.Errorf("[认证] 刷新令牌失败：生成访问令牌失败 用户ID=%d 错误=%v", user.ID, err)
+		return nil, errorx.Internal("刷新登录状态失败，请稍后重试")
+	}
+	return &super.RefreshSessionResp{
+		Token:        token,
+		RefreshToken: refresh,
+		UserId:       strconv.FormatUint(uint64(user.ID), 10),
+		SessionId:    strconv.FormatUint(uint64(session.ID), 10),
+		ExpiresIn:    accessTokenExpiresIn(),
+	}, nil
+}
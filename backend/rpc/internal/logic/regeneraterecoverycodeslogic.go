@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"context"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RegenerateRecoveryCodesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRegenerateRecoveryCodesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RegenerateRecoveryCodesLogic {
+	return &RegenerateRecoveryCodesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 用验证码（或一个未使用的恢复码）换一组新的恢复码，旧的全部作废
+func (l *RegenerateRecoveryCodesLogic) RegenerateRecoveryCodes(in *super.RegenerateRecoveryCodesReq) (*super.RecoveryCodesResp, error) {
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	user, err := loadUserByID(db, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	var codes []string
+	err = db.Transaction(func(tx *gorm.DB) error {
+		totp, err := enabledTOTP(tx.Clauses(clause.Locking{Strength: "UPDATE"}), user.ID)
+		if err != nil {
+			return err
+		}
+		if totp == nil {
+			return errorx.InvalidArgument("未开启两步验证")
+		}
+		method, err := verifySecondFactor(tx, totp, in.Code)
+		if err != nil {
+			return err
+		}
+		if method == "" {
+			return errorx.InvalidArgument("验证码错误")
+		}
+		codes, err = replaceRecoveryCodes(tx, user.ID)
+		return err
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[认证] 重新生成恢复码失败 用户ID=%d 错误=%v", user.ID, err)
+		return nil, errorx.Internal("生成恢复码失败，请稍后重试")
+	}
+
+	if err := recordSecurityEvent(l.svcCtx.DB, user.ID, model.SecurityEventRecoveryReset, in.ClientIp, in.UserAgent, ""); err != nil {
+		l.Error("记录重新生成恢复码事件失败: ", err)
+	}
+	return &super.RecoveryCodesResp{RecoveryCodes: codes}, nil
+}
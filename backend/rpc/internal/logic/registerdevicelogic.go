@@ -0,0 +1,102 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/push"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm/clause"
+)
+
+// 每个用户保留的设备数上限，超出时淘汰最久未活跃的设备
+const maxDevicesPerUser = 10
+
+type RegisterDeviceLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRegisterDeviceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RegisterDeviceLogic {
+	return &RegisterDeviceLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 注册设备推送令牌；App 每次启动或令牌刷新时调用。
+// 同一令牌换账号登录时归属到新用户（令牌唯一），避免推送发到已退出的账号
+func (l *RegisterDeviceLogic) RegisterDevice(in *super.RegisterDeviceReq) (*super.RegisterDeviceResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+	token := strings.TrimSpace(in.Token)
+	if token == "" || len(token) > 255 {
+		return nil, errorx.InvalidArgument("无效的设备令牌")
+	}
+
+	platform := strings.ToLower(strings.TrimSpace(in.Platform))
+	switch platform {
+	case "android", "ios", "web":
+	default:
+		return nil, errorx.InvalidArgument("platform 只能是 android、ios 或 web")
+	}
+	provider := strings.ToLower(strings.TrimSpace(in.Provider))
+	switch provider {
+	case "":
+		provider = push.ProviderFcm
+		if platform == "ios" {
+			provider = push.ProviderApns
+		}
+	case push.ProviderFcm, push.ProviderApns:
+	default:
+		return nil, errorx.InvalidArgument("provider 只能是 fcm 或 apns")
+	}
+	if provider == push.ProviderApns && platform != "ios" {
+		return nil, errorx.InvalidArgument("apns 只支持 ios 设备")
+	}
+
+	now := time.Now()
+	device := model.UserDevice{
+		UserID:     uint(userID),
+		Token:      token,
+		Platform:   platform,
+		Provider:   provider,
+		AppVersion: in.AppVersion,
+		LastSeenAt: now,
+	}
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	if err := db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "token"}},
+		DoUpdates: clause.AssignmentColumns([]string{"user_id", "platform", "provider", "app_version", "last_seen_at", "updated_at"}),
+	}).Create(&device).Error; err != nil {
+		l.Error("注册设备失败:", err)
+		return nil, errorx.Internal("注册设备失败")
+	}
+
+	// 超出上限时删除最久未活跃的设备
+	var stale []uint
+	if err := db.Model(&model.UserDevice{}).Where("user_id = ?", userID).
+		Order("last_seen_at desc, id desc").Offset(maxDevicesPerUser).Pluck("id", &stale).Error; err != nil {
+		l.Error("查询用户设备失败:", err)
+	} else if len(stale) > 0 {
+		if err := db.Delete(&model.UserDevice{}, stale).Error; err != nil {
+			l.Error("清理旧设备失败:", err)
+		}
+	}
+
+	if !l.svcCtx.Push.Supports(provider) {
+		l.Infof("设备已注册，但 %s 推送通道未配置 用户ID=%d", provider, userID)
+	}
+	return &super.RegisterDeviceResp{}, nil
+}
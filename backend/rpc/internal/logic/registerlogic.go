@@ -72,6 +72,11 @@ func (l *RegisterLogic) Register(in *super.RegisterReq) (*super.RegisterResp, er
 	}
 	_ = l.svcCtx.DB.First(&user, user.ID).Error
 
+	// 5. 发送邮箱验证邮件；失败不影响注册，用户可在资料页重新发送
+	if err := sendEmailVerification(l.ctx, l.svcCtx, &user, normalizeEmail(user.Email), model.EmailVerifyPurposeRegister, in.Lang); err != nil {
+		l.Errorf("[认证] 注册过程异常：发送验证邮件失败 用户ID=%d 错误=%v", user.ID, err)
+	}
+
 	l.Infof("[认证] 注册成功 用户ID=%d 用户名=%s Moe号=%s 邮箱=%s",
 		user.ID, user.Username, user.MoeNo, logutil.MaskEmail(user.Email))
 
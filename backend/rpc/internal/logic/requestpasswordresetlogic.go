@@ -0,0 +1,140 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/logutil"
+	"backend/rpc/internal/mail"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type RequestPasswordResetLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRequestPasswordResetLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RequestPasswordResetLogic {
+	return &RequestPasswordResetLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 申请找回密码：生成验证码与链接令牌（只存哈希）并发到邮箱，同时作废该邮箱此前未使用的凭证。
+// 邮箱未注册时同样返回成功、同样计入限流，对外不暴露账号是否存在
+func (l *RequestPasswordResetLogic) RequestPasswordReset(in *super.RequestPasswordResetReq) (*super.RequestPasswordResetResp, error) {
+	email := normalizeEmail(in.Email)
+	if email == "" || !strings.Contains(email, "@") {
+		return nil, errorx.InvalidArgument("邮箱格式不正确")
+	}
+	if !l.svcCtx.Mail.Enabled() {
+		l.Errorf("[认证] 找回密码失败：未配置邮件发送 邮箱=%s", logutil.MaskEmail(email))
+		return nil, errorx.Internal("邮件服务暂不可用，请联系管理员")
+	}
+
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	now := time.Now()
+	if err := l.throttle(db, email, in.ClientIp, now); err != nil {
+		return nil, err
+	}
+
+	var user model.User
+	err := db.Where("LOWER(TRIM(email)) = ?", email).First(&user).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		l.Errorf("[认证] 找回密码失败：查询用户时数据库异常 邮箱=%s 错误=%v", logutil.MaskEmail(email), err)
+		return nil, errorx.Internal("服务器内部错误")
+	}
+
+	code, salt, linkToken, err := newOneTimeSecrets()
+	if err != nil {
+		l.Errorf("[认证] 找回密码失败：生成验证码失败 错误=%v", err)
+		return nil, errorx.Internal("服务器内部错误")
+	}
+	row := model.PasswordResetToken{
+		UserID:    user.ID,
+		Email:     email,
+		CodeSalt:  salt,
+		CodeHash:  sha256Hex(salt + code),
+		LinkHash:  sha256Hex(linkToken),
+		ExpiresAt: now.Add(passwordResetTTL),
+		RequestIP: in.ClientIp,
+	}
+	if err := quietSession(db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.PasswordResetToken{}).
+			Where("email = ? AND used_at IS NULL AND revoked_at IS NULL", email).
+			Update("revoked_at", now).Error; err != nil {
+			return err
+		}
+		return tx.Create(&row).Error
+	}); err != nil {
+		l.Errorf("[认证] 找回密码失败：保存验证码失败 邮箱=%s 错误=%v", logutil.MaskEmail(email), err)
+		return nil, errorx.Internal("服务器内部错误")
+	}
+
+	if user.ID == 0 {
+		l.Infof("[认证] 找回密码：邮箱未注册，不发送邮件 邮箱=%s IP=%s", logutil.MaskEmail(email), in.ClientIp)
+		return &super.RequestPasswordResetResp{}, nil
+	}
+	if _, err := l.svcCtx.Mail.Enqueue(l.ctx, user.Email, mail.TemplatePasswordReset, in.Lang, mail.CodeData{
+		Username:      user.Username,
+		Code:          code,
+		Link:          linkWithToken(l.svcCtx.Config.PasswordResetUrl, linkToken),
+		ExpireMinutes: int(passwordResetTTL / time.Minute),
+	}); err != nil {
+		l.Errorf("[认证] 找回密码失败：写入邮件失败 用户ID=%d 错误=%v", user.ID, err)
+		return nil, errorx.Internal("发送邮件失败，请稍后重试")
+	}
+
+	l.Infof("[认证] 找回密码：已发送验证码 用户ID=%d 邮箱=%s IP=%s", user.ID, logutil.MaskEmail(email), in.ClientIp)
+	return &super.RequestPasswordResetResp{}, nil
+}
+
+// throttle 按邮箱（间隔与每小时上限）和 IP（每小时上限）限流
+func (l *RequestPasswordResetLogic) throttle(db *gorm.DB, email, ip string, now time.Time) error {
+	var recent, hourly int64
+	if err := db.Model(&model.PasswordResetToken{}).
+		Where("email = ? AND created_at > ?", email, now.Add(-passwordResetEmailInterval)).
+		Count(&recent).Error; err != nil {
+		l.Errorf("[认证] 找回密码限流查询失败: %v", err)
+		return errorx.Internal("服务器内部错误")
+	}
+	if recent > 0 {
+		return errorx.TooManyRequests("验证码已发送，请稍后再试")
+	}
+	if err := db.Model(&model.PasswordResetToken{}).
+		Where("email = ? AND created_at > ?", email, now.Add(-time.Hour)).
+		Count(&hourly).Error; err != nil {
+		l.Errorf("[认证] 找回密码限流查询失败: %v", err)
+		return errorx.Internal("服务器内部错误")
+	}
+	if hourly >= passwordResetEmailPerHour {
+		return errorx.TooManyRequests("申请过于频繁，请一小时后再试")
+	}
+
+	if ip == "" {
+		return nil
+	}
+	var byIP int64
+	if err := db.Model(&model.PasswordResetToken{}).
+		Where("request_ip = ? AND created_at > ?", ip, now.Add(-time.Hour)).
+		Count(&byIP).Error; err != nil {
+		l.Errorf("[认证] 找回密码限流查询失败: %v", err)
+		return errorx.Internal("服务器内部错误")
+	}
+	if byIP >= passwordResetIPPerHour {
+		l.Infof("[认证] 找回密码：IP 申请过于频繁 IP=%s", ip)
+		return errorx.TooManyRequests("申请过于频繁，请稍后再试")
+	}
+	return nil
+}
@@ -3,6 +3,10 @@ package logic
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"backend/model"
 	"backend/rpc/internal/errorx"
@@ -11,7 +15,9 @@ import (
 	"backend/rpc/pb/super"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ResetPasswordLogic struct {
@@ -28,36 +34,99 @@ func NewResetPasswordLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Res
 	}
 }
 
+// 用邮件中的验证码（email + code）或链接令牌（token）设置新密码。
+// 成功后凭证作废、其余未使用的凭证一并作废，并递增令牌版本使此前签发的登录令牌全部失效
 func (l *ResetPasswordLogic) ResetPassword(in *super.ResetPasswordReq) (*super.ResetPasswordResp, error) {
-	// 1. 查找用户
+	email := normalizeEmail(in.Email)
+	code := strings.TrimSpace(in.Code)
+	token := strings.TrimSpace(in.Token)
+	if token == "" && (email == "" || code == "") {
+		return nil, errorx.InvalidArgument("请填写邮箱和验证码")
+	}
+	if in.NewPassword == "" {
+		return nil, errorx.InvalidArgument("新密码不能为空")
+	}
+	if len(in.NewPassword) > 72 {
+		return nil, errorx.InvalidArgument("新密码过长")
+	}
+
+	invalid := errorx.InvalidArgument("验证码无效或已过期，请重新获取")
+	now := time.Now()
 	var user model.User
-	result := l.svcCtx.DB.Where("email = ?", in.Email).First(&user)
-	if result.Error != nil {
-		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
-			l.Infof("[认证] 重置密码失败：未找到该邮箱对应用户 邮箱=%s", logutil.MaskEmail(in.Email))
+	remaining := -1
+	err := quietSession(l.svcCtx.DB).WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		var t model.PasswordResetToken
+		q := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id <> 0 AND used_at IS NULL AND revoked_at IS NULL AND expires_at > ?", now)
+		if token != "" {
+			q = q.Where("link_hash = ?", sha256Hex(token))
 		} else {
-			l.Errorf("[认证] 重置密码失败：查询用户时数据库异常 邮箱=%s 错误=%v",
-				logutil.MaskEmail(in.Email), result.Error)
+			q = q.Where("email = ?", email).Order("id desc")
+		}
+		if err := q.First(&t).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return invalid
+			}
+			return err
 		}
-		// 对外仍统一返回「用户不存在」，避免枚举邮箱
-		return nil, errorx.NotFound("用户不存在")
-	}
 
-	// 2. 更新密码
-	// 直接赋值新密码，GORM的BeforeSave钩子会处理哈希
-	// 注意：User模型中的BeforeSave只有在Password字段非空时才会哈希
-	user.Password = in.NewPassword
+		if token == "" {
+			if t.Attempts >= passwordResetMaxAttempts {
+				return errorx.TooManyRequests("验证码错误次数过多，请重新获取")
+			}
+			if !oneTimeCodeMatches(t.CodeSalt, t.CodeHash, code) {
+				// 输错次数需要提交，不能随事务回滚
+				remaining = passwordResetMaxAttempts - t.Attempts - 1
+				return tx.Model(&t).UpdateColumn("attempts", gorm.Expr("attempts + 1")).Error
+			}
+		}
 
-	// 3. 保存更新
-	err := l.svcCtx.DB.Save(&user).Error
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&user, t.UserID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return invalid
+			}
+			return err
+		}
+		// BeforeSave 钩子负责哈希新密码
+		user.Password = in.NewPassword
+		user.TokenVersion++
+		if err := tx.Save(&user).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&t).Update("used_at", now).Error; err != nil {
+			return err
+		}
+		// 令牌版本只能吊销访问令牌，刷新令牌需要随会话一起撤销
+		if _, err := revokeSessions(tx, user.ID, model.SessionRevokePasswordReset); err != nil {
+			return err
+		}
+		return tx.Model(&model.PasswordResetToken{}).
+			Where("user_id = ? AND id <> ? AND used_at IS NULL AND revoked_at IS NULL", t.UserID, t.ID).
+			Update("revoked_at", now).Error
+	})
 	if err != nil {
-		l.Errorf("[认证] 重置密码失败：保存新密码时数据库异常 用户ID=%d 邮箱=%s 错误=%v",
-			user.ID, logutil.MaskEmail(in.Email), err)
-		return nil, errorx.Internal("更新密码失败，请稍后重试")
+		if _, ok := status.FromError(err); ok {
+			l.Infof("[认证] 重置密码失败 邮箱=%s 原因=%v", logutil.MaskEmail(email), err)
+			return nil, err
+		}
+		l.Errorf("[认证] 重置密码失败：数据库异常 邮箱=%s 错误=%v", logutil.MaskEmail(email), err)
+		return nil, errorx.Internal("重置密码失败，请稍后重试")
+	}
+	if remaining >= 0 {
+		l.Infof("[认证] 重置密码失败：验证码错误 邮箱=%s 剩余次数=%d", logutil.MaskEmail(email), remaining)
+		if remaining == 0 {
+			return nil, errorx.TooManyRequests("验证码错误次数过多，请重新获取")
+		}
+		return nil, errorx.InvalidArgument(fmt.Sprintf("验证码错误，还可尝试 %d 次", remaining))
 	}
 
-	l.Infof("[认证] 重置密码成功 用户ID=%d 用户名=%s 邮箱=%s",
-		user.ID, user.Username, logutil.MaskEmail(user.Email))
+	kickSessions(l.svcCtx, user.ID)
+	// 重置密码也会清零该账号的登录失败计数（见 accountLoginFailures）
+	if err := recordSecurityEvent(l.svcCtx.DB, user.ID, model.SecurityEventPasswordReset, in.ClientIp, in.UserAgent, ""); err != nil {
+		l.Errorf("[认证] 记录重置密码事件失败 用户ID=%d 错误=%v", user.ID, err)
+	}
 
-	return &super.ResetPasswordResp{}, nil
+	l.Infof("[认证] 重置密码成功，已吊销旧登录令牌 用户ID=%d 用户名=%s 邮箱=%s",
+		user.ID, user.Username, logutil.MaskEmail(user.Email))
+	return &super.ResetPasswordResp{UserId: strconv.FormatUint(uint64(user.ID), 10)}, nil
 }
@@ -0,0 +1,80 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/notify"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RespondFollowRequestLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRespondFollowRequestLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RespondFollowRequestLogic {
+	return &RespondFollowRequestLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RespondFollowRequest 私密账号批准或拒绝关注请求，处理后请求记录删除
+func (l *RespondFollowRequestLogic) RespondFollowRequest(in *super.RespondFollowRequestReq) (*super.RespondFollowRequestResp, error) {
+	me, err := parseActorUint(in.GetActorUserId())
+	if err != nil || me == 0 {
+		return nil, errorx.Unauthenticated("请先登录")
+	}
+	reqID, err := strconv.ParseUint(in.GetRequestId(), 10, 64)
+	if err != nil || reqID == 0 {
+		return nil, errorx.InvalidArgument("无效的请求 ID")
+	}
+
+	var followerID uint
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		var req model.FollowRequest
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND following_id = ?", reqID, me).First(&req).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorx.NotFound("关注请求不存在")
+		}
+		if err != nil {
+			return err
+		}
+		followerID = req.FollowerID
+		if in.Approve {
+			if err := upsertFollow(tx, req.FollowerID, me); err != nil {
+				return err
+			}
+		}
+		return tx.Delete(&req).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[关注请求] 处理失败 用户ID=%d 请求ID=%d 错误=%v", me, reqID, err)
+		return nil, errorx.Internal("处理失败")
+	}
+
+	if in.Approve {
+		// 批准后按普通关注通知被关注者本人（与公开账号被关注时一致）
+		if _, err := l.svcCtx.Notifier.Notify(l.ctx, notify.KindFollow, me, followerID, notify.Payload{}); err != nil {
+			l.Errorf("[关注请求] 创建关注通知失败 用户ID=%d 错误=%v", me, err)
+		}
+	}
+	l.Infof("[关注请求] 已处理 用户ID=%d 请求者=%d 批准=%v", me, followerID, in.Approve)
+	return &super.RespondFollowRequestResp{}, nil
+}
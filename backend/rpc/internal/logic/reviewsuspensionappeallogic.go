@@ -0,0 +1,114 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/notify"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReviewSuspensionAppealLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReviewSuspensionAppealLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewSuspensionAppealLogic {
+	return &ReviewSuspensionAppealLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReviewSuspensionAppeal 处理申诉：通过时解除对应的处罚（若仍生效），结果以系统通知告知用户
+func (l *ReviewSuspensionAppealLogic) ReviewSuspensionAppeal(in *super.ReviewSuspensionAppealReq) (*super.SuspensionAppealResp, error) {
+	actorID, err := requirePermission(l.ctx, l.svcCtx, in.ActorUserId, utils.PermUserModerate)
+	if err != nil {
+		return nil, err
+	}
+	appealID, err := strconv.ParseUint(in.AppealId, 10, 64)
+	if err != nil || appealID == 0 {
+		return nil, errorx.InvalidArgument("无效的申诉ID")
+	}
+	note := strings.TrimSpace(in.Note)
+	if len([]rune(note)) > suspensionReasonMaxLen {
+		return nil, errorx.InvalidArgument(fmt.Sprintf("处理意见不能超过%d个字符", suspensionReasonMaxLen))
+	}
+
+	now := time.Now()
+	var (
+		appeal     model.SuspensionAppeal
+		suspension model.UserSuspension
+	)
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND status = ?", appealID, model.AppealStatusPending).First(&appeal).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorx.NotFound("申诉不存在或已处理")
+		}
+		if err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&suspension, appeal.SuspensionID).Error; err != nil {
+			return err
+		}
+
+		appeal.Status = model.AppealStatusRejected
+		if in.Approve {
+			appeal.Status = model.AppealStatusAccepted
+		}
+		appeal.ReviewedBy, appeal.ReviewNote, appeal.ReviewedAt = actorID, note, &now
+		if err := tx.Model(&appeal).Updates(map[string]interface{}{
+			"status":      appeal.Status,
+			"reviewed_by": actorID,
+			"review_note": note,
+			"reviewed_at": now,
+		}).Error; err != nil {
+			return err
+		}
+		if !in.Approve || !suspension.Active(now) {
+			return nil
+		}
+		suspension.LiftedAt, suspension.LiftedBy, suspension.LiftReason = &now, actorID, "申诉通过"
+		return tx.Model(&suspension).Updates(map[string]interface{}{
+			"lifted_at":   now,
+			"lifted_by":   actorID,
+			"lift_reason": suspension.LiftReason,
+		}).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[处罚] 处理申诉失败 操作者ID=%d 申诉ID=%d 错误=%v", actorID, appealID, err)
+		return nil, errorx.Internal("处理申诉失败")
+	}
+
+	content := "你的申诉已通过，处罚已解除"
+	if !in.Approve {
+		content = "你的申诉未通过"
+		if note != "" {
+			content += "：" + note
+		}
+	}
+	if _, err := l.svcCtx.Notifier.Notify(l.ctx, notify.KindSystem, appeal.UserID, actorID, notify.Payload{Content: content}); err != nil {
+		l.Errorf("[处罚] 创建申诉结果通知失败 用户ID=%d 错误=%v", appeal.UserID, err)
+	}
+	l.Infof("[处罚] 处理申诉 操作者ID=%d 申诉ID=%d 用户ID=%d 结果=%s", actorID, appealID, appeal.UserID, appeal.Status)
+	return &super.SuspensionAppealResp{Appeal: modelAppealToProto(&appeal, nil, &suspension)}, nil
+}
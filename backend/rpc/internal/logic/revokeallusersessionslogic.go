@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type RevokeAllUserSessionsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRevokeAllUserSessionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeAllUserSessionsLogic {
+	return &RevokeAllUserSessionsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 退出所有设备：撤销全部会话，同时递增令牌版本，使不带 sid 的旧版令牌也一并失效
+func (l *RevokeAllUserSessionsLogic) RevokeAllUserSessions(in *super.RevokeAllUserSessionsReq) (*super.RevokeAllUserSessionsResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+
+	var ids []uint
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&model.User{}).Where("id = ?", userID).
+			UpdateColumn("token_version", gorm.Expr("token_version + 1"))
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		var err error
+		ids, err = revokeSessions(tx, uint(userID), model.SessionRevokeLogoutAll)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("用户不存在")
+		}
+		l.Errorf("退出所有设备失败 用户ID=%d: %v", userID, err)
+		return nil, errorx.Internal("退出登录失败，请稍后重试")
+	}
+
+	out := make([]string, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, strconv.FormatUint(uint64(id), 10))
+	}
+	kickSessions(l.svcCtx, uint(userID))
+	l.Infof("[认证] 退出所有设备 用户ID=%d 会话数=%d", userID, len(ids))
+	return &super.RevokeAllUserSessionsResp{SessionIds: out}, nil
+}
@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeUserSessionLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRevokeUserSessionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeUserSessionLogic {
+	return &RevokeUserSessionLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 撤销单个会话并通知各 API 实例断开该会话的连接；会话不属于该用户或已撤销时返回 NotFound
+func (l *RevokeUserSessionLogic) RevokeUserSession(in *super.RevokeUserSessionReq) (*super.RevokeUserSessionResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+	sessionID, err := strconv.ParseUint(in.SessionId, 10, 32)
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的会话ID")
+	}
+
+	ids, err := revokeSessions(l.svcCtx.DB.WithContext(l.ctx), uint(userID), model.SessionRevokeLogout, uint(sessionID))
+	if err != nil {
+		l.Errorf("撤销会话失败 用户ID=%d 会话ID=%d: %v", userID, sessionID, err)
+		return nil, errorx.Internal("退出登录失败，请稍后重试")
+	}
+	if len(ids) == 0 {
+		return nil, errorx.NotFound("会话不存在或已退出")
+	}
+	kickSessions(l.svcCtx, uint(userID), ids...)
+	l.Infof("[认证] 撤销会话 用户ID=%d 会话ID=%d", userID, sessionID)
+	return &super.RevokeUserSessionResp{}, nil
+}
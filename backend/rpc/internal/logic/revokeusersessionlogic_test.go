@@ -0,0 +1,57 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/notifyhub"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/pb/super"
+)
+
+// 退出设备与退出所有设备都经通知流广播会话撤销事件，其他 API 实例据此断开连接
+func TestRevokeSessionsBroadcastSessionKick(t *testing.T) {
+	db := testdb.New(t, &model.User{}, &model.UserSession{})
+	user := model.User{Username: "alice", Email: "alice@example.com", Password: "password"}
+	if err := db.Create(&user).Error; err != nil {
+		t.Fatal(err)
+	}
+	sessions := []model.UserSession{
+		{UserID: user.ID, ExpiresAt: time.Now().Add(time.Hour)},
+		{UserID: user.ID, ExpiresAt: time.Now().Add(time.Hour)},
+	}
+	if err := db.Create(&sessions).Error; err != nil {
+		t.Fatal(err)
+	}
+	hub := notifyhub.NewHub()
+	events, cancel := hub.Subscribe()
+	defer cancel()
+	svcCtx := &svc.ServiceContext{DB: db, NotificationHub: hub}
+	uid := strconv.FormatUint(uint64(user.ID), 10)
+	sid := strconv.FormatUint(uint64(sessions[0].ID), 10)
+
+	if _, err := NewRevokeUserSessionLogic(context.Background(), svcCtx).RevokeUserSession(&super.RevokeUserSessionReq{
+		UserId:    uid,
+		SessionId: sid,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	kicks := drainKicks(events)
+	if len(kicks) != 1 || kicks[0].UserId != uid || len(kicks[0].SessionIds) != 1 || kicks[0].SessionIds[0] != sid {
+		t.Fatalf("退出设备 kicks = %v, want session %s of user %s", kicks, sid, uid)
+	}
+
+	if _, err := NewRevokeAllUserSessionsLogic(context.Background(), svcCtx).RevokeAllUserSessions(&super.RevokeAllUserSessionsReq{
+		UserId: uid,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	kicks = drainKicks(events)
+	if len(kicks) != 1 || kicks[0].UserId != uid || len(kicks[0].SessionIds) != 0 {
+		t.Fatalf("退出所有设备 kicks = %v, want every session of user %s", kicks, uid)
+	}
+}
@@ -0,0 +1,174 @@
+package logic
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// 同一账号：统计上次登录成功（或重置密码）之后、这段时间内的失败次数
+	loginAccountWindow = 24 * time.Hour
+	// 同一 IP：统计这段时间内的失败次数（不分账号，含不存在的账号）
+	loginIPWindow = time.Hour
+	// 失败达到这么多次后要求人机验证
+	loginAccountCaptchaAfter = 3
+	loginIPCaptchaAfter      = 10
+	// 失败达到这么多次后开始锁定，之后每多失败一次锁定时长翻倍
+	loginAccountLockAfter = 5
+	loginIPLockAfter      = 20
+	loginLockBase         = time.Minute
+	loginLockMax          = time.Hour
+	// 安全事件保留时长（登录时顺带清理）
+	securityEventRetention = 180 * 24 * time.Hour
+)
+
+// loginFailures 登录失败统计：次数与最近一次失败的时间
+type loginFailures struct {
+	count int
+	last  time.Time
+}
+
+// lockRemaining 失败次数达到 lockAfter 后按指数退避锁定，返回剩余锁定时长
+func (f loginFailures) lockRemaining(lockAfter int, now time.Time) time.Duration {
+	if f.count < lockAfter {
+		return 0
+	}
+	d := loginLockMax
+	if shift := f.count - lockAfter; shift < 6 {
+		d = min(loginLockBase<<shift, loginLockMax)
+	}
+	if remain := f.last.Add(d).Sub(now); remain > 0 {
+		return remain
+	}
+	return 0
+}
+
+// countLoginFailures 失败次数与最近一次失败的时间（按索引取最新一条，不依赖驱动对 MAX 结果的类型推断）
+func countLoginFailures(q *gorm.DB) (loginFailures, error) {
+	q = q.Model(&model.SecurityEvent{}).Where("kind = ?", model.SecurityEventLoginFailure)
+	var count int64
+	if err := q.Session(&gorm.Session{}).Count(&count).Error; err != nil || count == 0 {
+		return loginFailures{}, err
+	}
+	var last model.SecurityEvent
+	if err := q.Select("created_at").Order("created_at desc").Limit(1).Find(&last).Error; err != nil {
+		return loginFailures{}, err
+	}
+	return loginFailures{count: int(count), last: last.CreatedAt}, nil
+}
+
+// accountLoginFailures 账号自上次登录成功或重置密码以来的失败次数（最多回溯 loginAccountWindow）
+func accountLoginFailures(db *gorm.DB, userID uint, now time.Time) (loginFailures, error) {
+	since := now.Add(-loginAccountWindow)
+	var last model.SecurityEvent
+	err := db.Where("user_id = ? AND kind IN ? AND created_at > ?", userID,
+		[]string{model.SecurityEventLoginSuccess, model.SecurityEventPasswordReset}, since).
+		Order("created_at desc").Limit(1).Find(&last).Error
+	if err != nil {
+		return loginFailures{}, err
+	}
+	if last.ID != 0 {
+		since = last.CreatedAt
+	}
+	return countLoginFailures(db.Where("user_id = ? AND created_at > ?", userID, since))
+}
+
+// ipLoginFailures IP 最近 loginIPWindow 内的失败次数
+func ipLoginFailures(db *gorm.DB, ip string, now time.Time) (loginFailures, error) {
+	if ip == "" {
+		return loginFailures{}, nil
+	}
+	return countLoginFailures(db.Where("client_ip = ? AND created_at > ?", ip, now.Add(-loginIPWindow)))
+}
+
+// lockLoginAccount 在事务中锁住账号的登录行锁（不存在时先创建）。持有期间统计失败次数、校验并记录失败，
+// 同一账号的其他登录尝试要等事务结束，看到的失败次数因此总是包含之前的尝试
+func lockLoginAccount(tx *gorm.DB, userID uint) error {
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.LoginLock{UserID: userID}).Error; err != nil {
+		return err
+	}
+	var lock model.LoginLock
+	return tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("user_id = ?", userID).First(&lock).Error
+}
+
+// loginGuard 本次登录前的限制状态
+type loginGuard struct {
+	captcha bool
+	retry   time.Duration
+}
+
+func newLoginGuard(account, ip loginFailures, now time.Time) loginGuard {
+	return loginGuard{
+		captcha: account.count >= loginAccountCaptchaAfter || ip.count >= loginIPCaptchaAfter,
+		retry:   max(account.lockRemaining(loginAccountLockAfter, now), ip.lockRemaining(loginIPLockAfter, now)),
+	}
+}
+
+// withLoginInfo 在登录错误中附带人机验证要求与锁定剩余时间，供 API 层返回给客户端
+func (g loginGuard) withLoginInfo(err error) error {
+	if !g.captcha && g.retry <= 0 {
+		return err
+	}
+	md := map[string]string{utils.LoginInfoCaptchaRequired: strconv.FormatBool(g.captcha)}
+	if g.retry > 0 {
+		md[utils.LoginInfoRetryAfter] = strconv.FormatInt(int64((g.retry+time.Second-1)/time.Second), 10)
+	}
+	return errorx.WithInfo(err, utils.LoginThrottledReason, md)
+}
+
+// lockedError 锁定期间的登录错误
+func (g loginGuard) lockedError() error {
+	minutes := int((g.retry + time.Minute - 1) / time.Minute)
+	return g.withLoginInfo(errorx.TooManyRequests(fmt.Sprintf("登录失败次数过多，请 %d 分钟后再试", minutes)))
+}
+
+// recordSecurityEvent 写入安全事件；调用方在写入失败时只记录日志，不影响主流程
+func recordSecurityEvent(db *gorm.DB, userID uint, kind, clientIP, userAgent, detail string) error {
+	return db.Create(&model.SecurityEvent{
+		UserID:    userID,
+		Kind:      kind,
+		ClientIP:  clientIP,
+		UserAgent: truncateUserAgent(userAgent),
+		Detail:    detail,
+	}).Error
+}
+
+// isNewDevice 用户以前登录成功过，但没有用过这个 User-Agent（首次登录不算新设备）
+func isNewDevice(db *gorm.DB, userID uint, userAgent string) (bool, error) {
+	q := db.Model(&model.SecurityEvent{}).Where("user_id = ? AND kind = ?", userID, model.SecurityEventLoginSuccess)
+	var logins, same int64
+	if err := q.Session(&gorm.Session{}).Count(&logins).Error; err != nil || logins == 0 {
+		return false, err
+	}
+	if err := q.Where("user_agent = ?", truncateUserAgent(userAgent)).Count(&same).Error; err != nil {
+		return false, err
+	}
+	return same == 0, nil
+}
+
+// purgeSecurityEvents 清理用户过期的安全事件，以及不存在账号的登录失败记录
+func purgeSecurityEvents(db *gorm.DB, userID uint) error {
+	cutoff := time.Now().Add(-securityEventRetention)
+	return db.Where("user_id IN ? AND created_at < ?", []uint{0, userID}, cutoff).
+		Delete(&model.SecurityEvent{}).Error
+}
+
+func modelSecurityEventToProto(e *model.SecurityEvent) *super.SecurityEvent {
+	return &super.SecurityEvent{
+		Id:        strconv.FormatUint(uint64(e.ID), 10),
+		Kind:      e.Kind,
+		ClientIp:  e.ClientIP,
+		UserAgent: e.UserAgent,
+		Detail:    e.Detail,
+		CreatedAt: e.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
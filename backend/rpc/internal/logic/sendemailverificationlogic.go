@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+type SendEmailVerificationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSendEmailVerificationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendEmailVerificationLogic {
+	return &SendEmailVerificationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 重新发送验证邮件：有待验证的新邮箱时验证新邮箱，否则验证当前邮箱
+func (l *SendEmailVerificationLogic) SendEmailVerification(in *super.SendEmailVerificationReq) (*super.SendEmailVerificationResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+
+	var user model.User
+	if err := l.svcCtx.DB.WithContext(l.ctx).First(&user, userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("用户不存在")
+		}
+		l.Error("查找用户失败: ", err)
+		return nil, errorx.Internal("服务器内部错误")
+	}
+
+	email, purpose := user.PendingEmail, model.EmailVerifyPurposeChange
+	if email == "" {
+		if user.EmailVerifiedAt != nil {
+			return nil, errorx.InvalidArgument("邮箱已验证")
+		}
+		email, purpose = normalizeEmail(user.Email), model.EmailVerifyPurposeRegister
+	}
+	if err := sendEmailVerification(l.ctx, l.svcCtx, &user, email, purpose, in.Lang); err != nil {
+		return nil, err
+	}
+	return &super.SendEmailVerificationResp{Email: email}, nil
+}
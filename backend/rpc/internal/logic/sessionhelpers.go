@@ -0,0 +1,142 @@
+package logic
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// 刷新令牌有效期：每次轮换顺延，超过这么久未使用需重新登录
+	refreshTokenTTL = 30 * 24 * time.Hour
+	// 已结束的会话保留这么久后清理（含其刷新令牌记录）
+	sessionRetention = 30 * 24 * time.Hour
+	// 会话记录中 User-Agent 的最大长度（字符，与列宽一致）
+	sessionUserAgentMaxLen = 255
+)
+
+// newRefreshToken 随机生成不透明的刷新令牌（32 字节，base64url）
+func newRefreshToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// issueRefreshToken 为会话签发一个新的刷新令牌，只保存哈希
+func issueRefreshToken(tx *gorm.DB, sessionID uint) (string, error) {
+	token, err := newRefreshToken()
+	if err != nil {
+		return "", err
+	}
+	if err := tx.Create(&model.RefreshToken{SessionID: sessionID, TokenHash: sha256Hex(token)}).Error; err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// truncateUserAgent 按字符截断过长的 User-Agent
+func truncateUserAgent(ua string) string {
+	if r := []rune(ua); len(r) > sessionUserAgentMaxLen {
+		return string(r[:sessionUserAgentMaxLen])
+	}
+	return ua
+}
+
+// startSession 登录成功后创建会话并签发第一个刷新令牌
+func startSession(db *gorm.DB, userID uint, clientIP, userAgent string) (*model.UserSession, string, error) {
+	now := time.Now()
+	session := model.UserSession{
+		UserID:     userID,
+		UserAgent:  truncateUserAgent(userAgent),
+		ClientIP:   clientIP,
+		LastUsedAt: now,
+		ExpiresAt:  now.Add(refreshTokenTTL),
+	}
+	var refresh string
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&session).Error; err != nil {
+			return err
+		}
+		var err error
+		refresh, err = issueRefreshToken(tx, session.ID)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return &session, refresh, nil
+}
+
+// revokeSessions 撤销用户的会话（不传 sessionIDs 时撤销全部有效会话），返回被撤销的会话 ID
+func revokeSessions(tx *gorm.DB, userID uint, reason string, sessionIDs ...uint) ([]uint, error) {
+	q := tx.Model(&model.UserSession{}).Where("user_id = ? AND revoked_at IS NULL", userID)
+	if len(sessionIDs) > 0 {
+		q = q.Where("id IN ?", sessionIDs)
+	}
+	var ids []uint
+	if err := q.Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	err := tx.Model(&model.UserSession{}).Where("id IN ?", ids).
+		Updates(map[string]interface{}{"revoked_at": time.Now(), "revoke_reason": reason}).Error
+	return ids, err
+}
+
+// kickSessions 会话撤销提交后，经通知流广播给全部 API 实例：丢弃该用户缓存的登录状态，
+// 断开这些会话的 WebSocket 连接（不传 sessionIDs 时断开该用户的全部连接）
+func kickSessions(svcCtx *svc.ServiceContext, userID uint, sessionIDs ...uint) {
+	if svcCtx.NotificationHub == nil {
+		return
+	}
+	kick := &super.SessionKick{UserId: strconv.FormatUint(uint64(userID), 10)}
+	for _, id := range sessionIDs {
+		kick.SessionIds = append(kick.SessionIds, strconv.FormatUint(uint64(id), 10))
+	}
+	svcCtx.NotificationHub.Publish(&super.NotificationEvent{Kick: kick})
+}
+
+// purgeEndedSessions 清理用户早已过期或撤销的会话及其刷新令牌记录（登录时顺带执行）
+func purgeEndedSessions(db *gorm.DB, userID uint) error {
+	cutoff := time.Now().Add(-sessionRetention)
+	var ids []uint
+	if err := db.Model(&model.UserSession{}).
+		Where("user_id = ? AND (expires_at < ? OR revoked_at < ?)", userID, cutoff, cutoff).
+		Pluck("id", &ids).Error; err != nil || len(ids) == 0 {
+		return err
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("session_id IN ?", ids).Delete(&model.RefreshToken{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id IN ?", ids).Delete(&model.UserSession{}).Error
+	})
+}
+
+// accessTokenExpiresIn 访问令牌有效期（秒），随登录、刷新响应一起返回
+func accessTokenExpiresIn() int64 {
+	return int64(utils.AccessTokenTTL / time.Second)
+}
+
+func modelSessionToProto(s *model.UserSession) *super.UserSession {
+	return &super.UserSession{
+		Id:         strconv.FormatUint(uint64(s.ID), 10),
+		UserAgent:  s.UserAgent,
+		ClientIp:   s.ClientIP,
+		CreatedAt:  s.CreatedAt.Format("2006-01-02 15:04:05"),
+		LastUsedAt: s.LastUsedAt.Format("2006-01-02 15:04:05"),
+		ExpiresAt:  s.ExpiresAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"backend/model"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetMfaPolicyRespectsRoleRank(t *testing.T) {
+	cases := []struct {
+		name      string
+		actorRole string
+		role      string
+		required  bool
+		want      codes.Code
+	}{
+		{"管理员设置普通用户", utils.RoleAdmin, utils.RoleUser, true, codes.OK},
+		{"管理员不能放宽管理员", utils.RoleAdmin, utils.RoleAdmin, false, codes.PermissionDenied},
+		{"管理员不能放宽超级管理员", utils.RoleAdmin, utils.RoleSuperAdmin, false, codes.PermissionDenied},
+		{"超级管理员设置管理员", utils.RoleSuperAdmin, utils.RoleAdmin, false, codes.OK},
+		{"超级管理员放宽自己的角色", utils.RoleSuperAdmin, utils.RoleSuperAdmin, false, codes.OK},
+		{"超级管理员未绑定时不能要求自己的角色", utils.RoleSuperAdmin, utils.RoleSuperAdmin, true, codes.InvalidArgument},
+		{"普通用户没有权限", utils.RoleUser, utils.RoleUser, false, codes.PermissionDenied},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := testdb.New(t, &model.User{}, &model.MFAPolicy{}, &model.UserTOTP{})
+			actor := model.User{Username: "actor", Password: "password", Role: tc.actorRole}
+			if err := db.Create(&actor).Error; err != nil {
+				t.Fatal(err)
+			}
+			svcCtx := &svc.ServiceContext{DB: db}
+
+			_, err := NewSetMfaPolicyLogic(context.Background(), svcCtx).SetMfaPolicy(&super.SetMfaPolicyReq{
+				ActorUserId: strconv.FormatUint(uint64(actor.ID), 10),
+				Role:        tc.role,
+				Required:    tc.required,
+			})
+			if got := status.Code(err); got != tc.want {
+				t.Fatalf("code = %v (%v), want %v", got, err, tc.want)
+			}
+
+			var n int64
+			db.Model(&model.MFAPolicy{}).Where("role = ?", tc.role).Count(&n)
+			if saved := n > 0; saved != (tc.want == codes.OK) {
+				t.Fatalf("policy saved = %v, want %v", saved, tc.want == codes.OK)
+			}
+		})
+	}
+}
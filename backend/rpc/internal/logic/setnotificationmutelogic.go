@@ -0,0 +1,82 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm/clause"
+)
+
+type SetNotificationMuteLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetNotificationMuteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetNotificationMuteLogic {
+	return &SetNotificationMuteLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 屏蔽/取消屏蔽某条动态或某个用户引发的通知
+func (l *SetNotificationMuteLogic) SetNotificationMute(in *super.SetNotificationMuteReq) (*super.SetNotificationMuteResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+	targetID, err := strconv.ParseUint(in.TargetId, 10, 32)
+	if err != nil || targetID == 0 {
+		return nil, errorx.InvalidArgument("无效的目标ID")
+	}
+
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	if !in.Muted {
+		if err := db.Where("user_id = ? AND target_type = ? AND target_id = ?", userID, in.TargetType, targetID).
+			Delete(&model.NotificationMute{}).Error; err != nil {
+			l.Error("取消通知屏蔽失败:", err)
+			return nil, errorx.Internal("取消屏蔽失败")
+		}
+		return &super.SetNotificationMuteResp{}, nil
+	}
+
+	var count int64
+	switch in.TargetType {
+	case model.MuteTargetPost:
+		err = db.Model(&model.Post{}).Where("id = ?", targetID).Count(&count).Error
+	case model.MuteTargetUser:
+		if uint64(userID) == targetID {
+			return nil, errorx.InvalidArgument("不能屏蔽自己")
+		}
+		err = db.Model(&model.User{}).Where("id = ?", targetID).Count(&count).Error
+	default:
+		return nil, errorx.InvalidArgument("target_type 只能是 post 或 user")
+	}
+	if err != nil {
+		l.Error("查询屏蔽目标失败:", err)
+		return nil, errorx.Internal("屏蔽失败")
+	}
+	if count == 0 {
+		return nil, errorx.NotFound("屏蔽的对象不存在")
+	}
+
+	mute := model.NotificationMute{
+		UserID:     uint(userID),
+		TargetType: in.TargetType,
+		TargetID:   uint(targetID),
+	}
+	// 重复屏蔽视为成功
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&mute).Error; err != nil {
+		l.Error("保存通知屏蔽失败:", err)
+		return nil, errorx.Internal("屏蔽失败")
+	}
+	return &super.SetNotificationMuteResp{}, nil
+}
@@ -0,0 +1,28 @@
+package logic
+
+import (
+	"context"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type StoreEncryptedMessageLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewStoreEncryptedMessageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *StoreEncryptedMessageLogic {
+	return &StoreEncryptedMessageLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *StoreEncryptedMessageLogic) StoreEncryptedMessage(in *super.StoreEncryptedMessageReq) (*super.StoreEncryptedMessageResp, error) {
+	return NewE2eeLogic(l.ctx, l.svcCtx).StoreEncryptedMessage(in)
+}
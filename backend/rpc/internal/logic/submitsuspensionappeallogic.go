@@ -0,0 +1,104 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SubmitSuspensionAppealLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSubmitSuspensionAppealLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubmitSuspensionAppealLogic {
+	return &SubmitSuspensionAppealLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SubmitSuspensionAppeal 对生效中的处罚提交申诉。被限制的用户按 user_id 提交；
+// 被封禁的用户无法登录，用登录被拒时下发的 appeal_token 提交。每条处罚同时只能有一条待处理的申诉
+func (l *SubmitSuspensionAppealLogic) SubmitSuspensionAppeal(in *super.SubmitSuspensionAppealReq) (*super.SuspensionAppealResp, error) {
+	message := strings.TrimSpace(in.Message)
+	if message == "" {
+		return nil, errorx.InvalidArgument("请填写申诉内容")
+	}
+	if len([]rune(message)) > appealMessageMaxLen {
+		return nil, errorx.InvalidArgument(fmt.Sprintf("申诉内容不能超过%d个字符", appealMessageMaxLen))
+	}
+	token := strings.TrimSpace(in.AppealToken)
+	var userID uint64
+	if token == "" {
+		var err error
+		if userID, err = strconv.ParseUint(in.UserId, 10, 32); err != nil || userID == 0 {
+			return nil, errorx.Unauthenticated("请先登录或使用申诉令牌")
+		}
+	}
+
+	now := time.Now()
+	var (
+		suspension model.UserSuspension
+		appeal     model.SuspensionAppeal
+	)
+	err := l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		q := activeSuspensionQuery(tx, now).Clauses(clause.Locking{Strength: "UPDATE"})
+		if token != "" {
+			q = q.Where("appeal_token_hash = ? AND appeal_token_expires_at > ?", sha256Hex(token), now)
+		} else {
+			q = q.Where("user_id = ?", userID).Order("id desc")
+		}
+		if err := q.First(&suspension).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				if token != "" {
+					return errorx.Unauthenticated("申诉令牌无效或已过期，请重新登录获取")
+				}
+				return errorx.NotFound("当前没有生效中的处罚")
+			}
+			return err
+		}
+
+		var pending int64
+		if err := tx.Model(&model.SuspensionAppeal{}).
+			Where("suspension_id = ? AND status = ?", suspension.ID, model.AppealStatusPending).
+			Count(&pending).Error; err != nil {
+			return err
+		}
+		if pending > 0 {
+			return errorx.AlreadyExists("申诉正在处理中，请耐心等待")
+		}
+		appeal = model.SuspensionAppeal{
+			SuspensionID: suspension.ID,
+			UserID:       suspension.UserID,
+			Message:      message,
+			Status:       model.AppealStatusPending,
+		}
+		return tx.Create(&appeal).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[处罚] 提交申诉失败 用户ID=%d 错误=%v", userID, err)
+		return nil, errorx.Internal("提交申诉失败")
+	}
+
+	l.Infof("[处罚] 提交申诉 用户ID=%d 处罚ID=%d 申诉ID=%d", suspension.UserID, suspension.ID, appeal.ID)
+	return &super.SuspensionAppealResp{Appeal: modelAppealToProto(&appeal, nil, &suspension)}, nil
+}
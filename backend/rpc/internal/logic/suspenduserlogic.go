@@ -0,0 +1,138 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/notify"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SuspendUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSuspendUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SuspendUserLogic {
+	return &SuspendUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SuspendUser 封禁或限制用户（替换生效中的旧处罚）。封禁时撤销全部登录会话并使已签发的令牌失效，
+// 并广播给全部 API 实例断开该用户的 WebSocket 连接
+func (l *SuspendUserLogic) SuspendUser(in *super.SuspendUserReq) (*super.SuspensionResp, error) {
+	actorID, err := requirePermission(l.ctx, l.svcCtx, in.ActorUserId, utils.PermUserModerate)
+	if err != nil {
+		return nil, err
+	}
+	if in.Kind != model.SuspensionKindSuspend && in.Kind != model.SuspensionKindRestrict {
+		return nil, errorx.InvalidArgument("处罚类型必须是 suspend 或 restrict")
+	}
+	reason := strings.TrimSpace(in.Reason)
+	if reason == "" {
+		return nil, errorx.InvalidArgument("请填写处罚原因")
+	}
+	if len([]rune(reason)) > suspensionReasonMaxLen {
+		return nil, errorx.InvalidArgument(fmt.Sprintf("处罚原因不能超过%d个字符", suspensionReasonMaxLen))
+	}
+	if in.DurationSeconds < 0 {
+		return nil, errorx.InvalidArgument("处罚时长不能为负数")
+	}
+	targetID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil || targetID == 0 {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+	if uint(targetID) == actorID {
+		return nil, errorx.InvalidArgument("不能处罚自己")
+	}
+
+	now := time.Now()
+	suspension := model.UserSuspension{
+		UserID:      uint(targetID),
+		Kind:        in.Kind,
+		Reason:      reason,
+		HideContent: in.HideContent,
+		CreatedBy:   actorID,
+	}
+	if in.DurationSeconds > 0 {
+		expires := now.Add(time.Duration(in.DurationSeconds) * time.Second)
+		suspension.ExpiresAt = &expires
+	}
+
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		var actor, user model.User
+		if err := tx.Select("id", "role").First(&actor, actorID).Error; err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "role").First(&user, targetID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errorx.NotFound("用户不存在")
+			}
+			return err
+		}
+		if utils.RoleRank(user.Role) >= utils.RoleRank(actor.Role) {
+			return errorx.New(403, "不能处罚同级或更高级别的管理员")
+		}
+
+		if err := activeSuspensionQuery(tx, now).Where("user_id = ?", user.ID).Updates(map[string]interface{}{
+			"lifted_at":   now,
+			"lifted_by":   actorID,
+			"lift_reason": "被新的处罚替换",
+		}).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&suspension).Error; err != nil {
+			return err
+		}
+		if in.Kind != model.SuspensionKindSuspend {
+			return nil
+		}
+		if _, err := revokeSessions(tx, user.ID, model.SessionRevokeSuspended); err != nil {
+			return err
+		}
+		// 旧版令牌不带会话，提升令牌版本使其一并失效
+		return tx.Model(&model.User{}).Where("id = ?", user.ID).
+			UpdateColumn("token_version", gorm.Expr("token_version + 1")).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[处罚] 处罚用户失败 操作者ID=%d 用户ID=%d 错误=%v", actorID, targetID, err)
+		return nil, errorx.Internal("处罚失败")
+	}
+
+	if in.Kind == model.SuspensionKindSuspend {
+		kickSessions(l.svcCtx, suspension.UserID)
+	}
+	content := "你的账号已被限制，暂时只能浏览，原因：" + reason
+	if in.Kind == model.SuspensionKindSuspend {
+		content = "你的账号已被封禁，原因：" + reason
+	}
+	if suspension.ExpiresAt != nil {
+		content += "。到期时间：" + formatOptionalTime(suspension.ExpiresAt)
+	}
+	if _, err := l.svcCtx.Notifier.Notify(l.ctx, notify.KindSystem, suspension.UserID, actorID, notify.Payload{Content: content}); err != nil {
+		l.Errorf("[处罚] 创建处罚通知失败 用户ID=%d 错误=%v", suspension.UserID, err)
+	}
+	l.Infof("[处罚] 处罚用户 操作者ID=%d 用户ID=%d 类型=%s 到期=%s 隐藏内容=%v 原因=%q",
+		actorID, targetID, in.Kind, formatOptionalTime(suspension.ExpiresAt), in.HideContent, reason)
+	return &super.SuspensionResp{Suspension: modelSuspensionToProto(&suspension)}, nil
+}
@@ -0,0 +1,80 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"backend/model"
+	"backend/rpc/internal/notify"
+	"backend/rpc/internal/notifyhub"
+	"backend/rpc/internal/svc"
+	"backend/rpc/internal/testdb"
+	"backend/rpc/pb/super"
+	"backend/utils"
+)
+
+// drainKicks 取出已发布的全部事件中的会话撤销事件
+func drainKicks(events <-chan *super.NotificationEvent) []*super.SessionKick {
+	var kicks []*super.SessionKick
+	for {
+		select {
+		case ev := <-events:
+			if k := ev.GetKick(); k != nil {
+				kicks = append(kicks, k)
+			}
+		default:
+			return kicks
+		}
+	}
+}
+
+// 封禁经通知流广播会话撤销事件，让每个 API 实例断开该用户的连接；限制不断开连接
+func TestSuspendUserBroadcastsSessionKick(t *testing.T) {
+	cases := []struct {
+		kind     string
+		wantKick bool
+	}{
+		{model.SuspensionKindSuspend, true},
+		{model.SuspensionKindRestrict, false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.kind, func(t *testing.T) {
+			db := testdb.New(t, &model.User{}, &model.UserSuspension{}, &model.UserSession{},
+				&model.Notification{}, &model.NotificationActor{}, &model.NotificationPreference{},
+				&model.NotificationMute{}, &model.UserBlock{}, &model.MFAPolicy{}, &model.UserTOTP{})
+			admin := model.User{Username: "admin", Password: "password", Role: utils.RoleAdmin, Email: "admin@example.com", MoeNo: "1000000001"}
+			target := model.User{Username: "target", Password: "password", Email: "target@example.com", MoeNo: "1000000002"}
+			for _, u := range []*model.User{&admin, &target} {
+				if err := db.Create(u).Error; err != nil {
+					t.Fatal(err)
+				}
+			}
+			hub := notifyhub.NewHub()
+			events, cancel := hub.Subscribe()
+			defer cancel()
+			svcCtx := &svc.ServiceContext{DB: db, NotificationHub: hub, Notifier: notify.NewService(db, hub, nil)}
+
+			_, err := NewSuspendUserLogic(context.Background(), svcCtx).SuspendUser(&super.SuspendUserReq{
+				ActorUserId: strconv.FormatUint(uint64(admin.ID), 10),
+				UserId:      strconv.FormatUint(uint64(target.ID), 10),
+				Kind:        tc.kind,
+				Reason:      "spam",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			kicks := drainKicks(events)
+			if !tc.wantKick {
+				if len(kicks) != 0 {
+					t.Fatalf("kicks = %v, want none", kicks)
+				}
+				return
+			}
+			if len(kicks) != 1 || kicks[0].UserId != strconv.FormatUint(uint64(target.ID), 10) || len(kicks[0].SessionIds) != 0 {
+				t.Fatalf("kicks = %v, want one kick of every session of user %d", kicks, target.ID)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package logic
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// 被封禁用户登录时下发的申诉令牌有效期
+	appealTokenTTL = 24 * time.Hour
+	// 处罚原因、申诉内容的最大长度（字符）
+	suspensionReasonMaxLen = 500
+	appealMessageMaxLen    = 2000
+)
+
+// activeSuspensionQuery 生效中的处罚：未解除且未到期
+func activeSuspensionQuery(db *gorm.DB, now time.Time) *gorm.DB {
+	return db.Model(&model.UserSuspension{}).
+		Where("lifted_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", now)
+}
+
+// activeSuspension 用户当前生效中的处罚；没有时返回 nil
+func activeSuspension(db *gorm.DB, userID uint) (*model.UserSuspension, error) {
+	var list []model.UserSuspension
+	err := activeSuspensionQuery(db, time.Now()).Where("user_id = ?", userID).
+		Order("id desc").Limit(1).Find(&list).Error
+	if err != nil || len(list) == 0 {
+		return nil, err
+	}
+	return &list[0], nil
+}
+
+// checkCanPublish 被封禁或限制的用户不能发动态、评论、私信
+func checkCanPublish(db *gorm.DB, userID uint) error {
+	s, err := activeSuspension(db, userID)
+	if err != nil || s == nil {
+		return err
+	}
+	if s.Kind == model.SuspensionKindSuspend {
+		return errorx.New(403, "账号已被封禁")
+	}
+	return errorx.New(403, "账号处于限制状态，暂时只能浏览")
+}
+
+// hiddenContentScope 列表查询中排除处罚期间被隐藏内容的用户（viewer 本人的内容仍可见），column 为作者 ID 列
+func hiddenContentScope(viewer uint, column string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		hidden := activeSuspensionQuery(db.Session(&gorm.Session{NewDB: true}), time.Now()).
+			Select("user_id").Where("hide_content = ?", true)
+		if viewer != 0 {
+			hidden = hidden.Where("user_id <> ?", viewer)
+		}
+		return db.Where(column+" NOT IN (?)", hidden)
+	}
+}
+
+// contentHidden author 的内容是否对 viewer 隐藏（本人仍可见）
+func contentHidden(db *gorm.DB, author, viewer uint) (bool, error) {
+	if author == viewer {
+		return false, nil
+	}
+	var n int64
+	err := activeSuspensionQuery(db, time.Now()).
+		Where("user_id = ? AND hide_content = ?", author, true).Count(&n).Error
+	return n > 0, err
+}
+
+// suspendedLoginError 被封禁用户的登录错误：附带处罚原因、到期时间，并签发申诉令牌
+func suspendedLoginError(db *gorm.DB, s *model.UserSuspension) error {
+	msg := "账号已被永久封禁"
+	md := map[string]string{
+		utils.SuspensionInfoReason:    s.Reason,
+		utils.SuspensionInfoExpiresAt: formatOptionalTime(s.ExpiresAt),
+	}
+	if s.ExpiresAt != nil {
+		msg = fmt.Sprintf("账号已被封禁至 %s", s.ExpiresAt.Format("2006-01-02 15:04:05"))
+	}
+	// 申诉令牌生成失败时仍然拒绝登录，只是不附带令牌
+	if token, err := newRefreshToken(); err == nil {
+		expires := time.Now().Add(appealTokenTTL)
+		err = db.Model(&model.UserSuspension{}).Where("id = ?", s.ID).Updates(map[string]interface{}{
+			"appeal_token_hash":       sha256Hex(token),
+			"appeal_token_expires_at": expires,
+		}).Error
+		if err == nil {
+			md[utils.SuspensionInfoAppealToken] = token
+			md[utils.SuspensionInfoAppealDeadline] = expires.Format("2006-01-02 15:04:05")
+		}
+	}
+	return errorx.WithInfo(errorx.New(403, msg), utils.AccountSuspendedReason, md)
+}
+
+func modelSuspensionToProto(s *model.UserSuspension) *super.Suspension {
+	if s == nil {
+		return nil
+	}
+	return &super.Suspension{
+		Id:          strconv.FormatUint(uint64(s.ID), 10),
+		UserId:      strconv.FormatUint(uint64(s.UserID), 10),
+		Kind:        s.Kind,
+		Reason:      s.Reason,
+		HideContent: s.HideContent,
+		ExpiresAt:   formatOptionalTime(s.ExpiresAt),
+		CreatedBy:   strconv.FormatUint(uint64(s.CreatedBy), 10),
+		CreatedAt:   s.CreatedAt.Format("2006-01-02 15:04:05"),
+		LiftedAt:    formatOptionalTime(s.LiftedAt),
+		LiftReason:  s.LiftReason,
+		Active:      s.Active(time.Now()),
+	}
+}
+
+func modelAppealToProto(a *model.SuspensionAppeal, user *model.User, s *model.UserSuspension) *super.SuspensionAppeal {
+	out := &super.SuspensionAppeal{
+		Id:           strconv.FormatUint(uint64(a.ID), 10),
+		SuspensionId: strconv.FormatUint(uint64(a.SuspensionID), 10),
+		Message:      a.Message,
+		Status:       a.Status,
+		ReviewNote:   a.ReviewNote,
+		ReviewedAt:   formatOptionalTime(a.ReviewedAt),
+		CreatedAt:    a.CreatedAt.Format("2006-01-02 15:04:05"),
+		Suspension:   modelSuspensionToProto(s),
+	}
+	if a.ReviewedBy != 0 {
+		out.ReviewedBy = strconv.FormatUint(uint64(a.ReviewedBy), 10)
+	}
+	if user != nil {
+		out.User = modelUserToProto(user)
+	}
+	return out
+}
+
+// liftSuspension 解除用户生效中的处罚（管理员解除、申诉通过），没有生效中的处罚时返回 NotFound
+func liftSuspension(db *gorm.DB, userID, actorID uint, reason string) (*model.UserSuspension, error) {
+	var s model.UserSuspension
+	err := db.Transaction(func(tx *gorm.DB) error {
+		err := activeSuspensionQuery(tx, time.Now()).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id = ?", userID).Order("id desc").First(&s).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorx.NotFound("该用户没有生效中的处罚")
+		}
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		s.LiftedAt, s.LiftedBy, s.LiftReason = &now, actorID, reason
+		return tx.Model(&s).Updates(map[string]interface{}{
+			"lifted_at":   now,
+			"lifted_by":   actorID,
+			"lift_reason": reason,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
@@ -0,0 +1,41 @@
+package logic
+
+import (
+	"context"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnblockUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnblockUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnblockUserLogic {
+	return &UnblockUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *UnblockUserLogic) UnblockUser(in *super.BlockUserReq) (*super.BlockUserResp, error) {
+	me, target, err := parseBlockPair(in)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.svcCtx.DB.WithContext(l.ctx).
+		Where("blocker_id = ? AND blocked_id = ?", me, target).
+		Delete(&model.UserBlock{}).Error; err != nil {
+		l.Errorf("[拉黑] 取消拉黑失败 用户ID=%d 对方=%d 错误=%v", me, target, err)
+		return nil, errorx.Internal("取消拉黑失败")
+	}
+	// 被拉黑时删除的关注与好友关系不会恢复
+	return &super.BlockUserResp{Ok: true}, nil
+}
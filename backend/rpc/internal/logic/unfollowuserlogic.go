@@ -48,6 +48,12 @@ func (l *UnfollowUserLogic) UnfollowUser(in *super.UnfollowUserReq) (*super.Foll
 		return nil, result.Error
 	}
 	
+	// 对方是私密账号时同时撤回未批准的关注请求
+	if err := l.svcCtx.DB.Where("follower_id = ? AND following_id = ?", followerID, followingID).Delete(&model.FollowRequest{}).Error; err != nil {
+		l.Error("撤回关注请求失败:", err)
+		return nil, err
+	}
+
 	l.Debug("取消关注成功:", followerID, "取消关注了", followingID)
 	
 	return &super.FollowUserResp{
@@ -0,0 +1,86 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UnlinkUserIdentityLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnlinkUserIdentityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnlinkUserIdentityLogic {
+	return &UnlinkUserIdentityLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UnlinkUserIdentity 解绑第三方账号；没有设置密码时不能解绑最后一个，否则账号将无法登录
+func (l *UnlinkUserIdentityLogic) UnlinkUserIdentity(in *super.UnlinkUserIdentityReq) (*super.UnlinkUserIdentityResp, error) {
+	actorID, err := parseActorUint(in.ActorUserId)
+	if err != nil || actorID == 0 {
+		return nil, errorx.Unauthenticated("请先登录")
+	}
+	identityID, err := strconv.ParseUint(in.IdentityId, 10, 32)
+	if err != nil || identityID == 0 {
+		return nil, errorx.InvalidArgument("无效的绑定ID")
+	}
+	db := l.svcCtx.DB
+	var identity model.UserIdentity
+	err = db.Transaction(func(tx *gorm.DB) error {
+		// 锁住用户行，避免并发解绑两个账号时都通过“不是最后一个”的检查
+		var user model.User
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "password").First(&user, actorID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorx.NotFound("用户不存在")
+		}
+		if err != nil {
+			return err
+		}
+		err = tx.Where("id = ? AND user_id = ?", identityID, actorID).First(&identity).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorx.NotFound("绑定的第三方账号不存在")
+		}
+		if err != nil {
+			return err
+		}
+		if user.Password == "" {
+			var n int64
+			if err := tx.Model(&model.UserIdentity{}).Where("user_id = ?", actorID).Count(&n).Error; err != nil {
+				return err
+			}
+			if n <= 1 {
+				return errorx.New(400, "账号尚未设置密码，解绑后将无法登录，请先通过找回密码设置密码")
+			}
+		}
+		return tx.Delete(&identity).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[第三方登录] 解绑失败 用户ID=%d 绑定ID=%d 错误=%v", actorID, identityID, err)
+		return nil, errorx.Internal("解绑失败，请稍后重试")
+	}
+
+	if err := recordSecurityEvent(db, actorID, model.SecurityEventIdentityUnlink, in.ClientIp, in.UserAgent, identity.Provider); err != nil {
+		l.Errorf("[第三方登录] 记录解绑事件失败 用户ID=%d 错误=%v", actorID, err)
+	}
+	l.Infof("[第三方登录] 解绑成功 用户ID=%d 提供方=%s", actorID, identity.Provider)
+	return &super.UnlinkUserIdentityResp{}, nil
+}
@@ -0,0 +1,48 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnregisterDeviceLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnregisterDeviceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnregisterDeviceLogic {
+	return &UnregisterDeviceLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 注销设备（退出登录时调用）；令牌不存在或属于其他用户时视为成功
+func (l *UnregisterDeviceLogic) UnregisterDevice(in *super.UnregisterDeviceReq) (*super.UnregisterDeviceResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+	token := strings.TrimSpace(in.Token)
+	if token == "" {
+		return nil, errorx.InvalidArgument("无效的设备令牌")
+	}
+
+	if err := l.svcCtx.DB.WithContext(l.ctx).
+		Where("user_id = ? AND token = ?", userID, token).
+		Delete(&model.UserDevice{}).Error; err != nil {
+		l.Error("注销设备失败:", err)
+		return nil, errorx.Internal("注销设备失败")
+	}
+	return &super.UnregisterDeviceResp{}, nil
+}
@@ -0,0 +1,81 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/notify"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateNotificationPreferencesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateNotificationPreferencesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateNotificationPreferencesLogic {
+	return &UpdateNotificationPreferencesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *UpdateNotificationPreferencesLogic) UpdateNotificationPreferences(in *super.UpdateNotificationPreferencesReq) (*super.NotificationPreferencesResp, error) {
+	userID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	prefs, err := notify.LoadPreferences(db, uint(userID))
+	if err != nil {
+		l.Error("查询通知偏好失败:", err)
+		return nil, errorx.Internal("查询通知偏好失败")
+	}
+
+	// 只覆盖请求中出现的部分
+	for _, k := range in.Kinds {
+		if notify.ByName(k.Kind) == nil {
+			return nil, errorx.InvalidArgument("未知的通知类型: " + k.Kind)
+		}
+		prefs.Channels[k.Kind] = notify.Channels{InApp: k.InApp, Push: k.Push, Email: k.Email}
+	}
+	if in.Timezone != "" {
+		if _, err := time.LoadLocation(in.Timezone); err != nil {
+			return nil, errorx.InvalidArgument("无效的时区: " + in.Timezone)
+		}
+		prefs.Timezone = in.Timezone
+	}
+	if q := in.QuietHours; q != nil {
+		if q.Enabled {
+			if _, err := notify.ParseClock(q.Start); err != nil {
+				return nil, errorx.InvalidArgument("免打扰开始" + err.Error())
+			}
+			if _, err := notify.ParseClock(q.End); err != nil {
+				return nil, errorx.InvalidArgument("免打扰结束" + err.Error())
+			}
+		}
+		prefs.QuietEnabled = q.Enabled
+		prefs.QuietStart = q.Start
+		prefs.QuietEnd = q.End
+	}
+
+	if err := notify.SavePreferences(db, uint(userID), prefs); err != nil {
+		l.Error("保存通知偏好失败:", err)
+		return nil, errorx.Internal("保存通知偏好失败")
+	}
+
+	out, err := loadNotificationPreferences(l.ctx, l.svcCtx, uint(userID))
+	if err != nil {
+		l.Error("查询通知偏好失败:", err)
+		return nil, errorx.Internal("查询通知偏好失败")
+	}
+	return &super.NotificationPreferencesResp{Preferences: out}, nil
+}
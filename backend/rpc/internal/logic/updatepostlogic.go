@@ -0,0 +1,171 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UpdatePostLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdatePostLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdatePostLogic {
+	return &UpdatePostLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UpdatePost 作者编辑帖子的文字、图片与话题；每次有改动时把旧版本存入编辑历史并标记为已编辑
+func (l *UpdatePostLogic) UpdatePost(in *super.UpdatePostReq) (*super.UpdatePostResp, error) {
+	postID, err := strconv.ParseUint(strings.TrimSpace(in.PostId), 10, 32)
+	if err != nil || postID == 0 {
+		return nil, errorx.InvalidArgument("无效的帖子ID")
+	}
+	userID, err := parseActorUint(in.UserId)
+	if err != nil || userID == 0 {
+		return nil, errorx.Unauthenticated("未登录")
+	}
+	// 被封禁或限制的账号不能编辑动态
+	if err := checkCanPublish(l.svcCtx.DB, userID); err != nil {
+		if _, ok := status.FromError(err); !ok {
+			l.Errorf("[帖子] 查询处罚失败 用户ID=%d 错误=%v", userID, err)
+			return nil, errorx.Internal("服务器内部错误")
+		}
+		return nil, err
+	}
+
+	images := make([]string, 0, len(in.Images))
+	for _, img := range in.Images {
+		if img = strings.TrimSpace(img); img != "" {
+			images = append(images, img)
+		}
+	}
+	imagesJSON := ""
+	if len(images) > 0 {
+		b, err := json.Marshal(images)
+		if err != nil {
+			return nil, errorx.Internal("服务器内部错误")
+		}
+		imagesJSON = string(b)
+	}
+	tags := normalizeTopicTags(in.TopicTags)
+
+	var (
+		post    model.Post
+		newTags []model.TopicTag
+	)
+	err = l.svcCtx.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&post, postID).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errorx.NotFound("帖子不存在")
+		}
+		if err != nil {
+			return err
+		}
+		if post.UserID != userID {
+			return errorx.New(403, "只能编辑自己的帖子")
+		}
+		if strings.TrimSpace(in.Content) == "" && len(images) == 0 && post.HandDrawCard == "" {
+			return errorx.InvalidArgument("请填写文字、上传图片或添加手绘卡片")
+		}
+		oldTags, err := postTopicTags(tx, post.ID)
+		if err != nil {
+			return err
+		}
+		oldNames := topicTagNames(oldTags)
+		if in.Content == post.Content && imagesJSON == post.Images && sameTopicNames(oldNames, topicTagNames(tags)) {
+			// 没有改动时不记历史，也不标记为已编辑
+			newTags = oldTags
+			return nil
+		}
+
+		oldNamesJSON, err := json.Marshal(oldNames)
+		if err != nil {
+			return err
+		}
+		if err := tx.Create(&model.PostRevision{
+			PostID:    post.ID,
+			EditorID:  userID,
+			Content:   post.Content,
+			Images:    post.Images,
+			TopicTags: string(oldNamesJSON),
+		}).Error; err != nil {
+			return err
+		}
+		now := time.Now()
+		if err := tx.Model(&post).Updates(map[string]interface{}{
+			"content":   in.Content,
+			"images":    imagesJSON,
+			"edited_at": now,
+		}).Error; err != nil {
+			return err
+		}
+		post.Content, post.Images, post.EditedAt = in.Content, imagesJSON, &now
+		newTags, err = replacePostTopics(tx, post.ID, tags)
+		return err
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[帖子] 编辑帖子失败 用户ID=%d 帖子ID=%d 错误=%v", userID, postID, err)
+		return nil, errorx.Internal("编辑帖子失败")
+	}
+
+	var user model.User
+	if err := l.svcCtx.DB.First(&user, userID).Error; err != nil {
+		l.Errorf("[帖子] 查询用户失败 用户ID=%d 错误=%v", userID, err)
+		return nil, errorx.Internal("服务器内部错误")
+	}
+	respTags := make([]*super.TopicTag, 0, len(newTags))
+	for _, tag := range newTags {
+		respTags = append(respTags, &super.TopicTag{
+			Id:    strconv.FormatUint(uint64(tag.ID), 10),
+			Name:  tag.Name,
+			Color: tag.Color,
+		})
+	}
+	var respImages []string
+	if post.Images != "" {
+		_ = json.Unmarshal([]byte(post.Images), &respImages)
+	}
+	liked := LikedTargetIDSet(l.svcCtx.DB, userID, "post", []uint{post.ID})
+	return &super.UpdatePostResp{
+		Post: &super.Post{
+			Id:               strconv.FormatUint(uint64(post.ID), 10),
+			UserId:           strconv.FormatUint(uint64(post.UserID), 10),
+			UserName:         user.Username,
+			UserAvatar:       user.Avatar,
+			Content:          post.Content,
+			Images:           respImages,
+			TopicTags:        respTags,
+			Likes:            int32(post.Likes),
+			Comments:         int32(post.Comments),
+			IsLiked:          liked[post.ID],
+			CreatedAt:        post.CreatedAt.Format("2006-01-02 15:04:05"),
+			HandDrawCard:     post.HandDrawCard,
+			HandDrawThumbUrl: post.HandDrawThumbURL,
+			ModerationStatus: moderationStatusOrDefault(post.ModerationStatus),
+			Edited:           post.EditedAt != nil,
+			EditedAt:         formatOptionalTime(post.EditedAt),
+		},
+	}, nil
+}
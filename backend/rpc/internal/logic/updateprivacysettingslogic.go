@@ -0,0 +1,93 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UpdatePrivacySettingsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdatePrivacySettingsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdatePrivacySettingsLogic {
+	return &UpdatePrivacySettingsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 整体替换隐私设置。私密账号改为公开时，待批准的关注请求全部自动通过
+func (l *UpdatePrivacySettingsLogic) UpdatePrivacySettings(in *super.UpdatePrivacySettingsReq) (*super.PrivacySettingsResp, error) {
+	db := l.svcCtx.DB.WithContext(l.ctx)
+	user, err := loadUserByID(db, in.UserId)
+	if err != nil {
+		return nil, err
+	}
+	want := in.GetSettings()
+	if want == nil {
+		want = &super.PrivacySettings{}
+	}
+	next := model.DefaultPrivacySettings(user.ID)
+	next.PrivateAccount = want.PrivateAccount
+	next.HideFollowLists = want.HideFollowLists
+	if v := strings.TrimSpace(want.DmPermission); v != "" {
+		next.DMPermission = v
+	}
+	if v := strings.TrimSpace(want.CommentPermission); v != "" {
+		next.CommentPermission = v
+	}
+	if !model.ValidAudience(next.DMPermission) || !model.ValidAudience(next.CommentPermission) {
+		return nil, errorx.InvalidArgument("权限只能是 everyone、followers、friends 或 nobody")
+	}
+
+	approved := 0
+	err = db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id").First(&model.User{}, user.ID).Error; err != nil {
+			return err
+		}
+		prev, err := loadPrivacySettings(tx, user.ID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"private_account", "dm_permission", "comment_permission", "hide_follow_lists", "updated_at"}),
+		}).Create(&next).Error; err != nil {
+			return err
+		}
+		if !prev.PrivateAccount || next.PrivateAccount {
+			return nil
+		}
+		var reqs []model.FollowRequest
+		if err := tx.Where("following_id = ?", user.ID).Find(&reqs).Error; err != nil {
+			return err
+		}
+		for _, r := range reqs {
+			if err := upsertFollow(tx, r.FollowerID, r.FollowingID); err != nil {
+				return err
+			}
+		}
+		approved = len(reqs)
+		return tx.Where("following_id = ?", user.ID).Delete(&model.FollowRequest{}).Error
+	})
+	if err != nil {
+		l.Errorf("[隐私] 保存隐私设置失败 用户ID=%d 错误=%v", user.ID, err)
+		return nil, errorx.Internal("保存隐私设置失败")
+	}
+
+	l.Infof("[隐私] 已更新隐私设置 用户ID=%d 私密账号=%v 私信=%s 评论=%s 隐藏关注列表=%v 自动通过关注请求=%d",
+		user.ID, next.PrivateAccount, next.DMPermission, next.CommentPermission, next.HideFollowLists, approved)
+	return &super.PrivacySettingsResp{Settings: modelPrivacySettingsToProto(&next)}, nil
+}
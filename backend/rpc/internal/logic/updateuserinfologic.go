@@ -2,6 +2,7 @@ package logic
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"backend/model"
@@ -40,8 +41,31 @@ func (l *UpdateUserInfoLogic) UpdateUserInfo(in *super.UpdateUserInfoReq) (*supe
 	if in.Username != "" {
 		user.Username = in.Username
 	}
-	if in.Email != "" {
-		user.Email = in.Email
+	// 修改邮箱：新邮箱先记为待验证并发送验证邮件，验证通过前仍使用原邮箱
+	pendingEmail := ""
+	cancelPending := false
+	if in.Email != "" && normalizeEmail(in.Email) == normalizeEmail(user.Email) {
+		// 改回原邮箱：取消尚未验证的修改
+		cancelPending = user.PendingEmail != ""
+	} else if in.Email != "" {
+		email := normalizeEmail(in.Email)
+		if !strings.Contains(email, "@") || len(email) > 100 {
+			return nil, errorx.InvalidArgument("邮箱格式不正确")
+		}
+		var count int64
+		if err := l.svcCtx.DB.Model(&model.User{}).
+			Where("id <> ? AND LOWER(TRIM(email)) = ?", user.ID, email).
+			Count(&count).Error; err != nil {
+			l.Error("检查邮箱失败: ", err)
+			return nil, errorx.Internal("更新用户信息失败，请稍后重试")
+		}
+		if count > 0 {
+			return nil, errorx.AlreadyExists("邮箱已被注册")
+		}
+		if err := sendEmailVerification(l.ctx, l.svcCtx, &user, email, model.EmailVerifyPurposeChange, ""); err != nil {
+			return nil, err
+		}
+		pendingEmail = email
 	}
 	// 更新头像：仅当传入非空字符串时才更新；去掉 host，只存 /api/images/... 或外链
 	if in.Avatar != "" {
@@ -104,8 +128,15 @@ func (l *UpdateUserInfoLogic) UpdateUserInfo(in *super.UpdateUserInfoReq) (*supe
 	if in.Username != "" {
 		updates["username"] = user.Username
 	}
-	if in.Email != "" {
-		updates["email"] = user.Email
+	if pendingEmail != "" {
+		updates["pending_email"] = pendingEmail
+	} else if cancelPending {
+		updates["pending_email"] = ""
+		if err := l.svcCtx.DB.Model(&model.EmailVerification{}).
+			Where("user_id = ? AND purpose = ? AND used_at IS NULL AND revoked_at IS NULL", user.ID, model.EmailVerifyPurposeChange).
+			Update("revoked_at", time.Now()).Error; err != nil {
+			l.Error("作废邮箱验证凭证失败: ", err)
+		}
 	}
 	if in.Avatar != "" {
 		updates["avatar"] = user.Avatar
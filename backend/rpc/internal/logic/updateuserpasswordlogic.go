@@ -46,6 +46,9 @@ func (l *UpdateUserPasswordLogic) UpdateUserPassword(in *super.UpdateUserPasswor
 		l.Error("更新密码失败: ", err)
 		return nil, errorx.Internal("更新密码失败，请稍后重试")
 	}
+	if err := recordSecurityEvent(l.svcCtx.DB, user.ID, model.SecurityEventPasswordChange, in.ClientIp, in.UserAgent, ""); err != nil {
+		l.Error("记录修改密码事件失败: ", err)
+	}
 
 	// 4. 构建响应
 	return &super.UpdateUserPasswordResp{}, nil
@@ -0,0 +1,106 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UpdateUserRoleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateUserRoleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateUserRoleLogic {
+	return &UpdateUserRoleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 授予或撤销角色：角色变更与审计记录在同一事务中写入。
+// 不允许修改自己的角色，因此至少会保留操作者这一名超级管理员
+func (l *UpdateUserRoleLogic) UpdateUserRole(in *super.UpdateUserRoleReq) (*super.UpdateUserRoleResp, error) {
+	actorID, err := requirePermission(l.ctx, l.svcCtx, in.ActorUserId, utils.PermRoleManage)
+	if err != nil {
+		return nil, err
+	}
+	if !utils.ValidRole(in.Role) {
+		return nil, errorx.InvalidArgument("角色必须是 user、admin 或 super_admin")
+	}
+	targetID, err := strconv.ParseUint(in.UserId, 10, 32)
+	if err != nil || targetID == 0 {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+	if uint(targetID) == actorID {
+		return nil, errorx.InvalidArgument("不能修改自己的角色")
+	}
+	reason := strings.TrimSpace(in.Reason)
+	if len([]rune(reason)) > 200 {
+		return nil, errorx.InvalidArgument("原因不能超过200个字符")
+	}
+
+	var previous string
+	err = l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		var user model.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Select("id", "role").First(&user, targetID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errorx.NotFound("用户不存在")
+			}
+			return err
+		}
+		previous = user.Role
+		if previous == "" {
+			previous = utils.RoleUser
+		}
+		if previous == in.Role {
+			return errorx.InvalidArgument("用户已经是该角色")
+		}
+		if err := tx.Model(&user).UpdateColumn("role", in.Role).Error; err != nil {
+			return err
+		}
+
+		action := model.RoleAuditGrant
+		if utils.RoleRank(in.Role) < utils.RoleRank(previous) {
+			action = model.RoleAuditRevoke
+		}
+		return tx.Create(&model.RoleAuditLog{
+			UserID:      user.ID,
+			ActorUserID: actorID,
+			Action:      action,
+			FromRole:    previous,
+			ToRole:      in.Role,
+			Reason:      reason,
+			ClientIP:    in.ClientIp,
+		}).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		l.Errorf("[权限] 修改角色失败 操作者ID=%d 用户ID=%d 错误=%v", actorID, targetID, err)
+		return nil, errorx.Internal("修改角色失败")
+	}
+
+	l.Infof("[权限] 修改角色 操作者ID=%d 用户ID=%d %s -> %s 原因=%q IP=%s",
+		actorID, targetID, previous, in.Role, reason, in.ClientIp)
+	return &super.UpdateUserRoleResp{
+		UserId:       in.UserId,
+		Role:         in.Role,
+		PreviousRole: previous,
+	}, nil
+}
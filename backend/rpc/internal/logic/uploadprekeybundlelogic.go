@@ -0,0 +1,29 @@
+package logic
+
+import (
+	"context"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UploadPreKeyBundleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUploadPreKeyBundleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UploadPreKeyBundleLogic {
+	return &UploadPreKeyBundleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 端到端加密（服务端只保存公钥与密文，不参与解密）
+func (l *UploadPreKeyBundleLogic) UploadPreKeyBundle(in *super.UploadPreKeyBundleReq) (*super.UploadPreKeyBundleResp, error) {
+	return NewE2eeLogic(l.ctx, l.svcCtx).UploadPreKeyBundle(in)
+}
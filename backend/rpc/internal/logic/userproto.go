@@ -1,10 +1,14 @@
 package logic
 
 import (
+	"errors"
 	"strconv"
 
 	"backend/model"
+	"backend/rpc/internal/errorx"
 	"backend/rpc/pb/super"
+
+	"gorm.io/gorm"
 )
 
 // modelUserToProto 将数据库用户转为 RPC User（含 moe_no）。
@@ -37,5 +41,23 @@ func modelUserToProto(user *model.User) *super.User {
 		Inventory:       user.Inventory,
 		EquippedFrameId: user.EquippedFrameId,
 		MoeNo:           user.MoeNo,
+		EmailVerified:   user.EmailVerifiedAt != nil,
+		PendingEmail:    user.PendingEmail,
+	}
+}
+
+// loadUserByID 按字符串用户 ID 加载用户，错误已转换为 gRPC 状态
+func loadUserByID(db *gorm.DB, userID string) (*model.User, error) {
+	id, err := strconv.ParseUint(userID, 10, 32)
+	if err != nil || id == 0 {
+		return nil, errorx.InvalidArgument("无效的用户ID")
+	}
+	var user model.User
+	if err := db.First(&user, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorx.NotFound("用户不存在")
+		}
+		return nil, errorx.Internal("查询用户失败")
 	}
+	return &user, nil
 }
@@ -0,0 +1,140 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/logutil"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type VerifyEmailLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewVerifyEmailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyEmailLogic {
+	return &VerifyEmailLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 用邮件中的验证码（user_id + code）或链接令牌（token）完成验证。
+// 注册验证标记当前邮箱已验证；修改邮箱验证把 Email 换成新邮箱并清空待验证邮箱
+func (l *VerifyEmailLogic) VerifyEmail(in *super.VerifyEmailReq) (*super.VerifyEmailResp, error) {
+	code := strings.TrimSpace(in.Code)
+	token := strings.TrimSpace(in.Token)
+	var userID uint64
+	if token == "" {
+		var err error
+		if userID, err = strconv.ParseUint(in.UserId, 10, 32); err != nil || code == "" {
+			return nil, errorx.InvalidArgument("请填写验证码")
+		}
+	}
+
+	invalid := errorx.InvalidArgument("验证码无效或已过期，请重新获取")
+	now := time.Now()
+	var user model.User
+	remaining := -1
+	err := quietSession(l.svcCtx.DB).WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		var v model.EmailVerification
+		q := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("used_at IS NULL AND revoked_at IS NULL AND expires_at > ?", now)
+		if token != "" {
+			q = q.Where("link_hash = ?", sha256Hex(token))
+		} else {
+			q = q.Where("user_id = ?", userID).Order("id desc")
+		}
+		if err := q.First(&v).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return invalid
+			}
+			return err
+		}
+
+		if token == "" {
+			if v.Attempts >= emailVerifyMaxAttempts {
+				return errorx.TooManyRequests("验证码错误次数过多，请重新获取")
+			}
+			if !oneTimeCodeMatches(v.CodeSalt, v.CodeHash, code) {
+				// 输错次数需要提交，不能随事务回滚
+				remaining = emailVerifyMaxAttempts - v.Attempts - 1
+				return tx.Model(&v).UpdateColumn("attempts", gorm.Expr("attempts + 1")).Error
+			}
+		}
+
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&user, v.UserID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return invalid
+			}
+			return err
+		}
+		updates := map[string]interface{}{"email_verified_at": now}
+		switch v.Purpose {
+		case model.EmailVerifyPurposeChange:
+			// 修改被取消或已改为其他邮箱
+			if normalizeEmail(user.PendingEmail) != v.Email {
+				return invalid
+			}
+			var count int64
+			if err := tx.Model(&model.User{}).
+				Where("id <> ? AND LOWER(TRIM(email)) = ?", user.ID, v.Email).
+				Count(&count).Error; err != nil {
+				return err
+			}
+			if count > 0 {
+				return errorx.AlreadyExists("邮箱已被注册")
+			}
+			updates["email"] = v.Email
+			updates["pending_email"] = ""
+		default:
+			// 验证邮件发出后邮箱已被修改
+			if normalizeEmail(user.Email) != v.Email {
+				return invalid
+			}
+		}
+		if err := tx.Model(&user).Updates(updates).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&v).Update("used_at", now).Error; err != nil {
+			return err
+		}
+		return tx.Model(&model.EmailVerification{}).
+			Where("user_id = ? AND id <> ? AND used_at IS NULL AND revoked_at IS NULL", user.ID, v.ID).
+			Update("revoked_at", now).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			l.Infof("[认证] 邮箱验证失败 用户ID=%s 原因=%v", in.UserId, err)
+			return nil, err
+		}
+		l.Errorf("[认证] 邮箱验证失败：数据库异常 用户ID=%s 错误=%v", in.UserId, err)
+		return nil, errorx.Internal("验证失败，请稍后重试")
+	}
+	if remaining >= 0 {
+		l.Infof("[认证] 邮箱验证失败：验证码错误 用户ID=%d 剩余次数=%d", userID, remaining)
+		if remaining == 0 {
+			return nil, errorx.TooManyRequests("验证码错误次数过多，请重新获取")
+		}
+		return nil, errorx.InvalidArgument(fmt.Sprintf("验证码错误，还可尝试 %d 次", remaining))
+	}
+
+	_ = l.svcCtx.DB.WithContext(l.ctx).First(&user, user.ID).Error
+	l.Infof("[认证] 邮箱验证成功 用户ID=%d 邮箱=%s", user.ID, logutil.MaskEmail(user.Email))
+	return &super.VerifyEmailResp{User: modelUserToProto(&user)}, nil
+}
@@ -0,0 +1,126 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/errorx"
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type VerifyLoginMfaLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewVerifyLoginMfaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyLoginMfaLogic {
+	return &VerifyLoginMfaLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 登录第二步：用密码验证后拿到的 mfa_token 加 TOTP 验证码（或恢复码）完成登录。
+// 验证码输错计入登录失败次数，与密码输错共用同一套锁定规则
+func (l *VerifyLoginMfaLogic) VerifyLoginMfa(in *super.VerifyLoginMfaReq) (*super.LoginResp, error) {
+	token := strings.TrimSpace(in.MfaToken)
+	code := strings.TrimSpace(in.Code)
+	if token == "" || code == "" {
+		return nil, errorx.InvalidArgument("请填写两步验证码")
+	}
+
+	expired := errorx.Unauthenticated("验证已过期，请重新登录")
+	now := time.Now()
+	var user model.User
+	var method string
+	var guard loginGuard
+	var account, ip loginFailures
+	wrong := false
+	login := NewLoginLogic(l.ctx, l.svcCtx)
+	req := &super.LoginReq{ClientIp: in.ClientIp, UserAgent: in.UserAgent}
+	err := l.svcCtx.DB.WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		var c model.MFAChallenge
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("token_hash = ? AND used_at IS NULL AND expires_at > ?", sha256Hex(token), now).
+			First(&c).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return expired
+			}
+			return err
+		}
+		if c.Attempts >= mfaChallengeMaxAttempts {
+			return errorx.TooManyRequests("验证码错误次数过多，请重新登录")
+		}
+		if err := tx.First(&user, c.UserID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return expired
+			}
+			return err
+		}
+
+		// 与密码登录共用账号的登录行锁，输错计数不会被并发请求绕过
+		if err := lockLoginAccount(tx, user.ID); err != nil {
+			return err
+		}
+		var err error
+		if account, err = accountLoginFailures(tx, user.ID, now); err != nil {
+			return err
+		}
+		if ip, err = ipLoginFailures(tx, in.ClientIp, now); err != nil {
+			return err
+		}
+		if guard = newLoginGuard(account, ip, now); guard.retry > 0 {
+			return guard.lockedError()
+		}
+
+		// 两步验证在此期间被关闭时，让用户重新走一遍登录
+		totp, err := enabledTOTP(tx, user.ID)
+		if err != nil {
+			return err
+		}
+		if totp == nil {
+			return expired
+		}
+		if method, err = verifySecondFactor(tx, totp, code); err != nil {
+			return err
+		}
+		if method == "" {
+			// 输错次数与失败记录需要提交，不能随事务回滚
+			wrong = true
+			guard = login.recordFailure(tx, user.ID, req, "两步验证码错误", account, ip, now)
+			return tx.Model(&c).UpdateColumn("attempts", gorm.Expr("attempts + 1")).Error
+		}
+		return tx.Model(&c).Update("used_at", now).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			l.Infof("[认证] 两步验证失败 用户ID=%d IP=%s 原因=%v", user.ID, in.ClientIp, err)
+			return nil, err
+		}
+		l.Errorf("[认证] 两步验证失败：数据库异常 用户ID=%d 错误=%v", user.ID, err)
+		return nil, errorx.Internal("登录失败，请稍后重试")
+	}
+
+	if wrong {
+		l.Infof("[认证] 两步验证失败：验证码错误 用户ID=%d IP=%s", user.ID, in.ClientIp)
+		return nil, guard.withLoginInfo(errorx.Unauthenticated("两步验证码错误"))
+	}
+
+	if method == mfaMethodRecovery {
+		if err := recordSecurityEvent(l.svcCtx.DB, user.ID, model.SecurityEventRecoveryUsed, in.ClientIp, in.UserAgent, ""); err != nil {
+			l.Errorf("[认证] 记录恢复码使用事件失败 用户ID=%d 错误=%v", user.ID, err)
+		}
+	}
+	return login.finishLogin(&user, req, "方式="+method)
+}
@@ -0,0 +1,43 @@
+package logic
+
+import (
+	"context"
+
+	"backend/rpc/internal/svc"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type WatchNotificationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewWatchNotificationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WatchNotificationsLogic {
+	return &WatchNotificationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+func (l *WatchNotificationsLogic) WatchNotifications(in *super.WatchNotificationsReq, stream super.Super_WatchNotificationsServer) error {
+	events, cancel := l.svcCtx.NotificationHub.Subscribe()
+	defer cancel()
+	l.Infof("API 实例 %s 开始订阅通知", in.InstanceId)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			l.Infof("API 实例 %s 取消订阅通知", in.InstanceId)
+			return nil
+		case ev := <-events:
+			if err := stream.Send(ev); err != nil {
+				l.Errorf("向 API 实例 %s 推送通知失败: %v", in.InstanceId, err)
+				return err
+			}
+		}
+	}
+}
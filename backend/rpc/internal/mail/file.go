@@ -0,0 +1,51 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// FileMailer 开发环境：每封邮件写成一个 .eml 文件，不连接任何邮件服务器
+type FileMailer struct {
+	dir  string
+	from mail.Address
+}
+
+func NewFileMailer(c Conf) (*FileMailer, error) {
+	from := mail.Address{Name: c.FromName, Address: c.From}
+	if from.Address == "" {
+		from.Address = "noreply@localhost"
+	}
+	if err := os.MkdirAll(c.Dir, 0o755); err != nil {
+		return nil, fmt.Errorf("创建邮件输出目录失败: %w", err)
+	}
+	return &FileMailer{dir: c.Dir, from: from}, nil
+}
+
+func (m *FileMailer) Send(ctx context.Context, msg *Message) error {
+	now := time.Now()
+	raw, err := buildMessage(m.from, msg, now)
+	if err != nil {
+		return err
+	}
+	to := ""
+	if len(msg.To) > 0 {
+		to = msg.To[0]
+	}
+	name := fmt.Sprintf("%s_%s.eml", now.Format("20060102-150405.000000"), unsafeFileChars.ReplaceAllString(to, "_"))
+	path := filepath.Join(m.dir, name)
+	if err := os.WriteFile(path, raw, 0o644); err != nil {
+		return err
+	}
+	logx.WithContext(ctx).Infof("[mail/file] %s -> %s", msg.Subject, path)
+	return nil
+}
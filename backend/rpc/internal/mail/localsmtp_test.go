@@ -0,0 +1,187 @@
+package mail
+
+import (
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+)
+
+// ReceivedMail LocalSMTPServer 收到的一封邮件
+type ReceivedMail struct {
+	From string
+	To   []string
+	Data []byte // 邮件原文（已去掉 SMTP 的点转义）
+}
+
+// LocalSMTPServer 测试用的进程内最小 SMTP 服务器（EHLO、AUTH PLAIN、MAIL、RCPT、DATA），不做转发。
+// 用真实的 SMTPMailer 指向它即可验证模板、MIME 编码与发件箱的投递和重试，不需要外部邮件服务。
+type LocalSMTPServer struct {
+	ln net.Listener
+
+	mu       sync.Mutex
+	received []ReceivedMail
+	failures []int // 依次用于接下来的 DATA 应答，如 451 临时失败、550 拒收
+	wg       sync.WaitGroup
+}
+
+// NewLocalSMTPServer 在 127.0.0.1 的随机端口上启动
+func NewLocalSMTPServer() (*LocalSMTPServer, error) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &LocalSMTPServer{ln: ln}
+	s.wg.Add(1)
+	go s.serve()
+	return s, nil
+}
+
+// Conf 指向本服务器的 SMTP 配置（无 TLS，带一组任意的认证信息）
+func (s *LocalSMTPServer) Conf() Conf {
+	addr := s.ln.Addr().(*net.TCPAddr)
+	return Conf{
+		Mode:        ModeSMTP,
+		Host:        "127.0.0.1",
+		Port:        addr.Port,
+		Username:    "local",
+		Password:    "local",
+		TLS:         "none",
+		From:        "noreply@moe.local",
+		FromName:    "Moe Social",
+		DefaultLang: LangZh,
+	}
+}
+
+// FailNext 接下来的几封邮件依次以给定的应答码拒绝（4xx 临时失败、5xx 永久失败）
+func (s *LocalSMTPServer) FailNext(codes ...int) {
+	s.mu.Lock()
+	s.failures = append(s.failures, codes...)
+	s.mu.Unlock()
+}
+
+// Received 已收到的邮件（副本）
+func (s *LocalSMTPServer) Received() []ReceivedMail {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]ReceivedMail, len(s.received))
+	copy(out, s.received)
+	return out
+}
+
+// Close 停止监听并等待进行中的会话结束
+func (s *LocalSMTPServer) Close() error {
+	err := s.ln.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *LocalSMTPServer) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.session(conn)
+		}()
+	}
+}
+
+func (s *LocalSMTPServer) session(conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	reply := func(format string, args ...interface{}) bool {
+		return tp.PrintfLine(format, args...) == nil
+	}
+
+	var from string
+	var to []string
+	if !reply("220 moe.local ESMTP ready") {
+		return
+	}
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO":
+			reply("250-moe.local")
+			reply("250-8BITMIME")
+			reply("250 AUTH PLAIN")
+		case "HELO":
+			reply("250 moe.local")
+		case "AUTH":
+			reply("235 2.7.0 Authentication successful")
+		case "MAIL":
+			from = addrArg(arg)
+			to = nil
+			reply("250 2.1.0 OK")
+		case "RCPT":
+			if from == "" {
+				reply("503 5.5.1 MAIL first")
+				continue
+			}
+			to = append(to, addrArg(arg))
+			reply("250 2.1.5 OK")
+		case "DATA":
+			if len(to) == 0 {
+				reply("503 5.5.1 RCPT first")
+				continue
+			}
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			data, err := tp.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			if code := s.nextFailure(); code != 0 {
+				reply("%d simulated failure", code)
+			} else {
+				s.mu.Lock()
+				s.received = append(s.received, ReceivedMail{From: from, To: to, Data: data})
+				s.mu.Unlock()
+				reply("250 2.0.0 OK queued")
+			}
+			from, to = "", nil
+		case "RSET":
+			from, to = "", nil
+			reply("250 2.0.0 OK")
+		case "NOOP":
+			reply("250 2.0.0 OK")
+		case "QUIT":
+			reply("221 2.0.0 Bye")
+			return
+		default:
+			reply("502 5.5.2 Command not recognized")
+		}
+	}
+}
+
+func (s *LocalSMTPServer) nextFailure() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.failures) == 0 {
+		return 0
+	}
+	code := s.failures[0]
+	s.failures = s.failures[1:]
+	return code
+}
+
+// addrArg 从 "FROM:<a@b.c> BODY=8BITMIME" 中取出地址
+func addrArg(arg string) string {
+	_, v, ok := strings.Cut(arg, ":")
+	if !ok {
+		return ""
+	}
+	v = strings.TrimSpace(v)
+	if i := strings.IndexByte(v, '>'); i >= 0 {
+		v = v[:i+1]
+	}
+	return strings.Trim(v, "<>")
+}
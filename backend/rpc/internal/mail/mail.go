@@ -0,0 +1,74 @@
+// Package mail 邮件发送：模板渲染（中文 / 英文）、发件箱持久化与失败重试，
+// 以及 SMTP、本地 .eml 文件两种投递方式。业务方只调用 Outbox.Enqueue，不直接连接邮件服务器。
+package mail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// 投递方式
+const (
+	ModeSMTP = "smtp"
+	ModeFile = "file"
+)
+
+var (
+	// ErrNotConfigured 未配置邮件服务，业务方应提示“暂不支持邮件”而不是静默成功
+	ErrNotConfigured = errors.New("mail: not configured")
+	// ErrPermanent 收件人或内容被服务器拒绝（5xx），重试也不会成功
+	ErrPermanent = errors.New("mail: permanent failure")
+)
+
+// Conf 邮件配置；Mode 为空时不发送邮件
+type Conf struct {
+	// Mode smtp 通过 SMTP 服务器发送；file 写成 .eml 文件到 Dir（开发环境，用邮件客户端直接打开查看）
+	Mode     string `json:",optional"`
+	Host     string `json:",optional"`
+	Port     int    `json:",optional"`
+	Username string `json:",optional"`
+	Password string `json:",optional"`
+	// TLS starttls / tls（465 端口的隐式 TLS）/ none；为空时 465 端口用 tls，其余用 starttls
+	TLS      string `json:",optional"`
+	From     string `json:",optional"` // 发件地址，如 noreply@example.com
+	FromName string `json:",optional"` // 为空时为 Moe Social
+	// Dir file 模式的输出目录
+	Dir string `json:",default=./tmp/mail"`
+	// DefaultLang 调用方未指定语言时使用的模板语言
+	DefaultLang string `json:",default=zh"`
+}
+
+// Message 一封待投递的邮件；正文同时提供纯文本与 HTML，由客户端择一显示
+type Message struct {
+	To      []string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Mailer 一种投递方式的实现
+type Mailer interface {
+	// Send 投递一封邮件；服务器明确拒绝时返回包装了 ErrPermanent 的错误（可用 errors.Is 判断）
+	Send(ctx context.Context, msg *Message) error
+}
+
+// 未配置 FromName 时的发件人名称
+const defaultFromName = "Moe Social"
+
+// NewMailer 按配置创建投递方式；Mode 为空时返回 nil
+func NewMailer(c Conf) (Mailer, error) {
+	if c.FromName == "" {
+		c.FromName = defaultFromName
+	}
+	switch c.Mode {
+	case "":
+		return nil, nil
+	case ModeSMTP:
+		return NewSMTPMailer(c)
+	case ModeFile:
+		return NewFileMailer(c)
+	default:
+		return nil, fmt.Errorf("未知的邮件发送方式 %q（可选 smtp、file）", c.Mode)
+	}
+}
@@ -0,0 +1,215 @@
+package mail
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const (
+	// 轮询待投递邮件的间隔；新写入的邮件通过 Kick 立即投递
+	outboxPollInterval = 15 * time.Second
+	// 每轮最多投递的邮件数
+	outboxBatchSize = 50
+	// 单封邮件的投递超时
+	sendTimeout = 30 * time.Second
+	// sending 状态超过这么久视为投递进程已退出，重新投递
+	outboxStaleAfter = 5 * time.Minute
+	// 最多尝试次数，按退避约覆盖 4 小时
+	maxAttempts = 8
+	// 重试退避：30s、1m、2m …… 封顶 1 小时
+	backoffBase = 30 * time.Second
+	backoffMax  = time.Hour
+)
+
+// Outbox 邮件发件箱：业务方写入后立即返回，后台投递，失败按指数退避重试
+type Outbox struct {
+	db          *gorm.DB
+	mailer      Mailer
+	defaultLang string
+	kick        chan struct{}
+}
+
+// NewOutbox 按配置创建发件箱；未配置或配置有误时记录日志，Enqueue 返回 ErrNotConfigured
+func NewOutbox(db *gorm.DB, c Conf) *Outbox {
+	// 正文里有验证码与重置链接，发件箱的读写不进 SQL 日志（全局为 Info 级）
+	quiet := db.Session(&gorm.Session{Logger: logger.Default.LogMode(logger.Silent)})
+	o := &Outbox{db: quiet, defaultLang: NormalizeLang(c.DefaultLang), kick: make(chan struct{}, 1)}
+	if o.defaultLang == "" {
+		o.defaultLang = LangZh
+	}
+	mailer, err := NewMailer(c)
+	if err != nil {
+		logx.Errorf("邮件发送未启用: %v", err)
+		return o
+	}
+	o.mailer = mailer
+	return o
+}
+
+// Enabled 是否配置了邮件发送
+func (o *Outbox) Enabled() bool {
+	return o.mailer != nil
+}
+
+// Enqueue 渲染模板并写入发件箱；lang 为空或不支持时使用默认语言
+func (o *Outbox) Enqueue(ctx context.Context, to, template, lang string, data interface{}) (*model.EmailOutbox, error) {
+	if o.mailer == nil {
+		return nil, ErrNotConfigured
+	}
+	if lang = NormalizeLang(lang); lang == "" {
+		lang = o.defaultLang
+	}
+	r, err := Render(template, lang, data)
+	if err != nil {
+		return nil, err
+	}
+
+	row := &model.EmailOutbox{
+		Recipient:     to,
+		Template:      template,
+		Lang:          lang,
+		Subject:       r.Subject,
+		TextBody:      r.Text,
+		HTMLBody:      r.HTML,
+		Status:        model.EmailStatusPending,
+		NextAttemptAt: time.Now(),
+	}
+	if err := o.db.WithContext(ctx).Create(row).Error; err != nil {
+		return nil, err
+	}
+	o.Kick()
+	return row, nil
+}
+
+// Start 启动后台投递循环；未配置邮件发送时不启动
+func (o *Outbox) Start() {
+	if o.mailer == nil {
+		return
+	}
+	go o.loop()
+}
+
+// Kick 立即投递一轮（不阻塞）
+func (o *Outbox) Kick() {
+	select {
+	case o.kick <- struct{}{}:
+	default:
+	}
+}
+
+func (o *Outbox) loop() {
+	ticker := time.NewTicker(outboxPollInterval)
+	defer ticker.Stop()
+	for {
+		if o.DeliverDue() == outboxBatchSize {
+			// 满批说明还有积压，继续投递
+			continue
+		}
+		select {
+		case <-ticker.C:
+		case <-o.kick:
+		}
+	}
+}
+
+// DeliverDue 投递一轮到期的邮件，返回本轮处理的封数
+func (o *Outbox) DeliverDue() int {
+	now := time.Now()
+	var ids []uint
+	if err := o.db.Model(&model.EmailOutbox{}).
+		Where("(status = ? AND next_attempt_at <= ?) OR (status = ? AND updated_at < ?)",
+			model.EmailStatusPending, now, model.EmailStatusSending, now.Add(-outboxStaleAfter)).
+		Order("next_attempt_at, id").
+		Limit(outboxBatchSize).
+		Pluck("id", &ids).Error; err != nil {
+		logx.Errorf("查询待投递邮件失败: %v", err)
+		return 0
+	}
+	for _, id := range ids {
+		o.deliver(id)
+	}
+	return len(ids)
+}
+
+// deliver 认领并投递一封邮件；已被其他实例认领时直接返回
+func (o *Outbox) deliver(id uint) {
+	now := time.Now()
+	res := o.db.Model(&model.EmailOutbox{}).
+		Where("id = ? AND ((status = ? AND next_attempt_at <= ?) OR (status = ? AND updated_at < ?))",
+			id, model.EmailStatusPending, now, model.EmailStatusSending, now.Add(-outboxStaleAfter)).
+		Updates(map[string]interface{}{
+			"status":     model.EmailStatusSending,
+			"updated_at": now,
+		})
+	if res.Error != nil || res.RowsAffected == 0 {
+		return
+	}
+
+	var row model.EmailOutbox
+	if err := o.db.First(&row, id).Error; err != nil {
+		logx.Errorf("读取待投递邮件 %d 失败: %v", id, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sendTimeout)
+	err := o.mailer.Send(ctx, &Message{
+		To:      []string{row.Recipient},
+		Subject: row.Subject,
+		Text:    row.TextBody,
+		HTML:    row.HTMLBody,
+	})
+	cancel()
+
+	attempts := row.Attempts + 1
+	updates := map[string]interface{}{"attempts": attempts}
+	switch {
+	case err == nil:
+		updates["status"] = model.EmailStatusSent
+		updates["sent_at"] = time.Now()
+		updates["last_error"] = ""
+		// 正文可能含验证码、重置链接等一次性凭据，投递结束后不再保留
+		updates["text_body"] = ""
+		updates["html_body"] = ""
+	case errors.Is(err, ErrPermanent) || attempts >= maxAttempts:
+		logx.Errorf("邮件 %d 投递失败，不再重试（第 %d 次）: %v", id, attempts, err)
+		updates["status"] = model.EmailStatusFailed
+		updates["last_error"] = truncate(err.Error(), 512)
+		updates["text_body"] = ""
+		updates["html_body"] = ""
+	default:
+		logx.Infof("邮件 %d 投递失败，稍后重试（第 %d 次）: %v", id, attempts, err)
+		updates["status"] = model.EmailStatusPending
+		updates["next_attempt_at"] = time.Now().Add(Backoff(attempts))
+		updates["last_error"] = truncate(err.Error(), 512)
+	}
+	if err := o.db.Model(&model.EmailOutbox{}).Where("id = ?", id).Updates(updates).Error; err != nil {
+		logx.Errorf("更新邮件 %d 投递状态失败: %v", id, err)
+	}
+}
+
+// Backoff 第 attempts 次失败后到下次重试的间隔
+func Backoff(attempts int) time.Duration {
+	d := backoffBase
+	for i := 1; i < attempts && d < backoffMax; i++ {
+		d *= 2
+	}
+	if d > backoffMax {
+		d = backoffMax
+	}
+	return d
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
@@ -0,0 +1,201 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/testdb"
+
+	"gorm.io/gorm"
+)
+
+// newTestOutbox 指向 LocalSMTPServer 的发件箱，用真实的 SMTPMailer 投递
+func newTestOutbox(t *testing.T) (*Outbox, *LocalSMTPServer, *gorm.DB) {
+	t.Helper()
+	srv, err := NewLocalSMTPServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { srv.Close() })
+	mailer, err := NewSMTPMailer(srv.Conf())
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := testdb.New(t, &model.EmailOutbox{})
+	return &Outbox{db: db, mailer: mailer, defaultLang: LangZh, kick: make(chan struct{}, 1)}, srv, db
+}
+
+func enqueue(t *testing.T, o *Outbox) *model.EmailOutbox {
+	t.Helper()
+	row, err := o.Enqueue(context.Background(), "alice@example.com", TemplateNotification, "",
+		NotificationData{Username: "alice", Title: "标题", Content: "secret-123456"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return row
+}
+
+func reload(t *testing.T, db *gorm.DB, id uint) model.EmailOutbox {
+	t.Helper()
+	var row model.EmailOutbox
+	if err := db.First(&row, id).Error; err != nil {
+		t.Fatal(err)
+	}
+	return row
+}
+
+// makeDue 跳过退避等待
+func makeDue(t *testing.T, db *gorm.DB, id uint) {
+	t.Helper()
+	if err := db.Model(&model.EmailOutbox{}).Where("id = ?", id).
+		Update("next_attempt_at", time.Now().Add(-time.Second)).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func assertBodiesCleared(t *testing.T, row model.EmailOutbox) {
+	t.Helper()
+	if row.TextBody != "" || row.HTMLBody != "" {
+		t.Fatalf("投递结束后仍保留正文: text=%q html=%q", row.TextBody, row.HTMLBody)
+	}
+}
+
+func TestOutboxDelivers(t *testing.T) {
+	o, srv, db := newTestOutbox(t)
+	row := enqueue(t, o)
+
+	if n := o.DeliverDue(); n != 1 {
+		t.Fatalf("DeliverDue = %d, want 1", n)
+	}
+	got := srv.Received()
+	if len(got) != 1 || len(got[0].To) != 1 || got[0].To[0] != "alice@example.com" {
+		t.Fatalf("received = %+v", got)
+	}
+	if !bytes.Contains(got[0].Data, []byte("Subject:")) {
+		t.Fatalf("邮件原文缺少主题: %s", got[0].Data)
+	}
+
+	sent := reload(t, db, row.ID)
+	if sent.Status != model.EmailStatusSent || sent.Attempts != 1 || sent.SentAt == nil {
+		t.Fatalf("status=%s attempts=%d sent_at=%v", sent.Status, sent.Attempts, sent.SentAt)
+	}
+	assertBodiesCleared(t, sent)
+
+	if n := o.DeliverDue(); n != 0 {
+		t.Fatalf("已投递的邮件被再次投递: %d", n)
+	}
+}
+
+func TestOutboxRetriesTemporaryFailure(t *testing.T) {
+	o, srv, db := newTestOutbox(t)
+	srv.FailNext(451, 451)
+	row := enqueue(t, o)
+
+	before := time.Now()
+	o.DeliverDue()
+	r := reload(t, db, row.ID)
+	if r.Status != model.EmailStatusPending || r.Attempts != 1 || r.LastError == "" {
+		t.Fatalf("第一次失败后 status=%s attempts=%d last_error=%q", r.Status, r.Attempts, r.LastError)
+	}
+	if wait := r.NextAttemptAt.Sub(before); wait < Backoff(1)-time.Second || wait > Backoff(1)+5*time.Second {
+		t.Fatalf("下次重试在 %v 后，want ~%v", wait, Backoff(1))
+	}
+	if r.TextBody == "" {
+		t.Fatal("等待重试的邮件不应清空正文")
+	}
+
+	// 退避未到期时不投递
+	if n := o.DeliverDue(); n != 0 {
+		t.Fatalf("退避期内被投递: %d", n)
+	}
+
+	makeDue(t, db, row.ID)
+	o.DeliverDue()
+	if r = reload(t, db, row.ID); r.Status != model.EmailStatusPending || r.Attempts != 2 {
+		t.Fatalf("第二次失败后 status=%s attempts=%d", r.Status, r.Attempts)
+	}
+
+	makeDue(t, db, row.ID)
+	o.DeliverDue()
+	r = reload(t, db, row.ID)
+	if r.Status != model.EmailStatusSent || r.Attempts != 3 || r.LastError != "" {
+		t.Fatalf("重试成功后 status=%s attempts=%d last_error=%q", r.Status, r.Attempts, r.LastError)
+	}
+	assertBodiesCleared(t, r)
+	if len(srv.Received()) != 1 {
+		t.Fatalf("received %d, want 1", len(srv.Received()))
+	}
+}
+
+func TestOutboxStopsOnPermanentFailure(t *testing.T) {
+	o, srv, db := newTestOutbox(t)
+	srv.FailNext(550)
+	row := enqueue(t, o)
+
+	o.DeliverDue()
+	r := reload(t, db, row.ID)
+	if r.Status != model.EmailStatusFailed || r.Attempts != 1 {
+		t.Fatalf("status=%s attempts=%d", r.Status, r.Attempts)
+	}
+	assertBodiesCleared(t, r)
+}
+
+func TestOutboxGivesUpAfterMaxAttempts(t *testing.T) {
+	o, srv, db := newTestOutbox(t)
+	row := enqueue(t, o)
+	if err := db.Model(&model.EmailOutbox{}).Where("id = ?", row.ID).
+		Update("attempts", maxAttempts-1).Error; err != nil {
+		t.Fatal(err)
+	}
+	srv.FailNext(451)
+
+	o.DeliverDue()
+	r := reload(t, db, row.ID)
+	if r.Status != model.EmailStatusFailed || r.Attempts != maxAttempts {
+		t.Fatalf("status=%s attempts=%d", r.Status, r.Attempts)
+	}
+	assertBodiesCleared(t, r)
+}
+
+func TestOutboxReclaimsStaleSending(t *testing.T) {
+	o, srv, db := newTestOutbox(t)
+	row := enqueue(t, o)
+	// 模拟投递进程在 sending 状态下退出
+	if err := db.Model(&model.EmailOutbox{}).Where("id = ?", row.ID).
+		UpdateColumns(map[string]interface{}{
+			"status":     model.EmailStatusSending,
+			"updated_at": time.Now().Add(-outboxStaleAfter - time.Minute),
+		}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	o.DeliverDue()
+	if r := reload(t, db, row.ID); r.Status != model.EmailStatusSent {
+		t.Fatalf("status=%s, want sent", r.Status)
+	}
+	if len(srv.Received()) != 1 {
+		t.Fatalf("received %d, want 1", len(srv.Received()))
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cases := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{3, 2 * time.Minute},
+		{7, 32 * time.Minute},
+		{8, time.Hour},
+		{20, time.Hour},
+	}
+	for _, tc := range cases {
+		if got := Backoff(tc.attempts); got != tc.want {
+			t.Errorf("Backoff(%d) = %v, want %v", tc.attempts, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"time"
+)
+
+// SMTPMailer 通过 SMTP 服务器投递，支持 STARTTLS、隐式 TLS 与 PLAIN 认证
+type SMTPMailer struct {
+	conf Conf
+	from mail.Address
+	addr string
+	tls  string
+}
+
+func NewSMTPMailer(c Conf) (*SMTPMailer, error) {
+	if c.Host == "" || c.Port == 0 {
+		return nil, errors.New("SMTP 需要配置 Host 与 Port")
+	}
+	from, err := mail.ParseAddress(c.From)
+	if err != nil {
+		return nil, fmt.Errorf("发件地址 From 无效: %w", err)
+	}
+	from.Name = c.FromName
+
+	mode := c.TLS
+	if mode == "" {
+		mode = "starttls"
+		if c.Port == 465 {
+			mode = "tls"
+		}
+	}
+	switch mode {
+	case "starttls", "tls", "none":
+	default:
+		return nil, fmt.Errorf("未知的 TLS 方式 %q（可选 starttls、tls、none）", c.TLS)
+	}
+	return &SMTPMailer{
+		conf: c,
+		from: *from,
+		addr: net.JoinHostPort(c.Host, strconv.Itoa(c.Port)),
+		tls:  mode,
+	}, nil
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg *Message) error {
+	raw, err := buildMessage(m.from, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	var conn net.Conn
+	if m.tls == "tls" {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: m.conf.Host}}).DialContext(ctx, "tcp", m.addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", m.addr)
+	}
+	if err != nil {
+		return err
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(time.Minute)
+	}
+	_ = conn.SetDeadline(deadline)
+
+	c, err := smtp.NewClient(conn, m.conf.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if m.tls == "starttls" {
+		if ok, _ := c.Extension("STARTTLS"); !ok {
+			return errors.New("SMTP 服务器不支持 STARTTLS")
+		}
+		if err := c.StartTLS(&tls.Config{ServerName: m.conf.Host}); err != nil {
+			return err
+		}
+	}
+	if m.conf.Username != "" {
+		if err := c.Auth(smtp.PlainAuth("", m.conf.Username, m.conf.Password, m.conf.Host)); err != nil {
+			return classify(err)
+		}
+	}
+	if err := c.Mail(m.from.Address); err != nil {
+		return classify(err)
+	}
+	for _, to := range msg.To {
+		if err := c.Rcpt(to); err != nil {
+			return classify(err)
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return classify(err)
+	}
+	if _, err := w.Write(raw); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return classify(err)
+	}
+	return c.Quit()
+}
+
+// classify 5xx 应答为永久失败（地址不存在、被拒收），4xx 与网络错误可重试
+func classify(err error) error {
+	var te *textproto.Error
+	if errors.As(err, &te) && te.Code >= 500 {
+		return fmt.Errorf("%w: %v", ErrPermanent, err)
+	}
+	return err
+}
@@ -0,0 +1,114 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+// 内置模板名
+const (
+	TemplateVerifyEmail     = "verify_email"
+	TemplatePasswordReset   = "password_reset"
+	TemplateAccountDeletion = "account_deletion"
+	TemplateNotification    = "notification"
+)
+
+// 支持的模板语言；找不到对应语言的模板时回退到中文
+const (
+	LangZh = "zh"
+	LangEn = "en"
+)
+
+// templates 下每个模板有 <name>.<lang>.txt（首行 define "subject" 定义主题）与 <name>.<lang>.html，
+// HTML 正文定义 "content" 块，由 layout.html 套上统一的页眉页脚
+//
+//go:embed templates
+var templateFS embed.FS
+
+// CodeData 验证码类邮件（邮箱验证、找回密码）的模板数据；Link 为空时只展示验证码
+type CodeData struct {
+	Username      string
+	Code          string
+	Link          string
+	ExpireMinutes int
+}
+
+// DeletionData 注销账号申请通知的模板数据
+type DeletionData struct {
+	Username    string
+	ScheduledAt string
+}
+
+// NotificationData 通知邮件的模板数据（开启了邮件渠道的通知类型）
+type NotificationData struct {
+	Username string
+	Title    string
+	Content  string
+}
+
+// Rendered 渲染好的主题与正文
+type Rendered struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// 模板中可用的数据：Data 为调用方传入的值，其余由渲染时填充
+type view struct {
+	Lang    string
+	Subject string
+	Data    interface{}
+}
+
+// NormalizeLang 把 zh-CN、en_US、Accept-Language 之类的值归一到支持的模板语言，无法识别时返回空串
+func NormalizeLang(lang string) string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	switch {
+	case strings.HasPrefix(lang, LangZh):
+		return LangZh
+	case strings.HasPrefix(lang, LangEn):
+		return LangEn
+	}
+	return ""
+}
+
+// Render 按语言渲染模板；lang 已经过 NormalizeLang
+func Render(name, lang string, data interface{}) (*Rendered, error) {
+	if _, err := templateFS.Open("templates/" + name + "." + lang + ".txt"); err != nil {
+		lang = LangZh
+	}
+	base := "templates/" + name + "." + lang
+
+	text, err := texttemplate.ParseFS(templateFS, base+".txt")
+	if err != nil {
+		return nil, fmt.Errorf("未知的邮件模板 %s: %w", name, err)
+	}
+	v := view{Lang: lang, Data: data}
+	var subject, body bytes.Buffer
+	if err := text.ExecuteTemplate(&subject, "subject", v); err != nil {
+		return nil, err
+	}
+	v.Subject = strings.TrimSpace(subject.String())
+	if err := text.Execute(&body, v); err != nil {
+		return nil, err
+	}
+
+	html, err := htmltemplate.ParseFS(templateFS, "templates/layout.html", base+".html")
+	if err != nil {
+		return nil, err
+	}
+	var page bytes.Buffer
+	if err := html.ExecuteTemplate(&page, "layout.html", v); err != nil {
+		return nil, err
+	}
+
+	return &Rendered{
+		Subject: v.Subject,
+		Text:    strings.TrimSpace(body.String()) + "\n",
+		HTML:    page.String(),
+	}, nil
+}
@@ -0,0 +1,126 @@
+package notify
+
+import (
+	"context"
+	"time"
+
+	"backend/model"
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// BatchPayload 批量通知的内容
+type BatchPayload struct {
+	Content    string
+	CampaignID uint // 来自推送活动时填写，用于统计
+}
+
+// NotifyBatch 给一批接收者各写入一条相同的通知（推送活动等），返回实际写入条数。
+// 只按接收者的渠道偏好与免打扰过滤，不做屏蔽、去重与聚合，适用于没有具体来源的系统类通知。
+// 开启了邮件渠道的接收者同时收到通知邮件。
+func (s *Service) NotifyBatch(ctx context.Context, kind *Kind, recipients []uint, actor uint, p BatchPayload) (int, error) {
+	if len(recipients) == 0 {
+		return 0, nil
+	}
+	db := s.db.WithContext(ctx)
+	prefs, err := loadPreferencesBatch(db, recipients)
+	if err != nil {
+		return 0, err
+	}
+
+	content := p.Content
+	if r := []rune(content); len(r) > maxContentRunes {
+		content = string(r[:maxContentRunes])
+	}
+
+	now := time.Now()
+	rows := make([]model.Notification, 0, len(recipients))
+	push := make(map[uint]bool, len(recipients))
+	email := make(map[uint]bool, len(recipients))
+	for _, uid := range recipients {
+		ch := prefs[uid].ChannelsFor(kind)
+		if !ch.InApp {
+			continue
+		}
+		rows = append(rows, model.Notification{
+			UserID:     uid,
+			SenderID:   actor,
+			Type:       kind.Type,
+			Content:    content,
+			ActorCount: 1,
+			CampaignID: p.CampaignID,
+		})
+		quiet := prefs[uid].InQuietHours(now)
+		push[uid] = ch.Push && !quiet
+		email[uid] = ch.Email && !quiet
+	}
+	if len(rows) == 0 {
+		return 0, nil
+	}
+	if err := db.CreateInBatches(&rows, 200).Error; err != nil {
+		return 0, err
+	}
+
+	s.publishBatch(ctx, rows, push)
+	emailRows := make([]model.Notification, 0, len(rows))
+	for _, n := range rows {
+		if email[n.UserID] {
+			emailRows = append(emailRows, n)
+		}
+	}
+	s.email(ctx, kind, emailRows)
+	return len(rows), nil
+}
+
+// publishBatch 批量推送：发起人只查一次，未读数一次分组统计
+func (s *Service) publishBatch(ctx context.Context, rows []model.Notification, push map[uint]bool) {
+	if s.hub == nil || !s.hub.HasSubscribers() {
+		return
+	}
+
+	db := s.db.WithContext(ctx)
+	var sender model.User
+	if actor := rows[0].SenderID; actor != 0 {
+		if err := db.Select("id", "username", "email", "avatar").First(&sender, actor).Error; err != nil {
+			logx.WithContext(ctx).Errorf("推送通知时查询发送者失败: %v", err)
+		}
+	}
+
+	userIDs := make([]uint, 0, len(rows))
+	for _, n := range rows {
+		if push[n.UserID] {
+			userIDs = append(userIDs, n.UserID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return
+	}
+	var counts []struct {
+		UserID uint
+		Unread int64
+	}
+	if err := db.Model(&model.Notification{}).
+		Select("user_id, COUNT(*) AS unread").
+		Where("user_id IN ? AND is_read = ?", userIDs, false).
+		Group("user_id").
+		Scan(&counts).Error; err != nil {
+		logx.WithContext(ctx).Errorf("推送通知时查询未读数失败: %v", err)
+	}
+	unread := make(map[uint]int64, len(counts))
+	for _, c := range counts {
+		unread[c.UserID] = c.Unread
+	}
+
+	for i := range rows {
+		n := &rows[i]
+		if !push[n.UserID] {
+			continue
+		}
+		n.Sender = sender
+		s.hub.Publish(&super.NotificationEvent{
+			Notification: ToRpc(n, nil),
+			UnreadCount:  int32(unread[n.UserID]),
+		})
+	}
+}
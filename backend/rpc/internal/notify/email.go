@@ -0,0 +1,59 @@
+package notify
+
+import (
+	"context"
+
+	"backend/model"
+	"backend/rpc/internal/mail"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// email 把通知写入发件箱，发到各接收者的邮箱；邮箱未验证的接收者跳过。
+// 邮件由发件箱后台投递，写入失败只记日志，不影响通知本身
+func (s *Service) email(ctx context.Context, kind *Kind, rows []model.Notification) {
+	if s.mail == nil || !s.mail.Enabled() || len(rows) == 0 {
+		return
+	}
+	db := s.db.WithContext(ctx)
+
+	userIDs := make([]uint, 0, len(rows))
+	for _, n := range rows {
+		userIDs = append(userIDs, n.UserID)
+	}
+	var users []model.User
+	if err := db.Select("id", "username", "email", "email_verified_at").
+		Where("id IN ?", userIDs).Find(&users).Error; err != nil {
+		logx.WithContext(ctx).Errorf("发送通知邮件时查询接收者失败: %v", err)
+		return
+	}
+	byID := make(map[uint]*model.User, len(users))
+	for i := range users {
+		byID[users[i].ID] = &users[i]
+	}
+
+	// 同一批通知的发起人相同，只查一次
+	var senderName string
+	if actor := rows[0].SenderID; actor != 0 && kind.TargetType != "" {
+		var sender model.User
+		if err := db.Select("id", "username", "email").First(&sender, actor).Error; err != nil {
+			logx.WithContext(ctx).Errorf("发送通知邮件时查询发起人失败: %v", err)
+		}
+		senderName = displayName(&sender)
+	}
+	title := kind.Title([]string{senderName}, 1)
+
+	for _, n := range rows {
+		u := byID[n.UserID]
+		if u == nil || u.Email == "" || u.EmailVerifiedAt == nil {
+			continue
+		}
+		if _, err := s.mail.Enqueue(ctx, u.Email, mail.TemplateNotification, "", mail.NotificationData{
+			Username: displayName(u),
+			Title:    title,
+			Content:  n.Content,
+		}); err != nil {
+			logx.WithContext(ctx).Errorf("写入通知邮件失败 通知ID=%d: %v", n.ID, err)
+		}
+	}
+}
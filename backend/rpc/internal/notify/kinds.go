@@ -0,0 +1,202 @@
+// Package notify 统一的站内通知：通知类型注册表（展示模板、跳转目标、默认投递渠道）
+// 与写入服务 Notify。各业务（关注、点赞、评论、好友申请、私信……）只声明“发生了什么”，
+// 落库、去重与实时推送都在这里完成。
+package notify
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/model"
+)
+
+// Channels 投递渠道
+type Channels struct {
+	InApp bool `json:"in_app"` // 站内信（通知中心）
+	Push  bool `json:"push"`   // 实时推送（在线走 WebSocket）
+	Email bool `json:"email"`  // 邮件
+}
+
+// Kind 一种通知的元数据
+type Kind struct {
+	Type int    // notifications.type 存储值
+	Name string // 稳定标识，对外接口与偏好设置使用
+	// Label 偏好设置页展示的名称
+	Label string
+
+	// Template 标题模板，{actor} 替换为发起人昵称
+	Template string
+
+	// TargetType 跳转目标类型：post / comment / user / friend_request；为空表示无跳转目标
+	TargetType string
+	// Route 客户端路由名（见 lib/main.dart routes），参数由 target_type / target_id / post_id 组装
+	Route string
+
+	// Defaults 用户未设置偏好时的默认渠道
+	Defaults Channels
+
+	// NotifySelf 发起人就是接收者时是否仍然通知（默认不通知自己）
+	NotifySelf bool
+	// Dedupe 同一发起人对同一目标已有未读通知时不再重复写入（如反复点赞/取消点赞）
+	Dedupe bool
+	// Aggregate 聚合窗口：同一接收者、同一目标的未读通知在窗口内合并为一条，
+	// 记录发起人数与最近几位发起人（“A、B 等 14 人赞了你的动态”）；0 表示不聚合
+	Aggregate time.Duration
+}
+
+// 聚合标题中最多列出的发起人数
+const titleActors = 2
+
+// Title 按模板渲染标题；actors 为最近的发起人昵称（新的在前），total 为发起人总数
+func (k *Kind) Title(actors []string, total int) string {
+	names := make([]string, 0, titleActors)
+	for _, name := range actors {
+		if name == "" {
+			continue
+		}
+		names = append(names, name)
+		if len(names) == titleActors {
+			break
+		}
+	}
+	actor := "有人"
+	if len(names) > 0 {
+		actor = strings.Join(names, "、")
+		if total > len(names) {
+			actor += " 等 " + strconv.Itoa(total) + " 人"
+		}
+	}
+	return strings.ReplaceAll(k.Template, "{actor}", actor)
+}
+
+var (
+	kindsByType = make(map[int]*Kind)
+	kindsByName = make(map[string]*Kind)
+	kindList    []*Kind
+)
+
+func register(k Kind) *Kind {
+	if _, dup := kindsByType[k.Type]; dup {
+		panic("notify: duplicate kind type " + strconv.Itoa(k.Type))
+	}
+	if _, dup := kindsByName[k.Name]; dup {
+		panic("notify: duplicate kind name " + k.Name)
+	}
+	kp := &k
+	kindsByType[k.Type] = kp
+	kindsByName[k.Name] = kp
+	kindList = append(kindList, kp)
+	return kp
+}
+
+var (
+	KindLikePost = register(Kind{
+		Type:       model.NotificationTypeLikePost,
+		Name:       "like_post",
+		Label:      "点赞动态",
+		Template:   "{actor} 赞了你的动态",
+		TargetType: "post",
+		Route:      "/comments",
+		Defaults:   Channels{InApp: true, Push: true},
+		Dedupe:     true,
+		Aggregate:  24 * time.Hour,
+	})
+	KindComment = register(Kind{
+		Type:       model.NotificationTypeComment,
+		Name:       "comment",
+		Label:      "评论",
+		Template:   "{actor} 评论了你的动态",
+		TargetType: "post",
+		Route:      "/comments",
+		Defaults:   Channels{InApp: true, Push: true},
+		Aggregate:  24 * time.Hour,
+	})
+	KindFollow = register(Kind{
+		Type:       model.NotificationTypeFollow,
+		Name:       "follow",
+		Label:      "新关注",
+		Template:   "{actor} 关注了你",
+		TargetType: "user",
+		Route:      "/user-profile",
+		Defaults:   Channels{InApp: true, Push: true},
+		Dedupe:     true,
+	})
+	KindSystem = register(Kind{
+		Type:       model.NotificationTypeSystem,
+		Name:       "system",
+		Label:      "系统通知",
+		Template:   "系统通知",
+		Route:      "/notifications",
+		Defaults:   Channels{InApp: true, Push: true, Email: true},
+		NotifySelf: true,
+	})
+	KindLikeComment = register(Kind{
+		Type:       model.NotificationTypeLikeComment,
+		Name:       "like_comment",
+		Label:      "点赞评论",
+		Template:   "{actor} 赞了你的评论",
+		TargetType: "comment",
+		Route:      "/comments",
+		Defaults:   Channels{InApp: true},
+		Dedupe:     true,
+		Aggregate:  24 * time.Hour,
+	})
+	KindPrivateMessage = register(Kind{
+		Type:       model.NotificationTypePrivateMessage,
+		Name:       "private_message",
+		Label:      "私信",
+		Template:   "{actor} 给你发来一条私信",
+		TargetType: "user",
+		Route:      "/direct-chat",
+		Defaults:   Channels{InApp: true, Push: true},
+		Dedupe:     true,
+	})
+	KindFriendRequest = register(Kind{
+		Type:       model.NotificationTypeFriendRequest,
+		Name:       "friend_request",
+		Label:      "好友申请",
+		Template:   "{actor} 请求添加你为好友",
+		TargetType: "friend_request",
+		Route:      "/friends",
+		Defaults:   Channels{InApp: true, Push: true},
+		Dedupe:     true,
+	})
+	// KindFollowRequest 关注私密账号时发给账号本人，待其批准
+	KindFollowRequest = register(Kind{
+		Type:       model.NotificationTypeFollowRequest,
+		Name:       "follow_request",
+		Label:      "关注请求",
+		Template:   "{actor} 请求关注你",
+		TargetType: "user",
+		Route:      "/follow-requests",
+		Defaults:   Channels{InApp: true, Push: true},
+		Dedupe:     true,
+	})
+	// KindIncomingCall 来电：只走设备推送唤醒接听界面，不写入通知中心
+	KindIncomingCall = register(Kind{
+		Type:       model.NotificationTypeIncomingCall,
+		Name:       "incoming_call",
+		Label:      "来电",
+		Template:   "{actor} 正在呼叫你",
+		TargetType: "user",
+		Defaults:   Channels{Push: true},
+	})
+)
+
+// ByType 按存储值查找；未注册的历史数据返回 nil
+func ByType(t int) *Kind {
+	return kindsByType[t]
+}
+
+// ByName 按稳定标识查找
+func ByName(name string) *Kind {
+	return kindsByName[name]
+}
+
+// Kinds 全部已注册类型（注册顺序）
+func Kinds() []*Kind {
+	out := make([]*Kind, len(kindList))
+	copy(out, kindList)
+	return out
+}
@@ -0,0 +1,400 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/mail"
+	"backend/rpc/internal/notifyhub"
+	"backend/rpc/pb/super"
+	"backend/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// 通知内容摘要最大长度（字符）
+	maxContentRunes = 200
+	// 聚合通知返回的最近发起人数
+	maxActors = 3
+)
+
+// Payload 通知携带的业务数据
+type Payload struct {
+	PostID uint // 相关帖子
+	// TargetID 跳转目标 ID；为 0 时按类型推断：post 取 PostID，user 取发起人
+	TargetID uint
+	// Content 内容摘要（评论内容等），超长截断
+	Content string
+}
+
+type Service struct {
+	db   *gorm.DB
+	hub  *notifyhub.Hub
+	mail *mail.Outbox
+}
+
+// NewService outbox 为空或未配置邮件发送时不发通知邮件
+func NewService(db *gorm.DB, hub *notifyhub.Hub, outbox *mail.Outbox) *Service {
+	return &Service{db: db, hub: hub, mail: outbox}
+}
+
+// Notify 给 recipient 写入一条 kind 类型的通知并实时推送给在线的接收者。
+// 聚合类型会并入窗口内同一目标的未读通知并将其顶到最前。
+// 写入与推送前先查接收者偏好：关闭了站内信或屏蔽了来源时不写入，关闭推送或处于免打扰时段时只写入不推送。
+// 开启了邮件渠道时，新写入的通知同时发到接收者已验证的邮箱；并入已有聚合通知时不再发邮件。
+// 发起人即接收者（且该类型不通知自己）、被偏好拦截或命中去重时返回 (nil, nil)。
+func (s *Service) Notify(ctx context.Context, kind *Kind, recipient, actor uint, p Payload) (*model.Notification, error) {
+	if kind == nil {
+		return nil, errors.New("notify: nil kind")
+	}
+	if recipient == 0 {
+		return nil, errors.New("notify: empty recipient")
+	}
+	if recipient == actor && !kind.NotifySelf {
+		return nil, nil
+	}
+
+	targetID := p.TargetID
+	if targetID == 0 {
+		switch kind.TargetType {
+		case "post":
+			targetID = p.PostID
+		case "user":
+			targetID = actor
+		}
+	}
+
+	channels, err := s.channels(ctx, kind, recipient, actor, p.PostID)
+	if err != nil {
+		return nil, err
+	}
+	if !channels.InApp {
+		return nil, nil
+	}
+
+	content := p.Content
+	if r := []rune(content); len(r) > maxContentRunes {
+		content = string(r[:maxContentRunes])
+	}
+
+	notification := model.Notification{
+		UserID:     recipient,
+		SenderID:   actor,
+		Type:       kind.Type,
+		PostID:     p.PostID,
+		TargetID:   targetID,
+		Content:    content,
+		IsRead:     false,
+		ActorCount: 1,
+	}
+
+	var n *model.Notification
+	if kind.Aggregate > 0 {
+		n, err = s.aggregate(ctx, kind, &notification)
+	} else {
+		n, err = s.create(ctx, kind, &notification)
+	}
+	if err != nil || n == nil {
+		return nil, err
+	}
+
+	if channels.Push {
+		s.publish(ctx, n)
+	}
+	if channels.Email && n == &notification {
+		s.email(ctx, kind, []model.Notification{*n})
+	}
+	return n, nil
+}
+
+// PushAllowed 按接收者偏好判断能否发送设备推送（推送开关、屏蔽、免打扰），供离线私信、来电等只推送不落库的场景使用
+func (s *Service) PushAllowed(ctx context.Context, kind *Kind, recipient, actor uint) (bool, error) {
+	ch, err := s.channels(ctx, kind, recipient, actor, 0)
+	if err != nil {
+		return false, err
+	}
+	return ch.Push, nil
+}
+
+// channels 按接收者偏好计算这条通知的生效渠道：屏蔽了来源或与来源存在拉黑关系时全部关闭，免打扰时段关闭推送与邮件。
+// 系统通知没有具体来源，不受屏蔽影响。
+func (s *Service) channels(ctx context.Context, kind *Kind, recipient, actor, postID uint) (Channels, error) {
+	db := s.db.WithContext(ctx)
+	prefs, err := LoadPreferences(db, recipient)
+	if err != nil {
+		return Channels{}, err
+	}
+	ch := prefs.ChannelsFor(kind)
+
+	if kind.TargetType != "" && (ch.InApp || ch.Push || ch.Email) {
+		// 与发起人存在拉黑关系时不通知（含离线私信、来电推送）
+		blocked, err := utils.Blocked(db, recipient, actor)
+		if err != nil {
+			return Channels{}, err
+		}
+		if blocked {
+			return Channels{}, nil
+		}
+		isMuted, err := muted(db, recipient, actor, postID)
+		if err != nil {
+			return Channels{}, err
+		}
+		if isMuted {
+			return Channels{}, nil
+		}
+	}
+
+	if prefs.InQuietHours(time.Now()) {
+		ch.Push = false
+		ch.Email = false
+	}
+	return ch, nil
+}
+
+// create 写入一条独立通知
+func (s *Service) create(ctx context.Context, kind *Kind, n *model.Notification) (*model.Notification, error) {
+	db := s.db.WithContext(ctx)
+	if kind.Dedupe {
+		var count int64
+		if err := db.Model(&model.Notification{}).
+			Where("user_id = ? AND sender_id = ? AND type = ? AND target_id = ? AND is_read = ?", n.UserID, n.SenderID, n.Type, n.TargetID, false).
+			Count(&count).Error; err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, nil
+		}
+	}
+
+	if err := db.Create(n).Error; err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// aggregateKey 聚合分组的唯一键：同一接收者、类型、目标同时只有一组接收新的发起人
+func aggregateKey(n *model.Notification) string {
+	return fmt.Sprintf("%d:%d:%d", n.UserID, n.Type, n.TargetID)
+}
+
+// aggregate 并入窗口内同一目标的未读聚合通知，没有则新建。
+// 只合并未读通知：接收者读过之后再有人互动会开启新的一组，已读状态始终按组整体生效。
+// 并发的首次互动（如两人同时点赞）都查不到分组时，分组键的唯一索引只让一方建成，另一方重新查询后并入该组。
+func (s *Service) aggregate(ctx context.Context, kind *Kind, n *model.Notification) (*model.Notification, error) {
+	var result *model.Notification
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		cutoff := time.Now().Add(-kind.Aggregate)
+		key := aggregateKey(n)
+		var group model.Notification
+		var err error
+		for attempt := 0; ; attempt++ {
+			err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+				Where("user_id = ? AND type = ? AND target_id = ? AND is_read = ? AND created_at >= ?",
+					n.UserID, n.Type, n.TargetID, false, cutoff).
+				Order("id desc").
+				First(&group).Error
+			if !errors.Is(err, gorm.ErrRecordNotFound) || attempt > 0 {
+				break
+			}
+
+			// 读过、过期或删除的分组让出分组键（不刷新 updated_at，列表顺序不变）
+			if err := tx.Unscoped().Model(&model.Notification{}).
+				Where("aggregate_key = ? AND (is_read = ? OR created_at < ? OR deleted_at IS NOT NULL)", key, true, cutoff).
+				UpdateColumn("aggregate_key", nil).Error; err != nil {
+				return err
+			}
+			n.AggregateKey = &key
+			res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(n)
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected > 0 {
+				result = n
+				return tx.Create(&model.NotificationActor{NotificationID: n.ID, ActorID: n.SenderID}).Error
+			}
+			// 分组刚被并发的请求建好：重新查询并入
+			n.ID, n.AggregateKey = 0, nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// 早期数据没有发起人明细，SenderID 即唯一的发起人
+		joined := group.SenderID == n.SenderID
+		if !joined {
+			var count int64
+			if err := tx.Model(&model.NotificationActor{}).
+				Where("notification_id = ? AND actor_id = ?", group.ID, n.SenderID).
+				Count(&count).Error; err != nil {
+				return err
+			}
+			joined = count > 0
+		}
+		if joined && kind.Dedupe {
+			return nil
+		}
+
+		updates := map[string]interface{}{
+			"sender_id":  n.SenderID,
+			"updated_at": time.Now(),
+		}
+		if n.Content != "" {
+			updates["content"] = n.Content
+		}
+		if !joined {
+			if err := tx.Create(&model.NotificationActor{NotificationID: group.ID, ActorID: n.SenderID}).Error; err != nil {
+				return err
+			}
+			updates["actor_count"] = gorm.Expr("actor_count + 1")
+		}
+		if err := tx.Model(&group).Updates(updates).Error; err != nil {
+			return err
+		}
+		if err := tx.First(&group, group.ID).Error; err != nil {
+			return err
+		}
+		result = &group
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// publish 落库后广播给各 API 实例（附接收者最新未读数），由持有其 WebSocket 的实例实时推送。
+// 推送失败不影响通知本身，没有实例在监听时直接跳过。
+func (s *Service) publish(ctx context.Context, n *model.Notification) {
+	if s.hub == nil || !s.hub.HasSubscribers() {
+		return
+	}
+
+	db := s.db.WithContext(ctx)
+	if n.Sender.ID == 0 && n.SenderID != 0 {
+		if err := db.Select("id", "username", "email", "avatar").First(&n.Sender, n.SenderID).Error; err != nil {
+			logx.WithContext(ctx).Errorf("推送通知时查询发送者失败: %v", err)
+		}
+	}
+	actors, err := LoadActors(db, []model.Notification{*n})
+	if err != nil {
+		logx.WithContext(ctx).Errorf("推送通知时查询发起人失败: %v", err)
+	}
+
+	var unread int64
+	if err := db.Model(&model.Notification{}).
+		Where("user_id = ? AND is_read = ?", n.UserID, false).
+		Count(&unread).Error; err != nil {
+		logx.WithContext(ctx).Errorf("推送通知时查询未读数失败: %v", err)
+	}
+
+	s.hub.Publish(&super.NotificationEvent{
+		Notification: ToRpc(n, actors[n.ID]),
+		UnreadCount:  int32(unread),
+	})
+}
+
+// LoadActors 批量查询聚合通知最近的发起人（新的在前，每条最多 maxActors 位）。
+// 只有一位发起人的通知不查询，ToRpc 直接使用 Sender。
+func LoadActors(db *gorm.DB, notifications []model.Notification) (map[uint][]model.User, error) {
+	out := make(map[uint][]model.User)
+	var ids []uint
+	for _, n := range notifications {
+		if n.ActorCount > 1 {
+			ids = append(ids, n.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return out, nil
+	}
+
+	var rows []model.NotificationActor
+	if err := db.Where("notification_id IN ?", ids).
+		Order("id desc").
+		Preload("Actor", func(db *gorm.DB) *gorm.DB {
+			return db.Select("id", "username", "email", "avatar")
+		}).
+		Find(&rows).Error; err != nil {
+		return out, err
+	}
+	for _, row := range rows {
+		if len(out[row.NotificationID]) < maxActors {
+			out[row.NotificationID] = append(out[row.NotificationID], row.Actor)
+		}
+	}
+	return out, nil
+}
+
+func displayName(u *model.User) string {
+	if u.Username != "" {
+		return u.Username
+	}
+	return u.Email
+}
+
+// ToRpc 转换通知并补上注册表中的元数据；n.Sender 需已加载（未加载时显示“未知用户”），
+// actors 为 LoadActors 的结果，为空时以 Sender 作为唯一发起人
+func ToRpc(n *model.Notification, actors []model.User) *super.Notification {
+	senderName := displayName(&n.Sender)
+	if senderName == "" {
+		senderName = "未知用户"
+	}
+	if len(actors) == 0 && n.Sender.ID != 0 {
+		actors = []model.User{n.Sender}
+	}
+	actorCount := n.ActorCount
+	if actorCount < 1 {
+		actorCount = 1
+	}
+
+	out := &super.Notification{
+		Id:           strconv.FormatUint(uint64(n.ID), 10),
+		UserId:       strconv.FormatUint(uint64(n.UserID), 10),
+		SenderId:     strconv.FormatUint(uint64(n.SenderID), 10),
+		SenderName:   senderName,
+		SenderAvatar: n.Sender.Avatar,
+		Type:         int32(n.Type),
+		PostId:       strconv.FormatUint(uint64(n.PostID), 10),
+		Content:      n.Content,
+		IsRead:       n.IsRead,
+		CreatedAt:    n.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:    n.UpdatedAt.Format("2006-01-02 15:04:05"),
+		TargetId:     strconv.FormatUint(uint64(n.TargetID), 10),
+		ActorCount:   int32(actorCount),
+	}
+	names := make([]string, 0, len(actors))
+	for i := range actors {
+		names = append(names, displayName(&actors[i]))
+		out.Actors = append(out.Actors, &super.NotificationActor{
+			Id:     strconv.FormatUint(uint64(actors[i].ID), 10),
+			Name:   displayName(&actors[i]),
+			Avatar: actors[i].Avatar,
+		})
+	}
+	if len(names) == 0 {
+		names = append(names, senderName)
+	}
+
+	if kind := ByType(n.Type); kind != nil {
+		// 早期数据没有 target_id，按类型回填
+		if n.TargetID == 0 {
+			switch kind.TargetType {
+			case "post":
+				out.TargetId = out.PostId
+			case "user":
+				out.TargetId = out.SenderId
+			}
+		}
+		out.Kind = kind.Name
+		out.Title = kind.Title(names, actorCount)
+		out.TargetType = kind.TargetType
+		out.Route = kind.Route
+	}
+	return out
+}
@@ -0,0 +1,96 @@
+package notify
+
+import (
+	"context"
+	"testing"
+
+	"backend/model"
+	"backend/rpc/internal/testdb"
+
+	"gorm.io/gorm"
+)
+
+func newAggregateTestService(t *testing.T) (*Service, *gorm.DB) {
+	t.Helper()
+	db := testdb.New(t, &model.Notification{}, &model.NotificationActor{}, &model.NotificationPreference{},
+		&model.NotificationMute{}, &model.UserBlock{})
+	return NewService(db, nil, nil), db
+}
+
+func groups(t *testing.T, db *gorm.DB) []model.Notification {
+	t.Helper()
+	var list []model.Notification
+	if err := db.Order("id").Find(&list).Error; err != nil {
+		t.Fatal(err)
+	}
+	return list
+}
+
+// 并发的首次点赞：另一个请求在本次查询之后抢先建好了分组，本次写入撞上分组键后并入该组，而不是再建一组
+func TestAggregateJoinsGroupCreatedConcurrently(t *testing.T) {
+	s, db := newAggregateTestService(t)
+	const recipient, first, second, post = 1, 2, 3, 10
+
+	raced := false
+	if err := db.Callback().Query().After("gorm:query").Register("test:race_group", func(tx *gorm.DB) {
+		if tx.Statement.Table != "notifications" || raced {
+			return
+		}
+		raced = true
+		key := aggregateKey(&model.Notification{UserID: recipient, Type: KindLikePost.Type, TargetID: post})
+		other := tx.Session(&gorm.Session{NewDB: true})
+		other.Error = nil
+		group := model.Notification{UserID: recipient, SenderID: first, Type: KindLikePost.Type, PostID: post,
+			TargetID: post, ActorCount: 1, AggregateKey: &key}
+		if err := other.Create(&group).Error; err != nil {
+			t.Error(err)
+			return
+		}
+		if err := other.Create(&model.NotificationActor{NotificationID: group.ID, ActorID: first}).Error; err != nil {
+			t.Error(err)
+		}
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := s.Notify(context.Background(), KindLikePost, recipient, second, Payload{PostID: post})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !raced {
+		t.Fatal("没有模拟到并发建组")
+	}
+	list := groups(t, db)
+	if len(list) != 1 {
+		t.Fatalf("groups = %d, want 1", len(list))
+	}
+	if n.ID != list[0].ID || list[0].ActorCount != 2 || list[0].SenderID != second {
+		t.Fatalf("group = %+v", list[0])
+	}
+}
+
+// 分组读过之后让出分组键，再有人点赞开启新的一组
+func TestAggregateStartsNewGroupAfterRead(t *testing.T) {
+	s, db := newAggregateTestService(t)
+	const recipient, post = 1, 10
+	ctx := context.Background()
+
+	if _, err := s.Notify(ctx, KindLikePost, recipient, 2, Payload{PostID: post}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Notify(ctx, KindLikePost, recipient, 3, Payload{PostID: post}); err != nil {
+		t.Fatal(err)
+	}
+	if list := groups(t, db); len(list) != 1 || list[0].ActorCount != 2 {
+		t.Fatalf("groups = %+v, want one group of 2", list)
+	}
+
+	db.Model(&model.Notification{}).Where("user_id = ?", recipient).Update("is_read", true)
+	if _, err := s.Notify(ctx, KindLikePost, recipient, 4, Payload{PostID: post}); err != nil {
+		t.Fatal(err)
+	}
+	list := groups(t, db)
+	if len(list) != 2 || list[0].AggregateKey != nil || list[1].AggregateKey == nil || list[1].ActorCount != 1 {
+		t.Fatalf("groups = %+v, want a read group and a new open group", list)
+	}
+}
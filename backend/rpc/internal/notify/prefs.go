@@ -0,0 +1,210 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"backend/model"
+	"backend/rpc/pb/super"
+
+	"gorm.io/gorm"
+)
+
+// Preferences 用户通知偏好；Channels 只包含用户改过的类型，其余按 Kind.Defaults
+type Preferences struct {
+	Channels     map[string]Channels
+	Timezone     string
+	QuietEnabled bool
+	QuietStart   string // HH:MM
+	QuietEnd     string // HH:MM
+}
+
+// ChannelsFor 某类通知的生效渠道
+func (p *Preferences) ChannelsFor(k *Kind) Channels {
+	if ch, ok := p.Channels[k.Name]; ok {
+		return ch
+	}
+	return k.Defaults
+}
+
+// Location 用户时区，未设置或无法识别时使用默认时区
+func (p *Preferences) Location() *time.Location {
+	name := p.Timezone
+	if name == "" {
+		name = model.DefaultTimezone
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return time.FixedZone("CST", 8*3600)
+	}
+	return loc
+}
+
+// InQuietHours now 是否落在用户时区的免打扰时段内；起止相同视为未设置
+func (p *Preferences) InQuietHours(now time.Time) bool {
+	if !p.QuietEnabled {
+		return false
+	}
+	start, err1 := ParseClock(p.QuietStart)
+	end, err2 := ParseClock(p.QuietEnd)
+	if err1 != nil || err2 != nil || start == end {
+		return false
+	}
+	local := now.In(p.Location())
+	m := local.Hour()*60 + local.Minute()
+	if start < end {
+		return m >= start && m < end
+	}
+	// 跨零点，如 22:00-08:00
+	return m >= start || m < end
+}
+
+// ParseClock 解析 HH:MM，返回当天的分钟数
+func ParseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("时间格式应为 HH:MM: %q", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// LoadPreferences 读取用户偏好，没有记录时返回全默认
+func LoadPreferences(db *gorm.DB, userID uint) (*Preferences, error) {
+	var row model.NotificationPreference
+	err := db.Where("user_id = ?", userID).First(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return defaultPreferences(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return preferencesFromRow(&row)
+}
+
+// loadPreferencesBatch 批量读取偏好，没有记录的用户为全默认
+func loadPreferencesBatch(db *gorm.DB, userIDs []uint) (map[uint]*Preferences, error) {
+	out := make(map[uint]*Preferences, len(userIDs))
+	if len(userIDs) == 0 {
+		return out, nil
+	}
+	var rows []model.NotificationPreference
+	if err := db.Where("user_id IN ?", userIDs).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		p, err := preferencesFromRow(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		out[rows[i].UserID] = p
+	}
+	for _, id := range userIDs {
+		if out[id] == nil {
+			out[id] = defaultPreferences()
+		}
+	}
+	return out, nil
+}
+
+func defaultPreferences() *Preferences {
+	return &Preferences{Channels: map[string]Channels{}, Timezone: model.DefaultTimezone}
+}
+
+func preferencesFromRow(row *model.NotificationPreference) (*Preferences, error) {
+	p := defaultPreferences()
+	if row.Channels != "" {
+		if err := json.Unmarshal([]byte(row.Channels), &p.Channels); err != nil {
+			return nil, err
+		}
+	}
+	if row.Timezone != "" {
+		p.Timezone = row.Timezone
+	}
+	p.QuietEnabled = row.QuietEnabled
+	p.QuietStart = row.QuietStart
+	p.QuietEnd = row.QuietEnd
+	return p, nil
+}
+
+// SavePreferences 整体覆盖用户偏好；与默认值相同的类型不落库，便于以后调整默认值
+func SavePreferences(db *gorm.DB, userID uint, p *Preferences) error {
+	overrides := make(map[string]Channels)
+	for name, ch := range p.Channels {
+		kind := ByName(name)
+		if kind == nil {
+			return fmt.Errorf("未知的通知类型: %s", name)
+		}
+		if ch != kind.Defaults {
+			overrides[name] = ch
+		}
+	}
+	raw, err := json.Marshal(overrides)
+	if err != nil {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		var row model.NotificationPreference
+		err := tx.Where("user_id = ?", userID).First(&row).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		row.UserID = userID
+		row.Channels = string(raw)
+		row.Timezone = p.Timezone
+		row.QuietEnabled = p.QuietEnabled
+		row.QuietStart = p.QuietStart
+		row.QuietEnd = p.QuietEnd
+		return tx.Save(&row).Error
+	})
+}
+
+// muted 接收者是否屏蔽了这条通知的来源（发起人或所属动态）
+func muted(db *gorm.DB, recipient, actor, postID uint) (bool, error) {
+	q := db.Model(&model.NotificationMute{}).Where("user_id = ?", recipient)
+	if postID != 0 {
+		q = q.Where("(target_type = ? AND target_id = ?) OR (target_type = ? AND target_id = ?)",
+			model.MuteTargetUser, actor, model.MuteTargetPost, postID)
+	} else {
+		q = q.Where("target_type = ? AND target_id = ?", model.MuteTargetUser, actor)
+	}
+	var n int64
+	if err := q.Count(&n).Error; err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// PreferencesToRpc 转换为接口结构，kinds 按注册顺序列出全部类型的生效设置
+func PreferencesToRpc(p *Preferences, mutes []model.NotificationMute) *super.NotificationPreferences {
+	out := &super.NotificationPreferences{
+		Timezone: p.Timezone,
+		QuietHours: &super.NotificationQuietHours{
+			Enabled: p.QuietEnabled,
+			Start:   p.QuietStart,
+			End:     p.QuietEnd,
+		},
+	}
+	for _, k := range Kinds() {
+		ch := p.ChannelsFor(k)
+		out.Kinds = append(out.Kinds, &super.NotificationKindPreference{
+			Kind:  k.Name,
+			Label: k.Label,
+			InApp: ch.InApp,
+			Push:  ch.Push,
+			Email: ch.Email,
+		})
+	}
+	for _, m := range mutes {
+		out.Mutes = append(out.Mutes, &super.NotificationMute{
+			TargetType: m.TargetType,
+			TargetId:   strconv.FormatUint(uint64(m.TargetID), 10),
+			CreatedAt:  m.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return out
+}
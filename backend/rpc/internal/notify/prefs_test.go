@@ -0,0 +1,167 @@
+package notify
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/model"
+	"backend/rpc/internal/testdb"
+)
+
+func newTestService(t *testing.T) *Service {
+	t.Helper()
+	db := testdb.New(t, &model.NotificationPreference{}, &model.NotificationMute{}, &model.UserBlock{})
+	return NewService(db, nil, nil)
+}
+
+func TestPushAllowedFollowsPreferences(t *testing.T) {
+	const recipient, actor = 1, 2
+	ctx := context.Background()
+
+	loc, err := time.LoadLocation(model.DefaultTimezone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().In(loc)
+	aroundNow := &Preferences{
+		Channels:     map[string]Channels{},
+		Timezone:     model.DefaultTimezone,
+		QuietEnabled: true,
+		QuietStart:   now.Add(-time.Hour).Format("15:04"),
+		QuietEnd:     now.Add(time.Hour).Format("15:04"),
+	}
+
+	cases := []struct {
+		name  string
+		setup func(t *testing.T, s *Service)
+		kind  *Kind
+		want  bool
+	}{
+		{
+			name: "默认开启推送",
+			kind: KindPrivateMessage,
+			want: true,
+		},
+		{
+			name: "关闭了该类型的推送",
+			setup: func(t *testing.T, s *Service) {
+				save(t, s, &Preferences{
+					Channels: map[string]Channels{KindPrivateMessage.Name: {InApp: true, Push: false}},
+					Timezone: model.DefaultTimezone,
+				})
+			},
+			kind: KindPrivateMessage,
+			want: false,
+		},
+		{
+			name: "只关闭其他类型不受影响",
+			setup: func(t *testing.T, s *Service) {
+				save(t, s, &Preferences{
+					Channels: map[string]Channels{KindLikePost.Name: {InApp: true}},
+					Timezone: model.DefaultTimezone,
+				})
+			},
+			kind: KindPrivateMessage,
+			want: true,
+		},
+		{
+			name:  "免打扰时段",
+			setup: func(t *testing.T, s *Service) { save(t, s, aroundNow) },
+			kind:  KindPrivateMessage,
+			want:  false,
+		},
+		{
+			name: "屏蔽了发起人",
+			setup: func(t *testing.T, s *Service) {
+				create(t, s, &model.NotificationMute{UserID: recipient, TargetType: model.MuteTargetUser, TargetID: actor})
+			},
+			kind: KindPrivateMessage,
+			want: false,
+		},
+		{
+			name: "被接收者拉黑",
+			setup: func(t *testing.T, s *Service) {
+				create(t, s, &model.UserBlock{BlockerID: recipient, BlockedID: actor})
+			},
+			kind: KindPrivateMessage,
+			want: false,
+		},
+		{
+			name: "拉黑了接收者",
+			setup: func(t *testing.T, s *Service) {
+				create(t, s, &model.UserBlock{BlockerID: actor, BlockedID: recipient})
+			},
+			kind: KindPrivateMessage,
+			want: false,
+		},
+		{
+			name: "系统通知不受屏蔽影响",
+			setup: func(t *testing.T, s *Service) {
+				create(t, s, &model.NotificationMute{UserID: recipient, TargetType: model.MuteTargetUser, TargetID: actor})
+			},
+			kind: KindSystem,
+			want: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newTestService(t)
+			if tc.setup != nil {
+				tc.setup(t, s)
+			}
+			got, err := s.PushAllowed(ctx, tc.kind, recipient, actor)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Fatalf("PushAllowed = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestInQuietHours(t *testing.T) {
+	loc, err := time.LoadLocation(model.DefaultTimezone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(hh, mm int) time.Time { return time.Date(2026, 1, 2, hh, mm, 0, 0, loc) }
+	cases := []struct {
+		start, end string
+		now        time.Time
+		want       bool
+	}{
+		{"22:00", "08:00", at(23, 30), true},
+		{"22:00", "08:00", at(7, 59), true},
+		{"22:00", "08:00", at(8, 0), false},
+		{"22:00", "08:00", at(12, 0), false},
+		{"13:00", "14:00", at(13, 0), true},
+		{"13:00", "14:00", at(14, 0), false},
+		{"09:00", "09:00", at(9, 0), false},
+	}
+	for _, tc := range cases {
+		p := &Preferences{Timezone: model.DefaultTimezone, QuietEnabled: true, QuietStart: tc.start, QuietEnd: tc.end}
+		if got := p.InQuietHours(tc.now); got != tc.want {
+			t.Errorf("%s-%s at %s = %v, want %v", tc.start, tc.end, tc.now.Format("15:04"), got, tc.want)
+		}
+	}
+	off := &Preferences{Timezone: model.DefaultTimezone, QuietStart: "00:00", QuietEnd: "23:59"}
+	if off.InQuietHours(at(12, 0)) {
+		t.Error("quiet hours apply while disabled")
+	}
+}
+
+func save(t *testing.T, s *Service, p *Preferences) {
+	t.Helper()
+	if err := SavePreferences(s.db, 1, p); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func create(t *testing.T, s *Service, v interface{}) {
+	t.Helper()
+	if err := s.db.Create(v).Error; err != nil {
+		t.Fatal(err)
+	}
+}
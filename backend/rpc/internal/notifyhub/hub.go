@@ -0,0 +1,65 @@
+// Package notifyhub 进程内的新通知广播：RPC 层写入通知后 Publish，
+// WatchNotifications 流把事件转给各 API 实例，由持有对方 WebSocket 的实例推送。事件只在创建通知的实例上发布，
+// 所以每个 API 实例都订阅全部 RPC 实例。撤销会话（SessionKick）也经同一条流通知到每个 API 实例。
+package notifyhub
+
+import (
+	"sync"
+
+	"backend/rpc/pb/super"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// 每个订阅者的缓冲；API 实例消费过慢时丢弃新事件（客户端仍可通过未读数接口补齐）
+const subscriberBuffer = 256
+
+type Hub struct {
+	mu   sync.RWMutex
+	subs map[chan *super.NotificationEvent]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{subs: make(map[chan *super.NotificationEvent]struct{})}
+}
+
+// Subscribe 注册订阅者，返回事件通道与取消函数
+func (h *Hub) Subscribe() (<-chan *super.NotificationEvent, func()) {
+	ch := make(chan *super.NotificationEvent, subscriberBuffer)
+	h.mu.Lock()
+	h.subs[ch] = struct{}{}
+	h.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			delete(h.subs, ch)
+			h.mu.Unlock()
+		})
+	}
+}
+
+// HasSubscribers 没有 API 实例在监听时调用方可跳过组装事件
+func (h *Hub) HasSubscribers() bool {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return len(h.subs) > 0
+}
+
+// Publish 非阻塞投递给所有订阅者
+func (h *Hub) Publish(ev *super.NotificationEvent) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for ch := range h.subs {
+		select {
+		case ch <- ev:
+		default:
+			if k := ev.GetKick(); k != nil {
+				logx.Errorf("notifyhub: subscriber buffer full, dropping session kick for user %s", k.UserId)
+			} else {
+				logx.Errorf("notifyhub: subscriber buffer full, dropping notification %s", ev.GetNotification().GetId())
+			}
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package oidc
+
+import "time"
+
+// AgeKeys 把已缓存公钥的拉取时间往前拨 d，模拟缓存过了最短刷新间隔
+func AgeKeys(p *Provider, d time.Duration) {
+	p.keys.mu.Lock()
+	p.keys.fetchedAt = p.keys.fetchedAt.Add(-d)
+	p.keys.mu.Unlock()
+}
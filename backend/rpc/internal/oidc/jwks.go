@@ -0,0 +1,181 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// errJWKS 拿不到提供方的公钥（网络或提供方故障），与签名校验失败区分开
+var errJWKS = errors.New("oidc: jwks unavailable")
+
+const (
+	// 公钥缓存时间；遇到未知的 kid 时提前刷新（提供方轮换了密钥），但两次刷新至少间隔 jwksMinRefresh
+	jwksTTL        = time.Hour
+	jwksMinRefresh = time.Minute
+)
+
+// jwk JWKS 中的一把公钥，只支持 RSA 与 EC（P-256 / P-384 / P-521）
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// keySet 按 kid 缓存提供方的签名公钥
+type keySet struct {
+	client *http.Client
+
+	mu        sync.Mutex
+	uri       string
+	keys      map[string]interface{}
+	fetchedAt time.Time
+}
+
+func newKeySet(client *http.Client) *keySet {
+	return &keySet{client: client}
+}
+
+// keyfunc 供 jwt.ParseWithClaims 使用：按 ID Token 头部的 kid 取公钥
+func (s *keySet) keyfunc(ctx context.Context, uri string) jwt.Keyfunc {
+	return func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return s.get(ctx, uri, kid)
+	}
+}
+
+func (s *keySet) get(ctx context.Context, uri, kid string) (interface{}, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stale := s.uri != uri || time.Since(s.fetchedAt) > jwksTTL
+	if !stale {
+		if key := s.lookup(kid); key != nil {
+			return key, nil
+		}
+		if time.Since(s.fetchedAt) < jwksMinRefresh {
+			return nil, fmt.Errorf("未知的签名密钥 kid=%q", kid)
+		}
+	}
+	keys, err := s.fetch(ctx, uri)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errJWKS, err)
+	}
+	s.uri, s.keys, s.fetchedAt = uri, keys, time.Now()
+	if key := s.lookup(kid); key != nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("未知的签名密钥 kid=%q", kid)
+}
+
+// lookup 没有 kid 时只在提供方仅有一把密钥的情况下使用它
+func (s *keySet) lookup(kid string) interface{} {
+	if kid == "" && len(s.keys) == 1 {
+		for _, k := range s.keys {
+			return k
+		}
+	}
+	return s.keys[kid]
+}
+
+func (s *keySet) fetch(ctx context.Context, uri string) (map[string]interface{}, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("JWKS %s 返回 %d", uri, resp.StatusCode)
+	}
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("解析 JWKS 失败: %w", err)
+	}
+	keys := make(map[string]interface{}, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		// 不认识的密钥类型直接跳过，不影响同一 JWKS 中的其他密钥
+		if key, err := k.publicKey(); err == nil {
+			keys[k.Kid] = key
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("JWKS %s 中没有可用的签名密钥", uri)
+	}
+	return keys, nil
+}
+
+func (k jwk) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() < 3 || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("RSA 公钥指数无效")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("不支持的曲线 %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("EC 公钥不在曲线上")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("不支持的密钥类型 %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) == 0 {
+		return nil, fmt.Errorf("密钥参数不是有效的 base64url")
+	}
+	return new(big.Int).SetBytes(b), nil
+}
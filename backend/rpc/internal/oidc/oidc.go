@@ -0,0 +1,290 @@
+// Package oidc 第三方登录（OpenID Connect）：授权码 + PKCE 流程，通过提供方的 JWKS 校验 ID Token。
+// 各提供方的端点从 <Issuer>/.well-known/openid-configuration 发现，不需要逐个适配。
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ErrRejected 授权码无效、已使用，或 ID Token 校验不通过；应提示用户重新授权，而不是当作服务故障
+var ErrRejected = errors.New("oidc: rejected")
+
+// Conf 第三方登录配置；不配置提供方时不提供第三方登录
+type Conf struct {
+	Providers []ProviderConf `json:",optional"`
+}
+
+// ProviderConf 一个 OIDC 提供方
+type ProviderConf struct {
+	Name         string // 提供方标识，出现在接口路径中，如 google
+	DisplayName  string `json:",optional"` // 登录按钮上的名称，为空时用 Name
+	Issuer       string // 如 https://accounts.google.com
+	ClientId     string
+	ClientSecret string   `json:",optional"` // 公共客户端（只用 PKCE）可不填
+	RedirectUrl  string   // 客户端接收授权码的地址，需在提供方后台登记
+	Scopes       []string `json:",optional"` // 为空时为 openid email profile
+}
+
+// Claims ID Token 中用到的用户信息
+type Claims struct {
+	Subject           string
+	Email             string
+	EmailVerified     bool
+	Name              string
+	PreferredUsername string
+	Picture           string
+}
+
+// 发现文档缓存时间；JWKS 另有自己的缓存（见 keySet）
+const discoveryTTL = time.Hour
+
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+// Provider 一个已配置的提供方
+type Provider struct {
+	conf   ProviderConf
+	client *http.Client
+	keys   *keySet
+
+	mu        sync.Mutex
+	doc       *discovery
+	fetchedAt time.Time
+}
+
+func NewProvider(c ProviderConf) (*Provider, error) {
+	if c.Name == "" || c.Issuer == "" || c.ClientId == "" || c.RedirectUrl == "" {
+		return nil, fmt.Errorf("提供方 %q 缺少 Name、Issuer、ClientId 或 RedirectUrl", c.Name)
+	}
+	if len(c.Scopes) == 0 {
+		c.Scopes = []string{"openid", "email", "profile"}
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	return &Provider{conf: c, client: client, keys: newKeySet(client)}, nil
+}
+
+func (p *Provider) Name() string {
+	return p.conf.Name
+}
+
+func (p *Provider) DisplayName() string {
+	if p.conf.DisplayName != "" {
+		return p.conf.DisplayName
+	}
+	return p.conf.Name
+}
+
+// AuthURL 授权页地址；state、nonce、PKCE verifier 由调用方生成并保存到回调时使用
+func (p *Provider) AuthURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	q := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.conf.ClientId},
+		"redirect_uri":          {p.conf.RedirectUrl},
+		"scope":                 {strings.Join(p.conf.Scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {CodeChallenge(verifier)},
+		"code_challenge_method": {"S256"},
+	}
+	sep := "?"
+	if strings.Contains(doc.AuthorizationEndpoint, "?") {
+		sep = "&"
+	}
+	return doc.AuthorizationEndpoint + sep + q.Encode(), nil
+}
+
+// Exchange 用授权码换取并校验 ID Token，返回其中的用户信息
+func (p *Provider) Exchange(ctx context.Context, code, verifier, nonce string) (*Claims, error) {
+	doc, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.conf.RedirectUrl},
+		"client_id":     {p.conf.ClientId},
+		"code_verifier": {verifier},
+	}
+	if p.conf.ClientSecret != "" {
+		form.Set("client_secret", p.conf.ClientSecret)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, doc.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	var out struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	decodeErr := json.NewDecoder(resp.Body).Decode(&out)
+	// 4xx 是授权码或客户端参数被拒（如 invalid_grant），5xx 与无法解析的响应当作服务故障
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+		return nil, fmt.Errorf("%w: 令牌端点返回 %d %s", ErrRejected, resp.StatusCode, strings.TrimSpace(out.Error+" "+out.ErrorDescription))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("令牌端点返回 %d", resp.StatusCode)
+	}
+	if decodeErr != nil {
+		return nil, fmt.Errorf("解析令牌端点响应失败: %w", decodeErr)
+	}
+	if out.IDToken == "" {
+		return nil, fmt.Errorf("%w: 响应中没有 id_token", ErrRejected)
+	}
+	return p.verify(ctx, doc, out.IDToken, nonce)
+}
+
+// idTokenClaims email_verified 有的提供方返回字符串 "true"，用 flexBool 兼容
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce             string   `json:"nonce"`
+	AuthorizedParty   string   `json:"azp"`
+	Email             string   `json:"email"`
+	EmailVerified     flexBool `json:"email_verified"`
+	Name              string   `json:"name"`
+	PreferredUsername string   `json:"preferred_username"`
+	Picture           string   `json:"picture"`
+}
+
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	*b = flexBool(s == "true")
+	return nil
+}
+
+func (p *Provider) verify(ctx context.Context, doc *discovery, raw, nonce string) (*Claims, error) {
+	var c idTokenClaims
+	_, err := jwt.ParseWithClaims(raw, &c, p.keys.keyfunc(ctx, doc.JwksURI),
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(doc.Issuer),
+		jwt.WithAudience(p.conf.ClientId),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		if errors.Is(err, errJWKS) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", ErrRejected, err)
+	}
+	if c.Nonce != nonce {
+		return nil, fmt.Errorf("%w: nonce 不匹配", ErrRejected)
+	}
+	// 多个受众时 azp 必须是本客户端
+	if len(c.Audience) > 1 && c.AuthorizedParty != p.conf.ClientId {
+		return nil, fmt.Errorf("%w: azp 不匹配", ErrRejected)
+	}
+	if c.Subject == "" {
+		return nil, fmt.Errorf("%w: 缺少 sub", ErrRejected)
+	}
+	return &Claims{
+		Subject:           c.Subject,
+		Email:             strings.TrimSpace(c.Email),
+		EmailVerified:     bool(c.EmailVerified),
+		Name:              c.Name,
+		PreferredUsername: c.PreferredUsername,
+		Picture:           c.Picture,
+	}, nil
+}
+
+// discover 读取（并缓存）发现文档；issuer 必须与配置一致，防止被指向其他提供方
+func (p *Provider) discover(ctx context.Context) (*discovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.doc != nil && time.Since(p.fetchedAt) < discoveryTTL {
+		return p.doc, nil
+	}
+	u := strings.TrimSuffix(p.conf.Issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("发现文档 %s 返回 %d", u, resp.StatusCode)
+	}
+	var doc discovery
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("解析发现文档失败: %w", err)
+	}
+	if strings.TrimSuffix(doc.Issuer, "/") != strings.TrimSuffix(p.conf.Issuer, "/") {
+		return nil, fmt.Errorf("发现文档的 issuer %q 与配置 %q 不一致", doc.Issuer, p.conf.Issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JwksURI == "" {
+		return nil, fmt.Errorf("发现文档缺少授权、令牌或 JWKS 端点")
+	}
+	p.doc, p.fetchedAt = &doc, time.Now()
+	return p.doc, nil
+}
+
+// Registry 已配置的提供方，按配置顺序排列
+type Registry struct {
+	providers []*Provider
+}
+
+// NewRegistry 按配置创建各提供方；配置有误时记录日志并跳过该提供方，不影响服务启动
+func NewRegistry(c Conf) *Registry {
+	r := &Registry{}
+	for _, pc := range c.Providers {
+		if r.Get(pc.Name) != nil {
+			logx.Errorf("第三方登录提供方 %q 重复配置，已忽略", pc.Name)
+			continue
+		}
+		p, err := NewProvider(pc)
+		if err != nil {
+			logx.Errorf("第三方登录提供方未启用: %v", err)
+			continue
+		}
+		r.providers = append(r.providers, p)
+	}
+	return r
+}
+
+// Get 按标识查找提供方；未配置时返回 nil
+func (r *Registry) Get(name string) *Provider {
+	for _, p := range r.providers {
+		if p.conf.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// List 全部提供方
+func (r *Registry) List() []*Provider {
+	return r.providers
+}
@@ -0,0 +1,130 @@
+package oidc_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"backend/rpc/internal/oidc"
+	"backend/rpc/internal/oidc/oidctest"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func newTestProvider(t *testing.T) (*oidctest.Server, *oidc.Provider) {
+	t.Helper()
+	srv := oidctest.NewServer(t)
+	p, err := oidc.NewProvider(srv.ProviderConf("test"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, p
+}
+
+// authorize 发起授权并取得授权码，返回授权码以及回调时需要的 verifier 与 nonce
+func authorize(t *testing.T, srv *oidctest.Server, p *oidc.Provider) (code, verifier, nonce string) {
+	t.Helper()
+	verifier, _ = oidc.RandomString()
+	nonce, _ = oidc.RandomString()
+	authURL, err := p.AuthURL(context.Background(), "state", nonce, verifier)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code, err = srv.Authorize(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return code, verifier, nonce
+}
+
+func TestExchangeWithPKCE(t *testing.T) {
+	srv, p := newTestProvider(t)
+	code, verifier, nonce := authorize(t, srv, p)
+	claims, err := p.Exchange(context.Background(), code, verifier, nonce)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.Subject != "test-user-1" || claims.Email != "test-user-1@example.com" || !claims.EmailVerified {
+		t.Fatalf("claims = %+v", claims)
+	}
+
+	// 授权码只能用一次
+	if _, err := p.Exchange(context.Background(), code, verifier, nonce); !errors.Is(err, oidc.ErrRejected) {
+		t.Fatalf("重复使用授权码: err = %v, want ErrRejected", err)
+	}
+	// code_verifier 与授权时的 code_challenge 不匹配
+	code, _, nonce = authorize(t, srv, p)
+	if _, err := p.Exchange(context.Background(), code, "wrong-verifier", nonce); !errors.Is(err, oidc.ErrRejected) {
+		t.Fatalf("错误的 verifier: err = %v, want ErrRejected", err)
+	}
+}
+
+func TestExchangeRejectsBadNonce(t *testing.T) {
+	srv, p := newTestProvider(t)
+	code, verifier, _ := authorize(t, srv, p)
+	if _, err := p.Exchange(context.Background(), code, verifier, "other-nonce"); !errors.Is(err, oidc.ErrRejected) {
+		t.Fatalf("err = %v, want ErrRejected", err)
+	}
+}
+
+func TestExchangeRejectsBadClaims(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(jwt.MapClaims)
+	}{
+		{"其他签发方", func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }},
+		{"其他受众", func(c jwt.MapClaims) { c["aud"] = "other-client" }},
+		{"多个受众但 azp 不是本客户端", func(c jwt.MapClaims) {
+			c["aud"] = []string{oidctest.ClientID, "other-client"}
+			c["azp"] = "other-client"
+		}},
+		{"已过期", func(c jwt.MapClaims) {
+			c["iat"] = time.Now().Add(-2 * time.Hour).Unix()
+			c["exp"] = time.Now().Add(-time.Hour).Unix()
+		}},
+		{"缺少 exp", func(c jwt.MapClaims) { delete(c, "exp") }},
+		{"缺少 sub", func(c jwt.MapClaims) { delete(c, "sub") }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, p := newTestProvider(t)
+			srv.Tamper(tt.tamper)
+			code, verifier, nonce := authorize(t, srv, p)
+			if _, err := p.Exchange(context.Background(), code, verifier, nonce); !errors.Is(err, oidc.ErrRejected) {
+				t.Fatalf("err = %v, want ErrRejected", err)
+			}
+		})
+	}
+}
+
+// 提供方轮换密钥后出现未知的 kid：最短刷新间隔内不重新拉取 JWKS，过了间隔后拉取新公钥
+func TestUnknownKidRefetchesJWKS(t *testing.T) {
+	srv, p := newTestProvider(t)
+	exchange := func() error {
+		code, verifier, nonce := authorize(t, srv, p)
+		_, err := p.Exchange(context.Background(), code, verifier, nonce)
+		return err
+	}
+	if err := exchange(); err != nil {
+		t.Fatal(err)
+	}
+	if err := srv.RotateKey(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := exchange(); !errors.Is(err, oidc.ErrRejected) {
+		t.Fatalf("刷新间隔内: err = %v, want ErrRejected", err)
+	}
+	if n := srv.JWKSCalls(); n != 1 {
+		t.Fatalf("刷新间隔内 JWKS 请求 %d 次, want 1", n)
+	}
+
+	oidc.AgeKeys(p, 2*time.Minute)
+	if err := exchange(); err != nil {
+		t.Fatalf("过了刷新间隔: err = %v", err)
+	}
+	if n := srv.JWKSCalls(); n != 2 {
+		t.Fatalf("JWKS 请求 %d 次, want 2", n)
+	}
+}
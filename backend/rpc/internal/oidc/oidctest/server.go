@@ -0,0 +1,287 @@
+// Package oidctest 测试用的本地 OIDC 提供方（发现文档、授权页、令牌端点、JWKS），不访问外网。
+// 用真实的 oidc.Provider 指向它即可完整走一遍 PKCE、换取令牌与 JWKS 验签
+package oidctest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"backend/rpc/internal/oidc"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	ClientID     = "moe-social"
+	ClientSecret = "test-client-secret"
+
+	codeTTL = 5 * time.Minute
+)
+
+// User 授权时登录的第三方用户
+type User struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Picture       string
+}
+
+type authCode struct {
+	user        User
+	clientID    string
+	redirectURI string
+	challenge   string
+	nonce       string
+	expiresAt   time.Time
+}
+
+// Server 授权页不展示登录界面，直接以当前用户同意授权并跳回 redirect_uri
+type Server struct {
+	*httptest.Server
+
+	mu    sync.Mutex
+	key   *rsa.PrivateKey
+	kid   string
+	keyNo int
+	user  User
+	codes map[string]authCode
+	// tamper 签发 ID Token 前修改声明，用来构造错误的 iss、aud、exp 等
+	tamper    func(jwt.MapClaims)
+	jwksCalls int
+}
+
+// NewServer 启动服务器，测试结束时自动关闭
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+	s := &Server{
+		codes: make(map[string]authCode),
+		user: User{
+			Subject:       "test-user-1",
+			Email:         "test-user-1@example.com",
+			EmailVerified: true,
+			Name:          "Test User",
+		},
+	}
+	if err := s.RotateKey(); err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", s.handleDiscovery)
+	mux.HandleFunc("/authorize", s.handleAuthorize)
+	mux.HandleFunc("/token", s.handleToken)
+	mux.HandleFunc("/jwks", s.handleJWKS)
+	s.Server = httptest.NewServer(mux)
+	t.Cleanup(s.Close)
+	return s
+}
+
+// ProviderConf 指向本服务器的提供方配置
+func (s *Server) ProviderConf(name string) oidc.ProviderConf {
+	return oidc.ProviderConf{
+		Name:         name,
+		Issuer:       s.URL,
+		ClientId:     ClientID,
+		ClientSecret: ClientSecret,
+		RedirectUrl:  s.URL + "/callback",
+	}
+}
+
+// SetUser 之后的授权以该用户登录
+func (s *Server) SetUser(u User) {
+	s.mu.Lock()
+	s.user = u
+	s.mu.Unlock()
+}
+
+// Tamper 之后签发的 ID Token 先经 f 修改声明；传 nil 恢复正常签发
+func (s *Server) Tamper(f func(jwt.MapClaims)) {
+	s.mu.Lock()
+	s.tamper = f
+	s.mu.Unlock()
+}
+
+// RotateKey 换一把新的签名密钥（新的 kid），JWKS 中只公布新密钥
+func (s *Server) RotateKey() error {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.keyNo++
+	s.key, s.kid = key, "test-key-"+strconv.Itoa(s.keyNo)
+	s.mu.Unlock()
+	return nil
+}
+
+// JWKSCalls JWKS 端点被请求的次数
+func (s *Server) JWKSCalls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.jwksCalls
+}
+
+// Authorize 打开授权页地址，返回跳回 redirect_uri 时带的授权码
+func (s *Server) Authorize(authURL string) (string, error) {
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := client.Get(authURL)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		return "", fmt.Errorf("授权页返回 %d", resp.StatusCode)
+	}
+	back, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		return "", err
+	}
+	return back.Query().Get("code"), nil
+}
+
+func (s *Server) handleDiscovery(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"issuer":                                s.URL,
+		"authorization_endpoint":                s.URL + "/authorize",
+		"token_endpoint":                        s.URL + "/token",
+		"jwks_uri":                              s.URL + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (s *Server) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil || q.Get("redirect_uri") == "" {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	if q.Get("response_type") != "code" || q.Get("client_id") != ClientID ||
+		q.Get("code_challenge") == "" || q.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid_request", http.StatusBadRequest)
+		return
+	}
+	code, err := oidc.RandomString()
+	if err != nil {
+		http.Error(w, "server_error", http.StatusInternalServerError)
+		return
+	}
+	s.mu.Lock()
+	s.codes[code] = authCode{
+		user:        s.user,
+		clientID:    q.Get("client_id"),
+		redirectURI: q.Get("redirect_uri"),
+		challenge:   q.Get("code_challenge"),
+		nonce:       q.Get("nonce"),
+		expiresAt:   time.Now().Add(codeTTL),
+	}
+	s.mu.Unlock()
+	back := redirect.Query()
+	back.Set("code", code)
+	back.Set("state", q.Get("state"))
+	redirect.RawQuery = back.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (s *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.ParseForm() != nil || r.PostForm.Get("grant_type") != "authorization_code" {
+		tokenError(w, http.StatusBadRequest, "invalid_request")
+		return
+	}
+	clientID, secret, ok := r.BasicAuth()
+	if !ok {
+		clientID, secret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	}
+	if clientID != ClientID || subtle.ConstantTimeCompare([]byte(secret), []byte(ClientSecret)) != 1 {
+		tokenError(w, http.StatusUnauthorized, "invalid_client")
+		return
+	}
+	// 授权码只能用一次
+	s.mu.Lock()
+	c, found := s.codes[r.PostForm.Get("code")]
+	delete(s.codes, r.PostForm.Get("code"))
+	key, kid, tamper := s.key, s.kid, s.tamper
+	s.mu.Unlock()
+	if !found || time.Now().After(c.expiresAt) || c.clientID != clientID ||
+		c.redirectURI != r.PostForm.Get("redirect_uri") ||
+		oidc.CodeChallenge(r.PostForm.Get("code_verifier")) != c.challenge {
+		tokenError(w, http.StatusBadRequest, "invalid_grant")
+		return
+	}
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":            s.URL,
+		"sub":            c.user.Subject,
+		"aud":            clientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+		"email":          c.user.Email,
+		"email_verified": c.user.EmailVerified,
+		"name":           c.user.Name,
+	}
+	if c.nonce != "" {
+		claims["nonce"] = c.nonce
+	}
+	if c.user.Picture != "" {
+		claims["picture"] = c.user.Picture
+	}
+	if tamper != nil {
+		tamper(claims)
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	idToken, err := token.SignedString(key)
+	if err != nil {
+		tokenError(w, http.StatusInternalServerError, "server_error")
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": "test-access-token",
+		"token_type":   "Bearer",
+		"expires_in":   3600,
+		"id_token":     idToken,
+	})
+}
+
+func (s *Server) handleJWKS(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.jwksCalls++
+	pub, kid := s.key.PublicKey, s.kid
+	s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}},
+	})
+}
+
+func tokenError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
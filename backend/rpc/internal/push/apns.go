@@ -0,0 +1,175 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	apnsProductionEndpoint  = "https://api.push.apple.com"
+	apnsDevelopmentEndpoint = "https://api.sandbox.push.apple.com"
+	// 苹果要求 provider token 在 20~60 分钟之间刷新
+	apnsTokenLifetime = 50 * time.Minute
+)
+
+// ApnsConf APNs（基于 .p8 密钥的 token 认证）配置
+type ApnsConf struct {
+	KeyFile string `json:",optional"` // AuthKey_XXXXXXXXXX.p8
+	KeyId   string `json:",optional"`
+	TeamId  string `json:",optional"`
+	Topic   string `json:",optional"` // App 的 Bundle ID
+	// Production 为 false 时使用沙盒环境（开发版 App 的令牌只能发到沙盒）
+	Production bool `json:",optional"`
+	// Endpoint 不为空时覆盖上面的环境地址；测试时指向 FakeServer
+	Endpoint string `json:",optional"`
+}
+
+// ApnsProvider 通过 APNs HTTP/2 provider API 直接发送到 iOS 设备
+type ApnsProvider struct {
+	conf     ApnsConf
+	endpoint string
+	key      *ecdsa.PrivateKey
+	client   *http.Client
+
+	mu       sync.Mutex
+	jwtToken string
+	issuedAt time.Time
+}
+
+func NewApnsProvider(c ApnsConf) (*ApnsProvider, error) {
+	if c.KeyId == "" || c.TeamId == "" || c.Topic == "" {
+		return nil, errors.New("APNs 需要配置 KeyId、TeamId、Topic")
+	}
+	raw, err := os.ReadFile(c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("读取 APNs 密钥失败: %w", err)
+	}
+	key, err := jwt.ParseECPrivateKeyFromPEM(raw)
+	if err != nil {
+		return nil, fmt.Errorf("解析 APNs 密钥失败: %w", err)
+	}
+
+	endpoint := strings.TrimRight(c.Endpoint, "/")
+	if endpoint == "" {
+		endpoint = apnsDevelopmentEndpoint
+		if c.Production {
+			endpoint = apnsProductionEndpoint
+		}
+	}
+	return &ApnsProvider{
+		conf:     c,
+		endpoint: endpoint,
+		key:      key,
+		client:   &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+func (p *ApnsProvider) Name() string {
+	return ProviderApns
+}
+
+func (p *ApnsProvider) Send(ctx context.Context, msg *Message) error {
+	token, err := p.providerToken()
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"aps": map[string]interface{}{
+			"alert": map[string]string{
+				"title": msg.Title,
+				"body":  msg.Body,
+			},
+			"sound": "default",
+		},
+	}
+	for k, v := range msg.Data {
+		if k != "aps" {
+			payload[k] = v
+		}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint+"/3/device/"+msg.Token, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("authorization", "bearer "+token)
+	req.Header.Set("apns-topic", p.conf.Topic)
+	req.Header.Set("apns-push-type", "alert")
+	if msg.HighPriority {
+		req.Header.Set("apns-priority", "10")
+	} else {
+		req.Header.Set("apns-priority", "5")
+	}
+	if msg.CollapseKey != "" {
+		req.Header.Set("apns-collapse-id", msg.CollapseKey)
+	}
+	if msg.TTLSeconds > 0 {
+		req.Header.Set("apns-expiration", strconv.FormatInt(time.Now().Unix()+int64(msg.TTLSeconds), 10))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 16<<10))
+	var e struct {
+		Reason string `json:"reason"`
+	}
+	_ = json.Unmarshal(respBody, &e)
+	switch {
+	case resp.StatusCode == http.StatusGone,
+		e.Reason == "BadDeviceToken", e.Reason == "DeviceTokenNotForTopic", e.Reason == "Unregistered":
+		return fmt.Errorf("%w: apns %d %s", ErrInvalidToken, resp.StatusCode, e.Reason)
+	case e.Reason == "ExpiredProviderToken" || e.Reason == "InvalidProviderToken":
+		p.mu.Lock()
+		p.jwtToken = ""
+		p.mu.Unlock()
+	}
+	return fmt.Errorf("apns 推送失败: %d %s", resp.StatusCode, e.Reason)
+}
+
+// providerToken ES256 签名的 provider token，按苹果要求复用并定期刷新
+func (p *ApnsProvider) providerToken() (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.jwtToken != "" && time.Since(p.issuedAt) < apnsTokenLifetime {
+		return p.jwtToken, nil
+	}
+
+	now := time.Now()
+	t := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": p.conf.TeamId,
+		"iat": now.Unix(),
+	})
+	t.Header["kid"] = p.conf.KeyId
+	signed, err := t.SignedString(p.key)
+	if err != nil {
+		return "", err
+	}
+	p.jwtToken = signed
+	p.issuedAt = now
+	return signed, nil
+}
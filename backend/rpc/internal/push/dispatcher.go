@@ -0,0 +1,99 @@
+package push
+
+import (
+	"context"
+	"errors"
+
+	"backend/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// Conf 系统推送配置；两个通道都未配置时不发送设备推送（WebSocket 实时推送不受影响）
+type Conf struct {
+	Fcm  FcmConf  `json:",optional"`
+	Apns ApnsConf `json:",optional"`
+	// Fake 开发环境：FCM 与 APNs 设备都不真正发送，只把推送的标题等元信息打印到日志（不含正文）
+	Fake bool `json:",optional"`
+}
+
+// Dispatcher 把推送发到用户的所有设备，并清理失效令牌
+type Dispatcher struct {
+	db        *gorm.DB
+	providers map[string]PushProvider
+}
+
+// NewDispatcher 按配置创建各通道；配置有误时记录日志并跳过该通道，不影响服务启动
+func NewDispatcher(db *gorm.DB, c Conf) *Dispatcher {
+	d := &Dispatcher{db: db, providers: make(map[string]PushProvider)}
+
+	if c.Fake {
+		d.Register(logProvider{name: ProviderFcm})
+		d.Register(logProvider{name: ProviderApns})
+		logx.Info("系统推送未真正发送，只记录到日志（Push.Fake）")
+		return d
+	}
+
+	if c.Fcm.CredentialsFile != "" {
+		if p, err := NewFcmProvider(c.Fcm); err != nil {
+			logx.Errorf("FCM 推送未启用: %v", err)
+		} else {
+			d.Register(p)
+		}
+	}
+	if c.Apns.KeyFile != "" {
+		if p, err := NewApnsProvider(c.Apns); err != nil {
+			logx.Errorf("APNs 推送未启用: %v", err)
+		} else {
+			d.Register(p)
+		}
+	}
+	return d
+}
+
+// Register 注册（或替换）一个通道
+func (d *Dispatcher) Register(p PushProvider) {
+	d.providers[p.Name()] = p
+}
+
+// Supports 是否配置了该通道
+func (d *Dispatcher) Supports(provider string) bool {
+	return d.providers[provider] != nil
+}
+
+// SendToUser 发给用户的每台设备，返回成功条数与清理掉的失效设备数。
+// 单台设备失败不影响其他设备；没有配置对应通道的设备跳过。
+func (d *Dispatcher) SendToUser(ctx context.Context, userID uint, msg Message) (sent, pruned int, err error) {
+	if len(d.providers) == 0 {
+		return 0, 0, nil
+	}
+	var devices []model.UserDevice
+	if err := d.db.WithContext(ctx).Where("user_id = ?", userID).Find(&devices).Error; err != nil {
+		return 0, 0, err
+	}
+
+	for _, dev := range devices {
+		p := d.providers[dev.Provider]
+		if p == nil {
+			continue
+		}
+		m := msg
+		m.Token = dev.Token
+		err := p.Send(ctx, &m)
+		switch {
+		case err == nil:
+			sent++
+		case errors.Is(err, ErrInvalidToken):
+			logx.WithContext(ctx).Infof("清理失效推送令牌 用户ID=%d 设备ID=%d: %v", userID, dev.ID, err)
+			if err := d.db.WithContext(ctx).Delete(&model.UserDevice{}, dev.ID).Error; err != nil {
+				logx.WithContext(ctx).Errorf("删除失效设备失败 设备ID=%d: %v", dev.ID, err)
+			} else {
+				pruned++
+			}
+		default:
+			logx.WithContext(ctx).Errorf("系统推送失败 用户ID=%d 设备ID=%d: %v", userID, dev.ID, err)
+		}
+	}
+	return sent, pruned, nil
+}
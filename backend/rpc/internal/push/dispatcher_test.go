@@ -0,0 +1,156 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"backend/model"
+	"backend/rpc/internal/testdb"
+)
+
+func newTestDispatcher(t *testing.T) (*Dispatcher, *FakeServer) {
+	t.Helper()
+	fake := NewFakeServer()
+	t.Cleanup(fake.Close)
+	dir := t.TempDir()
+	fcmConf, err := fake.FcmConf(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	apnsConf, err := fake.ApnsConf(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	db := testdb.New(t, &model.UserDevice{})
+	return NewDispatcher(db, Conf{Fcm: fcmConf, Apns: apnsConf}), fake
+}
+
+func addDevice(t *testing.T, d *Dispatcher, userID uint, provider, token string) {
+	t.Helper()
+	if err := d.db.Create(&model.UserDevice{UserID: userID, Token: token, Platform: "android", Provider: provider}).Error; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func deviceTokens(t *testing.T, d *Dispatcher, userID uint) map[string]bool {
+	t.Helper()
+	var tokens []string
+	if err := d.db.Model(&model.UserDevice{}).Where("user_id = ?", userID).Pluck("token", &tokens).Error; err != nil {
+		t.Fatal(err)
+	}
+	out := make(map[string]bool, len(tokens))
+	for _, tok := range tokens {
+		out[tok] = true
+	}
+	return out
+}
+
+func TestSendToUserDeliversToEveryDevice(t *testing.T) {
+	d, fake := newTestDispatcher(t)
+	addDevice(t, d, 1, ProviderFcm, "fcm-a")
+	addDevice(t, d, 1, ProviderApns, "apns-a")
+	addDevice(t, d, 2, ProviderFcm, "fcm-other-user")
+
+	sent, pruned, err := d.SendToUser(context.Background(), 1, Message{
+		Title:        "alice 给你发来一条私信",
+		Body:         "点击查看消息",
+		Data:         map[string]string{"type": "chat_message"},
+		HighPriority: true,
+		CollapseKey:  "chat:7",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent != 2 || pruned != 0 {
+		t.Fatalf("sent=%d pruned=%d, want 2 and 0", sent, pruned)
+	}
+
+	got := make(map[string]FakePush)
+	for _, p := range fake.Sent() {
+		got[p.Token] = p
+	}
+	if len(got) != 2 {
+		t.Fatalf("fake received %d pushes, want 2: %+v", len(got), fake.Sent())
+	}
+	for _, tok := range []string{"fcm-a", "apns-a"} {
+		p, ok := got[tok]
+		if !ok {
+			t.Fatalf("no push for %s", tok)
+		}
+		if p.Title != "alice 给你发来一条私信" || !p.HighPriority || p.CollapseKey != "chat:7" || p.Data["type"] != "chat_message" {
+			t.Errorf("push to %s = %+v", tok, p)
+		}
+	}
+}
+
+func TestSendToUserPrunesInvalidTokens(t *testing.T) {
+	d, fake := newTestDispatcher(t)
+	addDevice(t, d, 1, ProviderFcm, "fcm-live")
+	addDevice(t, d, 1, ProviderFcm, "fcm-dead")
+	addDevice(t, d, 1, ProviderApns, "apns-dead")
+	fake.MarkInvalid("fcm-dead", "apns-dead")
+
+	sent, pruned, err := d.SendToUser(context.Background(), 1, Message{Title: "t"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent != 1 || pruned != 2 {
+		t.Fatalf("sent=%d pruned=%d, want 1 and 2", sent, pruned)
+	}
+	tokens := deviceTokens(t, d, 1)
+	if len(tokens) != 1 || !tokens["fcm-live"] {
+		t.Fatalf("remaining devices = %v, want only fcm-live", tokens)
+	}
+}
+
+// 令牌以外的失败（如服务端故障）不删除设备
+type failingProvider struct{ name string }
+
+func (p failingProvider) Name() string { return p.name }
+
+func (p failingProvider) Send(context.Context, *Message) error { return errors.New("unavailable") }
+
+func TestSendToUserKeepsDevicesOnOtherErrors(t *testing.T) {
+	d, _ := newTestDispatcher(t)
+	d.Register(failingProvider{name: ProviderFcm})
+	addDevice(t, d, 1, ProviderFcm, "fcm-a")
+
+	sent, pruned, err := d.SendToUser(context.Background(), 1, Message{Title: "t"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent != 0 || pruned != 0 {
+		t.Fatalf("sent=%d pruned=%d, want 0 and 0", sent, pruned)
+	}
+	if !deviceTokens(t, d, 1)["fcm-a"] {
+		t.Fatal("device was deleted after a non-token error")
+	}
+}
+
+func TestSendToUserSkipsUnconfiguredProviders(t *testing.T) {
+	db := testdb.New(t, &model.UserDevice{})
+	d := NewDispatcher(db, Conf{})
+	d.Register(failingProvider{name: ProviderApns})
+	addDevice(t, d, 1, ProviderFcm, "fcm-a")
+
+	sent, pruned, err := d.SendToUser(context.Background(), 1, Message{Title: "t"})
+	if err != nil || sent != 0 || pruned != 0 {
+		t.Fatalf("sent=%d pruned=%d err=%v, want nothing sent", sent, pruned, err)
+	}
+	if !deviceTokens(t, d, 1)["fcm-a"] {
+		t.Fatal("device of an unconfigured provider was deleted")
+	}
+}
+
+func TestFakeModeDoesNotSend(t *testing.T) {
+	db := testdb.New(t, &model.UserDevice{})
+	d := NewDispatcher(db, Conf{Fake: true})
+	addDevice(t, d, 1, ProviderFcm, "fcm-a")
+	addDevice(t, d, 1, ProviderApns, "apns-a")
+
+	sent, pruned, err := d.SendToUser(context.Background(), 1, Message{Title: "t", Body: "secret"})
+	if err != nil || sent != 2 || pruned != 0 {
+		t.Fatalf("sent=%d pruned=%d err=%v, want 2 logged", sent, pruned, err)
+	}
+}
@@ -0,0 +1,221 @@
+package push
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+const fakeAccessToken = "fake-fcm-access-token"
+
+// FakePush FakeServer 收到的一条推送
+type FakePush struct {
+	Provider     string
+	Token        string
+	Title        string
+	Body         string
+	Data         map[string]string
+	HighPriority bool
+	CollapseKey  string
+}
+
+// FakeServer 测试用的本地 FCM v1（含 OAuth 令牌端点）与 APNs 服务，不访问外网。
+// 用真实的 FcmProvider / ApnsProvider 指向它即可完整走一遍签名、发送与失效令牌清理
+type FakeServer struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	sent    []FakePush
+	invalid map[string]bool
+}
+
+func NewFakeServer() *FakeServer {
+	f := &FakeServer{invalid: make(map[string]bool)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", f.handleToken)
+	mux.HandleFunc("/v1/projects/", f.handleFcm)
+	mux.HandleFunc("/3/device/", f.handleApns)
+	f.Server = httptest.NewServer(mux)
+	return f
+}
+
+// MarkInvalid 之后发往这些令牌的推送按“设备已注销”拒绝
+func (f *FakeServer) MarkInvalid(tokens ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, t := range tokens {
+		f.invalid[t] = true
+	}
+}
+
+// Sent 已接收的推送（副本）
+func (f *FakeServer) Sent() []FakePush {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	out := make([]FakePush, len(f.sent))
+	copy(out, f.sent)
+	return out
+}
+
+func (f *FakeServer) record(p FakePush) bool {
+	f.mu.Lock()
+	if f.invalid[p.Token] {
+		f.mu.Unlock()
+		return false
+	}
+	f.sent = append(f.sent, p)
+	f.mu.Unlock()
+	return true
+}
+
+func (f *FakeServer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.FormValue("assertion") == "" {
+		http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"access_token": fakeAccessToken,
+		"expires_in":   3600,
+		"token_type":   "Bearer",
+	})
+}
+
+func (f *FakeServer) handleFcm(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("Authorization") != "Bearer "+fakeAccessToken {
+		http.Error(w, `{"error":{"code":401,"status":"UNAUTHENTICATED"}}`, http.StatusUnauthorized)
+		return
+	}
+	var body struct {
+		Message struct {
+			Token        string            `json:"token"`
+			Notification map[string]string `json:"notification"`
+			Data         map[string]string `json:"data"`
+			Android      struct {
+				Priority    string `json:"priority"`
+				CollapseKey string `json:"collapse_key"`
+			} `json:"android"`
+		} `json:"message"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body.Message.Token == "" {
+		http.Error(w, `{"error":{"code":400,"status":"INVALID_ARGUMENT","message":"bad request"}}`, http.StatusBadRequest)
+		return
+	}
+	m := body.Message
+	ok := f.record(FakePush{
+		Provider:     ProviderFcm,
+		Token:        m.Token,
+		Title:        m.Notification["title"],
+		Body:         m.Notification["body"],
+		Data:         m.Data,
+		HighPriority: m.Android.Priority == "HIGH",
+		CollapseKey:  m.Android.CollapseKey,
+	})
+	w.Header().Set("Content-Type", "application/json")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"code":404,"message":"Requested entity was not found.","status":"NOT_FOUND",` +
+			`"details":[{"@type":"type.googleapis.com/google.firebase.fcm.v1.FcmError","errorCode":"UNREGISTERED"}]}}`))
+		return
+	}
+	_ = json.NewEncoder(w).Encode(map[string]string{"name": "projects/fake/messages/1"})
+}
+
+func (f *FakeServer) handleApns(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("authorization"), "bearer ") || r.Header.Get("apns-topic") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"reason":"MissingProviderToken"}`))
+		return
+	}
+	var payload map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"reason":"PayloadEmpty"}`))
+		return
+	}
+	p := FakePush{
+		Provider:     ProviderApns,
+		Token:        strings.TrimPrefix(r.URL.Path, "/3/device/"),
+		Data:         make(map[string]string),
+		HighPriority: r.Header.Get("apns-priority") == "10",
+		CollapseKey:  r.Header.Get("apns-collapse-id"),
+	}
+	for k, v := range payload {
+		if k == "aps" {
+			aps, _ := v.(map[string]interface{})
+			alert, _ := aps["alert"].(map[string]interface{})
+			p.Title, _ = alert["title"].(string)
+			p.Body, _ = alert["body"].(string)
+			continue
+		}
+		if s, ok := v.(string); ok {
+			p.Data[k] = s
+		}
+	}
+	if !f.record(p) {
+		w.WriteHeader(http.StatusGone)
+		_, _ = w.Write([]byte(`{"reason":"Unregistered"}`))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// FcmConf 生成一份指向本服务器的服务账号（随机 RSA 密钥）并返回对应配置
+func (f *FakeServer) FcmConf(dir string) (FcmConf, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return FcmConf{}, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return FcmConf{}, err
+	}
+	account, err := json.Marshal(serviceAccount{
+		ProjectID:    "fake-project",
+		PrivateKeyID: "fake-key",
+		PrivateKey:   string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		ClientEmail:  "push@fake-project.iam.gserviceaccount.com",
+		TokenURI:     f.URL + "/token",
+	})
+	if err != nil {
+		return FcmConf{}, err
+	}
+	path := filepath.Join(dir, "fake-fcm-service-account.json")
+	if err := os.WriteFile(path, account, 0o600); err != nil {
+		return FcmConf{}, err
+	}
+	return FcmConf{CredentialsFile: path, Endpoint: f.URL}, nil
+}
+
+// ApnsConf 生成一把指向本服务器的 .p8 密钥（随机 P-256）并返回对应配置
+func (f *FakeServer) ApnsConf(dir string) (ApnsConf, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return ApnsConf{}, err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return ApnsConf{}, err
+	}
+	path := filepath.Join(dir, "fake-apns.p8")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		return ApnsConf{}, err
+	}
+	return ApnsConf{
+		KeyFile:  path,
+		KeyId:    "FAKEKEY001",
+		TeamId:   "FAKETEAM01",
+		Topic:    "com.example.moe_social",
+		Endpoint: f.URL,
+	}, nil
+}
@@ -0,0 +1,248 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	fcmDefaultEndpoint = "https://fcm.googleapis.com"
+	fcmScope           = "https://www.googleapis.com/auth/firebase.messaging"
+)
+
+// FcmConf FCM HTTP v1 配置
+type FcmConf struct {
+	// CredentialsFile Firebase 服务账号 JSON（控制台 → 项目设置 → 服务账号 → 生成新的私钥）
+	CredentialsFile string `json:",optional"`
+	// ProjectId 为空时取服务账号中的 project_id
+	ProjectId string `json:",optional"`
+	// Endpoint 为空时为 https://fcm.googleapis.com；测试时指向 FakeServer
+	Endpoint string `json:",optional"`
+}
+
+// 服务账号 JSON 中用到的字段
+type serviceAccount struct {
+	ProjectID    string `json:"project_id"`
+	PrivateKeyID string `json:"private_key_id"`
+	PrivateKey   string `json:"private_key"`
+	ClientEmail  string `json:"client_email"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// FcmProvider 通过 FCM HTTP v1 API 发送（Android、Web，以及走 FCM 的 iOS）
+type FcmProvider struct {
+	endpoint  string
+	projectID string
+	account   serviceAccount
+	key       *rsa.PrivateKey
+	client    *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+func NewFcmProvider(c FcmConf) (*FcmProvider, error) {
+	raw, err := os.ReadFile(c.CredentialsFile)
+	if err != nil {
+		return nil, fmt.Errorf("读取 FCM 服务账号失败: %w", err)
+	}
+	var account serviceAccount
+	if err := json.Unmarshal(raw, &account); err != nil {
+		return nil, fmt.Errorf("解析 FCM 服务账号失败: %w", err)
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(account.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("解析 FCM 服务账号私钥失败: %w", err)
+	}
+	if account.TokenURI == "" {
+		account.TokenURI = "https://oauth2.googleapis.com/token"
+	}
+
+	p := &FcmProvider{
+		endpoint:  strings.TrimRight(c.Endpoint, "/"),
+		projectID: c.ProjectId,
+		account:   account,
+		key:       key,
+		client:    &http.Client{Timeout: 10 * time.Second},
+	}
+	if p.endpoint == "" {
+		p.endpoint = fcmDefaultEndpoint
+	}
+	if p.projectID == "" {
+		p.projectID = account.ProjectID
+	}
+	if p.projectID == "" {
+		return nil, errors.New("FCM 缺少 ProjectId")
+	}
+	return p, nil
+}
+
+func (p *FcmProvider) Name() string {
+	return ProviderFcm
+}
+
+func (p *FcmProvider) Send(ctx context.Context, msg *Message) error {
+	token, err := p.token(ctx)
+	if err != nil {
+		return err
+	}
+
+	android := map[string]interface{}{"priority": "NORMAL"}
+	apnsHeaders := map[string]string{"apns-priority": "5"}
+	webpushHeaders := map[string]string{"Urgency": "normal"}
+	if msg.HighPriority {
+		android["priority"] = "HIGH"
+		apnsHeaders["apns-priority"] = "10"
+		webpushHeaders["Urgency"] = "high"
+	}
+	if msg.CollapseKey != "" {
+		android["collapse_key"] = msg.CollapseKey
+		apnsHeaders["apns-collapse-id"] = msg.CollapseKey
+	}
+	if msg.TTLSeconds > 0 {
+		android["ttl"] = strconv.Itoa(msg.TTLSeconds) + "s"
+		apnsHeaders["apns-expiration"] = strconv.FormatInt(time.Now().Unix()+int64(msg.TTLSeconds), 10)
+		webpushHeaders["TTL"] = strconv.Itoa(msg.TTLSeconds)
+	}
+
+	body, err := json.Marshal(map[string]interface{}{
+		"message": map[string]interface{}{
+			"token": msg.Token,
+			"notification": map[string]string{
+				"title": msg.Title,
+				"body":  msg.Body,
+			},
+			"data":    msg.Data,
+			"android": android,
+			"apns":    map[string]interface{}{"headers": apnsHeaders},
+			"webpush": map[string]interface{}{"headers": webpushHeaders},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	sendURL := p.endpoint + "/v1/projects/" + url.PathEscape(p.projectID) + "/messages:send"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sendURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode == http.StatusUnauthorized {
+		// 访问令牌被提前吊销，下次重新换取
+		p.mu.Lock()
+		p.accessToken = ""
+		p.mu.Unlock()
+	}
+	if fcmTokenInvalid(resp.StatusCode, respBody) {
+		return fmt.Errorf("%w: fcm %d %s", ErrInvalidToken, resp.StatusCode, respBody)
+	}
+	return fmt.Errorf("fcm 推送失败: %d %s", resp.StatusCode, respBody)
+}
+
+// fcmTokenInvalid 按 FCM v1 错误码判断是否为令牌失效：
+// UNREGISTERED（应用卸载/令牌过期）、SENDER_ID_MISMATCH（令牌属于其他项目）、令牌格式错误
+func fcmTokenInvalid(status int, body []byte) bool {
+	var e struct {
+		Error struct {
+			Status  string `json:"status"`
+			Message string `json:"message"`
+			Details []struct {
+				ErrorCode string `json:"errorCode"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+	if json.Unmarshal(body, &e) != nil {
+		return status == http.StatusNotFound
+	}
+	for _, d := range e.Error.Details {
+		switch d.ErrorCode {
+		case "UNREGISTERED", "SENDER_ID_MISMATCH":
+			return true
+		}
+	}
+	if e.Error.Status == "INVALID_ARGUMENT" && strings.Contains(e.Error.Message, "registration token") {
+		return true
+	}
+	return status == http.StatusNotFound
+}
+
+// token 用服务账号换取 OAuth2 访问令牌（JWT bearer 授权），过期前 1 分钟刷新
+func (p *FcmProvider) token(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.accessToken != "" && time.Now().Before(p.expiresAt.Add(-time.Minute)) {
+		return p.accessToken, nil
+	}
+
+	now := time.Now()
+	assertion := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   p.account.ClientEmail,
+		"scope": fcmScope,
+		"aud":   p.account.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	})
+	assertion.Header["kid"] = p.account.PrivateKeyID
+	signed, err := assertion.SignedString(p.key)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {signed},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.account.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("获取 FCM 访问令牌失败: %d %s", resp.StatusCode, respBody)
+	}
+	var tok struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.Unmarshal(respBody, &tok); err != nil || tok.AccessToken == "" {
+		return "", fmt.Errorf("获取 FCM 访问令牌失败: %s", respBody)
+	}
+
+	p.accessToken = tok.AccessToken
+	p.expiresAt = now.Add(time.Duration(tok.ExpiresIn) * time.Second)
+	return p.accessToken, nil
+}
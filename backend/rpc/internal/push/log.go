@@ -0,0 +1,30 @@
+package push
+
+import (
+	"context"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// logProvider 开发环境的推送通道：不发送，只记日志。正文可能是私信、评论等用户内容，不打印
+type logProvider struct {
+	name string
+}
+
+func (p logProvider) Name() string {
+	return p.name
+}
+
+func (p logProvider) Send(ctx context.Context, msg *Message) error {
+	logx.WithContext(ctx).Infof("[push/fake] %s token=%s title=%q high=%v collapse=%s",
+		p.name, tokenSuffix(msg.Token), msg.Title, msg.HighPriority, msg.CollapseKey)
+	return nil
+}
+
+// tokenSuffix 日志中只保留令牌末尾几位，便于区分设备
+func tokenSuffix(token string) string {
+	if len(token) <= 8 {
+		return token
+	}
+	return "…" + token[len(token)-8:]
+}
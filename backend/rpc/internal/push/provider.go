@@ -0,0 +1,40 @@
+// Package push 移动端系统推送：设备令牌投递到 FCM（Android / Web）或 APNs（iOS），
+// 供离线私信、来电等需要唤醒设备的场景使用。站内通知与在线用户的实时推送走 WebSocket，不经过这里。
+package push
+
+import (
+	"context"
+	"errors"
+)
+
+// 推送通道
+const (
+	ProviderFcm  = "fcm"
+	ProviderApns = "apns"
+)
+
+// ErrInvalidToken 设备令牌已失效（卸载、过期、与应用不匹配），调用方应删除该设备
+var ErrInvalidToken = errors.New("push: invalid device token")
+
+// Message 发给单个设备的推送
+type Message struct {
+	Token string
+	Title string
+	Body  string
+	// Data 透传给客户端的业务数据（如 type、call_id），值统一为字符串以兼容 FCM
+	Data map[string]string
+	// HighPriority 立即唤醒设备（来电、私信）；否则由系统择机投递
+	HighPriority bool
+	// CollapseKey 同一 key 的未送达推送只保留最新一条（如同一会话的多条私信）
+	CollapseKey string
+	// TTLSeconds 设备离线时的保留时长，0 表示使用通道默认值；来电应设置较短的值
+	TTLSeconds int
+}
+
+// PushProvider 一种推送通道的实现
+type PushProvider interface {
+	// Name 通道名，对应 UserDevice.Provider
+	Name() string
+	// Send 发送一条推送；令牌失效时返回 ErrInvalidToken（可用 errors.Is 判断）
+	Send(ctx context.Context, msg *Message) error
+}
@@ -59,16 +59,136 @@ func (s *SuperServer) UpdateUserPassword(ctx context.Context, in *super.UpdateUs
 	return l.UpdateUserPassword(in)
 }
 
+func (s *SuperServer) RequestPasswordReset(ctx context.Context, in *super.RequestPasswordResetReq) (*super.RequestPasswordResetResp, error) {
+	l := logic.NewRequestPasswordResetLogic(ctx, s.svcCtx)
+	return l.RequestPasswordReset(in)
+}
+
 func (s *SuperServer) ResetPassword(ctx context.Context, in *super.ResetPasswordReq) (*super.ResetPasswordResp, error) {
 	l := logic.NewResetPasswordLogic(ctx, s.svcCtx)
 	return l.ResetPassword(in)
 }
 
+func (s *SuperServer) GetUserAuthState(ctx context.Context, in *super.GetUserAuthStateReq) (*super.GetUserAuthStateResp, error) {
+	l := logic.NewGetUserAuthStateLogic(ctx, s.svcCtx)
+	return l.GetUserAuthState(in)
+}
+
+func (s *SuperServer) RefreshSession(ctx context.Context, in *super.RefreshSessionReq) (*super.RefreshSessionResp, error) {
+	l := logic.NewRefreshSessionLogic(ctx, s.svcCtx)
+	return l.RefreshSession(in)
+}
+
+func (s *SuperServer) ListUserSessions(ctx context.Context, in *super.ListUserSessionsReq) (*super.ListUserSessionsResp, error) {
+	l := logic.NewListUserSessionsLogic(ctx, s.svcCtx)
+	return l.ListUserSessions(in)
+}
+
+func (s *SuperServer) RevokeUserSession(ctx context.Context, in *super.RevokeUserSessionReq) (*super.RevokeUserSessionResp, error) {
+	l := logic.NewRevokeUserSessionLogic(ctx, s.svcCtx)
+	return l.RevokeUserSession(in)
+}
+
+func (s *SuperServer) RevokeAllUserSessions(ctx context.Context, in *super.RevokeAllUserSessionsReq) (*super.RevokeAllUserSessionsResp, error) {
+	l := logic.NewRevokeAllUserSessionsLogic(ctx, s.svcCtx)
+	return l.RevokeAllUserSessions(in)
+}
+
+func (s *SuperServer) IssueWsTicket(ctx context.Context, in *super.IssueWsTicketReq) (*super.IssueWsTicketResp, error) {
+	l := logic.NewIssueWsTicketLogic(ctx, s.svcCtx)
+	return l.IssueWsTicket(in)
+}
+
+func (s *SuperServer) RedeemWsTicket(ctx context.Context, in *super.RedeemWsTicketReq) (*super.RedeemWsTicketResp, error) {
+	l := logic.NewRedeemWsTicketLogic(ctx, s.svcCtx)
+	return l.RedeemWsTicket(in)
+}
+
+func (s *SuperServer) SendEmailVerification(ctx context.Context, in *super.SendEmailVerificationReq) (*super.SendEmailVerificationResp, error) {
+	l := logic.NewSendEmailVerificationLogic(ctx, s.svcCtx)
+	return l.SendEmailVerification(in)
+}
+
+func (s *SuperServer) VerifyEmail(ctx context.Context, in *super.VerifyEmailReq) (*super.VerifyEmailResp, error) {
+	l := logic.NewVerifyEmailLogic(ctx, s.svcCtx)
+	return l.VerifyEmail(in)
+}
+
+func (s *SuperServer) UpdateUserRole(ctx context.Context, in *super.UpdateUserRoleReq) (*super.UpdateUserRoleResp, error) {
+	l := logic.NewUpdateUserRoleLogic(ctx, s.svcCtx)
+	return l.UpdateUserRole(in)
+}
+
+func (s *SuperServer) ListRoleAuditLogs(ctx context.Context, in *super.ListRoleAuditLogsReq) (*super.ListRoleAuditLogsResp, error) {
+	l := logic.NewListRoleAuditLogsLogic(ctx, s.svcCtx)
+	return l.ListRoleAuditLogs(in)
+}
+
+func (s *SuperServer) ListSecurityEvents(ctx context.Context, in *super.ListSecurityEventsReq) (*super.ListSecurityEventsResp, error) {
+	l := logic.NewListSecurityEventsLogic(ctx, s.svcCtx)
+	return l.ListSecurityEvents(in)
+}
+
+func (s *SuperServer) VerifyLoginMfa(ctx context.Context, in *super.VerifyLoginMfaReq) (*super.LoginResp, error) {
+	l := logic.NewVerifyLoginMfaLogic(ctx, s.svcCtx)
+	return l.VerifyLoginMfa(in)
+}
+
+func (s *SuperServer) GetMfaStatus(ctx context.Context, in *super.GetMfaStatusReq) (*super.GetMfaStatusResp, error) {
+	l := logic.NewGetMfaStatusLogic(ctx, s.svcCtx)
+	return l.GetMfaStatus(in)
+}
+
+func (s *SuperServer) BeginTotpEnrollment(ctx context.Context, in *super.BeginTotpEnrollmentReq) (*super.BeginTotpEnrollmentResp, error) {
+	l := logic.NewBeginTotpEnrollmentLogic(ctx, s.svcCtx)
+	return l.BeginTotpEnrollment(in)
+}
+
+func (s *SuperServer) ConfirmTotpEnrollment(ctx context.Context, in *super.ConfirmTotpEnrollmentReq) (*super.RecoveryCodesResp, error) {
+	l := logic.NewConfirmTotpEnrollmentLogic(ctx, s.svcCtx)
+	return l.ConfirmTotpEnrollment(in)
+}
+
+func (s *SuperServer) DisableTotp(ctx context.Context, in *super.DisableTotpReq) (*super.DisableTotpResp, error) {
+	l := logic.NewDisableTotpLogic(ctx, s.svcCtx)
+	return l.DisableTotp(in)
+}
+
+func (s *SuperServer) RegenerateRecoveryCodes(ctx context.Context, in *super.RegenerateRecoveryCodesReq) (*super.RecoveryCodesResp, error) {
+	l := logic.NewRegenerateRecoveryCodesLogic(ctx, s.svcCtx)
+	return l.RegenerateRecoveryCodes(in)
+}
+
+func (s *SuperServer) ListMfaPolicies(ctx context.Context, in *super.ListMfaPoliciesReq) (*super.ListMfaPoliciesResp, error) {
+	l := logic.NewListMfaPoliciesLogic(ctx, s.svcCtx)
+	return l.ListMfaPolicies(in)
+}
+
+func (s *SuperServer) SetMfaPolicy(ctx context.Context, in *super.SetMfaPolicyReq) (*super.SetMfaPolicyResp, error) {
+	l := logic.NewSetMfaPolicyLogic(ctx, s.svcCtx)
+	return l.SetMfaPolicy(in)
+}
+
 func (s *SuperServer) DeleteUser(ctx context.Context, in *super.DeleteUserReq) (*super.DeleteUserResp, error) {
 	l := logic.NewDeleteUserLogic(ctx, s.svcCtx)
 	return l.DeleteUser(in)
 }
 
+func (s *SuperServer) GetAccountDeletion(ctx context.Context, in *super.GetAccountDeletionReq) (*super.GetAccountDeletionResp, error) {
+	l := logic.NewGetAccountDeletionLogic(ctx, s.svcCtx)
+	return l.GetAccountDeletion(in)
+}
+
+func (s *SuperServer) CancelAccountDeletion(ctx context.Context, in *super.CancelAccountDeletionReq) (*super.CancelAccountDeletionResp, error) {
+	l := logic.NewCancelAccountDeletionLogic(ctx, s.svcCtx)
+	return l.CancelAccountDeletion(in)
+}
+
+func (s *SuperServer) ExportUserData(in *super.ExportUserDataReq, stream super.Super_ExportUserDataServer) error {
+	l := logic.NewExportUserDataLogic(stream.Context(), s.svcCtx)
+	return l.ExportUserData(in, stream)
+}
+
 func (s *SuperServer) UpdateUserVip(ctx context.Context, in *super.UpdateUserVipReq) (*super.UpdateUserVipResp, error) {
 	l := logic.NewUpdateUserVipLogic(ctx, s.svcCtx)
 	return l.UpdateUserVip(in)
@@ -174,6 +294,16 @@ func (s *SuperServer) CreatePost(ctx context.Context, in *super.CreatePostReq) (
 	return l.CreatePost(in)
 }
 
+func (s *SuperServer) UpdatePost(ctx context.Context, in *super.UpdatePostReq) (*super.UpdatePostResp, error) {
+	l := logic.NewUpdatePostLogic(ctx, s.svcCtx)
+	return l.UpdatePost(in)
+}
+
+func (s *SuperServer) DeletePost(ctx context.Context, in *super.DeletePostReq) (*super.DeletePostResp, error) {
+	l := logic.NewDeletePostLogic(ctx, s.svcCtx)
+	return l.DeletePost(in)
+}
+
 func (s *SuperServer) ReportPost(ctx context.Context, in *super.ReportPostReq) (*super.ReportPostResp, error) {
 	l := logic.NewReportPostLogic(ctx, s.svcCtx)
 	return l.ReportPost(in)
@@ -226,6 +356,61 @@ func (s *SuperServer) CreateNotification(ctx context.Context, in *super.CreateNo
 	return l.CreateNotification(in)
 }
 
+func (s *SuperServer) WatchNotifications(in *super.WatchNotificationsReq, stream super.Super_WatchNotificationsServer) error {
+	l := logic.NewWatchNotificationsLogic(stream.Context(), s.svcCtx)
+	return l.WatchNotifications(in, stream)
+}
+
+func (s *SuperServer) GetNotificationPreferences(ctx context.Context, in *super.GetNotificationPreferencesReq) (*super.NotificationPreferencesResp, error) {
+	l := logic.NewGetNotificationPreferencesLogic(ctx, s.svcCtx)
+	return l.GetNotificationPreferences(in)
+}
+
+func (s *SuperServer) UpdateNotificationPreferences(ctx context.Context, in *super.UpdateNotificationPreferencesReq) (*super.NotificationPreferencesResp, error) {
+	l := logic.NewUpdateNotificationPreferencesLogic(ctx, s.svcCtx)
+	return l.UpdateNotificationPreferences(in)
+}
+
+func (s *SuperServer) SetNotificationMute(ctx context.Context, in *super.SetNotificationMuteReq) (*super.SetNotificationMuteResp, error) {
+	l := logic.NewSetNotificationMuteLogic(ctx, s.svcCtx)
+	return l.SetNotificationMute(in)
+}
+
+func (s *SuperServer) CreateNotificationCampaign(ctx context.Context, in *super.CreateNotificationCampaignReq) (*super.NotificationCampaignResp, error) {
+	l := logic.NewCreateNotificationCampaignLogic(ctx, s.svcCtx)
+	return l.CreateNotificationCampaign(in)
+}
+
+func (s *SuperServer) ListNotificationCampaigns(ctx context.Context, in *super.ListNotificationCampaignsReq) (*super.ListNotificationCampaignsResp, error) {
+	l := logic.NewListNotificationCampaignsLogic(ctx, s.svcCtx)
+	return l.ListNotificationCampaigns(in)
+}
+
+func (s *SuperServer) GetNotificationCampaign(ctx context.Context, in *super.GetNotificationCampaignReq) (*super.NotificationCampaignResp, error) {
+	l := logic.NewGetNotificationCampaignLogic(ctx, s.svcCtx)
+	return l.GetNotificationCampaign(in)
+}
+
+func (s *SuperServer) CancelNotificationCampaign(ctx context.Context, in *super.GetNotificationCampaignReq) (*super.NotificationCampaignResp, error) {
+	l := logic.NewCancelNotificationCampaignLogic(ctx, s.svcCtx)
+	return l.CancelNotificationCampaign(in)
+}
+
+func (s *SuperServer) RegisterDevice(ctx context.Context, in *super.RegisterDeviceReq) (*super.RegisterDeviceResp, error) {
+	l := logic.NewRegisterDeviceLogic(ctx, s.svcCtx)
+	return l.RegisterDevice(in)
+}
+
+func (s *SuperServer) UnregisterDevice(ctx context.Context, in *super.UnregisterDeviceReq) (*super.UnregisterDeviceResp, error) {
+	l := logic.NewUnregisterDeviceLogic(ctx, s.svcCtx)
+	return l.UnregisterDevice(in)
+}
+
+func (s *SuperServer) SendDevicePush(ctx context.Context, in *super.SendDevicePushReq) (*super.SendDevicePushResp, error) {
+	l := logic.NewSendDevicePushLogic(ctx, s.svcCtx)
+	return l.SendDevicePush(in)
+}
+
 // 钱包相关服务
 func (s *SuperServer) Recharge(ctx context.Context, in *super.RechargeReq) (*super.RechargeResp, error) {
 	l := logic.NewRechargeLogic(ctx, s.svcCtx)
@@ -269,6 +454,32 @@ func (s *SuperServer) CheckFollow(ctx context.Context, in *super.CheckFollowReq)
 	return l.CheckFollow(in)
 }
 
+func (s *SuperServer) ListFollowRequests(ctx context.Context, in *super.ListFollowRequestsReq) (*super.ListFollowRequestsResp, error) {
+	l := logic.NewListFollowRequestsLogic(ctx, s.svcCtx)
+	return l.ListFollowRequests(in)
+}
+
+func (s *SuperServer) RespondFollowRequest(ctx context.Context, in *super.RespondFollowRequestReq) (*super.RespondFollowRequestResp, error) {
+	l := logic.NewRespondFollowRequestLogic(ctx, s.svcCtx)
+	return l.RespondFollowRequest(in)
+}
+
+// 隐私设置相关服务
+func (s *SuperServer) GetPrivacySettings(ctx context.Context, in *super.GetPrivacySettingsReq) (*super.PrivacySettingsResp, error) {
+	l := logic.NewGetPrivacySettingsLogic(ctx, s.svcCtx)
+	return l.GetPrivacySettings(in)
+}
+
+func (s *SuperServer) UpdatePrivacySettings(ctx context.Context, in *super.UpdatePrivacySettingsReq) (*super.PrivacySettingsResp, error) {
+	l := logic.NewUpdatePrivacySettingsLogic(ctx, s.svcCtx)
+	return l.UpdatePrivacySettings(in)
+}
+
+func (s *SuperServer) CheckDirectMessage(ctx context.Context, in *super.CheckDirectMessageReq) (*super.CheckDirectMessageResp, error) {
+	l := logic.NewCheckDirectMessageLogic(ctx, s.svcCtx)
+	return l.CheckDirectMessage(in)
+}
+
 func (s *SuperServer) SendFriendRequest(ctx context.Context, in *super.SendFriendRequestReq) (*super.SendFriendRequestResp, error) {
 	l := logic.NewSendFriendRequestLogic(ctx, s.svcCtx)
 	return l.SendFriendRequest(in)
@@ -304,6 +515,94 @@ func (s *SuperServer) GetFriendRelation(ctx context.Context, in *super.GetFriend
 	return l.GetFriendRelation(in)
 }
 
+// 拉黑相关服务
+func (s *SuperServer) BlockUser(ctx context.Context, in *super.BlockUserReq) (*super.BlockUserResp, error) {
+	l := logic.NewBlockUserLogic(ctx, s.svcCtx)
+	return l.BlockUser(in)
+}
+
+func (s *SuperServer) UnblockUser(ctx context.Context, in *super.BlockUserReq) (*super.BlockUserResp, error) {
+	l := logic.NewUnblockUserLogic(ctx, s.svcCtx)
+	return l.UnblockUser(in)
+}
+
+func (s *SuperServer) ListBlockedUsers(ctx context.Context, in *super.ListBlockedUsersReq) (*super.ListBlockedUsersResp, error) {
+	l := logic.NewListBlockedUsersLogic(ctx, s.svcCtx)
+	return l.ListBlockedUsers(in)
+}
+
+func (s *SuperServer) CheckUserBlock(ctx context.Context, in *super.CheckUserBlockReq) (*super.CheckUserBlockResp, error) {
+	l := logic.NewCheckUserBlockLogic(ctx, s.svcCtx)
+	return l.CheckUserBlock(in)
+}
+
+func (s *SuperServer) GetBlockedUserIds(ctx context.Context, in *super.GetBlockedUserIdsReq) (*super.GetBlockedUserIdsResp, error) {
+	l := logic.NewGetBlockedUserIdsLogic(ctx, s.svcCtx)
+	return l.GetBlockedUserIds(in)
+}
+
+// 处罚（封禁 / 限制）与申诉相关服务
+func (s *SuperServer) SuspendUser(ctx context.Context, in *super.SuspendUserReq) (*super.SuspensionResp, error) {
+	l := logic.NewSuspendUserLogic(ctx, s.svcCtx)
+	return l.SuspendUser(in)
+}
+
+func (s *SuperServer) LiftSuspension(ctx context.Context, in *super.LiftSuspensionReq) (*super.SuspensionResp, error) {
+	l := logic.NewLiftSuspensionLogic(ctx, s.svcCtx)
+	return l.LiftSuspension(in)
+}
+
+func (s *SuperServer) GetUserSuspension(ctx context.Context, in *super.GetUserSuspensionReq) (*super.SuspensionResp, error) {
+	l := logic.NewGetUserSuspensionLogic(ctx, s.svcCtx)
+	return l.GetUserSuspension(in)
+}
+
+func (s *SuperServer) SubmitSuspensionAppeal(ctx context.Context, in *super.SubmitSuspensionAppealReq) (*super.SuspensionAppealResp, error) {
+	l := logic.NewSubmitSuspensionAppealLogic(ctx, s.svcCtx)
+	return l.SubmitSuspensionAppeal(in)
+}
+
+func (s *SuperServer) ListSuspensionAppeals(ctx context.Context, in *super.ListSuspensionAppealsReq) (*super.ListSuspensionAppealsResp, error) {
+	l := logic.NewListSuspensionAppealsLogic(ctx, s.svcCtx)
+	return l.ListSuspensionAppeals(in)
+}
+
+func (s *SuperServer) ReviewSuspensionAppeal(ctx context.Context, in *super.ReviewSuspensionAppealReq) (*super.SuspensionAppealResp, error) {
+	l := logic.NewReviewSuspensionAppealLogic(ctx, s.svcCtx)
+	return l.ReviewSuspensionAppeal(in)
+}
+
+// 第三方登录（OIDC）与账号绑定相关服务
+func (s *SuperServer) ListOidcProviders(ctx context.Context, in *super.ListOidcProvidersReq) (*super.ListOidcProvidersResp, error) {
+	l := logic.NewListOidcProvidersLogic(ctx, s.svcCtx)
+	return l.ListOidcProviders(in)
+}
+
+func (s *SuperServer) StartOidcAuth(ctx context.Context, in *super.StartOidcAuthReq) (*super.StartOidcAuthResp, error) {
+	l := logic.NewStartOidcAuthLogic(ctx, s.svcCtx)
+	return l.StartOidcAuth(in)
+}
+
+func (s *SuperServer) OidcLogin(ctx context.Context, in *super.OidcLoginReq) (*super.LoginResp, error) {
+	l := logic.NewOidcLoginLogic(ctx, s.svcCtx)
+	return l.OidcLogin(in)
+}
+
+func (s *SuperServer) LinkUserIdentity(ctx context.Context, in *super.LinkUserIdentityReq) (*super.UserIdentityResp, error) {
+	l := logic.NewLinkUserIdentityLogic(ctx, s.svcCtx)
+	return l.LinkUserIdentity(in)
+}
+
+func (s *SuperServer) ListUserIdentities(ctx context.Context, in *super.ListUserIdentitiesReq) (*super.ListUserIdentitiesResp, error) {
+	l := logic.NewListUserIdentitiesLogic(ctx, s.svcCtx)
+	return l.ListUserIdentities(in)
+}
+
+func (s *SuperServer) UnlinkUserIdentity(ctx context.Context, in *super.UnlinkUserIdentityReq) (*super.UnlinkUserIdentityResp, error) {
+	l := logic.NewUnlinkUserIdentityLogic(ctx, s.svcCtx)
+	return l.UnlinkUserIdentity(in)
+}
+
 // 虚拟形象相关服务
 func (s *SuperServer) GetUserAvatar(ctx context.Context, in *super.GetUserAvatarReq) (*super.GetUserAvatarResp, error) {
 	l := logic.NewGetUserAvatarLogic(ctx, s.svcCtx)
@@ -340,3 +639,29 @@ func (s *SuperServer) GetExpLogs(ctx context.Context, in *super.GetExpLogsReq) (
 	l := logic.NewGetExpLogsLogic(ctx, s.svcCtx)
 	return l.GetExpLogs(in)
 }
+
+// 端到端加密（服务端只保存公钥与密文，不参与解密）
+func (s *SuperServer) UploadPreKeyBundle(ctx context.Context, in *super.UploadPreKeyBundleReq) (*super.UploadPreKeyBundleResp, error) {
+	l := logic.NewUploadPreKeyBundleLogic(ctx, s.svcCtx)
+	return l.UploadPreKeyBundle(in)
+}
+
+func (s *SuperServer) GetPreKeyBundles(ctx context.Context, in *super.GetPreKeyBundlesReq) (*super.GetPreKeyBundlesResp, error) {
+	l := logic.NewGetPreKeyBundlesLogic(ctx, s.svcCtx)
+	return l.GetPreKeyBundles(in)
+}
+
+func (s *SuperServer) StoreEncryptedMessage(ctx context.Context, in *super.StoreEncryptedMessageReq) (*super.StoreEncryptedMessageResp, error) {
+	l := logic.NewStoreEncryptedMessageLogic(ctx, s.svcCtx)
+	return l.StoreEncryptedMessage(in)
+}
+
+func (s *SuperServer) ListPendingEncryptedMessages(ctx context.Context, in *super.ListPendingEncryptedMessagesReq) (*super.ListPendingEncryptedMessagesResp, error) {
+	l := logic.NewListPendingEncryptedMessagesLogic(ctx, s.svcCtx)
+	return l.ListPendingEncryptedMessages(in)
+}
+
+func (s *SuperServer) AckEncryptedMessages(ctx context.Context, in *super.AckEncryptedMessagesReq) (*super.AckEncryptedMessagesResp, error) {
+	l := logic.NewAckEncryptedMessagesLogic(ctx, s.svcCtx)
+	return l.AckEncryptedMessages(in)
+}
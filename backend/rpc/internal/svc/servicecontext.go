@@ -1,15 +1,40 @@
 package svc
 
 import (
+	"backend/rpc/internal/campaign"
+	"backend/rpc/internal/captcha"
 	"backend/rpc/internal/config"
+	"backend/rpc/internal/deletion"
+	"backend/rpc/internal/mail"
+	"backend/rpc/internal/notify"
+	"backend/rpc/internal/notifyhub"
+	"backend/rpc/internal/oidc"
+	"backend/rpc/internal/push"
 	"backend/utils"
 
+	"github.com/spf13/viper"
 	"gorm.io/gorm"
 )
 
 type ServiceContext struct {
 	Config config.Config
 	DB     *gorm.DB
+	// NotificationHub 新通知广播，供 WatchNotifications 推给 API 实例
+	NotificationHub *notifyhub.Hub
+	// Notifier 统一的通知写入入口（类型注册表见 notify 包）
+	Notifier *notify.Service
+	// Campaigns 系统通知推送活动的后台发送
+	Campaigns *campaign.Runner
+	// Push 移动端系统推送（按用户设备投递，自动清理失效令牌）
+	Push *push.Dispatcher
+	// Mail 邮件发件箱（后台投递与失败重试）
+	Mail *mail.Outbox
+	// Captcha 登录人机验证的服务端校验
+	Captcha *captcha.Verifier
+	// Deletions 注销账号的后台清除（宽限期结束后执行）
+	Deletions *deletion.Runner
+	// Oidc 第三方登录提供方
+	Oidc *oidc.Registry
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -17,14 +42,32 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	if err := utils.InitConfig(); err != nil {
 		panic(err)
 	}
-	
+
+	// 初始化 JWT 密钥环（登录、刷新会话时签发令牌）
+	if err := utils.InitKeyRing(viper.GetViper()); err != nil {
+		panic(err)
+	}
+
 	// 初始化数据库连接
 	if err := utils.InitDB(); err != nil {
 		panic(err)
 	}
 
+	db := utils.GetDB()
+	hub := notifyhub.NewHub()
+	outbox := mail.NewOutbox(db, c.Mail)
+	notifier := notify.NewService(db, hub, outbox)
+
 	return &ServiceContext{
-		Config: c,
-		DB:     utils.GetDB(),
+		Config:          c,
+		DB:              db,
+		NotificationHub: hub,
+		Notifier:        notifier,
+		Campaigns:       campaign.NewRunner(db, notifier),
+		Push:            push.NewDispatcher(db, c.Push),
+		Mail:            outbox,
+		Captcha:         captcha.NewVerifier(c.Captcha),
+		Deletions:       deletion.NewRunner(db, c.ImageDir),
+		Oidc:            oidc.NewRegistry(c.Oidc),
 	}
 }
@@ -0,0 +1,39 @@
+// Package testdb 单元测试用的内存 SQLite 数据库（纯 Go 驱动，不需要 MySQL）。
+// 只迁移测试用到的表；MySQL 专有的语法（如 GREATEST）在这里不可用，业务 SQL 应写成两者都支持的形式（如 CASE WHEN）。
+package testdb
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var seq atomic.Int64
+
+// New 为当前测试创建一个独立的内存数据库并迁移 models，测试结束时关闭
+func New(t testing.TB, models ...interface{}) *gorm.DB {
+	t.Helper()
+	// 每个测试一个命名的共享缓存库：同一测试内的多个连接看到同一份数据，测试之间互不影响
+	dsn := fmt.Sprintf("file:testdb%d?mode=memory&cache=shared&_pragma=busy_timeout(5000)", seq.Add(1))
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("打开测试数据库失败: %v", err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("打开测试数据库失败: %v", err)
+	}
+	// SQLite 同时只允许一个写事务，单连接避免测试中出现 database is locked
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	if len(models) > 0 {
+		if err := db.AutoMigrate(models...); err != nil {
+			t.Fatalf("迁移测试数据库失败: %v", err)
+		}
+	}
+	return db
+}
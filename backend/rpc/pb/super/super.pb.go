@@ -40,6 +40,8 @@ type User struct {
 	Inventory       string                 `protobuf:"bytes,14,opt,name=inventory,proto3" json:"inventory,omitempty"`
 	EquippedFrameId string                 `protobuf:"bytes,15,opt,name=equipped_frame_id,json=equippedFrameId,proto3" json:"equipped_frame_id,omitempty"`
 	MoeNo           string                 `protobuf:"bytes,16,opt,name=moe_no,json=moeNo,proto3" json:"moe_no,omitempty"`
+	EmailVerified   bool                   `protobuf:"varint,17,opt,name=email_verified,json=emailVerified,proto3" json:"email_verified,omitempty"` // 当前邮箱是否已验证
+	PendingEmail    string                 `protobuf:"bytes,18,opt,name=pending_email,json=pendingEmail,proto3" json:"pending_email,omitempty"`     // 修改邮箱时待验证的新邮箱
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -186,12 +188,27 @@ func (x *User) GetMoeNo() string {
 	return ""
 }
 
+func (x *User) GetEmailVerified() bool {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return false
+}
+
+func (x *User) GetPendingEmail() string {
+	if x != nil {
+		return x.PendingEmail
+	}
+	return ""
+}
+
 // 用户注册请求
 type RegisterReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Lang          string                 `protobuf:"bytes,4,opt,name=lang,proto3" json:"lang,omitempty"` // 验证邮件语言 zh / en，为空时用默认语言
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -247,6 +264,13 @@ func (x *RegisterReq) GetEmail() string {
 	return ""
 }
 
+func (x *RegisterReq) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
 // 用户注册响应
 type RegisterResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -298,6 +322,9 @@ type LoginReq struct {
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,4,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"` // 记录到登录会话，供“登录设备”列表展示
+	UserAgent     string                 `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	CaptchaToken  string                 `protobuf:"bytes,6,opt,name=captcha_token,json=captchaToken,proto3" json:"captcha_token,omitempty"` // 失败多次后要求的人机验证令牌
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -353,13 +380,44 @@ func (x *LoginReq) GetPassword() string {
 	return ""
 }
 
+func (x *LoginReq) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *LoginReq) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *LoginReq) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
 // 用户登录响应
 type LoginResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	User         *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Token        string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                                   // 短期访问令牌（JWT，带会话 sid）
+	RefreshToken string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"` // 不透明刷新令牌，每次刷新后轮换
+	SessionId    string                 `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ExpiresIn    int64                  `protobuf:"varint,5,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // 访问令牌有效期（秒）
+	// 已开启两步验证：只返回 mfa_token，用 VerifyLoginMfa 提交验证码后才签发登录令牌
+	MfaRequired         bool   `protobuf:"varint,6,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`
+	MfaToken            string `protobuf:"bytes,7,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	MfaExpiresIn        int64  `protobuf:"varint,8,opt,name=mfa_expires_in,json=mfaExpiresIn,proto3" json:"mfa_expires_in,omitempty"`                      // mfa_token 有效期（秒）
+	MfaSetupRequired    bool   `protobuf:"varint,9,opt,name=mfa_setup_required,json=mfaSetupRequired,proto3" json:"mfa_setup_required,omitempty"`          // 当前角色要求两步验证但尚未绑定，绑定前不能使用管理权限
+	DeletionScheduledAt string `protobuf:"bytes,10,opt,name=deletion_scheduled_at,json=deletionScheduledAt,proto3" json:"deletion_scheduled_at,omitempty"` // 账号已申请注销时为清除时间，客户端可提示撤销
+	Registered          bool   `protobuf:"varint,11,opt,name=registered,proto3" json:"registered,omitempty"`                                               // 第三方首次登录时自动注册了新账号
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *LoginResp) Reset() {
@@ -406,27 +464,94 @@ func (x *LoginResp) GetToken() string {
 	return ""
 }
 
-type GetUserInfoReq struct {
+func (x *LoginResp) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *LoginResp) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *LoginResp) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *LoginResp) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *LoginResp) GetMfaToken() string {
+	if x != nil {
+		return x.MfaToken
+	}
+	return ""
+}
+
+func (x *LoginResp) GetMfaExpiresIn() int64 {
+	if x != nil {
+		return x.MfaExpiresIn
+	}
+	return 0
+}
+
+func (x *LoginResp) GetMfaSetupRequired() bool {
+	if x != nil {
+		return x.MfaSetupRequired
+	}
+	return false
+}
+
+func (x *LoginResp) GetDeletionScheduledAt() string {
+	if x != nil {
+		return x.DeletionScheduledAt
+	}
+	return ""
+}
+
+func (x *LoginResp) GetRegistered() bool {
+	if x != nil {
+		return x.Registered
+	}
+	return false
+}
+
+// 登录第二步：提交 TOTP 验证码或恢复码
+type VerifyLoginMfaReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	MfaToken      string                 `protobuf:"bytes,1,opt,name=mfa_token,json=mfaToken,proto3" json:"mfa_token,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserInfoReq) Reset() {
-	*x = GetUserInfoReq{}
+func (x *VerifyLoginMfaReq) Reset() {
+	*x = VerifyLoginMfaReq{}
 	mi := &file_super_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserInfoReq) String() string {
+func (x *VerifyLoginMfaReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserInfoReq) ProtoMessage() {}
+func (*VerifyLoginMfaReq) ProtoMessage() {}
 
-func (x *GetUserInfoReq) ProtoReflect() protoreflect.Message {
+func (x *VerifyLoginMfaReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -438,39 +563,60 @@ func (x *GetUserInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserInfoReq.ProtoReflect.Descriptor instead.
-func (*GetUserInfoReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyLoginMfaReq.ProtoReflect.Descriptor instead.
+func (*VerifyLoginMfaReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetUserInfoReq) GetUserId() string {
+func (x *VerifyLoginMfaReq) GetMfaToken() string {
 	if x != nil {
-		return x.UserId
+		return x.MfaToken
 	}
 	return ""
 }
 
-type GetUserInfoResp struct {
+func (x *VerifyLoginMfaReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyLoginMfaReq) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *VerifyLoginMfaReq) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+type GetMfaStatusReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserInfoResp) Reset() {
-	*x = GetUserInfoResp{}
+func (x *GetMfaStatusReq) Reset() {
+	*x = GetMfaStatusReq{}
 	mi := &file_super_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserInfoResp) String() string {
+func (x *GetMfaStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserInfoResp) ProtoMessage() {}
+func (*GetMfaStatusReq) ProtoMessage() {}
 
-func (x *GetUserInfoResp) ProtoReflect() protoreflect.Message {
+func (x *GetMfaStatusReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -482,40 +628,42 @@ func (x *GetUserInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserInfoResp.ProtoReflect.Descriptor instead.
-func (*GetUserInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMfaStatusReq.ProtoReflect.Descriptor instead.
+func (*GetMfaStatusReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetUserInfoResp) GetUser() *User {
+func (x *GetMfaStatusReq) GetUserId() string {
 	if x != nil {
-		return x.User
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// 获取单个用户请求
-type GetUserReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetMfaStatusResp struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Enabled                bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Required               bool                   `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"` // 当前角色是否要求开启
+	RecoveryCodesRemaining int32                  `protobuf:"varint,3,opt,name=recovery_codes_remaining,json=recoveryCodesRemaining,proto3" json:"recovery_codes_remaining,omitempty"`
+	EnabledAt              string                 `protobuf:"bytes,4,opt,name=enabled_at,json=enabledAt,proto3" json:"enabled_at,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetUserReq) Reset() {
-	*x = GetUserReq{}
+func (x *GetMfaStatusResp) Reset() {
+	*x = GetMfaStatusResp{}
 	mi := &file_super_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserReq) String() string {
+func (x *GetMfaStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserReq) ProtoMessage() {}
+func (*GetMfaStatusResp) ProtoMessage() {}
 
-func (x *GetUserReq) ProtoReflect() protoreflect.Message {
+func (x *GetMfaStatusResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -527,40 +675,61 @@ func (x *GetUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserReq.ProtoReflect.Descriptor instead.
-func (*GetUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetMfaStatusResp.ProtoReflect.Descriptor instead.
+func (*GetMfaStatusResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetUserReq) GetUserId() string {
+func (x *GetMfaStatusResp) GetEnabled() bool {
 	if x != nil {
-		return x.UserId
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *GetMfaStatusResp) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *GetMfaStatusResp) GetRecoveryCodesRemaining() int32 {
+	if x != nil {
+		return x.RecoveryCodesRemaining
+	}
+	return 0
+}
+
+func (x *GetMfaStatusResp) GetEnabledAt() string {
+	if x != nil {
+		return x.EnabledAt
 	}
 	return ""
 }
 
-// 获取单个用户响应
-type GetUserResp struct {
+// 开始绑定 TOTP：生成密钥，客户端把 otpauth_uri 渲染成二维码
+type BeginTotpEnrollmentReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserResp) Reset() {
-	*x = GetUserResp{}
+func (x *BeginTotpEnrollmentReq) Reset() {
+	*x = BeginTotpEnrollmentReq{}
 	mi := &file_super_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserResp) String() string {
+func (x *BeginTotpEnrollmentReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserResp) ProtoMessage() {}
+func (*BeginTotpEnrollmentReq) ProtoMessage() {}
 
-func (x *GetUserResp) ProtoReflect() protoreflect.Message {
+func (x *BeginTotpEnrollmentReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -572,40 +741,40 @@ func (x *GetUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserResp.ProtoReflect.Descriptor instead.
-func (*GetUserResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use BeginTotpEnrollmentReq.ProtoReflect.Descriptor instead.
+func (*BeginTotpEnrollmentReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *GetUserResp) GetUser() *User {
+func (x *BeginTotpEnrollmentReq) GetUserId() string {
 	if x != nil {
-		return x.User
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// 根据邮箱获取用户请求
-type GetUserByEmailReq struct {
+type BeginTotpEnrollmentResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	OtpauthUri    string                 `protobuf:"bytes,2,opt,name=otpauth_uri,json=otpauthUri,proto3" json:"otpauth_uri,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserByEmailReq) Reset() {
-	*x = GetUserByEmailReq{}
+func (x *BeginTotpEnrollmentResp) Reset() {
+	*x = BeginTotpEnrollmentResp{}
 	mi := &file_super_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserByEmailReq) String() string {
+func (x *BeginTotpEnrollmentResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserByEmailReq) ProtoMessage() {}
+func (*BeginTotpEnrollmentResp) ProtoMessage() {}
 
-func (x *GetUserByEmailReq) ProtoReflect() protoreflect.Message {
+func (x *BeginTotpEnrollmentResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -617,40 +786,50 @@ func (x *GetUserByEmailReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserByEmailReq.ProtoReflect.Descriptor instead.
-func (*GetUserByEmailReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use BeginTotpEnrollmentResp.ProtoReflect.Descriptor instead.
+func (*BeginTotpEnrollmentResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetUserByEmailReq) GetEmail() string {
+func (x *BeginTotpEnrollmentResp) GetSecret() string {
 	if x != nil {
-		return x.Email
+		return x.Secret
 	}
 	return ""
 }
 
-// 根据邮箱获取用户响应
-type GetUserByEmailResp struct {
+func (x *BeginTotpEnrollmentResp) GetOtpauthUri() string {
+	if x != nil {
+		return x.OtpauthUri
+	}
+	return ""
+}
+
+// 输入认证器上的验证码确认绑定，返回恢复码（只返回这一次）
+type ConfirmTotpEnrollmentReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserByEmailResp) Reset() {
-	*x = GetUserByEmailResp{}
+func (x *ConfirmTotpEnrollmentReq) Reset() {
+	*x = ConfirmTotpEnrollmentReq{}
 	mi := &file_super_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserByEmailResp) String() string {
+func (x *ConfirmTotpEnrollmentReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserByEmailResp) ProtoMessage() {}
+func (*ConfirmTotpEnrollmentReq) ProtoMessage() {}
 
-func (x *GetUserByEmailResp) ProtoReflect() protoreflect.Message {
+func (x *ConfirmTotpEnrollmentReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -662,49 +841,60 @@ func (x *GetUserByEmailResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserByEmailResp.ProtoReflect.Descriptor instead.
-func (*GetUserByEmailResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConfirmTotpEnrollmentReq.ProtoReflect.Descriptor instead.
+func (*ConfirmTotpEnrollmentReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetUserByEmailResp) GetUser() *User {
+func (x *ConfirmTotpEnrollmentReq) GetUserId() string {
 	if x != nil {
-		return x.User
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// 更新用户信息请求
-type UpdateUserInfoReq struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username           string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Email              string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	Avatar             string                 `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Signature          string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"` // 个性签名
-	Gender             string                 `protobuf:"bytes,6,opt,name=gender,proto3" json:"gender,omitempty"`       // 性别
-	Birthday           string                 `protobuf:"bytes,7,opt,name=birthday,proto3" json:"birthday,omitempty"`   // 生日
-	Inventory          string                 `protobuf:"bytes,8,opt,name=inventory,proto3" json:"inventory,omitempty"`
-	EquippedFrameId    string                 `protobuf:"bytes,9,opt,name=equipped_frame_id,json=equippedFrameId,proto3" json:"equipped_frame_id,omitempty"`
-	ClearEquippedFrame bool                   `protobuf:"varint,10,opt,name=clear_equipped_frame,json=clearEquippedFrame,proto3" json:"clear_equipped_frame,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *ConfirmTotpEnrollmentReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
 }
 
-func (x *UpdateUserInfoReq) Reset() {
-	*x = UpdateUserInfoReq{}
+func (x *ConfirmTotpEnrollmentReq) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *ConfirmTotpEnrollmentReq) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+type RecoveryCodesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecoveryCodesResp) Reset() {
+	*x = RecoveryCodesResp{}
 	mi := &file_super_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserInfoReq) String() string {
+func (x *RecoveryCodesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserInfoReq) ProtoMessage() {}
+func (*RecoveryCodesResp) ProtoMessage() {}
 
-func (x *UpdateUserInfoReq) ProtoReflect() protoreflect.Message {
+func (x *RecoveryCodesResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -716,104 +906,116 @@ func (x *UpdateUserInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserInfoReq.ProtoReflect.Descriptor instead.
-func (*UpdateUserInfoReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecoveryCodesResp.ProtoReflect.Descriptor instead.
+func (*RecoveryCodesResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *UpdateUserInfoReq) GetUserId() string {
+func (x *RecoveryCodesResp) GetRecoveryCodes() []string {
 	if x != nil {
-		return x.UserId
+		return x.RecoveryCodes
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateUserInfoReq) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+// 关闭两步验证：需要密码和验证码（或恢复码）
+type DisableTotpReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,4,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserInfoReq) GetEmail() string {
-	if x != nil {
-		return x.Email
-	}
-	return ""
+func (x *DisableTotpReq) Reset() {
+	*x = DisableTotpReq{}
+	mi := &file_super_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserInfoReq) GetAvatar() string {
-	if x != nil {
-		return x.Avatar
-	}
-	return ""
+func (x *DisableTotpReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateUserInfoReq) GetSignature() string {
+func (*DisableTotpReq) ProtoMessage() {}
+
+func (x *DisableTotpReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[12]
 	if x != nil {
-		return x.Signature
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateUserInfoReq) GetGender() string {
+// Deprecated: Use DisableTotpReq.ProtoReflect.Descriptor instead.
+func (*DisableTotpReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DisableTotpReq) GetUserId() string {
 	if x != nil {
-		return x.Gender
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateUserInfoReq) GetBirthday() string {
+func (x *DisableTotpReq) GetPassword() string {
 	if x != nil {
-		return x.Birthday
+		return x.Password
 	}
 	return ""
 }
 
-func (x *UpdateUserInfoReq) GetInventory() string {
+func (x *DisableTotpReq) GetCode() string {
 	if x != nil {
-		return x.Inventory
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UpdateUserInfoReq) GetEquippedFrameId() string {
+func (x *DisableTotpReq) GetClientIp() string {
 	if x != nil {
-		return x.EquippedFrameId
+		return x.ClientIp
 	}
 	return ""
 }
 
-func (x *UpdateUserInfoReq) GetClearEquippedFrame() bool {
+func (x *DisableTotpReq) GetUserAgent() string {
 	if x != nil {
-		return x.ClearEquippedFrame
+		return x.UserAgent
 	}
-	return false
+	return ""
 }
 
-// 更新用户信息响应
-type UpdateUserInfoResp struct {
+type DisableTotpResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserInfoResp) Reset() {
-	*x = UpdateUserInfoResp{}
-	mi := &file_super_proto_msgTypes[12]
+func (x *DisableTotpResp) Reset() {
+	*x = DisableTotpResp{}
+	mi := &file_super_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserInfoResp) String() string {
+func (x *DisableTotpResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserInfoResp) ProtoMessage() {}
+func (*DisableTotpResp) ProtoMessage() {}
 
-func (x *UpdateUserInfoResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[12]
+func (x *DisableTotpResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -824,43 +1026,37 @@ func (x *UpdateUserInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserInfoResp.ProtoReflect.Descriptor instead.
-func (*UpdateUserInfoResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *UpdateUserInfoResp) GetUser() *User {
-	if x != nil {
-		return x.User
-	}
-	return nil
+// Deprecated: Use DisableTotpResp.ProtoReflect.Descriptor instead.
+func (*DisableTotpResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{13}
 }
 
-// 更新用户密码请求
-type UpdateUserPasswordReq struct {
+// 重新生成恢复码，旧的全部作废
+type RegenerateRecoveryCodesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
-	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserPasswordReq) Reset() {
-	*x = UpdateUserPasswordReq{}
-	mi := &file_super_proto_msgTypes[13]
+func (x *RegenerateRecoveryCodesReq) Reset() {
+	*x = RegenerateRecoveryCodesReq{}
+	mi := &file_super_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserPasswordReq) String() string {
+func (x *RegenerateRecoveryCodesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserPasswordReq) ProtoMessage() {}
+func (*RegenerateRecoveryCodesReq) ProtoMessage() {}
 
-func (x *UpdateUserPasswordReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[13]
+func (x *RegenerateRecoveryCodesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -871,92 +1067,64 @@ func (x *UpdateUserPasswordReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserPasswordReq.ProtoReflect.Descriptor instead.
-func (*UpdateUserPasswordReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RegenerateRecoveryCodesReq.ProtoReflect.Descriptor instead.
+func (*RegenerateRecoveryCodesReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UpdateUserPasswordReq) GetUserId() string {
+func (x *RegenerateRecoveryCodesReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateUserPasswordReq) GetOldPassword() string {
+func (x *RegenerateRecoveryCodesReq) GetCode() string {
 	if x != nil {
-		return x.OldPassword
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UpdateUserPasswordReq) GetNewPassword() string {
+func (x *RegenerateRecoveryCodesReq) GetClientIp() string {
 	if x != nil {
-		return x.NewPassword
+		return x.ClientIp
 	}
 	return ""
 }
 
-// 更新用户密码响应
-type UpdateUserPasswordResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateUserPasswordResp) Reset() {
-	*x = UpdateUserPasswordResp{}
-	mi := &file_super_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateUserPasswordResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UpdateUserPasswordResp) ProtoMessage() {}
-
-func (x *UpdateUserPasswordResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[14]
+func (x *RegenerateRecoveryCodesReq) GetUserAgent() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UserAgent
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateUserPasswordResp.ProtoReflect.Descriptor instead.
-func (*UpdateUserPasswordResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{14}
+	return ""
 }
 
-// 重置用户密码请求
-type ResetPasswordReq struct {
+// 按角色强制两步验证
+type MfaPolicy struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	Required      bool                   `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetPasswordReq) Reset() {
-	*x = ResetPasswordReq{}
+func (x *MfaPolicy) Reset() {
+	*x = MfaPolicy{}
 	mi := &file_super_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetPasswordReq) String() string {
+func (x *MfaPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordReq) ProtoMessage() {}
+func (*MfaPolicy) ProtoMessage() {}
 
-func (x *ResetPasswordReq) ProtoReflect() protoreflect.Message {
+func (x *MfaPolicy) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -968,46 +1136,60 @@ func (x *ResetPasswordReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordReq.ProtoReflect.Descriptor instead.
-func (*ResetPasswordReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use MfaPolicy.ProtoReflect.Descriptor instead.
+func (*MfaPolicy) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ResetPasswordReq) GetEmail() string {
+func (x *MfaPolicy) GetRole() string {
 	if x != nil {
-		return x.Email
+		return x.Role
 	}
 	return ""
 }
 
-func (x *ResetPasswordReq) GetNewPassword() string {
+func (x *MfaPolicy) GetRequired() bool {
 	if x != nil {
-		return x.NewPassword
+		return x.Required
+	}
+	return false
+}
+
+func (x *MfaPolicy) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
 	}
 	return ""
 }
 
-// 重置用户密码响应
-type ResetPasswordResp struct {
+func (x *MfaPolicy) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListMfaPoliciesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetPasswordResp) Reset() {
-	*x = ResetPasswordResp{}
+func (x *ListMfaPoliciesReq) Reset() {
+	*x = ListMfaPoliciesReq{}
 	mi := &file_super_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetPasswordResp) String() string {
+func (x *ListMfaPoliciesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetPasswordResp) ProtoMessage() {}
+func (*ListMfaPoliciesReq) ProtoMessage() {}
 
-func (x *ResetPasswordResp) ProtoReflect() protoreflect.Message {
+func (x *ListMfaPoliciesReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1019,33 +1201,39 @@ func (x *ResetPasswordResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetPasswordResp.ProtoReflect.Descriptor instead.
-func (*ResetPasswordResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMfaPoliciesReq.ProtoReflect.Descriptor instead.
+func (*ListMfaPoliciesReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{16}
 }
 
-// 删除用户请求
-type DeleteUserReq struct {
+func (x *ListMfaPoliciesReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+type ListMfaPoliciesResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Policies      []*MfaPolicy           `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserReq) Reset() {
-	*x = DeleteUserReq{}
+func (x *ListMfaPoliciesResp) Reset() {
+	*x = ListMfaPoliciesResp{}
 	mi := &file_super_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserReq) String() string {
+func (x *ListMfaPoliciesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserReq) ProtoMessage() {}
+func (*ListMfaPoliciesResp) ProtoMessage() {}
 
-func (x *DeleteUserReq) ProtoReflect() protoreflect.Message {
+func (x *ListMfaPoliciesResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1057,39 +1245,41 @@ func (x *DeleteUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserReq.ProtoReflect.Descriptor instead.
-func (*DeleteUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMfaPoliciesResp.ProtoReflect.Descriptor instead.
+func (*ListMfaPoliciesResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DeleteUserReq) GetUserId() string {
+func (x *ListMfaPoliciesResp) GetPolicies() []*MfaPolicy {
 	if x != nil {
-		return x.UserId
+		return x.Policies
 	}
-	return ""
+	return nil
 }
 
-// 删除用户响应
-type DeleteUserResp struct {
+type SetMfaPolicyReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserResp) Reset() {
-	*x = DeleteUserResp{}
+func (x *SetMfaPolicyReq) Reset() {
+	*x = SetMfaPolicyReq{}
 	mi := &file_super_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserResp) String() string {
+func (x *SetMfaPolicyReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserResp) ProtoMessage() {}
+func (*SetMfaPolicyReq) ProtoMessage() {}
 
-func (x *DeleteUserResp) ProtoReflect() protoreflect.Message {
+func (x *SetMfaPolicyReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1101,35 +1291,53 @@ func (x *DeleteUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserResp.ProtoReflect.Descriptor instead.
-func (*DeleteUserResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetMfaPolicyReq.ProtoReflect.Descriptor instead.
+func (*SetMfaPolicyReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{18}
 }
 
-// 更新用户VIP状态请求
-type UpdateUserVipReq struct {
+func (x *SetMfaPolicyReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *SetMfaPolicyReq) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *SetMfaPolicyReq) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+type SetMfaPolicyResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	IsVip         bool                   `protobuf:"varint,2,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
-	VipExpires    string                 `protobuf:"bytes,3,opt,name=vip_expires,json=vipExpires,proto3" json:"vip_expires,omitempty"`
+	Policy        *MfaPolicy             `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserVipReq) Reset() {
-	*x = UpdateUserVipReq{}
+func (x *SetMfaPolicyResp) Reset() {
+	*x = SetMfaPolicyResp{}
 	mi := &file_super_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserVipReq) String() string {
+func (x *SetMfaPolicyResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserVipReq) ProtoMessage() {}
+func (*SetMfaPolicyResp) ProtoMessage() {}
 
-func (x *UpdateUserVipReq) ProtoReflect() protoreflect.Message {
+func (x *SetMfaPolicyResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1141,54 +1349,39 @@ func (x *UpdateUserVipReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserVipReq.ProtoReflect.Descriptor instead.
-func (*UpdateUserVipReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetMfaPolicyResp.ProtoReflect.Descriptor instead.
+func (*SetMfaPolicyResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *UpdateUserVipReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *UpdateUserVipReq) GetIsVip() bool {
-	if x != nil {
-		return x.IsVip
-	}
-	return false
-}
-
-func (x *UpdateUserVipReq) GetVipExpires() string {
+func (x *SetMfaPolicyResp) GetPolicy() *MfaPolicy {
 	if x != nil {
-		return x.VipExpires
+		return x.Policy
 	}
-	return ""
+	return nil
 }
 
-// 更新用户VIP状态响应
-type UpdateUserVipResp struct {
+type GetUserInfoReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserVipResp) Reset() {
-	*x = UpdateUserVipResp{}
+func (x *GetUserInfoReq) Reset() {
+	*x = GetUserInfoReq{}
 	mi := &file_super_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserVipResp) String() string {
+func (x *GetUserInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserVipResp) ProtoMessage() {}
+func (*GetUserInfoReq) ProtoMessage() {}
 
-func (x *UpdateUserVipResp) ProtoReflect() protoreflect.Message {
+func (x *GetUserInfoReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1200,40 +1393,39 @@ func (x *UpdateUserVipResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserVipResp.ProtoReflect.Descriptor instead.
-func (*UpdateUserVipResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserInfoReq.ProtoReflect.Descriptor instead.
+func (*GetUserInfoReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *UpdateUserVipResp) GetUser() *User {
+func (x *GetUserInfoReq) GetUserId() string {
 	if x != nil {
-		return x.User
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type GetUsersReq struct {
+type GetUserInfoResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUsersReq) Reset() {
-	*x = GetUsersReq{}
+func (x *GetUserInfoResp) Reset() {
+	*x = GetUserInfoResp{}
 	mi := &file_super_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUsersReq) String() string {
+func (x *GetUserInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsersReq) ProtoMessage() {}
+func (*GetUserInfoResp) ProtoMessage() {}
 
-func (x *GetUsersReq) ProtoReflect() protoreflect.Message {
+func (x *GetUserInfoResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1245,47 +1437,40 @@ func (x *GetUsersReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsersReq.ProtoReflect.Descriptor instead.
-func (*GetUsersReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserInfoResp.ProtoReflect.Descriptor instead.
+func (*GetUserInfoResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *GetUsersReq) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetUsersReq) GetPageSize() int32 {
+func (x *GetUserInfoResp) GetUser() *User {
 	if x != nil {
-		return x.PageSize
+		return x.User
 	}
-	return 0
+	return nil
 }
 
-type GetUsersResp struct {
+// 获取单个用户请求
+type GetUserReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUsersResp) Reset() {
-	*x = GetUsersResp{}
+func (x *GetUserReq) Reset() {
+	*x = GetUserReq{}
 	mi := &file_super_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUsersResp) String() string {
+func (x *GetUserReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUsersResp) ProtoMessage() {}
+func (*GetUserReq) ProtoMessage() {}
 
-func (x *GetUsersResp) ProtoReflect() protoreflect.Message {
+func (x *GetUserReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1297,45 +1482,40 @@ func (x *GetUsersResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUsersResp.ProtoReflect.Descriptor instead.
-func (*GetUsersResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserReq.ProtoReflect.Descriptor instead.
+func (*GetUserReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetUsersResp) GetUsers() []*User {
-	if x != nil {
-		return x.Users
-	}
-	return nil
-}
-
-func (x *GetUsersResp) GetTotal() int32 {
+func (x *GetUserReq) GetUserId() string {
 	if x != nil {
-		return x.Total
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-type GetUserCountReq struct {
+// 获取单个用户响应
+type GetUserResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserCountReq) Reset() {
-	*x = GetUserCountReq{}
+func (x *GetUserResp) Reset() {
+	*x = GetUserResp{}
 	mi := &file_super_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserCountReq) String() string {
+func (x *GetUserResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserCountReq) ProtoMessage() {}
+func (*GetUserResp) ProtoMessage() {}
 
-func (x *GetUserCountReq) ProtoReflect() protoreflect.Message {
+func (x *GetUserResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1347,32 +1527,40 @@ func (x *GetUserCountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserCountReq.ProtoReflect.Descriptor instead.
-func (*GetUserCountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserResp.ProtoReflect.Descriptor instead.
+func (*GetUserResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{23}
 }
 
-type GetUserCountResp struct {
+func (x *GetUserResp) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// 根据邮箱获取用户请求
+type GetUserByEmailReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserCountResp) Reset() {
-	*x = GetUserCountResp{}
+func (x *GetUserByEmailReq) Reset() {
+	*x = GetUserByEmailReq{}
 	mi := &file_super_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserCountResp) String() string {
+func (x *GetUserByEmailReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserCountResp) ProtoMessage() {}
+func (*GetUserByEmailReq) ProtoMessage() {}
 
-func (x *GetUserCountResp) ProtoReflect() protoreflect.Message {
+func (x *GetUserByEmailReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1384,46 +1572,40 @@ func (x *GetUserCountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserCountResp.ProtoReflect.Descriptor instead.
-func (*GetUserCountResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserByEmailReq.ProtoReflect.Descriptor instead.
+func (*GetUserByEmailReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetUserCountResp) GetCount() int32 {
+func (x *GetUserByEmailReq) GetEmail() string {
 	if x != nil {
-		return x.Count
+		return x.Email
 	}
-	return 0
+	return ""
 }
 
-// VIP套餐相关消息
-type VipPlan struct {
+// 根据邮箱获取用户响应
+type GetUserByEmailResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float32                `protobuf:"fixed32,4,opt,name=price,proto3" json:"price,omitempty"`
-	DurationDays  int32                  `protobuf:"varint,5,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VipPlan) Reset() {
-	*x = VipPlan{}
+func (x *GetUserByEmailResp) Reset() {
+	*x = GetUserByEmailResp{}
 	mi := &file_super_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VipPlan) String() string {
+func (x *GetUserByEmailResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VipPlan) ProtoMessage() {}
+func (*GetUserByEmailResp) ProtoMessage() {}
 
-func (x *VipPlan) ProtoReflect() protoreflect.Message {
+func (x *GetUserByEmailResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1435,82 +1617,49 @@ func (x *VipPlan) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VipPlan.ProtoReflect.Descriptor instead.
-func (*VipPlan) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserByEmailResp.ProtoReflect.Descriptor instead.
+func (*GetUserByEmailResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *VipPlan) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *VipPlan) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *VipPlan) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *VipPlan) GetPrice() float32 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *VipPlan) GetDurationDays() int32 {
-	if x != nil {
-		return x.DurationDays
-	}
-	return 0
-}
-
-func (x *VipPlan) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-func (x *VipPlan) GetUpdatedAt() string {
+func (x *GetUserByEmailResp) GetUser() *User {
 	if x != nil {
-		return x.UpdatedAt
+		return x.User
 	}
-	return ""
+	return nil
 }
 
-// 获取单个VIP套餐请求
-type GetVipPlanReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PlanId        string                 `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// 更新用户信息请求
+type UpdateUserInfoReq struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username           string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Email              string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Avatar             string                 `protobuf:"bytes,4,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Signature          string                 `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"` // 个性签名
+	Gender             string                 `protobuf:"bytes,6,opt,name=gender,proto3" json:"gender,omitempty"`       // 性别
+	Birthday           string                 `protobuf:"bytes,7,opt,name=birthday,proto3" json:"birthday,omitempty"`   // 生日
+	Inventory          string                 `protobuf:"bytes,8,opt,name=inventory,proto3" json:"inventory,omitempty"`
+	EquippedFrameId    string                 `protobuf:"bytes,9,opt,name=equipped_frame_id,json=equippedFrameId,proto3" json:"equipped_frame_id,omitempty"`
+	ClearEquippedFrame bool                   `protobuf:"varint,10,opt,name=clear_equipped_frame,json=clearEquippedFrame,proto3" json:"clear_equipped_frame,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetVipPlanReq) Reset() {
-	*x = GetVipPlanReq{}
+func (x *UpdateUserInfoReq) Reset() {
+	*x = UpdateUserInfoReq{}
 	mi := &file_super_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVipPlanReq) String() string {
+func (x *UpdateUserInfoReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVipPlanReq) ProtoMessage() {}
+func (*UpdateUserInfoReq) ProtoMessage() {}
 
-func (x *GetVipPlanReq) ProtoReflect() protoreflect.Message {
+func (x *UpdateUserInfoReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1522,40 +1671,103 @@ func (x *GetVipPlanReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipPlanReq.ProtoReflect.Descriptor instead.
-func (*GetVipPlanReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUserInfoReq.ProtoReflect.Descriptor instead.
+func (*UpdateUserInfoReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetVipPlanReq) GetPlanId() string {
+func (x *UpdateUserInfoReq) GetUserId() string {
 	if x != nil {
-		return x.PlanId
+		return x.UserId
 	}
 	return ""
 }
 
-// 获取单个VIP套餐响应
-type GetVipPlanResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Plan          *VipPlan               `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateUserInfoReq) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-func (x *GetVipPlanResp) Reset() {
-	*x = GetVipPlanResp{}
-	mi := &file_super_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UpdateUserInfoReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
 }
 
-func (x *GetVipPlanResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UpdateUserInfoReq) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
 }
 
-func (*GetVipPlanResp) ProtoMessage() {}
+func (x *UpdateUserInfoReq) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
 
-func (x *GetVipPlanResp) ProtoReflect() protoreflect.Message {
+func (x *UpdateUserInfoReq) GetGender() string {
+	if x != nil {
+		return x.Gender
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoReq) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoReq) GetInventory() string {
+	if x != nil {
+		return x.Inventory
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoReq) GetEquippedFrameId() string {
+	if x != nil {
+		return x.EquippedFrameId
+	}
+	return ""
+}
+
+func (x *UpdateUserInfoReq) GetClearEquippedFrame() bool {
+	if x != nil {
+		return x.ClearEquippedFrame
+	}
+	return false
+}
+
+// 更新用户信息响应
+type UpdateUserInfoResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserInfoResp) Reset() {
+	*x = UpdateUserInfoResp{}
+	mi := &file_super_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserInfoResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserInfoResp) ProtoMessage() {}
+
+func (x *UpdateUserInfoResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1567,43 +1779,44 @@ func (x *GetVipPlanResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipPlanResp.ProtoReflect.Descriptor instead.
-func (*GetVipPlanResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUserInfoResp.ProtoReflect.Descriptor instead.
+func (*UpdateUserInfoResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetVipPlanResp) GetPlan() *VipPlan {
+func (x *UpdateUserInfoResp) GetUser() *User {
 	if x != nil {
-		return x.Plan
+		return x.User
 	}
 	return nil
 }
 
-// 创建VIP套餐请求
-type CreateVipPlanReq struct {
+// 更新用户密码请求
+type UpdateUserPasswordReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float32                `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
-	DurationDays  int32                  `protobuf:"varint,4,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OldPassword   string                 `protobuf:"bytes,2,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,4,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"` // 记录到安全事件
+	UserAgent     string                 `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVipPlanReq) Reset() {
-	*x = CreateVipPlanReq{}
+func (x *UpdateUserPasswordReq) Reset() {
+	*x = UpdateUserPasswordReq{}
 	mi := &file_super_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVipPlanReq) String() string {
+func (x *UpdateUserPasswordReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVipPlanReq) ProtoMessage() {}
+func (*UpdateUserPasswordReq) ProtoMessage() {}
 
-func (x *CreateVipPlanReq) ProtoReflect() protoreflect.Message {
+func (x *UpdateUserPasswordReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1615,61 +1828,67 @@ func (x *CreateVipPlanReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVipPlanReq.ProtoReflect.Descriptor instead.
-func (*CreateVipPlanReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUserPasswordReq.ProtoReflect.Descriptor instead.
+func (*UpdateUserPasswordReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *CreateVipPlanReq) GetName() string {
+func (x *UpdateUserPasswordReq) GetUserId() string {
 	if x != nil {
-		return x.Name
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateVipPlanReq) GetDescription() string {
+func (x *UpdateUserPasswordReq) GetOldPassword() string {
 	if x != nil {
-		return x.Description
+		return x.OldPassword
 	}
 	return ""
 }
 
-func (x *CreateVipPlanReq) GetPrice() float32 {
+func (x *UpdateUserPasswordReq) GetNewPassword() string {
 	if x != nil {
-		return x.Price
+		return x.NewPassword
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateVipPlanReq) GetDurationDays() int32 {
+func (x *UpdateUserPasswordReq) GetClientIp() string {
 	if x != nil {
-		return x.DurationDays
+		return x.ClientIp
 	}
-	return 0
+	return ""
 }
 
-// 创建VIP套餐响应
-type CreateVipPlanResp struct {
+func (x *UpdateUserPasswordReq) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+// 更新用户密码响应
+type UpdateUserPasswordResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Plan          *VipPlan               `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVipPlanResp) Reset() {
-	*x = CreateVipPlanResp{}
+func (x *UpdateUserPasswordResp) Reset() {
+	*x = UpdateUserPasswordResp{}
 	mi := &file_super_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVipPlanResp) String() string {
+func (x *UpdateUserPasswordResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVipPlanResp) ProtoMessage() {}
+func (*UpdateUserPasswordResp) ProtoMessage() {}
 
-func (x *CreateVipPlanResp) ProtoReflect() protoreflect.Message {
+func (x *UpdateUserPasswordResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1681,38 +1900,35 @@ func (x *CreateVipPlanResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVipPlanResp.ProtoReflect.Descriptor instead.
-func (*CreateVipPlanResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUserPasswordResp.ProtoReflect.Descriptor instead.
+func (*UpdateUserPasswordResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CreateVipPlanResp) GetPlan() *VipPlan {
-	if x != nil {
-		return x.Plan
-	}
-	return nil
-}
-
-type GetVipPlansReq struct {
+// 申请找回密码：向邮箱发送验证码与重置链接
+type RequestPasswordResetReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,2,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"` // 用于按 IP 限流
+	Lang          string                 `protobuf:"bytes,3,opt,name=lang,proto3" json:"lang,omitempty"`                         // 邮件语言 zh / en，为空时用默认语言
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVipPlansReq) Reset() {
-	*x = GetVipPlansReq{}
+func (x *RequestPasswordResetReq) Reset() {
+	*x = RequestPasswordResetReq{}
 	mi := &file_super_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVipPlansReq) String() string {
+func (x *RequestPasswordResetReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVipPlansReq) ProtoMessage() {}
+func (*RequestPasswordResetReq) ProtoMessage() {}
 
-func (x *GetVipPlansReq) ProtoReflect() protoreflect.Message {
+func (x *RequestPasswordResetReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1724,32 +1940,52 @@ func (x *GetVipPlansReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipPlansReq.ProtoReflect.Descriptor instead.
-func (*GetVipPlansReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestPasswordResetReq.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{30}
 }
 
-type GetVipPlansResp struct {
+func (x *RequestPasswordResetReq) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetReq) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *RequestPasswordResetReq) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+type RequestPasswordResetResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Plans         []*VipPlan             `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVipPlansResp) Reset() {
-	*x = GetVipPlansResp{}
+func (x *RequestPasswordResetResp) Reset() {
+	*x = RequestPasswordResetResp{}
 	mi := &file_super_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVipPlansResp) String() string {
+func (x *RequestPasswordResetResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVipPlansResp) ProtoMessage() {}
+func (*RequestPasswordResetResp) ProtoMessage() {}
 
-func (x *GetVipPlansResp) ProtoReflect() protoreflect.Message {
+func (x *RequestPasswordResetResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1761,47 +1997,38 @@ func (x *GetVipPlansResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipPlansResp.ProtoReflect.Descriptor instead.
-func (*GetVipPlansResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use RequestPasswordResetResp.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *GetVipPlansResp) GetPlans() []*VipPlan {
-	if x != nil {
-		return x.Plans
-	}
-	return nil
-}
-
-// VIP订单相关消息
-type VipOrder struct {
+// 重置用户密码请求：email + code（邮件中的 6 位验证码）或 token（邮件链接中的令牌）二选一
+type ResetPasswordReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PlanId        string                 `protobuf:"bytes,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
-	PlanName      string                 `protobuf:"bytes,4,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
-	Amount        float32                `protobuf:"fixed32,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	PaidAt        string                 `protobuf:"bytes,8,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,5,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"` // 记录到安全事件
+	UserAgent     string                 `protobuf:"bytes,6,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VipOrder) Reset() {
-	*x = VipOrder{}
+func (x *ResetPasswordReq) Reset() {
+	*x = ResetPasswordReq{}
 	mi := &file_super_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VipOrder) String() string {
+func (x *ResetPasswordReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VipOrder) ProtoMessage() {}
+func (*ResetPasswordReq) ProtoMessage() {}
 
-func (x *VipOrder) ProtoReflect() protoreflect.Message {
+func (x *ResetPasswordReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1813,89 +2040,75 @@ func (x *VipOrder) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VipOrder.ProtoReflect.Descriptor instead.
-func (*VipOrder) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetPasswordReq.ProtoReflect.Descriptor instead.
+func (*ResetPasswordReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *VipOrder) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *VipOrder) GetUserId() string {
+func (x *ResetPasswordReq) GetEmail() string {
 	if x != nil {
-		return x.UserId
+		return x.Email
 	}
 	return ""
 }
 
-func (x *VipOrder) GetPlanId() string {
+func (x *ResetPasswordReq) GetNewPassword() string {
 	if x != nil {
-		return x.PlanId
+		return x.NewPassword
 	}
 	return ""
 }
 
-func (x *VipOrder) GetPlanName() string {
+func (x *ResetPasswordReq) GetCode() string {
 	if x != nil {
-		return x.PlanName
+		return x.Code
 	}
 	return ""
 }
 
-func (x *VipOrder) GetAmount() float32 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *VipOrder) GetStatus() string {
+func (x *ResetPasswordReq) GetToken() string {
 	if x != nil {
-		return x.Status
+		return x.Token
 	}
 	return ""
 }
 
-func (x *VipOrder) GetCreatedAt() string {
+func (x *ResetPasswordReq) GetClientIp() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ClientIp
 	}
 	return ""
 }
 
-func (x *VipOrder) GetPaidAt() string {
+func (x *ResetPasswordReq) GetUserAgent() string {
 	if x != nil {
-		return x.PaidAt
+		return x.UserAgent
 	}
 	return ""
 }
 
-type CreateVipOrderReq struct {
+// 重置用户密码响应
+type ResetPasswordResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PlanId        string                 `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVipOrderReq) Reset() {
-	*x = CreateVipOrderReq{}
+func (x *ResetPasswordResp) Reset() {
+	*x = ResetPasswordResp{}
 	mi := &file_super_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVipOrderReq) String() string {
+func (x *ResetPasswordResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVipOrderReq) ProtoMessage() {}
+func (*ResetPasswordResp) ProtoMessage() {}
 
-func (x *CreateVipOrderReq) ProtoReflect() protoreflect.Message {
+func (x *ResetPasswordResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1907,46 +2120,40 @@ func (x *CreateVipOrderReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVipOrderReq.ProtoReflect.Descriptor instead.
-func (*CreateVipOrderReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResetPasswordResp.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *CreateVipOrderReq) GetUserId() string {
+func (x *ResetPasswordResp) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateVipOrderReq) GetPlanId() string {
-	if x != nil {
-		return x.PlanId
-	}
-	return ""
-}
-
-type CreateVipOrderResp struct {
+// 用户的登录令牌状态，供 API 网关校验令牌是否已被吊销
+type GetUserAuthStateReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Order         *VipOrder              `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateVipOrderResp) Reset() {
-	*x = CreateVipOrderResp{}
+func (x *GetUserAuthStateReq) Reset() {
+	*x = GetUserAuthStateReq{}
 	mi := &file_super_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateVipOrderResp) String() string {
+func (x *GetUserAuthStateReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateVipOrderResp) ProtoMessage() {}
+func (*GetUserAuthStateReq) ProtoMessage() {}
 
-func (x *CreateVipOrderResp) ProtoReflect() protoreflect.Message {
+func (x *GetUserAuthStateReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1958,41 +2165,43 @@ func (x *CreateVipOrderResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateVipOrderResp.ProtoReflect.Descriptor instead.
-func (*CreateVipOrderResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserAuthStateReq.ProtoReflect.Descriptor instead.
+func (*GetUserAuthStateReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CreateVipOrderResp) GetOrder() *VipOrder {
+func (x *GetUserAuthStateReq) GetUserId() string {
 	if x != nil {
-		return x.Order
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type GetVipOrdersReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetUserAuthStateResp struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TokenVersion      int64                  `protobuf:"varint,1,opt,name=token_version,json=tokenVersion,proto3" json:"token_version,omitempty"`                 // 令牌中的 tv 小于该值即已失效
+	Role              string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`                                                      // 用户角色 user / admin / super_admin
+	RevokedSessionIds []string               `protobuf:"bytes,3,rep,name=revoked_session_ids,json=revokedSessionIds,proto3" json:"revoked_session_ids,omitempty"` // 最近撤销的会话（访问令牌有效期内），带这些 sid 的令牌已失效
+	Suspended         bool                   `protobuf:"varint,4,opt,name=suspended,proto3" json:"suspended,omitempty"`                                           // 账号被封禁：令牌一律失效
+	Restricted        bool                   `protobuf:"varint,5,opt,name=restricted,proto3" json:"restricted,omitempty"`                                         // 账号被限制：只能浏览，不能发动态、评论、私信
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetVipOrdersReq) Reset() {
-	*x = GetVipOrdersReq{}
+func (x *GetUserAuthStateResp) Reset() {
+	*x = GetUserAuthStateResp{}
 	mi := &file_super_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVipOrdersReq) String() string {
+func (x *GetUserAuthStateResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVipOrdersReq) ProtoMessage() {}
+func (*GetUserAuthStateResp) ProtoMessage() {}
 
-func (x *GetVipOrdersReq) ProtoReflect() protoreflect.Message {
+func (x *GetUserAuthStateResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2004,54 +2213,71 @@ func (x *GetVipOrdersReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipOrdersReq.ProtoReflect.Descriptor instead.
-func (*GetVipOrdersReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserAuthStateResp.ProtoReflect.Descriptor instead.
+func (*GetUserAuthStateResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetVipOrdersReq) GetUserId() string {
+func (x *GetUserAuthStateResp) GetTokenVersion() int64 {
 	if x != nil {
-		return x.UserId
+		return x.TokenVersion
+	}
+	return 0
+}
+
+func (x *GetUserAuthStateResp) GetRole() string {
+	if x != nil {
+		return x.Role
 	}
 	return ""
 }
 
-func (x *GetVipOrdersReq) GetPage() int32 {
+func (x *GetUserAuthStateResp) GetRevokedSessionIds() []string {
 	if x != nil {
-		return x.Page
+		return x.RevokedSessionIds
 	}
-	return 0
+	return nil
 }
 
-func (x *GetVipOrdersReq) GetPageSize() int32 {
+func (x *GetUserAuthStateResp) GetSuspended() bool {
 	if x != nil {
-		return x.PageSize
+		return x.Suspended
 	}
-	return 0
+	return false
 }
 
-type GetVipOrdersResp struct {
+func (x *GetUserAuthStateResp) GetRestricted() bool {
+	if x != nil {
+		return x.Restricted
+	}
+	return false
+}
+
+// 用刷新令牌换取新的访问令牌与刷新令牌（旧刷新令牌随即作废）；
+// 已用过的刷新令牌再次出现视为被盗用，整个会话被撤销
+type RefreshSessionReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*VipOrder            `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,2,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVipOrdersResp) Reset() {
-	*x = GetVipOrdersResp{}
+func (x *RefreshSessionReq) Reset() {
+	*x = RefreshSessionReq{}
 	mi := &file_super_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVipOrdersResp) String() string {
+func (x *RefreshSessionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVipOrdersResp) ProtoMessage() {}
+func (*RefreshSessionReq) ProtoMessage() {}
 
-func (x *GetVipOrdersResp) ProtoReflect() protoreflect.Message {
+func (x *RefreshSessionReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2063,54 +2289,57 @@ func (x *GetVipOrdersResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipOrdersResp.ProtoReflect.Descriptor instead.
-func (*GetVipOrdersResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshSessionReq.ProtoReflect.Descriptor instead.
+func (*RefreshSessionReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetVipOrdersResp) GetOrders() []*VipOrder {
+func (x *RefreshSessionReq) GetRefreshToken() string {
 	if x != nil {
-		return x.Orders
+		return x.RefreshToken
 	}
-	return nil
+	return ""
 }
 
-func (x *GetVipOrdersResp) GetTotal() int32 {
+func (x *RefreshSessionReq) GetClientIp() string {
 	if x != nil {
-		return x.Total
+		return x.ClientIp
 	}
-	return 0
+	return ""
 }
 
-// VIP记录相关消息
-type VipRecord struct {
+func (x *RefreshSessionReq) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+type RefreshSessionResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	PlanId        string                 `protobuf:"bytes,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
-	PlanName      string                 `protobuf:"bytes,4,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
-	StartAt       string                 `protobuf:"bytes,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
-	EndAt         string                 `protobuf:"bytes,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
-	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,4,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,5,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VipRecord) Reset() {
-	*x = VipRecord{}
+func (x *RefreshSessionResp) Reset() {
+	*x = RefreshSessionResp{}
 	mi := &file_super_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VipRecord) String() string {
+func (x *RefreshSessionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VipRecord) ProtoMessage() {}
+func (*RefreshSessionResp) ProtoMessage() {}
 
-func (x *VipRecord) ProtoReflect() protoreflect.Message {
+func (x *RefreshSessionResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2122,90 +2351,72 @@ func (x *VipRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VipRecord.ProtoReflect.Descriptor instead.
-func (*VipRecord) Descriptor() ([]byte, []int) {
+// Deprecated: Use RefreshSessionResp.ProtoReflect.Descriptor instead.
+func (*RefreshSessionResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *VipRecord) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *VipRecord) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *VipRecord) GetPlanId() string {
-	if x != nil {
-		return x.PlanId
-	}
-	return ""
-}
-
-func (x *VipRecord) GetPlanName() string {
+func (x *RefreshSessionResp) GetToken() string {
 	if x != nil {
-		return x.PlanName
+		return x.Token
 	}
 	return ""
 }
 
-func (x *VipRecord) GetStartAt() string {
+func (x *RefreshSessionResp) GetRefreshToken() string {
 	if x != nil {
-		return x.StartAt
+		return x.RefreshToken
 	}
 	return ""
 }
 
-func (x *VipRecord) GetEndAt() string {
+func (x *RefreshSessionResp) GetUserId() string {
 	if x != nil {
-		return x.EndAt
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *VipRecord) GetStatus() string {
+func (x *RefreshSessionResp) GetSessionId() string {
 	if x != nil {
-		return x.Status
+		return x.SessionId
 	}
 	return ""
 }
 
-func (x *VipRecord) GetCreatedAt() string {
+func (x *RefreshSessionResp) GetExpiresIn() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.ExpiresIn
 	}
-	return ""
+	return 0
 }
 
-type GetVipRecordsReq struct {
+type UserSession struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,2,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt    string                 `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVipRecordsReq) Reset() {
-	*x = GetVipRecordsReq{}
+func (x *UserSession) Reset() {
+	*x = UserSession{}
 	mi := &file_super_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVipRecordsReq) String() string {
+func (x *UserSession) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVipRecordsReq) ProtoMessage() {}
+func (*UserSession) ProtoMessage() {}
 
-func (x *GetVipRecordsReq) ProtoReflect() protoreflect.Message {
+func (x *UserSession) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2217,54 +2428,75 @@ func (x *GetVipRecordsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipRecordsReq.ProtoReflect.Descriptor instead.
-func (*GetVipRecordsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserSession.ProtoReflect.Descriptor instead.
+func (*UserSession) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetVipRecordsReq) GetUserId() string {
+func (x *UserSession) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetVipRecordsReq) GetPage() int32 {
+func (x *UserSession) GetUserAgent() string {
 	if x != nil {
-		return x.Page
+		return x.UserAgent
 	}
-	return 0
+	return ""
 }
 
-func (x *GetVipRecordsReq) GetPageSize() int32 {
+func (x *UserSession) GetClientIp() string {
 	if x != nil {
-		return x.PageSize
+		return x.ClientIp
 	}
-	return 0
+	return ""
 }
 
-type GetVipRecordsResp struct {
+func (x *UserSession) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserSession) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *UserSession) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// 用户当前有效的登录会话（登录设备）
+type ListUserSessionsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Records       []*VipRecord           `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetVipRecordsResp) Reset() {
-	*x = GetVipRecordsResp{}
+func (x *ListUserSessionsReq) Reset() {
+	*x = ListUserSessionsReq{}
 	mi := &file_super_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetVipRecordsResp) String() string {
+func (x *ListUserSessionsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVipRecordsResp) ProtoMessage() {}
+func (*ListUserSessionsReq) ProtoMessage() {}
 
-func (x *GetVipRecordsResp) ProtoReflect() protoreflect.Message {
+func (x *ListUserSessionsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2276,47 +2508,39 @@ func (x *GetVipRecordsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVipRecordsResp.ProtoReflect.Descriptor instead.
-func (*GetVipRecordsResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUserSessionsReq.ProtoReflect.Descriptor instead.
+func (*ListUserSessionsReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetVipRecordsResp) GetRecords() []*VipRecord {
-	if x != nil {
-		return x.Records
-	}
-	return nil
-}
-
-func (x *GetVipRecordsResp) GetTotal() int32 {
+func (x *ListUserSessionsReq) GetUserId() string {
 	if x != nil {
-		return x.Total
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-// 获取用户活跃VIP记录请求
-type GetUserActiveVipRecordReq struct {
+type ListUserSessionsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Sessions      []*UserSession         `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserActiveVipRecordReq) Reset() {
-	*x = GetUserActiveVipRecordReq{}
+func (x *ListUserSessionsResp) Reset() {
+	*x = ListUserSessionsResp{}
 	mi := &file_super_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserActiveVipRecordReq) String() string {
+func (x *ListUserSessionsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserActiveVipRecordReq) ProtoMessage() {}
+func (*ListUserSessionsResp) ProtoMessage() {}
 
-func (x *GetUserActiveVipRecordReq) ProtoReflect() protoreflect.Message {
+func (x *ListUserSessionsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2328,40 +2552,41 @@ func (x *GetUserActiveVipRecordReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserActiveVipRecordReq.ProtoReflect.Descriptor instead.
-func (*GetUserActiveVipRecordReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUserSessionsResp.ProtoReflect.Descriptor instead.
+func (*ListUserSessionsResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetUserActiveVipRecordReq) GetUserId() string {
+func (x *ListUserSessionsResp) GetSessions() []*UserSession {
 	if x != nil {
-		return x.UserId
+		return x.Sessions
 	}
-	return ""
+	return nil
 }
 
-// 获取用户活跃VIP记录响应
-type GetUserActiveVipRecordResp struct {
+// 撤销一个会话：其刷新令牌作废，已签发的访问令牌由 API 层按 sid 拒绝
+type RevokeUserSessionReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Record        *VipRecord             `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserActiveVipRecordResp) Reset() {
-	*x = GetUserActiveVipRecordResp{}
+func (x *RevokeUserSessionReq) Reset() {
+	*x = RevokeUserSessionReq{}
 	mi := &file_super_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserActiveVipRecordResp) String() string {
+func (x *RevokeUserSessionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserActiveVipRecordResp) ProtoMessage() {}
+func (*RevokeUserSessionReq) ProtoMessage() {}
 
-func (x *GetUserActiveVipRecordResp) ProtoReflect() protoreflect.Message {
+func (x *RevokeUserSessionReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2373,40 +2598,45 @@ func (x *GetUserActiveVipRecordResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserActiveVipRecordResp.ProtoReflect.Descriptor instead.
-func (*GetUserActiveVipRecordResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeUserSessionReq.ProtoReflect.Descriptor instead.
+func (*RevokeUserSessionReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetUserActiveVipRecordResp) GetRecord() *VipRecord {
+func (x *RevokeUserSessionReq) GetUserId() string {
 	if x != nil {
-		return x.Record
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// VIP状态相关消息
-type GetUserVipStatusReq struct {
+func (x *RevokeUserSessionReq) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type RevokeUserSessionResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserVipStatusReq) Reset() {
-	*x = GetUserVipStatusReq{}
+func (x *RevokeUserSessionResp) Reset() {
+	*x = RevokeUserSessionResp{}
 	mi := &file_super_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserVipStatusReq) String() string {
+func (x *RevokeUserSessionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserVipStatusReq) ProtoMessage() {}
+func (*RevokeUserSessionResp) ProtoMessage() {}
 
-func (x *GetUserVipStatusReq) ProtoReflect() protoreflect.Message {
+func (x *RevokeUserSessionResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2418,41 +2648,33 @@ func (x *GetUserVipStatusReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserVipStatusReq.ProtoReflect.Descriptor instead.
-func (*GetUserVipStatusReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeUserSessionResp.ProtoReflect.Descriptor instead.
+func (*RevokeUserSessionResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetUserVipStatusReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-type GetUserVipStatusResp struct {
+// 退出所有设备：撤销全部会话，并递增令牌版本使旧版（不带 sid 的）令牌一并失效
+type RevokeAllUserSessionsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsVip         bool                   `protobuf:"varint,1,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
-	ExpiresAt     string                 `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	AutoRenew     bool                   `protobuf:"varint,3,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserVipStatusResp) Reset() {
-	*x = GetUserVipStatusResp{}
+func (x *RevokeAllUserSessionsReq) Reset() {
+	*x = RevokeAllUserSessionsReq{}
 	mi := &file_super_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserVipStatusResp) String() string {
+func (x *RevokeAllUserSessionsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserVipStatusResp) ProtoMessage() {}
+func (*RevokeAllUserSessionsReq) ProtoMessage() {}
 
-func (x *GetUserVipStatusResp) ProtoReflect() protoreflect.Message {
+func (x *RevokeAllUserSessionsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2464,53 +2686,39 @@ func (x *GetUserVipStatusResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserVipStatusResp.ProtoReflect.Descriptor instead.
-func (*GetUserVipStatusResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeAllUserSessionsReq.ProtoReflect.Descriptor instead.
+func (*RevokeAllUserSessionsReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *GetUserVipStatusResp) GetIsVip() bool {
-	if x != nil {
-		return x.IsVip
-	}
-	return false
-}
-
-func (x *GetUserVipStatusResp) GetExpiresAt() string {
+func (x *RevokeAllUserSessionsReq) GetUserId() string {
 	if x != nil {
-		return x.ExpiresAt
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetUserVipStatusResp) GetAutoRenew() bool {
-	if x != nil {
-		return x.AutoRenew
-	}
-	return false
-}
-
-type CheckUserVipReq struct {
+type RevokeAllUserSessionsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionIds    []string               `protobuf:"bytes,1,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckUserVipReq) Reset() {
-	*x = CheckUserVipReq{}
+func (x *RevokeAllUserSessionsResp) Reset() {
+	*x = RevokeAllUserSessionsResp{}
 	mi := &file_super_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckUserVipReq) String() string {
+func (x *RevokeAllUserSessionsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckUserVipReq) ProtoMessage() {}
+func (*RevokeAllUserSessionsResp) ProtoMessage() {}
 
-func (x *CheckUserVipReq) ProtoReflect() protoreflect.Message {
+func (x *RevokeAllUserSessionsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2522,39 +2730,42 @@ func (x *CheckUserVipReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckUserVipReq.ProtoReflect.Descriptor instead.
-func (*CheckUserVipReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokeAllUserSessionsResp.ProtoReflect.Descriptor instead.
+func (*RevokeAllUserSessionsResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *CheckUserVipReq) GetUserId() string {
+func (x *RevokeAllUserSessionsResp) GetSessionIds() []string {
 	if x != nil {
-		return x.UserId
+		return x.SessionIds
 	}
-	return ""
+	return nil
 }
 
-type CheckUserVipResp struct {
+// WebSocket 连接票据：保存在数据库中，任一 API 实例签发的票据都能在其他实例上兑换
+type IssueWsTicketReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsVip         bool                   `protobuf:"varint,1,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"` // 签发票据的令牌所属的登录会话，旧版令牌为空
+	Endpoint      string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`                    // 票据只能用于该 WebSocket 路径，由 API 层校验取值
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckUserVipResp) Reset() {
-	*x = CheckUserVipResp{}
+func (x *IssueWsTicketReq) Reset() {
+	*x = IssueWsTicketReq{}
 	mi := &file_super_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckUserVipResp) String() string {
+func (x *IssueWsTicketReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckUserVipResp) ProtoMessage() {}
+func (*IssueWsTicketReq) ProtoMessage() {}
 
-func (x *CheckUserVipResp) ProtoReflect() protoreflect.Message {
+func (x *IssueWsTicketReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2566,40 +2777,54 @@ func (x *CheckUserVipResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckUserVipResp.ProtoReflect.Descriptor instead.
-func (*CheckUserVipResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use IssueWsTicketReq.ProtoReflect.Descriptor instead.
+func (*IssueWsTicketReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *CheckUserVipResp) GetIsVip() bool {
+func (x *IssueWsTicketReq) GetUserId() string {
 	if x != nil {
-		return x.IsVip
+		return x.UserId
 	}
-	return false
+	return ""
 }
 
-type UpdateAutoRenewReq struct {
+func (x *IssueWsTicketReq) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *IssueWsTicketReq) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type IssueWsTicketResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	AutoRenew     bool                   `protobuf:"varint,2,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	Ticket        string                 `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // 秒
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAutoRenewReq) Reset() {
-	*x = UpdateAutoRenewReq{}
+func (x *IssueWsTicketResp) Reset() {
+	*x = IssueWsTicketResp{}
 	mi := &file_super_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAutoRenewReq) String() string {
+func (x *IssueWsTicketResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAutoRenewReq) ProtoMessage() {}
+func (*IssueWsTicketResp) ProtoMessage() {}
 
-func (x *UpdateAutoRenewReq) ProtoReflect() protoreflect.Message {
+func (x *IssueWsTicketResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2611,45 +2836,48 @@ func (x *UpdateAutoRenewReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAutoRenewReq.ProtoReflect.Descriptor instead.
-func (*UpdateAutoRenewReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use IssueWsTicketResp.ProtoReflect.Descriptor instead.
+func (*IssueWsTicketResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *UpdateAutoRenewReq) GetUserId() string {
+func (x *IssueWsTicketResp) GetTicket() string {
 	if x != nil {
-		return x.UserId
+		return x.Ticket
 	}
 	return ""
 }
 
-func (x *UpdateAutoRenewReq) GetAutoRenew() bool {
+func (x *IssueWsTicketResp) GetExpiresIn() int64 {
 	if x != nil {
-		return x.AutoRenew
+		return x.ExpiresIn
 	}
-	return false
+	return 0
 }
 
-type UpdateAutoRenewResp struct {
+// 兑换票据：无论成功与否票据都会作废；不存在、已过期或端点不符时返回 InvalidArgument
+type RedeemWsTicketReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ticket        string                 `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Endpoint      string                 `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAutoRenewResp) Reset() {
-	*x = UpdateAutoRenewResp{}
+func (x *RedeemWsTicketReq) Reset() {
+	*x = RedeemWsTicketReq{}
 	mi := &file_super_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAutoRenewResp) String() string {
+func (x *RedeemWsTicketReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAutoRenewResp) ProtoMessage() {}
+func (*RedeemWsTicketReq) ProtoMessage() {}
 
-func (x *UpdateAutoRenewResp) ProtoReflect() protoreflect.Message {
+func (x *RedeemWsTicketReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2661,32 +2889,47 @@ func (x *UpdateAutoRenewResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAutoRenewResp.ProtoReflect.Descriptor instead.
-func (*UpdateAutoRenewResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use RedeemWsTicketReq.ProtoReflect.Descriptor instead.
+func (*RedeemWsTicketReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{47}
 }
 
-type SyncUserVipStatusReq struct {
+func (x *RedeemWsTicketReq) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *RedeemWsTicketReq) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+type RedeemWsTicketResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncUserVipStatusReq) Reset() {
-	*x = SyncUserVipStatusReq{}
+func (x *RedeemWsTicketResp) Reset() {
+	*x = RedeemWsTicketResp{}
 	mi := &file_super_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncUserVipStatusReq) String() string {
+func (x *RedeemWsTicketResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncUserVipStatusReq) ProtoMessage() {}
+func (*RedeemWsTicketResp) ProtoMessage() {}
 
-func (x *SyncUserVipStatusReq) ProtoReflect() protoreflect.Message {
+func (x *RedeemWsTicketResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2698,40 +2941,48 @@ func (x *SyncUserVipStatusReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncUserVipStatusReq.ProtoReflect.Descriptor instead.
-func (*SyncUserVipStatusReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RedeemWsTicketResp.ProtoReflect.Descriptor instead.
+func (*RedeemWsTicketResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *SyncUserVipStatusReq) GetUserId() string {
+func (x *RedeemWsTicketResp) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type SyncUserVipStatusResp struct {
+func (x *RedeemWsTicketResp) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+// 重新发送邮箱验证邮件：有待验证的新邮箱时发到新邮箱，否则发到当前未验证的邮箱
+type SendEmailVerificationReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsVip         bool                   `protobuf:"varint,1,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
-	ExpiresAt     string                 `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Lang          string                 `protobuf:"bytes,2,opt,name=lang,proto3" json:"lang,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SyncUserVipStatusResp) Reset() {
-	*x = SyncUserVipStatusResp{}
+func (x *SendEmailVerificationReq) Reset() {
+	*x = SendEmailVerificationReq{}
 	mi := &file_super_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SyncUserVipStatusResp) String() string {
+func (x *SendEmailVerificationReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SyncUserVipStatusResp) ProtoMessage() {}
+func (*SendEmailVerificationReq) ProtoMessage() {}
 
-func (x *SyncUserVipStatusResp) ProtoReflect() protoreflect.Message {
+func (x *SendEmailVerificationReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2743,49 +2994,46 @@ func (x *SyncUserVipStatusResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SyncUserVipStatusResp.ProtoReflect.Descriptor instead.
-func (*SyncUserVipStatusResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendEmailVerificationReq.ProtoReflect.Descriptor instead.
+func (*SendEmailVerificationReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *SyncUserVipStatusResp) GetIsVip() bool {
+func (x *SendEmailVerificationReq) GetUserId() string {
 	if x != nil {
-		return x.IsVip
+		return x.UserId
 	}
-	return false
+	return ""
 }
 
-func (x *SyncUserVipStatusResp) GetExpiresAt() string {
+func (x *SendEmailVerificationReq) GetLang() string {
 	if x != nil {
-		return x.ExpiresAt
+		return x.Lang
 	}
 	return ""
 }
 
-// 充值请求
-type RechargeReq struct {
+type SendEmailVerificationResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        float32                `protobuf:"fixed32,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"` // 验证邮件的收件邮箱
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RechargeReq) Reset() {
-	*x = RechargeReq{}
+func (x *SendEmailVerificationResp) Reset() {
+	*x = SendEmailVerificationResp{}
 	mi := &file_super_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RechargeReq) String() string {
+func (x *SendEmailVerificationResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RechargeReq) ProtoMessage() {}
+func (*SendEmailVerificationResp) ProtoMessage() {}
 
-func (x *RechargeReq) ProtoReflect() protoreflect.Message {
+func (x *SendEmailVerificationResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2797,55 +3045,42 @@ func (x *RechargeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RechargeReq.ProtoReflect.Descriptor instead.
-func (*RechargeReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SendEmailVerificationResp.ProtoReflect.Descriptor instead.
+func (*SendEmailVerificationResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *RechargeReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *RechargeReq) GetAmount() float32 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *RechargeReq) GetDescription() string {
+func (x *SendEmailVerificationResp) GetEmail() string {
 	if x != nil {
-		return x.Description
+		return x.Email
 	}
 	return ""
 }
 
-// 充值响应
-type RechargeResp struct {
+// 验证邮箱：user_id + code（邮件中的 6 位验证码）或 token（邮件链接中的令牌）二选一
+type VerifyEmailReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	NewBalance    float32                `protobuf:"fixed32,2,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RechargeResp) Reset() {
-	*x = RechargeResp{}
+func (x *VerifyEmailReq) Reset() {
+	*x = VerifyEmailReq{}
 	mi := &file_super_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RechargeResp) String() string {
+func (x *VerifyEmailReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RechargeResp) ProtoMessage() {}
+func (*VerifyEmailReq) ProtoMessage() {}
 
-func (x *RechargeResp) ProtoReflect() protoreflect.Message {
+func (x *VerifyEmailReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2857,49 +3092,53 @@ func (x *RechargeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RechargeResp.ProtoReflect.Descriptor instead.
-func (*RechargeResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyEmailReq.ProtoReflect.Descriptor instead.
+func (*VerifyEmailReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *RechargeResp) GetMessage() string {
+func (x *VerifyEmailReq) GetUserId() string {
 	if x != nil {
-		return x.Message
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *RechargeResp) GetNewBalance() float32 {
+func (x *VerifyEmailReq) GetCode() string {
 	if x != nil {
-		return x.NewBalance
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-// 获取交易记录请求
-type GetTransactionsReq struct {
+func (x *VerifyEmailReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyEmailResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionsReq) Reset() {
-	*x = GetTransactionsReq{}
+func (x *VerifyEmailResp) Reset() {
+	*x = VerifyEmailResp{}
 	mi := &file_super_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsReq) String() string {
+func (x *VerifyEmailResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsReq) ProtoMessage() {}
+func (*VerifyEmailResp) ProtoMessage() {}
 
-func (x *GetTransactionsReq) ProtoReflect() protoreflect.Message {
+func (x *VerifyEmailResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2911,60 +3150,44 @@ func (x *GetTransactionsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsReq.ProtoReflect.Descriptor instead.
-func (*GetTransactionsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use VerifyEmailResp.ProtoReflect.Descriptor instead.
+func (*VerifyEmailResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetTransactionsReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *GetTransactionsReq) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetTransactionsReq) GetPageSize() int32 {
+func (x *VerifyEmailResp) GetUser() *User {
 	if x != nil {
-		return x.PageSize
+		return x.User
 	}
-	return 0
+	return nil
 }
 
-// 交易记录
-type Transaction struct {
+// 修改用户角色（需要 role:manage 权限，即超级管理员），并写入审计记录
+type UpdateUserRoleReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Amount        float32                `protobuf:"fixed32,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"` // user / admin / super_admin；撤销角色即改为 user
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,5,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Transaction) Reset() {
-	*x = Transaction{}
+func (x *UpdateUserRoleReq) Reset() {
+	*x = UpdateUserRoleReq{}
 	mi := &file_super_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Transaction) String() string {
+func (x *UpdateUserRoleReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction) ProtoMessage() {}
+func (*UpdateUserRoleReq) ProtoMessage() {}
 
-func (x *Transaction) ProtoReflect() protoreflect.Message {
+func (x *UpdateUserRoleReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2976,83 +3199,69 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
-func (*Transaction) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUserRoleReq.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *Transaction) GetId() string {
+func (x *UpdateUserRoleReq) GetActorUserId() string {
 	if x != nil {
-		return x.Id
+		return x.ActorUserId
 	}
 	return ""
 }
 
-func (x *Transaction) GetUserId() string {
+func (x *UpdateUserRoleReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *Transaction) GetAmount() float32 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *Transaction) GetType() string {
+func (x *UpdateUserRoleReq) GetRole() string {
 	if x != nil {
-		return x.Type
+		return x.Role
 	}
 	return ""
 }
 
-func (x *Transaction) GetStatus() string {
+func (x *UpdateUserRoleReq) GetReason() string {
 	if x != nil {
-		return x.Status
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *Transaction) GetDescription() string {
+func (x *UpdateUserRoleReq) GetClientIp() string {
 	if x != nil {
-		return x.Description
+		return x.ClientIp
 	}
 	return ""
 }
 
-func (x *Transaction) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
-}
-
-// 交易记录响应
-type GetTransactionsResp struct {
+type UpdateUserRoleResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	PreviousRole  string                 `protobuf:"bytes,3,opt,name=previous_role,json=previousRole,proto3" json:"previous_role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionsResp) Reset() {
-	*x = GetTransactionsResp{}
+func (x *UpdateUserRoleResp) Reset() {
+	*x = UpdateUserRoleResp{}
 	mi := &file_super_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionsResp) String() string {
+func (x *UpdateUserRoleResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionsResp) ProtoMessage() {}
+func (*UpdateUserRoleResp) ProtoMessage() {}
 
-func (x *GetTransactionsResp) ProtoReflect() protoreflect.Message {
+func (x *UpdateUserRoleResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3064,47 +3273,61 @@ func (x *GetTransactionsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionsResp.ProtoReflect.Descriptor instead.
-func (*GetTransactionsResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUserRoleResp.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *GetTransactionsResp) GetTransactions() []*Transaction {
+func (x *UpdateUserRoleResp) GetUserId() string {
 	if x != nil {
-		return x.Transactions
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetTransactionsResp) GetTotal() int32 {
+func (x *UpdateUserRoleResp) GetRole() string {
 	if x != nil {
-		return x.Total
+		return x.Role
 	}
-	return 0
+	return ""
 }
 
-// 获取单个交易记录请求
-type GetTransactionReq struct {
+func (x *UpdateUserRoleResp) GetPreviousRole() string {
+	if x != nil {
+		return x.PreviousRole
+	}
+	return ""
+}
+
+type RoleAuditLog struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,3,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	Action        string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"` // grant / revoke
+	FromRole      string                 `protobuf:"bytes,5,opt,name=from_role,json=fromRole,proto3" json:"from_role,omitempty"`
+	ToRole        string                 `protobuf:"bytes,6,opt,name=to_role,json=toRole,proto3" json:"to_role,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,8,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionReq) Reset() {
-	*x = GetTransactionReq{}
+func (x *RoleAuditLog) Reset() {
+	*x = RoleAuditLog{}
 	mi := &file_super_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionReq) String() string {
+func (x *RoleAuditLog) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionReq) ProtoMessage() {}
+func (*RoleAuditLog) ProtoMessage() {}
 
-func (x *GetTransactionReq) ProtoReflect() protoreflect.Message {
+func (x *RoleAuditLog) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3116,40 +3339,99 @@ func (x *GetTransactionReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionReq.ProtoReflect.Descriptor instead.
-func (*GetTransactionReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RoleAuditLog.ProtoReflect.Descriptor instead.
+func (*RoleAuditLog) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetTransactionReq) GetId() string {
+func (x *RoleAuditLog) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-// 获取单个交易记录响应
-type GetTransactionResp struct {
+func (x *RoleAuditLog) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RoleAuditLog) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *RoleAuditLog) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RoleAuditLog) GetFromRole() string {
+	if x != nil {
+		return x.FromRole
+	}
+	return ""
+}
+
+func (x *RoleAuditLog) GetToRole() string {
+	if x != nil {
+		return x.ToRole
+	}
+	return ""
+}
+
+func (x *RoleAuditLog) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RoleAuditLog) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *RoleAuditLog) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 角色变更审计记录（需要 audit:read 权限）
+type ListRoleAuditLogsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 为空时返回全部
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransactionResp) Reset() {
-	*x = GetTransactionResp{}
+func (x *ListRoleAuditLogsReq) Reset() {
+	*x = ListRoleAuditLogsReq{}
 	mi := &file_super_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransactionResp) String() string {
+func (x *ListRoleAuditLogsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransactionResp) ProtoMessage() {}
+func (*ListRoleAuditLogsReq) ProtoMessage() {}
 
-func (x *GetTransactionResp) ProtoReflect() protoreflect.Message {
+func (x *ListRoleAuditLogsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3161,42 +3443,61 @@ func (x *GetTransactionResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransactionResp.ProtoReflect.Descriptor instead.
-func (*GetTransactionResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRoleAuditLogsReq.ProtoReflect.Descriptor instead.
+func (*ListRoleAuditLogsReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetTransactionResp) GetTransaction() *Transaction {
+func (x *ListRoleAuditLogsReq) GetActorUserId() string {
 	if x != nil {
-		return x.Transaction
+		return x.ActorUserId
 	}
-	return nil
+	return ""
 }
 
-// 话题标签消息
-type TopicTag struct {
+func (x *ListRoleAuditLogsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListRoleAuditLogsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRoleAuditLogsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListRoleAuditLogsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	Logs          []*RoleAuditLog        `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TopicTag) Reset() {
-	*x = TopicTag{}
+func (x *ListRoleAuditLogsResp) Reset() {
+	*x = ListRoleAuditLogsResp{}
 	mi := &file_super_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TopicTag) String() string {
+func (x *ListRoleAuditLogsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TopicTag) ProtoMessage() {}
+func (*ListRoleAuditLogsResp) ProtoMessage() {}
 
-func (x *TopicTag) ProtoReflect() protoreflect.Message {
+func (x *ListRoleAuditLogsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3208,67 +3509,52 @@ func (x *TopicTag) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TopicTag.ProtoReflect.Descriptor instead.
-func (*TopicTag) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRoleAuditLogsResp.ProtoReflect.Descriptor instead.
+func (*ListRoleAuditLogsResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *TopicTag) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *TopicTag) GetName() string {
+func (x *ListRoleAuditLogsResp) GetLogs() []*RoleAuditLog {
 	if x != nil {
-		return x.Name
+		return x.Logs
 	}
-	return ""
+	return nil
 }
 
-func (x *TopicTag) GetColor() string {
+func (x *ListRoleAuditLogsResp) GetTotal() int64 {
 	if x != nil {
-		return x.Color
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-// 服务定义帖子相关消息
-type Post struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserName         string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserAvatar       string                 `protobuf:"bytes,4,opt,name=user_avatar,json=userAvatar,proto3" json:"user_avatar,omitempty"`
-	Content          string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
-	Images           []string               `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`
-	TopicTags        []*TopicTag            `protobuf:"bytes,7,rep,name=topic_tags,json=topicTags,proto3" json:"topic_tags,omitempty"`
-	Likes            int32                  `protobuf:"varint,8,opt,name=likes,proto3" json:"likes,omitempty"`
-	Comments         int32                  `protobuf:"varint,9,opt,name=comments,proto3" json:"comments,omitempty"`
-	IsLiked          bool                   `protobuf:"varint,10,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
-	CreatedAt        string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	HandDrawCard     string                 `protobuf:"bytes,12,opt,name=hand_draw_card,json=handDrawCard,proto3" json:"hand_draw_card,omitempty"`
-	HandDrawThumbUrl string                 `protobuf:"bytes,13,opt,name=hand_draw_thumb_url,json=handDrawThumbUrl,proto3" json:"hand_draw_thumb_url,omitempty"`
-	ModerationStatus string                 `protobuf:"bytes,14,opt,name=moderation_status,json=moderationStatus,proto3" json:"moderation_status,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// 账号安全事件：登录成功 / 失败 / 锁定、修改密码、重置密码、新设备登录
+type SecurityEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	Detail        string                 `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Post) Reset() {
-	*x = Post{}
+func (x *SecurityEvent) Reset() {
+	*x = SecurityEvent{}
 	mi := &file_super_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Post) String() string {
+func (x *SecurityEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Post) ProtoMessage() {}
+func (*SecurityEvent) ProtoMessage() {}
 
-func (x *Post) ProtoReflect() protoreflect.Message {
+func (x *SecurityEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3280,220 +3566,135 @@ func (x *Post) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Post.ProtoReflect.Descriptor instead.
-func (*Post) Descriptor() ([]byte, []int) {
+// Deprecated: Use SecurityEvent.ProtoReflect.Descriptor instead.
+func (*SecurityEvent) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *Post) GetId() string {
+func (x *SecurityEvent) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Post) GetUserId() string {
+func (x *SecurityEvent) GetKind() string {
 	if x != nil {
-		return x.UserId
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *Post) GetUserName() string {
+func (x *SecurityEvent) GetClientIp() string {
 	if x != nil {
-		return x.UserName
+		return x.ClientIp
 	}
 	return ""
 }
 
-func (x *Post) GetUserAvatar() string {
+func (x *SecurityEvent) GetUserAgent() string {
 	if x != nil {
-		return x.UserAvatar
+		return x.UserAgent
 	}
 	return ""
 }
 
-func (x *Post) GetContent() string {
+func (x *SecurityEvent) GetDetail() string {
 	if x != nil {
-		return x.Content
+		return x.Detail
 	}
 	return ""
 }
 
-func (x *Post) GetImages() []string {
+func (x *SecurityEvent) GetCreatedAt() string {
 	if x != nil {
-		return x.Images
+		return x.CreatedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *Post) GetTopicTags() []*TopicTag {
-	if x != nil {
-		return x.TopicTags
-	}
-	return nil
+type ListSecurityEventsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Post) GetLikes() int32 {
-	if x != nil {
-		return x.Likes
-	}
-	return 0
+func (x *ListSecurityEventsReq) Reset() {
+	*x = ListSecurityEventsReq{}
+	mi := &file_super_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Post) GetComments() int32 {
-	if x != nil {
-		return x.Comments
-	}
-	return 0
+func (x *ListSecurityEventsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Post) GetIsLiked() bool {
+func (*ListSecurityEventsReq) ProtoMessage() {}
+
+func (x *ListSecurityEventsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[59]
 	if x != nil {
-		return x.IsLiked
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *Post) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+// Deprecated: Use ListSecurityEventsReq.ProtoReflect.Descriptor instead.
+func (*ListSecurityEventsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *Post) GetHandDrawCard() string {
+func (x *ListSecurityEventsReq) GetUserId() string {
 	if x != nil {
-		return x.HandDrawCard
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *Post) GetHandDrawThumbUrl() string {
+func (x *ListSecurityEventsReq) GetPage() int32 {
 	if x != nil {
-		return x.HandDrawThumbUrl
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *Post) GetModerationStatus() string {
+func (x *ListSecurityEventsReq) GetPageSize() int32 {
 	if x != nil {
-		return x.ModerationStatus
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-// 获取帖子列表请求
-type GetPostsReq struct {
-	state        protoimpl.MessageState `protogen:"open.v1"`
-	Page         int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize     int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	ViewerUserId string                 `protobuf:"bytes,3,opt,name=viewer_user_id,json=viewerUserId,proto3" json:"viewer_user_id,omitempty"`
-	// latest | hot | following（空则按 latest）
-	FeedMode string `protobuf:"bytes,4,opt,name=feed_mode,json=feedMode,proto3" json:"feed_mode,omitempty"`
-	// 按话题标签 ID 筛选（与 post_topics 关联）
-	TopicTagId string `protobuf:"bytes,5,opt,name=topic_tag_id,json=topicTagId,proto3" json:"topic_tag_id,omitempty"`
-	// 仅返回该作者发布的动态（与 feed_mode=following 互斥，优先按作者筛选）
-	AuthorUserId  string `protobuf:"bytes,6,opt,name=author_user_id,json=authorUserId,proto3" json:"author_user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetPostsReq) Reset() {
-	*x = GetPostsReq{}
-	mi := &file_super_proto_msgTypes[59]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetPostsReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetPostsReq) ProtoMessage() {}
-
-func (x *GetPostsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[59]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetPostsReq.ProtoReflect.Descriptor instead.
-func (*GetPostsReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{59}
-}
-
-func (x *GetPostsReq) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetPostsReq) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *GetPostsReq) GetViewerUserId() string {
-	if x != nil {
-		return x.ViewerUserId
-	}
-	return ""
-}
-
-func (x *GetPostsReq) GetFeedMode() string {
-	if x != nil {
-		return x.FeedMode
-	}
-	return ""
-}
-
-func (x *GetPostsReq) GetTopicTagId() string {
-	if x != nil {
-		return x.TopicTagId
-	}
-	return ""
-}
-
-func (x *GetPostsReq) GetAuthorUserId() string {
-	if x != nil {
-		return x.AuthorUserId
-	}
-	return ""
-}
-
-// 获取帖子列表响应
-type GetPostsResp struct {
+type ListSecurityEventsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Events        []*SecurityEvent       `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostsResp) Reset() {
-	*x = GetPostsResp{}
+func (x *ListSecurityEventsResp) Reset() {
+	*x = ListSecurityEventsResp{}
 	mi := &file_super_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostsResp) String() string {
+func (x *ListSecurityEventsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostsResp) ProtoMessage() {}
+func (*ListSecurityEventsResp) ProtoMessage() {}
 
-func (x *GetPostsResp) ProtoReflect() protoreflect.Message {
+func (x *ListSecurityEventsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3505,48 +3706,50 @@ func (x *GetPostsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostsResp.ProtoReflect.Descriptor instead.
-func (*GetPostsResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSecurityEventsResp.ProtoReflect.Descriptor instead.
+func (*ListSecurityEventsResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetPostsResp) GetPosts() []*Post {
+func (x *ListSecurityEventsResp) GetEvents() []*SecurityEvent {
 	if x != nil {
-		return x.Posts
+		return x.Events
 	}
 	return nil
 }
 
-func (x *GetPostsResp) GetTotal() int32 {
+func (x *ListSecurityEventsResp) GetTotal() int64 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-// 获取单个帖子请求
-type GetPostReq struct {
+// 删除用户请求
+type DeleteUserReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	ViewerUserId  string                 `protobuf:"bytes,2,opt,name=viewer_user_id,json=viewerUserId,proto3" json:"viewer_user_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,2,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"` // 发起人，管理员代为注销时与 user_id 不同
+	ClientIp      string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostReq) Reset() {
-	*x = GetPostReq{}
+func (x *DeleteUserReq) Reset() {
+	*x = DeleteUserReq{}
 	mi := &file_super_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostReq) String() string {
+func (x *DeleteUserReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostReq) ProtoMessage() {}
+func (*DeleteUserReq) ProtoMessage() {}
 
-func (x *GetPostReq) ProtoReflect() protoreflect.Message {
+func (x *DeleteUserReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3558,47 +3761,61 @@ func (x *GetPostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostReq.ProtoReflect.Descriptor instead.
-func (*GetPostReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteUserReq.ProtoReflect.Descriptor instead.
+func (*DeleteUserReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GetPostReq) GetPostId() string {
+func (x *DeleteUserReq) GetUserId() string {
 	if x != nil {
-		return x.PostId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetPostReq) GetViewerUserId() string {
+func (x *DeleteUserReq) GetActorUserId() string {
 	if x != nil {
-		return x.ViewerUserId
+		return x.ActorUserId
 	}
 	return ""
 }
 
-// 获取单个帖子响应
-type GetPostResp struct {
+func (x *DeleteUserReq) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *DeleteUserReq) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+// 删除用户响应：账号进入注销宽限期
+type DeleteUserResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	Deletion      *AccountDeletion       `protobuf:"bytes,1,opt,name=deletion,proto3" json:"deletion,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostResp) Reset() {
-	*x = GetPostResp{}
+func (x *DeleteUserResp) Reset() {
+	*x = DeleteUserResp{}
 	mi := &file_super_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostResp) String() string {
+func (x *DeleteUserResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostResp) ProtoMessage() {}
+func (*DeleteUserResp) ProtoMessage() {}
 
-func (x *GetPostResp) ProtoReflect() protoreflect.Message {
+func (x *DeleteUserResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3610,45 +3827,43 @@ func (x *GetPostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostResp.ProtoReflect.Descriptor instead.
-func (*GetPostResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteUserResp.ProtoReflect.Descriptor instead.
+func (*DeleteUserResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetPostResp) GetPost() *Post {
+func (x *DeleteUserResp) GetDeletion() *AccountDeletion {
 	if x != nil {
-		return x.Post
+		return x.Deletion
 	}
 	return nil
 }
 
-// 创建帖子请求
-type CreatePostReq struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Content          string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
-	Images           []string               `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
-	TopicTags        []*TopicTag            `protobuf:"bytes,4,rep,name=topic_tags,json=topicTags,proto3" json:"topic_tags,omitempty"`
-	HandDrawCard     string                 `protobuf:"bytes,5,opt,name=hand_draw_card,json=handDrawCard,proto3" json:"hand_draw_card,omitempty"`
-	HandDrawThumbUrl string                 `protobuf:"bytes,6,opt,name=hand_draw_thumb_url,json=handDrawThumbUrl,proto3" json:"hand_draw_thumb_url,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+// 注销申请
+type AccountDeletion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`                              // pending / canceled / processing / failed / completed
+	ScheduledAt   string                 `protobuf:"bytes,2,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // 宽限期结束、清除数据的时间
+	RequestedAt   string                 `protobuf:"bytes,3,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	CanceledAt    string                 `protobuf:"bytes,4,opt,name=canceled_at,json=canceledAt,proto3" json:"canceled_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePostReq) Reset() {
-	*x = CreatePostReq{}
+func (x *AccountDeletion) Reset() {
+	*x = AccountDeletion{}
 	mi := &file_super_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostReq) String() string {
+func (x *AccountDeletion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostReq) ProtoMessage() {}
+func (*AccountDeletion) ProtoMessage() {}
 
-func (x *CreatePostReq) ProtoReflect() protoreflect.Message {
+func (x *AccountDeletion) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3660,77 +3875,60 @@ func (x *CreatePostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostReq.ProtoReflect.Descriptor instead.
-func (*CreatePostReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountDeletion.ProtoReflect.Descriptor instead.
+func (*AccountDeletion) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *CreatePostReq) GetUserId() string {
+func (x *AccountDeletion) GetStatus() string {
 	if x != nil {
-		return x.UserId
+		return x.Status
 	}
 	return ""
 }
 
-func (x *CreatePostReq) GetContent() string {
+func (x *AccountDeletion) GetScheduledAt() string {
 	if x != nil {
-		return x.Content
+		return x.ScheduledAt
 	}
 	return ""
 }
 
-func (x *CreatePostReq) GetImages() []string {
-	if x != nil {
-		return x.Images
-	}
-	return nil
-}
-
-func (x *CreatePostReq) GetTopicTags() []*TopicTag {
-	if x != nil {
-		return x.TopicTags
-	}
-	return nil
-}
-
-func (x *CreatePostReq) GetHandDrawCard() string {
+func (x *AccountDeletion) GetRequestedAt() string {
 	if x != nil {
-		return x.HandDrawCard
+		return x.RequestedAt
 	}
 	return ""
 }
 
-func (x *CreatePostReq) GetHandDrawThumbUrl() string {
+func (x *AccountDeletion) GetCanceledAt() string {
 	if x != nil {
-		return x.HandDrawThumbUrl
+		return x.CanceledAt
 	}
 	return ""
 }
 
-// 举报帖子
-type ReportPostReq struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	PostId         string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	ReporterUserId string                 `protobuf:"bytes,2,opt,name=reporter_user_id,json=reporterUserId,proto3" json:"reporter_user_id,omitempty"`
-	Reason         string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetAccountDeletionReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReportPostReq) Reset() {
-	*x = ReportPostReq{}
+func (x *GetAccountDeletionReq) Reset() {
+	*x = GetAccountDeletionReq{}
 	mi := &file_super_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReportPostReq) String() string {
+func (x *GetAccountDeletionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportPostReq) ProtoMessage() {}
+func (*GetAccountDeletionReq) ProtoMessage() {}
 
-func (x *ReportPostReq) ProtoReflect() protoreflect.Message {
+func (x *GetAccountDeletionReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3742,52 +3940,39 @@ func (x *ReportPostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportPostReq.ProtoReflect.Descriptor instead.
-func (*ReportPostReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAccountDeletionReq.ProtoReflect.Descriptor instead.
+func (*GetAccountDeletionReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ReportPostReq) GetPostId() string {
-	if x != nil {
-		return x.PostId
-	}
-	return ""
-}
-
-func (x *ReportPostReq) GetReporterUserId() string {
-	if x != nil {
-		return x.ReporterUserId
-	}
-	return ""
-}
-
-func (x *ReportPostReq) GetReason() string {
+func (x *GetAccountDeletionReq) GetUserId() string {
 	if x != nil {
-		return x.Reason
+		return x.UserId
 	}
 	return ""
 }
 
-type ReportPostResp struct {
+type GetAccountDeletionResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deletion      *AccountDeletion       `protobuf:"bytes,1,opt,name=deletion,proto3" json:"deletion,omitempty"` // 没有申请过时为空
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReportPostResp) Reset() {
-	*x = ReportPostResp{}
+func (x *GetAccountDeletionResp) Reset() {
+	*x = GetAccountDeletionResp{}
 	mi := &file_super_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReportPostResp) String() string {
+func (x *GetAccountDeletionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReportPostResp) ProtoMessage() {}
+func (*GetAccountDeletionResp) ProtoMessage() {}
 
-func (x *ReportPostResp) ProtoReflect() protoreflect.Message {
+func (x *GetAccountDeletionResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3799,33 +3984,41 @@ func (x *ReportPostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReportPostResp.ProtoReflect.Descriptor instead.
-func (*ReportPostResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAccountDeletionResp.ProtoReflect.Descriptor instead.
+func (*GetAccountDeletionResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{65}
 }
 
-// 创建帖子响应
-type CreatePostResp struct {
+func (x *GetAccountDeletionResp) GetDeletion() *AccountDeletion {
+	if x != nil {
+		return x.Deletion
+	}
+	return nil
+}
+
+type CancelAccountDeletionReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,2,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreatePostResp) Reset() {
-	*x = CreatePostResp{}
+func (x *CancelAccountDeletionReq) Reset() {
+	*x = CancelAccountDeletionReq{}
 	mi := &file_super_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreatePostResp) String() string {
+func (x *CancelAccountDeletionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePostResp) ProtoMessage() {}
+func (*CancelAccountDeletionReq) ProtoMessage() {}
 
-func (x *CreatePostResp) ProtoReflect() protoreflect.Message {
+func (x *CancelAccountDeletionReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3837,41 +4030,52 @@ func (x *CreatePostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePostResp.ProtoReflect.Descriptor instead.
-func (*CreatePostResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelAccountDeletionReq.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *CreatePostResp) GetPost() *Post {
+func (x *CancelAccountDeletionReq) GetUserId() string {
 	if x != nil {
-		return x.Post
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// 点赞帖子请求
-type LikePostReq struct {
+func (x *CancelAccountDeletionReq) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *CancelAccountDeletionReq) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+type CancelAccountDeletionResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikePostReq) Reset() {
-	*x = LikePostReq{}
+func (x *CancelAccountDeletionResp) Reset() {
+	*x = CancelAccountDeletionResp{}
 	mi := &file_super_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikePostReq) String() string {
+func (x *CancelAccountDeletionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikePostReq) ProtoMessage() {}
+func (*CancelAccountDeletionResp) ProtoMessage() {}
 
-func (x *LikePostReq) ProtoReflect() protoreflect.Message {
+func (x *CancelAccountDeletionResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3883,47 +4087,33 @@ func (x *LikePostReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikePostReq.ProtoReflect.Descriptor instead.
-func (*LikePostReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelAccountDeletionResp.ProtoReflect.Descriptor instead.
+func (*CancelAccountDeletionResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *LikePostReq) GetPostId() string {
-	if x != nil {
-		return x.PostId
-	}
-	return ""
-}
-
-func (x *LikePostReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-// 点赞帖子响应
-type LikePostResp struct {
+// 导出个人数据：按文件分块返回，同名的连续块属于同一个文件
+type ExportUserDataReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikePostResp) Reset() {
-	*x = LikePostResp{}
+func (x *ExportUserDataReq) Reset() {
+	*x = ExportUserDataReq{}
 	mi := &file_super_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikePostResp) String() string {
+func (x *ExportUserDataReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikePostResp) ProtoMessage() {}
+func (*ExportUserDataReq) ProtoMessage() {}
 
-func (x *LikePostResp) ProtoReflect() protoreflect.Message {
+func (x *ExportUserDataReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3935,43 +4125,40 @@ func (x *LikePostResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikePostResp.ProtoReflect.Descriptor instead.
-func (*LikePostResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportUserDataReq.ProtoReflect.Descriptor instead.
+func (*ExportUserDataReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *LikePostResp) GetPost() *Post {
+func (x *ExportUserDataReq) GetUserId() string {
 	if x != nil {
-		return x.Post
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-// 获取帖子评论请求
-type GetPostCommentsReq struct {
+type ExportChunk struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	ViewerUserId  string                 `protobuf:"bytes,4,opt,name=viewer_user_id,json=viewerUserId,proto3" json:"viewer_user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"` // ZIP 内的路径，如 data/posts.json
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostCommentsReq) Reset() {
-	*x = GetPostCommentsReq{}
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
 	mi := &file_super_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostCommentsReq) String() string {
+func (x *ExportChunk) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostCommentsReq) ProtoMessage() {}
+func (*ExportChunk) ProtoMessage() {}
 
-func (x *GetPostCommentsReq) ProtoReflect() protoreflect.Message {
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3983,62 +4170,49 @@ func (x *GetPostCommentsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostCommentsReq.ProtoReflect.Descriptor instead.
-func (*GetPostCommentsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *GetPostCommentsReq) GetPostId() string {
+func (x *ExportChunk) GetName() string {
 	if x != nil {
-		return x.PostId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetPostCommentsReq) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetPostCommentsReq) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *GetPostCommentsReq) GetViewerUserId() string {
+func (x *ExportChunk) GetData() []byte {
 	if x != nil {
-		return x.ViewerUserId
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-// 获取帖子评论响应
-type GetPostCommentsResp struct {
+// 更新用户VIP状态请求
+type UpdateUserVipReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsVip         bool                   `protobuf:"varint,2,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
+	VipExpires    string                 `protobuf:"bytes,3,opt,name=vip_expires,json=vipExpires,proto3" json:"vip_expires,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPostCommentsResp) Reset() {
-	*x = GetPostCommentsResp{}
+func (x *UpdateUserVipReq) Reset() {
+	*x = UpdateUserVipReq{}
 	mi := &file_super_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPostCommentsResp) String() string {
+func (x *UpdateUserVipReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPostCommentsResp) ProtoMessage() {}
+func (*UpdateUserVipReq) ProtoMessage() {}
 
-func (x *GetPostCommentsResp) ProtoReflect() protoreflect.Message {
+func (x *UpdateUserVipReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4050,55 +4224,54 @@ func (x *GetPostCommentsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPostCommentsResp.ProtoReflect.Descriptor instead.
-func (*GetPostCommentsResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUserVipReq.ProtoReflect.Descriptor instead.
+func (*UpdateUserVipReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetPostCommentsResp) GetComments() []*Comment {
+func (x *UpdateUserVipReq) GetUserId() string {
 	if x != nil {
-		return x.Comments
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetPostCommentsResp) GetTotal() int32 {
+func (x *UpdateUserVipReq) GetIsVip() bool {
 	if x != nil {
-		return x.Total
+		return x.IsVip
 	}
-	return 0
+	return false
 }
 
-// 评论相关消息
-type Comment struct {
+func (x *UpdateUserVipReq) GetVipExpires() string {
+	if x != nil {
+		return x.VipExpires
+	}
+	return ""
+}
+
+// 更新用户VIP状态响应
+type UpdateUserVipResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	PostId        string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	UserName      string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
-	UserAvatar    string                 `protobuf:"bytes,5,opt,name=user_avatar,json=userAvatar,proto3" json:"user_avatar,omitempty"`
-	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
-	Likes         int32                  `protobuf:"varint,7,opt,name=likes,proto3" json:"likes,omitempty"`
-	IsLiked       bool                   `protobuf:"varint,8,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Comment) Reset() {
-	*x = Comment{}
+func (x *UpdateUserVipResp) Reset() {
+	*x = UpdateUserVipResp{}
 	mi := &file_super_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Comment) String() string {
+func (x *UpdateUserVipResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Comment) ProtoMessage() {}
+func (*UpdateUserVipResp) ProtoMessage() {}
 
-func (x *Comment) ProtoReflect() protoreflect.Message {
+func (x *UpdateUserVipResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4110,99 +4283,93 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
-func (*Comment) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUserVipResp.ProtoReflect.Descriptor instead.
+func (*UpdateUserVipResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *Comment) GetId() string {
+func (x *UpdateUserVipResp) GetUser() *User {
 	if x != nil {
-		return x.Id
+		return x.User
 	}
-	return ""
+	return nil
 }
 
-func (x *Comment) GetPostId() string {
-	if x != nil {
-		return x.PostId
-	}
-	return ""
+type GetUsersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Comment) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+func (x *GetUsersReq) Reset() {
+	*x = GetUsersReq{}
+	mi := &file_super_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Comment) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
+func (x *GetUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Comment) GetUserAvatar() string {
-	if x != nil {
-		return x.UserAvatar
-	}
-	return ""
-}
+func (*GetUsersReq) ProtoMessage() {}
 
-func (x *Comment) GetContent() string {
+func (x *GetUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[72]
 	if x != nil {
-		return x.Content
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Comment) GetLikes() int32 {
-	if x != nil {
-		return x.Likes
-	}
-	return 0
+// Deprecated: Use GetUsersReq.ProtoReflect.Descriptor instead.
+func (*GetUsersReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *Comment) GetIsLiked() bool {
+func (x *GetUsersReq) GetPage() int32 {
 	if x != nil {
-		return x.IsLiked
+		return x.Page
 	}
-	return false
+	return 0
 }
 
-func (x *Comment) GetCreatedAt() string {
+func (x *GetUsersReq) GetPageSize() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-// 创建评论请求
-type CreateCommentReq struct {
+type GetUsersResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCommentReq) Reset() {
-	*x = CreateCommentReq{}
-	mi := &file_super_proto_msgTypes[72]
+func (x *GetUsersResp) Reset() {
+	*x = GetUsersResp{}
+	mi := &file_super_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCommentReq) String() string {
+func (x *GetUsersResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCommentReq) ProtoMessage() {}
+func (*GetUsersResp) ProtoMessage() {}
 
-func (x *CreateCommentReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[72]
+func (x *GetUsersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4213,100 +4380,45 @@ func (x *CreateCommentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCommentReq.ProtoReflect.Descriptor instead.
-func (*CreateCommentReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{72}
-}
-
-func (x *CreateCommentReq) GetPostId() string {
-	if x != nil {
-		return x.PostId
-	}
-	return ""
-}
-
-func (x *CreateCommentReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *CreateCommentReq) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
-}
-
-// 创建评论响应
-type CreateCommentResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CreateCommentResp) Reset() {
-	*x = CreateCommentResp{}
-	mi := &file_super_proto_msgTypes[73]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CreateCommentResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use GetUsersResp.ProtoReflect.Descriptor instead.
+func (*GetUsersResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{73}
 }
 
-func (*CreateCommentResp) ProtoMessage() {}
-
-func (x *CreateCommentResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[73]
+func (x *GetUsersResp) GetUsers() []*User {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Users
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use CreateCommentResp.ProtoReflect.Descriptor instead.
-func (*CreateCommentResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{73}
+	return nil
 }
 
-func (x *CreateCommentResp) GetComment() *Comment {
+func (x *GetUsersResp) GetTotal() int32 {
 	if x != nil {
-		return x.Comment
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-// 点赞评论请求
-type LikeCommentReq struct {
+type GetUserCountReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeCommentReq) Reset() {
-	*x = LikeCommentReq{}
+func (x *GetUserCountReq) Reset() {
+	*x = GetUserCountReq{}
 	mi := &file_super_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeCommentReq) String() string {
+func (x *GetUserCountReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeCommentReq) ProtoMessage() {}
+func (*GetUserCountReq) ProtoMessage() {}
 
-func (x *LikeCommentReq) ProtoReflect() protoreflect.Message {
+func (x *GetUserCountReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4318,47 +4430,32 @@ func (x *LikeCommentReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeCommentReq.ProtoReflect.Descriptor instead.
-func (*LikeCommentReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserCountReq.ProtoReflect.Descriptor instead.
+func (*GetUserCountReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *LikeCommentReq) GetCommentId() string {
-	if x != nil {
-		return x.CommentId
-	}
-	return ""
-}
-
-func (x *LikeCommentReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-// 点赞评论响应
-type LikeCommentResp struct {
+type GetUserCountResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeCommentResp) Reset() {
-	*x = LikeCommentResp{}
+func (x *GetUserCountResp) Reset() {
+	*x = GetUserCountResp{}
 	mi := &file_super_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeCommentResp) String() string {
+func (x *GetUserCountResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeCommentResp) ProtoMessage() {}
+func (*GetUserCountResp) ProtoMessage() {}
 
-func (x *LikeCommentResp) ProtoReflect() protoreflect.Message {
+func (x *GetUserCountResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4370,49 +4467,46 @@ func (x *LikeCommentResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeCommentResp.ProtoReflect.Descriptor instead.
-func (*LikeCommentResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetUserCountResp.ProtoReflect.Descriptor instead.
+func (*GetUserCountResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *LikeCommentResp) GetComment() *Comment {
+func (x *GetUserCountResp) GetCount() int32 {
 	if x != nil {
-		return x.Comment
+		return x.Count
 	}
-	return nil
+	return 0
 }
 
-// 通知相关消息
-type Notification struct {
+// VIP套餐相关消息
+type VipPlan struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SenderId      string                 `protobuf:"bytes,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	SenderName    string                 `protobuf:"bytes,4,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
-	SenderAvatar  string                 `protobuf:"bytes,5,opt,name=sender_avatar,json=senderAvatar,proto3" json:"sender_avatar,omitempty"`
-	Type          int32                  `protobuf:"varint,6,opt,name=type,proto3" json:"type,omitempty"`
-	PostId        string                 `protobuf:"bytes,7,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Content       string                 `protobuf:"bytes,8,opt,name=content,proto3" json:"content,omitempty"`
-	IsRead        bool                   `protobuf:"varint,9,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float32                `protobuf:"fixed32,4,opt,name=price,proto3" json:"price,omitempty"`
+	DurationDays  int32                  `protobuf:"varint,5,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Notification) Reset() {
-	*x = Notification{}
+func (x *VipPlan) Reset() {
+	*x = VipPlan{}
 	mi := &file_super_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Notification) String() string {
+func (x *VipPlan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Notification) ProtoMessage() {}
+func (*VipPlan) ProtoMessage() {}
 
-func (x *Notification) ProtoReflect() protoreflect.Message {
+func (x *VipPlan) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4424,104 +4518,82 @@ func (x *Notification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
-func (*Notification) Descriptor() ([]byte, []int) {
+// Deprecated: Use VipPlan.ProtoReflect.Descriptor instead.
+func (*VipPlan) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *Notification) GetId() string {
+func (x *VipPlan) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Notification) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *Notification) GetSenderId() string {
-	if x != nil {
-		return x.SenderId
-	}
-	return ""
-}
-
-func (x *Notification) GetSenderName() string {
+func (x *VipPlan) GetName() string {
 	if x != nil {
-		return x.SenderName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Notification) GetSenderAvatar() string {
+func (x *VipPlan) GetDescription() string {
 	if x != nil {
-		return x.SenderAvatar
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Notification) GetType() int32 {
+func (x *VipPlan) GetPrice() float32 {
 	if x != nil {
-		return x.Type
+		return x.Price
 	}
 	return 0
 }
 
-func (x *Notification) GetPostId() string {
+func (x *VipPlan) GetDurationDays() int32 {
 	if x != nil {
-		return x.PostId
+		return x.DurationDays
 	}
-	return ""
+	return 0
 }
 
-func (x *Notification) GetContent() string {
+func (x *VipPlan) GetCreatedAt() string {
 	if x != nil {
-		return x.Content
+		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *Notification) GetIsRead() bool {
-	if x != nil {
-		return x.IsRead
-	}
-	return false
-}
-
-func (x *Notification) GetCreatedAt() string {
+func (x *VipPlan) GetUpdatedAt() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-type GetNotificationsReq struct {
+// 获取单个VIP套餐请求
+type GetVipPlanReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PlanId        string                 `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetNotificationsReq) Reset() {
-	*x = GetNotificationsReq{}
+func (x *GetVipPlanReq) Reset() {
+	*x = GetVipPlanReq{}
 	mi := &file_super_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNotificationsReq) String() string {
+func (x *GetVipPlanReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNotificationsReq) ProtoMessage() {}
+func (*GetVipPlanReq) ProtoMessage() {}
 
-func (x *GetNotificationsReq) ProtoReflect() protoreflect.Message {
+func (x *GetVipPlanReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4533,54 +4605,40 @@ func (x *GetNotificationsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotificationsReq.ProtoReflect.Descriptor instead.
-func (*GetNotificationsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVipPlanReq.ProtoReflect.Descriptor instead.
+func (*GetVipPlanReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetNotificationsReq) GetUserId() string {
+func (x *GetVipPlanReq) GetPlanId() string {
 	if x != nil {
-		return x.UserId
+		return x.PlanId
 	}
 	return ""
 }
 
-func (x *GetNotificationsReq) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetNotificationsReq) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// 获取单个VIP套餐响应
+type GetVipPlanResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plan          *VipPlan               `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type GetNotificationsResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetNotificationsResp) Reset() {
-	*x = GetNotificationsResp{}
+func (x *GetVipPlanResp) Reset() {
+	*x = GetVipPlanResp{}
 	mi := &file_super_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetNotificationsResp) String() string {
+func (x *GetVipPlanResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNotificationsResp) ProtoMessage() {}
+func (*GetVipPlanResp) ProtoMessage() {}
 
-func (x *GetNotificationsResp) ProtoReflect() protoreflect.Message {
+func (x *GetVipPlanResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4592,46 +4650,43 @@ func (x *GetNotificationsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotificationsResp.ProtoReflect.Descriptor instead.
-func (*GetNotificationsResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVipPlanResp.ProtoReflect.Descriptor instead.
+func (*GetVipPlanResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetNotificationsResp) GetNotifications() []*Notification {
+func (x *GetVipPlanResp) GetPlan() *VipPlan {
 	if x != nil {
-		return x.Notifications
+		return x.Plan
 	}
 	return nil
 }
 
-func (x *GetNotificationsResp) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-type GetUnreadCountReq struct {
+// 创建VIP套餐请求
+type CreateVipPlanReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float32                `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
+	DurationDays  int32                  `protobuf:"varint,4,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUnreadCountReq) Reset() {
-	*x = GetUnreadCountReq{}
+func (x *CreateVipPlanReq) Reset() {
+	*x = CreateVipPlanReq{}
 	mi := &file_super_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUnreadCountReq) String() string {
+func (x *CreateVipPlanReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUnreadCountReq) ProtoMessage() {}
+func (*CreateVipPlanReq) ProtoMessage() {}
 
-func (x *GetUnreadCountReq) ProtoReflect() protoreflect.Message {
+func (x *CreateVipPlanReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4643,39 +4698,61 @@ func (x *GetUnreadCountReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUnreadCountReq.ProtoReflect.Descriptor instead.
-func (*GetUnreadCountReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateVipPlanReq.ProtoReflect.Descriptor instead.
+func (*CreateVipPlanReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetUnreadCountReq) GetUserId() string {
+func (x *CreateVipPlanReq) GetName() string {
 	if x != nil {
-		return x.UserId
+		return x.Name
 	}
 	return ""
 }
 
-type GetUnreadCountResp struct {
+func (x *CreateVipPlanReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateVipPlanReq) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateVipPlanReq) GetDurationDays() int32 {
+	if x != nil {
+		return x.DurationDays
+	}
+	return 0
+}
+
+// 创建VIP套餐响应
+type CreateVipPlanResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Plan          *VipPlan               `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUnreadCountResp) Reset() {
-	*x = GetUnreadCountResp{}
+func (x *CreateVipPlanResp) Reset() {
+	*x = CreateVipPlanResp{}
 	mi := &file_super_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUnreadCountResp) String() string {
+func (x *CreateVipPlanResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUnreadCountResp) ProtoMessage() {}
+func (*CreateVipPlanResp) ProtoMessage() {}
 
-func (x *GetUnreadCountResp) ProtoReflect() protoreflect.Message {
+func (x *CreateVipPlanResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4687,40 +4764,38 @@ func (x *GetUnreadCountResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUnreadCountResp.ProtoReflect.Descriptor instead.
-func (*GetUnreadCountResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateVipPlanResp.ProtoReflect.Descriptor instead.
+func (*CreateVipPlanResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *GetUnreadCountResp) GetCount() int32 {
+func (x *CreateVipPlanResp) GetPlan() *VipPlan {
 	if x != nil {
-		return x.Count
+		return x.Plan
 	}
-	return 0
+	return nil
 }
 
-type ReadNotificationReq struct {
+type GetVipPlansReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadNotificationReq) Reset() {
-	*x = ReadNotificationReq{}
+func (x *GetVipPlansReq) Reset() {
+	*x = GetVipPlansReq{}
 	mi := &file_super_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadNotificationReq) String() string {
+func (x *GetVipPlansReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadNotificationReq) ProtoMessage() {}
+func (*GetVipPlansReq) ProtoMessage() {}
 
-func (x *ReadNotificationReq) ProtoReflect() protoreflect.Message {
+func (x *GetVipPlansReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4732,45 +4807,32 @@ func (x *ReadNotificationReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadNotificationReq.ProtoReflect.Descriptor instead.
-func (*ReadNotificationReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVipPlansReq.ProtoReflect.Descriptor instead.
+func (*GetVipPlansReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *ReadNotificationReq) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *ReadNotificationReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-type ReadNotificationResp struct {
+type GetVipPlansResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*VipPlan             `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadNotificationResp) Reset() {
-	*x = ReadNotificationResp{}
+func (x *GetVipPlansResp) Reset() {
+	*x = GetVipPlansResp{}
 	mi := &file_super_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadNotificationResp) String() string {
+func (x *GetVipPlansResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadNotificationResp) ProtoMessage() {}
+func (*GetVipPlansResp) ProtoMessage() {}
 
-func (x *ReadNotificationResp) ProtoReflect() protoreflect.Message {
+func (x *GetVipPlansResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4782,32 +4844,47 @@ func (x *ReadNotificationResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadNotificationResp.ProtoReflect.Descriptor instead.
-func (*ReadNotificationResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetVipPlansResp.ProtoReflect.Descriptor instead.
+func (*GetVipPlansResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{82}
 }
 
-type ReadAllNotificationsReq struct {
+func (x *GetVipPlansResp) GetPlans() []*VipPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// VIP订单相关消息
+type VipOrder struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlanId        string                 `protobuf:"bytes,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	PlanName      string                 `protobuf:"bytes,4,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	Amount        float32                `protobuf:"fixed32,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PaidAt        string                 `protobuf:"bytes,8,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadAllNotificationsReq) Reset() {
-	*x = ReadAllNotificationsReq{}
+func (x *VipOrder) Reset() {
+	*x = VipOrder{}
 	mi := &file_super_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadAllNotificationsReq) String() string {
+func (x *VipOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadAllNotificationsReq) ProtoMessage() {}
+func (*VipOrder) ProtoMessage() {}
 
-func (x *ReadAllNotificationsReq) ProtoReflect() protoreflect.Message {
+func (x *VipOrder) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4819,38 +4896,89 @@ func (x *ReadAllNotificationsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadAllNotificationsReq.ProtoReflect.Descriptor instead.
-func (*ReadAllNotificationsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use VipOrder.ProtoReflect.Descriptor instead.
+func (*VipOrder) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ReadAllNotificationsReq) GetUserId() string {
+func (x *VipOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VipOrder) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type ReadAllNotificationsResp struct {
+func (x *VipOrder) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *VipOrder) GetPlanName() string {
+	if x != nil {
+		return x.PlanName
+	}
+	return ""
+}
+
+func (x *VipOrder) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *VipOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VipOrder) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *VipOrder) GetPaidAt() string {
+	if x != nil {
+		return x.PaidAt
+	}
+	return ""
+}
+
+type CreateVipOrderReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlanId        string                 `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadAllNotificationsResp) Reset() {
-	*x = ReadAllNotificationsResp{}
+func (x *CreateVipOrderReq) Reset() {
+	*x = CreateVipOrderReq{}
 	mi := &file_super_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadAllNotificationsResp) String() string {
+func (x *CreateVipOrderReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadAllNotificationsResp) ProtoMessage() {}
+func (*CreateVipOrderReq) ProtoMessage() {}
 
-func (x *ReadAllNotificationsResp) ProtoReflect() protoreflect.Message {
+func (x *CreateVipOrderReq) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4862,36 +4990,46 @@ func (x *ReadAllNotificationsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadAllNotificationsResp.ProtoReflect.Descriptor instead.
-func (*ReadAllNotificationsResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateVipOrderReq.ProtoReflect.Descriptor instead.
+func (*CreateVipOrderReq) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{84}
 }
 
-type CreateNotificationReq struct {
+func (x *CreateVipOrderReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateVipOrderReq) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+type CreateVipOrderResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	SenderId      string                 `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
-	Type          int32                  `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
-	PostId        string                 `protobuf:"bytes,4,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
-	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Order         *VipOrder              `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateNotificationReq) Reset() {
-	*x = CreateNotificationReq{}
+func (x *CreateVipOrderResp) Reset() {
+	*x = CreateVipOrderResp{}
 	mi := &file_super_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNotificationReq) String() string {
+func (x *CreateVipOrderResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNotificationReq) ProtoMessage() {}
+func (*CreateVipOrderResp) ProtoMessage() {}
 
-func (x *CreateNotificationReq) ProtoReflect() protoreflect.Message {
+func (x *CreateVipOrderResp) ProtoReflect() protoreflect.Message {
 	mi := &file_super_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4903,68 +5041,101 @@ func (x *CreateNotificationReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNotificationReq.ProtoReflect.Descriptor instead.
-func (*CreateNotificationReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateVipOrderResp.ProtoReflect.Descriptor instead.
+func (*CreateVipOrderResp) Descriptor() ([]byte, []int) {
 	return file_super_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *CreateNotificationReq) GetUserId() string {
+func (x *CreateVipOrderResp) GetOrder() *VipOrder {
 	if x != nil {
-		return x.UserId
+		return x.Order
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateNotificationReq) GetSenderId() string {
+type GetVipOrdersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVipOrdersReq) Reset() {
+	*x = GetVipOrdersReq{}
+	mi := &file_super_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVipOrdersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVipOrdersReq) ProtoMessage() {}
+
+func (x *GetVipOrdersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[86]
 	if x != nil {
-		return x.SenderId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateNotificationReq) GetType() int32 {
+// Deprecated: Use GetVipOrdersReq.ProtoReflect.Descriptor instead.
+func (*GetVipOrdersReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetVipOrdersReq) GetUserId() string {
 	if x != nil {
-		return x.Type
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateNotificationReq) GetPostId() string {
+func (x *GetVipOrdersReq) GetPage() int32 {
 	if x != nil {
-		return x.PostId
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateNotificationReq) GetContent() string {
+func (x *GetVipOrdersReq) GetPageSize() int32 {
 	if x != nil {
-		return x.Content
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-type CreateNotificationResp struct {
+type GetVipOrdersResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Notification  *Notification          `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	Orders        []*VipOrder            `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateNotificationResp) Reset() {
-	*x = CreateNotificationResp{}
-	mi := &file_super_proto_msgTypes[86]
+func (x *GetVipOrdersResp) Reset() {
+	*x = GetVipOrdersResp{}
+	mi := &file_super_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateNotificationResp) String() string {
+func (x *GetVipOrdersResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateNotificationResp) ProtoMessage() {}
+func (*GetVipOrdersResp) ProtoMessage() {}
 
-func (x *CreateNotificationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[86]
+func (x *GetVipOrdersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4975,45 +5146,55 @@ func (x *CreateNotificationResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateNotificationResp.ProtoReflect.Descriptor instead.
-func (*CreateNotificationResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{86}
+// Deprecated: Use GetVipOrdersResp.ProtoReflect.Descriptor instead.
+func (*GetVipOrdersResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *CreateNotificationResp) GetNotification() *Notification {
+func (x *GetVipOrdersResp) GetOrders() []*VipOrder {
 	if x != nil {
-		return x.Notification
+		return x.Orders
 	}
 	return nil
 }
 
-type UserMemory struct {
+func (x *GetVipOrdersResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// VIP记录相关消息
+type VipRecord struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
-	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PlanId        string                 `protobuf:"bytes,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	PlanName      string                 `protobuf:"bytes,4,opt,name=plan_name,json=planName,proto3" json:"plan_name,omitempty"`
+	StartAt       string                 `protobuf:"bytes,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         string                 `protobuf:"bytes,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserMemory) Reset() {
-	*x = UserMemory{}
-	mi := &file_super_proto_msgTypes[87]
+func (x *VipRecord) Reset() {
+	*x = VipRecord{}
+	mi := &file_super_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserMemory) String() string {
+func (x *VipRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserMemory) ProtoMessage() {}
+func (*VipRecord) ProtoMessage() {}
 
-func (x *UserMemory) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[87]
+func (x *VipRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5024,77 +5205,91 @@ func (x *UserMemory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserMemory.ProtoReflect.Descriptor instead.
-func (*UserMemory) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{87}
+// Deprecated: Use VipRecord.ProtoReflect.Descriptor instead.
+func (*VipRecord) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *UserMemory) GetId() string {
+func (x *VipRecord) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UserMemory) GetUserId() string {
+func (x *VipRecord) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UserMemory) GetKey() string {
+func (x *VipRecord) GetPlanId() string {
 	if x != nil {
-		return x.Key
+		return x.PlanId
 	}
 	return ""
 }
 
-func (x *UserMemory) GetValue() string {
+func (x *VipRecord) GetPlanName() string {
 	if x != nil {
-		return x.Value
+		return x.PlanName
 	}
 	return ""
 }
 
-func (x *UserMemory) GetCreatedAt() string {
+func (x *VipRecord) GetStartAt() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.StartAt
 	}
 	return ""
 }
 
-func (x *UserMemory) GetUpdatedAt() string {
+func (x *VipRecord) GetEndAt() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.EndAt
 	}
 	return ""
 }
 
-type UpsertUserMemoryReq struct {
+func (x *VipRecord) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VipRecord) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type GetVipRecordsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertUserMemoryReq) Reset() {
-	*x = UpsertUserMemoryReq{}
-	mi := &file_super_proto_msgTypes[88]
+func (x *GetVipRecordsReq) Reset() {
+	*x = GetVipRecordsReq{}
+	mi := &file_super_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertUserMemoryReq) String() string {
+func (x *GetVipRecordsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertUserMemoryReq) ProtoMessage() {}
+func (*GetVipRecordsReq) ProtoMessage() {}
 
-func (x *UpsertUserMemoryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[88]
+func (x *GetVipRecordsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5105,54 +5300,55 @@ func (x *UpsertUserMemoryReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertUserMemoryReq.ProtoReflect.Descriptor instead.
-func (*UpsertUserMemoryReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use GetVipRecordsReq.ProtoReflect.Descriptor instead.
+func (*GetVipRecordsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *UpsertUserMemoryReq) GetUserId() string {
+func (x *GetVipRecordsReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpsertUserMemoryReq) GetKey() string {
+func (x *GetVipRecordsReq) GetPage() int32 {
 	if x != nil {
-		return x.Key
+		return x.Page
 	}
-	return ""
+	return 0
 }
 
-func (x *UpsertUserMemoryReq) GetValue() string {
+func (x *GetVipRecordsReq) GetPageSize() int32 {
 	if x != nil {
-		return x.Value
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-type UpsertUserMemoryResp struct {
+type GetVipRecordsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Memory        *UserMemory            `protobuf:"bytes,1,opt,name=memory,proto3" json:"memory,omitempty"`
+	Records       []*VipRecord           `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertUserMemoryResp) Reset() {
-	*x = UpsertUserMemoryResp{}
-	mi := &file_super_proto_msgTypes[89]
+func (x *GetVipRecordsResp) Reset() {
+	*x = GetVipRecordsResp{}
+	mi := &file_super_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertUserMemoryResp) String() string {
+func (x *GetVipRecordsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertUserMemoryResp) ProtoMessage() {}
+func (*GetVipRecordsResp) ProtoMessage() {}
 
-func (x *UpsertUserMemoryResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[89]
+func (x *GetVipRecordsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5163,40 +5359,48 @@ func (x *UpsertUserMemoryResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertUserMemoryResp.ProtoReflect.Descriptor instead.
-func (*UpsertUserMemoryResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{89}
+// Deprecated: Use GetVipRecordsResp.ProtoReflect.Descriptor instead.
+func (*GetVipRecordsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *UpsertUserMemoryResp) GetMemory() *UserMemory {
+func (x *GetVipRecordsResp) GetRecords() []*VipRecord {
 	if x != nil {
-		return x.Memory
+		return x.Records
 	}
 	return nil
 }
 
-type GetUserMemoriesReq struct {
+func (x *GetVipRecordsResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 获取用户活跃VIP记录请求
+type GetUserActiveVipRecordReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserMemoriesReq) Reset() {
-	*x = GetUserMemoriesReq{}
-	mi := &file_super_proto_msgTypes[90]
+func (x *GetUserActiveVipRecordReq) Reset() {
+	*x = GetUserActiveVipRecordReq{}
+	mi := &file_super_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserMemoriesReq) String() string {
+func (x *GetUserActiveVipRecordReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserMemoriesReq) ProtoMessage() {}
+func (*GetUserActiveVipRecordReq) ProtoMessage() {}
 
-func (x *GetUserMemoriesReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[90]
+func (x *GetUserActiveVipRecordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5207,40 +5411,41 @@ func (x *GetUserMemoriesReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserMemoriesReq.ProtoReflect.Descriptor instead.
-func (*GetUserMemoriesReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{90}
+// Deprecated: Use GetUserActiveVipRecordReq.ProtoReflect.Descriptor instead.
+func (*GetUserActiveVipRecordReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *GetUserMemoriesReq) GetUserId() string {
+func (x *GetUserActiveVipRecordReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type GetUserMemoriesResp struct {
+// 获取用户活跃VIP记录响应
+type GetUserActiveVipRecordResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Memories      []*UserMemory          `protobuf:"bytes,1,rep,name=memories,proto3" json:"memories,omitempty"`
+	Record        *VipRecord             `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserMemoriesResp) Reset() {
-	*x = GetUserMemoriesResp{}
-	mi := &file_super_proto_msgTypes[91]
+func (x *GetUserActiveVipRecordResp) Reset() {
+	*x = GetUserActiveVipRecordResp{}
+	mi := &file_super_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserMemoriesResp) String() string {
+func (x *GetUserActiveVipRecordResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserMemoriesResp) ProtoMessage() {}
+func (*GetUserActiveVipRecordResp) ProtoMessage() {}
 
-func (x *GetUserMemoriesResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[91]
+func (x *GetUserActiveVipRecordResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5251,41 +5456,41 @@ func (x *GetUserMemoriesResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserMemoriesResp.ProtoReflect.Descriptor instead.
-func (*GetUserMemoriesResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{91}
+// Deprecated: Use GetUserActiveVipRecordResp.ProtoReflect.Descriptor instead.
+func (*GetUserActiveVipRecordResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *GetUserMemoriesResp) GetMemories() []*UserMemory {
+func (x *GetUserActiveVipRecordResp) GetRecord() *VipRecord {
 	if x != nil {
-		return x.Memories
+		return x.Record
 	}
 	return nil
 }
 
-type DeleteUserMemoryReq struct {
+// VIP状态相关消息
+type GetUserVipStatusReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserMemoryReq) Reset() {
-	*x = DeleteUserMemoryReq{}
-	mi := &file_super_proto_msgTypes[92]
+func (x *GetUserVipStatusReq) Reset() {
+	*x = GetUserVipStatusReq{}
+	mi := &file_super_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserMemoryReq) String() string {
+func (x *GetUserVipStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserMemoryReq) ProtoMessage() {}
+func (*GetUserVipStatusReq) ProtoMessage() {}
 
-func (x *DeleteUserMemoryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[92]
+func (x *GetUserVipStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5296,46 +5501,42 @@ func (x *DeleteUserMemoryReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserMemoryReq.ProtoReflect.Descriptor instead.
-func (*DeleteUserMemoryReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use GetUserVipStatusReq.ProtoReflect.Descriptor instead.
+func (*GetUserVipStatusReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *DeleteUserMemoryReq) GetUserId() string {
+func (x *GetUserVipStatusReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *DeleteUserMemoryReq) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-type DeleteUserMemoryResp struct {
+type GetUserVipStatusResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsVip         bool                   `protobuf:"varint,1,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	AutoRenew     bool                   `protobuf:"varint,3,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserMemoryResp) Reset() {
-	*x = DeleteUserMemoryResp{}
-	mi := &file_super_proto_msgTypes[93]
+func (x *GetUserVipStatusResp) Reset() {
+	*x = GetUserVipStatusResp{}
+	mi := &file_super_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserMemoryResp) String() string {
+func (x *GetUserVipStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserMemoryResp) ProtoMessage() {}
+func (*GetUserVipStatusResp) ProtoMessage() {}
 
-func (x *DeleteUserMemoryResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[93]
+func (x *GetUserVipStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5346,38 +5547,54 @@ func (x *DeleteUserMemoryResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserMemoryResp.ProtoReflect.Descriptor instead.
-func (*DeleteUserMemoryResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use GetUserVipStatusResp.ProtoReflect.Descriptor instead.
+func (*GetUserVipStatusResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{94}
 }
 
-// 好友申请（同意后互相关注）
-type FriendRequestView struct {
+func (x *GetUserVipStatusResp) GetIsVip() bool {
+	if x != nil {
+		return x.IsVip
+	}
+	return false
+}
+
+func (x *GetUserVipStatusResp) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *GetUserVipStatusResp) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+type CheckUserVipReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	FromUser      *User                  `protobuf:"bytes,2,opt,name=from_user,json=fromUser,proto3" json:"from_user,omitempty"`
-	ToUser        *User                  `protobuf:"bytes,3,opt,name=to_user,json=toUser,proto3" json:"to_user,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FriendRequestView) Reset() {
-	*x = FriendRequestView{}
-	mi := &file_super_proto_msgTypes[94]
+func (x *CheckUserVipReq) Reset() {
+	*x = CheckUserVipReq{}
+	mi := &file_super_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FriendRequestView) String() string {
+func (x *CheckUserVipReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FriendRequestView) ProtoMessage() {}
+func (*CheckUserVipReq) ProtoMessage() {}
 
-func (x *FriendRequestView) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[94]
+func (x *CheckUserVipReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5388,70 +5605,7924 @@ func (x *FriendRequestView) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FriendRequestView.ProtoReflect.Descriptor instead.
-func (*FriendRequestView) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{94}
+// Deprecated: Use CheckUserVipReq.ProtoReflect.Descriptor instead.
+func (*CheckUserVipReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *FriendRequestView) GetId() string {
+func (x *CheckUserVipReq) GetUserId() string {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *FriendRequestView) GetFromUser() *User {
-	if x != nil {
-		return x.FromUser
-	}
-	return nil
+type CheckUserVipResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsVip         bool                   `protobuf:"varint,1,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FriendRequestView) GetToUser() *User {
-	if x != nil {
-		return x.ToUser
-	}
-	return nil
+func (x *CheckUserVipResp) Reset() {
+	*x = CheckUserVipResp{}
+	mi := &file_super_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FriendRequestView) GetStatus() string {
+func (x *CheckUserVipResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserVipResp) ProtoMessage() {}
+
+func (x *CheckUserVipResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[96]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FriendRequestView) GetCreatedAt() string {
+// Deprecated: Use CheckUserVipResp.ProtoReflect.Descriptor instead.
+func (*CheckUserVipResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CheckUserVipResp) GetIsVip() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.IsVip
 	}
-	return ""
+	return false
 }
 
-type SendFriendRequestReq struct {
+type UpdateAutoRenewReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
-	ToUserId      string                 `protobuf:"bytes,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
-	ToMoeNo       string                 `protobuf:"bytes,3,opt,name=to_moe_no,json=toMoeNo,proto3" json:"to_moe_no,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AutoRenew     bool                   `protobuf:"varint,2,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendFriendRequestReq) Reset() {
-	*x = SendFriendRequestReq{}
-	mi := &file_super_proto_msgTypes[95]
+func (x *UpdateAutoRenewReq) Reset() {
+	*x = UpdateAutoRenewReq{}
+	mi := &file_super_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAutoRenewReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAutoRenewReq) ProtoMessage() {}
+
+func (x *UpdateAutoRenewReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAutoRenewReq.ProtoReflect.Descriptor instead.
+func (*UpdateAutoRenewReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UpdateAutoRenewReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateAutoRenewReq) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+type UpdateAutoRenewResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAutoRenewResp) Reset() {
+	*x = UpdateAutoRenewResp{}
+	mi := &file_super_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAutoRenewResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAutoRenewResp) ProtoMessage() {}
+
+func (x *UpdateAutoRenewResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAutoRenewResp.ProtoReflect.Descriptor instead.
+func (*UpdateAutoRenewResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{98}
+}
+
+type SyncUserVipStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncUserVipStatusReq) Reset() {
+	*x = SyncUserVipStatusReq{}
+	mi := &file_super_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncUserVipStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncUserVipStatusReq) ProtoMessage() {}
+
+func (x *SyncUserVipStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncUserVipStatusReq.ProtoReflect.Descriptor instead.
+func (*SyncUserVipStatusReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SyncUserVipStatusReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SyncUserVipStatusResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsVip         bool                   `protobuf:"varint,1,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncUserVipStatusResp) Reset() {
+	*x = SyncUserVipStatusResp{}
+	mi := &file_super_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncUserVipStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncUserVipStatusResp) ProtoMessage() {}
+
+func (x *SyncUserVipStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncUserVipStatusResp.ProtoReflect.Descriptor instead.
+func (*SyncUserVipStatusResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *SyncUserVipStatusResp) GetIsVip() bool {
+	if x != nil {
+		return x.IsVip
+	}
+	return false
+}
+
+func (x *SyncUserVipStatusResp) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+// 充值请求
+type RechargeReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        float32                `protobuf:"fixed32,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RechargeReq) Reset() {
+	*x = RechargeReq{}
+	mi := &file_super_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RechargeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RechargeReq) ProtoMessage() {}
+
+func (x *RechargeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RechargeReq.ProtoReflect.Descriptor instead.
+func (*RechargeReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *RechargeReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RechargeReq) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RechargeReq) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// 充值响应
+type RechargeResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	NewBalance    float32                `protobuf:"fixed32,2,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RechargeResp) Reset() {
+	*x = RechargeResp{}
+	mi := &file_super_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RechargeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RechargeResp) ProtoMessage() {}
+
+func (x *RechargeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RechargeResp.ProtoReflect.Descriptor instead.
+func (*RechargeResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *RechargeResp) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RechargeResp) GetNewBalance() float32 {
+	if x != nil {
+		return x.NewBalance
+	}
+	return 0
+}
+
+// 获取交易记录请求
+type GetTransactionsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsReq) Reset() {
+	*x = GetTransactionsReq{}
+	mi := &file_super_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsReq) ProtoMessage() {}
+
+func (x *GetTransactionsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsReq.ProtoReflect.Descriptor instead.
+func (*GetTransactionsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetTransactionsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetTransactionsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetTransactionsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 交易记录
+type Transaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount        float32                `protobuf:"fixed32,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_super_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *Transaction) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Transaction) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Transaction) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transaction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Transaction) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Transaction) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Transaction) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 交易记录响应
+type GetTransactionsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transactions  []*Transaction         `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionsResp) Reset() {
+	*x = GetTransactionsResp{}
+	mi := &file_super_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionsResp) ProtoMessage() {}
+
+func (x *GetTransactionsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionsResp.ProtoReflect.Descriptor instead.
+func (*GetTransactionsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetTransactionsResp) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *GetTransactionsResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 获取单个交易记录请求
+type GetTransactionReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionReq) Reset() {
+	*x = GetTransactionReq{}
+	mi := &file_super_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionReq) ProtoMessage() {}
+
+func (x *GetTransactionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionReq.ProtoReflect.Descriptor instead.
+func (*GetTransactionReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetTransactionReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 获取单个交易记录响应
+type GetTransactionResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transaction   *Transaction           `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransactionResp) Reset() {
+	*x = GetTransactionResp{}
+	mi := &file_super_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransactionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionResp) ProtoMessage() {}
+
+func (x *GetTransactionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionResp.ProtoReflect.Descriptor instead.
+func (*GetTransactionResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetTransactionResp) GetTransaction() *Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// 话题标签消息
+type TopicTag struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopicTag) Reset() {
+	*x = TopicTag{}
+	mi := &file_super_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopicTag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopicTag) ProtoMessage() {}
+
+func (x *TopicTag) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopicTag.ProtoReflect.Descriptor instead.
+func (*TopicTag) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *TopicTag) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TopicTag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TopicTag) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+// 服务定义帖子相关消息
+type Post struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName         string                 `protobuf:"bytes,3,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserAvatar       string                 `protobuf:"bytes,4,opt,name=user_avatar,json=userAvatar,proto3" json:"user_avatar,omitempty"`
+	Content          string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Images           []string               `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`
+	TopicTags        []*TopicTag            `protobuf:"bytes,7,rep,name=topic_tags,json=topicTags,proto3" json:"topic_tags,omitempty"`
+	Likes            int32                  `protobuf:"varint,8,opt,name=likes,proto3" json:"likes,omitempty"`
+	Comments         int32                  `protobuf:"varint,9,opt,name=comments,proto3" json:"comments,omitempty"`
+	IsLiked          bool                   `protobuf:"varint,10,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
+	CreatedAt        string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	HandDrawCard     string                 `protobuf:"bytes,12,opt,name=hand_draw_card,json=handDrawCard,proto3" json:"hand_draw_card,omitempty"`
+	HandDrawThumbUrl string                 `protobuf:"bytes,13,opt,name=hand_draw_thumb_url,json=handDrawThumbUrl,proto3" json:"hand_draw_thumb_url,omitempty"`
+	ModerationStatus string                 `protobuf:"bytes,14,opt,name=moderation_status,json=moderationStatus,proto3" json:"moderation_status,omitempty"`
+	Edited           bool                   `protobuf:"varint,15,opt,name=edited,proto3" json:"edited,omitempty"`                    // 发布后是否编辑过
+	EditedAt         string                 `protobuf:"bytes,16,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"` // 最近一次编辑时间，未编辑时为空
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Post) Reset() {
+	*x = Post{}
+	mi := &file_super_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Post) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Post) ProtoMessage() {}
+
+func (x *Post) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Post.ProtoReflect.Descriptor instead.
+func (*Post) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *Post) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Post) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Post) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Post) GetUserAvatar() string {
+	if x != nil {
+		return x.UserAvatar
+	}
+	return ""
+}
+
+func (x *Post) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Post) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Post) GetTopicTags() []*TopicTag {
+	if x != nil {
+		return x.TopicTags
+	}
+	return nil
+}
+
+func (x *Post) GetLikes() int32 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *Post) GetComments() int32 {
+	if x != nil {
+		return x.Comments
+	}
+	return 0
+}
+
+func (x *Post) GetIsLiked() bool {
+	if x != nil {
+		return x.IsLiked
+	}
+	return false
+}
+
+func (x *Post) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Post) GetHandDrawCard() string {
+	if x != nil {
+		return x.HandDrawCard
+	}
+	return ""
+}
+
+func (x *Post) GetHandDrawThumbUrl() string {
+	if x != nil {
+		return x.HandDrawThumbUrl
+	}
+	return ""
+}
+
+func (x *Post) GetModerationStatus() string {
+	if x != nil {
+		return x.ModerationStatus
+	}
+	return ""
+}
+
+func (x *Post) GetEdited() bool {
+	if x != nil {
+		return x.Edited
+	}
+	return false
+}
+
+func (x *Post) GetEditedAt() string {
+	if x != nil {
+		return x.EditedAt
+	}
+	return ""
+}
+
+// 获取帖子列表请求
+type GetPostsReq struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Page         int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize     int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ViewerUserId string                 `protobuf:"bytes,3,opt,name=viewer_user_id,json=viewerUserId,proto3" json:"viewer_user_id,omitempty"`
+	// latest | hot | following（空则按 latest）
+	FeedMode string `protobuf:"bytes,4,opt,name=feed_mode,json=feedMode,proto3" json:"feed_mode,omitempty"`
+	// 按话题标签 ID 筛选（与 post_topics 关联）
+	TopicTagId string `protobuf:"bytes,5,opt,name=topic_tag_id,json=topicTagId,proto3" json:"topic_tag_id,omitempty"`
+	// 仅返回该作者发布的动态（与 feed_mode=following 互斥，优先按作者筛选）
+	AuthorUserId  string `protobuf:"bytes,6,opt,name=author_user_id,json=authorUserId,proto3" json:"author_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostsReq) Reset() {
+	*x = GetPostsReq{}
+	mi := &file_super_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsReq) ProtoMessage() {}
+
+func (x *GetPostsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsReq.ProtoReflect.Descriptor instead.
+func (*GetPostsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *GetPostsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPostsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetPostsReq) GetViewerUserId() string {
+	if x != nil {
+		return x.ViewerUserId
+	}
+	return ""
+}
+
+func (x *GetPostsReq) GetFeedMode() string {
+	if x != nil {
+		return x.FeedMode
+	}
+	return ""
+}
+
+func (x *GetPostsReq) GetTopicTagId() string {
+	if x != nil {
+		return x.TopicTagId
+	}
+	return ""
+}
+
+func (x *GetPostsReq) GetAuthorUserId() string {
+	if x != nil {
+		return x.AuthorUserId
+	}
+	return ""
+}
+
+// 获取帖子列表响应
+type GetPostsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Posts         []*Post                `protobuf:"bytes,1,rep,name=posts,proto3" json:"posts,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostsResp) Reset() {
+	*x = GetPostsResp{}
+	mi := &file_super_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostsResp) ProtoMessage() {}
+
+func (x *GetPostsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostsResp.ProtoReflect.Descriptor instead.
+func (*GetPostsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetPostsResp) GetPosts() []*Post {
+	if x != nil {
+		return x.Posts
+	}
+	return nil
+}
+
+func (x *GetPostsResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 获取单个帖子请求
+type GetPostReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ViewerUserId  string                 `protobuf:"bytes,2,opt,name=viewer_user_id,json=viewerUserId,proto3" json:"viewer_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostReq) Reset() {
+	*x = GetPostReq{}
+	mi := &file_super_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostReq) ProtoMessage() {}
+
+func (x *GetPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostReq.ProtoReflect.Descriptor instead.
+func (*GetPostReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetPostReq) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *GetPostReq) GetViewerUserId() string {
+	if x != nil {
+		return x.ViewerUserId
+	}
+	return ""
+}
+
+// 获取单个帖子响应
+type GetPostResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostResp) Reset() {
+	*x = GetPostResp{}
+	mi := &file_super_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostResp) ProtoMessage() {}
+
+func (x *GetPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostResp.ProtoReflect.Descriptor instead.
+func (*GetPostResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetPostResp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// 创建帖子请求
+type CreatePostReq struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content          string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	Images           []string               `protobuf:"bytes,3,rep,name=images,proto3" json:"images,omitempty"`
+	TopicTags        []*TopicTag            `protobuf:"bytes,4,rep,name=topic_tags,json=topicTags,proto3" json:"topic_tags,omitempty"`
+	HandDrawCard     string                 `protobuf:"bytes,5,opt,name=hand_draw_card,json=handDrawCard,proto3" json:"hand_draw_card,omitempty"`
+	HandDrawThumbUrl string                 `protobuf:"bytes,6,opt,name=hand_draw_thumb_url,json=handDrawThumbUrl,proto3" json:"hand_draw_thumb_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreatePostReq) Reset() {
+	*x = CreatePostReq{}
+	mi := &file_super_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostReq) ProtoMessage() {}
+
+func (x *CreatePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostReq.ProtoReflect.Descriptor instead.
+func (*CreatePostReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CreatePostReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreatePostReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreatePostReq) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *CreatePostReq) GetTopicTags() []*TopicTag {
+	if x != nil {
+		return x.TopicTags
+	}
+	return nil
+}
+
+func (x *CreatePostReq) GetHandDrawCard() string {
+	if x != nil {
+		return x.HandDrawCard
+	}
+	return ""
+}
+
+func (x *CreatePostReq) GetHandDrawThumbUrl() string {
+	if x != nil {
+		return x.HandDrawThumbUrl
+	}
+	return ""
+}
+
+// 举报帖子
+type ReportPostReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PostId         string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ReporterUserId string                 `protobuf:"bytes,2,opt,name=reporter_user_id,json=reporterUserId,proto3" json:"reporter_user_id,omitempty"`
+	Reason         string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReportPostReq) Reset() {
+	*x = ReportPostReq{}
+	mi := &file_super_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPostReq) ProtoMessage() {}
+
+func (x *ReportPostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPostReq.ProtoReflect.Descriptor instead.
+func (*ReportPostReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ReportPostReq) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *ReportPostReq) GetReporterUserId() string {
+	if x != nil {
+		return x.ReporterUserId
+	}
+	return ""
+}
+
+func (x *ReportPostReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReportPostResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportPostResp) Reset() {
+	*x = ReportPostResp{}
+	mi := &file_super_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportPostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportPostResp) ProtoMessage() {}
+
+func (x *ReportPostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportPostResp.ProtoReflect.Descriptor instead.
+func (*ReportPostResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{116}
+}
+
+// 创建帖子响应
+type CreatePostResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePostResp) Reset() {
+	*x = CreatePostResp{}
+	mi := &file_super_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePostResp) ProtoMessage() {}
+
+func (x *CreatePostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePostResp.ProtoReflect.Descriptor instead.
+func (*CreatePostResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *CreatePostResp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// 编辑帖子：仅作者本人；内容、图片、话题整体替换，旧版本存入编辑历史
+type UpdatePostReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Images        []string               `protobuf:"bytes,4,rep,name=images,proto3" json:"images,omitempty"`
+	TopicTags     []*TopicTag            `protobuf:"bytes,5,rep,name=topic_tags,json=topicTags,proto3" json:"topic_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePostReq) Reset() {
+	*x = UpdatePostReq{}
+	mi := &file_super_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostReq) ProtoMessage() {}
+
+func (x *UpdatePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostReq.ProtoReflect.Descriptor instead.
+func (*UpdatePostReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *UpdatePostReq) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *UpdatePostReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdatePostReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *UpdatePostReq) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *UpdatePostReq) GetTopicTags() []*TopicTag {
+	if x != nil {
+		return x.TopicTags
+	}
+	return nil
+}
+
+type UpdatePostResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePostResp) Reset() {
+	*x = UpdatePostResp{}
+	mi := &file_super_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePostResp) ProtoMessage() {}
+
+func (x *UpdatePostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePostResp.ProtoReflect.Descriptor instead.
+func (*UpdatePostResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *UpdatePostResp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// 删除帖子：作者本人或有 post:moderate 权限的管理员
+type DeletePostReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,2,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"` // 管理员删除时告知作者的原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostReq) Reset() {
+	*x = DeletePostReq{}
+	mi := &file_super_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostReq) ProtoMessage() {}
+
+func (x *DeletePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostReq.ProtoReflect.Descriptor instead.
+func (*DeletePostReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *DeletePostReq) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *DeletePostReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *DeletePostReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeletePostResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePostResp) Reset() {
+	*x = DeletePostResp{}
+	mi := &file_super_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePostResp) ProtoMessage() {}
+
+func (x *DeletePostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePostResp.ProtoReflect.Descriptor instead.
+func (*DeletePostResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{121}
+}
+
+// 点赞帖子请求
+type LikePostReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikePostReq) Reset() {
+	*x = LikePostReq{}
+	mi := &file_super_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikePostReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostReq) ProtoMessage() {}
+
+func (x *LikePostReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostReq.ProtoReflect.Descriptor instead.
+func (*LikePostReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *LikePostReq) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *LikePostReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// 点赞帖子响应
+type LikePostResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Post          *Post                  `protobuf:"bytes,1,opt,name=post,proto3" json:"post,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikePostResp) Reset() {
+	*x = LikePostResp{}
+	mi := &file_super_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikePostResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikePostResp) ProtoMessage() {}
+
+func (x *LikePostResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikePostResp.ProtoReflect.Descriptor instead.
+func (*LikePostResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *LikePostResp) GetPost() *Post {
+	if x != nil {
+		return x.Post
+	}
+	return nil
+}
+
+// 获取帖子评论请求
+type GetPostCommentsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ViewerUserId  string                 `protobuf:"bytes,4,opt,name=viewer_user_id,json=viewerUserId,proto3" json:"viewer_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostCommentsReq) Reset() {
+	*x = GetPostCommentsReq{}
+	mi := &file_super_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostCommentsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostCommentsReq) ProtoMessage() {}
+
+func (x *GetPostCommentsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostCommentsReq.ProtoReflect.Descriptor instead.
+func (*GetPostCommentsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *GetPostCommentsReq) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *GetPostCommentsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPostCommentsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetPostCommentsReq) GetViewerUserId() string {
+	if x != nil {
+		return x.ViewerUserId
+	}
+	return ""
+}
+
+// 获取帖子评论响应
+type GetPostCommentsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comments      []*Comment             `protobuf:"bytes,1,rep,name=comments,proto3" json:"comments,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPostCommentsResp) Reset() {
+	*x = GetPostCommentsResp{}
+	mi := &file_super_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPostCommentsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPostCommentsResp) ProtoMessage() {}
+
+func (x *GetPostCommentsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPostCommentsResp.ProtoReflect.Descriptor instead.
+func (*GetPostCommentsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetPostCommentsResp) GetComments() []*Comment {
+	if x != nil {
+		return x.Comments
+	}
+	return nil
+}
+
+func (x *GetPostCommentsResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 评论相关消息
+type Comment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PostId        string                 `protobuf:"bytes,2,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserName      string                 `protobuf:"bytes,4,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	UserAvatar    string                 `protobuf:"bytes,5,opt,name=user_avatar,json=userAvatar,proto3" json:"user_avatar,omitempty"`
+	Content       string                 `protobuf:"bytes,6,opt,name=content,proto3" json:"content,omitempty"`
+	Likes         int32                  `protobuf:"varint,7,opt,name=likes,proto3" json:"likes,omitempty"`
+	IsLiked       bool                   `protobuf:"varint,8,opt,name=is_liked,json=isLiked,proto3" json:"is_liked,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Comment) Reset() {
+	*x = Comment{}
+	mi := &file_super_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Comment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comment) ProtoMessage() {}
+
+func (x *Comment) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comment.ProtoReflect.Descriptor instead.
+func (*Comment) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *Comment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Comment) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Comment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Comment) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *Comment) GetUserAvatar() string {
+	if x != nil {
+		return x.UserAvatar
+	}
+	return ""
+}
+
+func (x *Comment) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Comment) GetLikes() int32 {
+	if x != nil {
+		return x.Likes
+	}
+	return 0
+}
+
+func (x *Comment) GetIsLiked() bool {
+	if x != nil {
+		return x.IsLiked
+	}
+	return false
+}
+
+func (x *Comment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 创建评论请求
+type CreateCommentReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostId        string                 `protobuf:"bytes,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentReq) Reset() {
+	*x = CreateCommentReq{}
+	mi := &file_super_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentReq) ProtoMessage() {}
+
+func (x *CreateCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentReq.ProtoReflect.Descriptor instead.
+func (*CreateCommentReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *CreateCommentReq) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *CreateCommentReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateCommentReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// 创建评论响应
+type CreateCommentResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommentResp) Reset() {
+	*x = CreateCommentResp{}
+	mi := &file_super_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommentResp) ProtoMessage() {}
+
+func (x *CreateCommentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommentResp.ProtoReflect.Descriptor instead.
+func (*CreateCommentResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *CreateCommentResp) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// 点赞评论请求
+type LikeCommentReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=comment_id,json=commentId,proto3" json:"comment_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeCommentReq) Reset() {
+	*x = LikeCommentReq{}
+	mi := &file_super_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeCommentReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeCommentReq) ProtoMessage() {}
+
+func (x *LikeCommentReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeCommentReq.ProtoReflect.Descriptor instead.
+func (*LikeCommentReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *LikeCommentReq) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *LikeCommentReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// 点赞评论响应
+type LikeCommentResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Comment       *Comment               `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeCommentResp) Reset() {
+	*x = LikeCommentResp{}
+	mi := &file_super_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeCommentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeCommentResp) ProtoMessage() {}
+
+func (x *LikeCommentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeCommentResp.ProtoReflect.Descriptor instead.
+func (*LikeCommentResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *LikeCommentResp) GetComment() *Comment {
+	if x != nil {
+		return x.Comment
+	}
+	return nil
+}
+
+// 通知相关消息
+type Notification struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SenderId      string                 `protobuf:"bytes,3,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	SenderName    string                 `protobuf:"bytes,4,opt,name=sender_name,json=senderName,proto3" json:"sender_name,omitempty"`
+	SenderAvatar  string                 `protobuf:"bytes,5,opt,name=sender_avatar,json=senderAvatar,proto3" json:"sender_avatar,omitempty"`
+	Type          int32                  `protobuf:"varint,6,opt,name=type,proto3" json:"type,omitempty"`
+	PostId        string                 `protobuf:"bytes,7,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Content       string                 `protobuf:"bytes,8,opt,name=content,proto3" json:"content,omitempty"`
+	IsRead        bool                   `protobuf:"varint,9,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Kind          string                 `protobuf:"bytes,11,opt,name=kind,proto3" json:"kind,omitempty"`                               // 通知类型标识，如 follow、like_post
+	Title         string                 `protobuf:"bytes,12,opt,name=title,proto3" json:"title,omitempty"`                             // 按类型模板渲染的标题
+	TargetType    string                 `protobuf:"bytes,13,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"` // 跳转目标类型：post / comment / user / friend_request
+	TargetId      string                 `protobuf:"bytes,14,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Route         string                 `protobuf:"bytes,15,opt,name=route,proto3" json:"route,omitempty"`                              // 客户端路由名
+	ActorCount    int32                  `protobuf:"varint,16,opt,name=actor_count,json=actorCount,proto3" json:"actor_count,omitempty"` // 聚合通知的发起人总数，非聚合类型为 1
+	Actors        []*NotificationActor   `protobuf:"bytes,17,rep,name=actors,proto3" json:"actors,omitempty"`                            // 最近的几位发起人（新的在前）
+	UpdatedAt     string                 `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`     // 聚合通知有新发起人时刷新
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	mi := &file_super_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Notification) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *Notification) GetSenderName() string {
+	if x != nil {
+		return x.SenderName
+	}
+	return ""
+}
+
+func (x *Notification) GetSenderAvatar() string {
+	if x != nil {
+		return x.SenderAvatar
+	}
+	return ""
+}
+
+func (x *Notification) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *Notification) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *Notification) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *Notification) GetIsRead() bool {
+	if x != nil {
+		return x.IsRead
+	}
+	return false
+}
+
+func (x *Notification) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Notification) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Notification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Notification) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *Notification) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *Notification) GetRoute() string {
+	if x != nil {
+		return x.Route
+	}
+	return ""
+}
+
+func (x *Notification) GetActorCount() int32 {
+	if x != nil {
+		return x.ActorCount
+	}
+	return 0
+}
+
+func (x *Notification) GetActors() []*NotificationActor {
+	if x != nil {
+		return x.Actors
+	}
+	return nil
+}
+
+func (x *Notification) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type NotificationActor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Avatar        string                 `protobuf:"bytes,3,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationActor) Reset() {
+	*x = NotificationActor{}
+	mi := &file_super_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationActor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationActor) ProtoMessage() {}
+
+func (x *NotificationActor) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationActor.ProtoReflect.Descriptor instead.
+func (*NotificationActor) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *NotificationActor) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NotificationActor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NotificationActor) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+type GetNotificationsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationsReq) Reset() {
+	*x = GetNotificationsReq{}
+	mi := &file_super_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsReq) ProtoMessage() {}
+
+func (x *GetNotificationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsReq.ProtoReflect.Descriptor instead.
+func (*GetNotificationsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *GetNotificationsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetNotificationsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetNotificationsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetNotificationsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notifications []*Notification        `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationsResp) Reset() {
+	*x = GetNotificationsResp{}
+	mi := &file_super_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsResp) ProtoMessage() {}
+
+func (x *GetNotificationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsResp.ProtoReflect.Descriptor instead.
+func (*GetNotificationsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *GetNotificationsResp) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *GetNotificationsResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetUnreadCountReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountReq) Reset() {
+	*x = GetUnreadCountReq{}
+	mi := &file_super_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountReq) ProtoMessage() {}
+
+func (x *GetUnreadCountReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountReq.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *GetUnreadCountReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUnreadCountResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Count         int32                  `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadCountResp) Reset() {
+	*x = GetUnreadCountResp{}
+	mi := &file_super_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadCountResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadCountResp) ProtoMessage() {}
+
+func (x *GetUnreadCountResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadCountResp.ProtoReflect.Descriptor instead.
+func (*GetUnreadCountResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *GetUnreadCountResp) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ReadNotificationReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadNotificationReq) Reset() {
+	*x = ReadNotificationReq{}
+	mi := &file_super_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadNotificationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadNotificationReq) ProtoMessage() {}
+
+func (x *ReadNotificationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadNotificationReq.ProtoReflect.Descriptor instead.
+func (*ReadNotificationReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ReadNotificationReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReadNotificationReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReadNotificationResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadNotificationResp) Reset() {
+	*x = ReadNotificationResp{}
+	mi := &file_super_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadNotificationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadNotificationResp) ProtoMessage() {}
+
+func (x *ReadNotificationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadNotificationResp.ProtoReflect.Descriptor instead.
+func (*ReadNotificationResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{138}
+}
+
+type ReadAllNotificationsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadAllNotificationsReq) Reset() {
+	*x = ReadAllNotificationsReq{}
+	mi := &file_super_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadAllNotificationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAllNotificationsReq) ProtoMessage() {}
+
+func (x *ReadAllNotificationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAllNotificationsReq.ProtoReflect.Descriptor instead.
+func (*ReadAllNotificationsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ReadAllNotificationsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReadAllNotificationsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadAllNotificationsResp) Reset() {
+	*x = ReadAllNotificationsResp{}
+	mi := &file_super_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadAllNotificationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadAllNotificationsResp) ProtoMessage() {}
+
+func (x *ReadAllNotificationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadAllNotificationsResp.ProtoReflect.Descriptor instead.
+func (*ReadAllNotificationsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{140}
+}
+
+type CreateNotificationReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SenderId      string                 `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	Type          int32                  `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	PostId        string                 `protobuf:"bytes,4,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"`
+	Kind          string                 `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"` // 通知类型标识，优先于 type
+	TargetId      string                 `protobuf:"bytes,7,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotificationReq) Reset() {
+	*x = CreateNotificationReq{}
+	mi := &file_super_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotificationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotificationReq) ProtoMessage() {}
+
+func (x *CreateNotificationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotificationReq.ProtoReflect.Descriptor instead.
+func (*CreateNotificationReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *CreateNotificationReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateNotificationReq) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *CreateNotificationReq) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *CreateNotificationReq) GetPostId() string {
+	if x != nil {
+		return x.PostId
+	}
+	return ""
+}
+
+func (x *CreateNotificationReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateNotificationReq) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateNotificationReq) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type CreateNotificationResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *Notification          `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotificationResp) Reset() {
+	*x = CreateNotificationResp{}
+	mi := &file_super_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotificationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotificationResp) ProtoMessage() {}
+
+func (x *CreateNotificationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotificationResp.ProtoReflect.Descriptor instead.
+func (*CreateNotificationResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *CreateNotificationResp) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+// API 实例订阅新通知（服务端流），收到后推送给本实例持有 WebSocket 的用户
+type WatchNotificationsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InstanceId    string                 `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"` // 仅用于日志
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchNotificationsReq) Reset() {
+	*x = WatchNotificationsReq{}
+	mi := &file_super_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchNotificationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchNotificationsReq) ProtoMessage() {}
+
+func (x *WatchNotificationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchNotificationsReq.ProtoReflect.Descriptor instead.
+func (*WatchNotificationsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *WatchNotificationsReq) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
+	}
+	return ""
+}
+
+type NotificationEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notification  *Notification          `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	UnreadCount   int32                  `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"` // 接收者当前未读数
+	Kick          *SessionKick           `protobuf:"bytes,3,opt,name=kick,proto3" json:"kick,omitempty"`                                   // 不为空时不是通知，而是会话撤销事件，notification 为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationEvent) Reset() {
+	*x = NotificationEvent{}
+	mi := &file_super_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationEvent) ProtoMessage() {}
+
+func (x *NotificationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationEvent.ProtoReflect.Descriptor instead.
+func (*NotificationEvent) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *NotificationEvent) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *NotificationEvent) GetUnreadCount() int32 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *NotificationEvent) GetKick() *SessionKick {
+	if x != nil {
+		return x.Kick
+	}
+	return nil
+}
+
+// SessionKick 封禁、退出设备、重置密码等撤销会话后广播给全部 API 实例：
+// 各实例丢弃该用户缓存的登录状态，并断开这些会话在本实例上的 WebSocket 连接
+type SessionKick struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SessionIds    []string               `protobuf:"bytes,2,rep,name=session_ids,json=sessionIds,proto3" json:"session_ids,omitempty"` // 为空时断开该用户的全部连接（含不带会话的旧版令牌）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionKick) Reset() {
+	*x = SessionKick{}
+	mi := &file_super_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionKick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionKick) ProtoMessage() {}
+
+func (x *SessionKick) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionKick.ProtoReflect.Descriptor instead.
+func (*SessionKick) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *SessionKick) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SessionKick) GetSessionIds() []string {
+	if x != nil {
+		return x.SessionIds
+	}
+	return nil
+}
+
+type NotificationKindPreference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`                 // 通知类型标识，如 like_comment
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`               // 展示名称（只读）
+	InApp         bool                   `protobuf:"varint,3,opt,name=in_app,json=inApp,proto3" json:"in_app,omitempty"` // 写入通知中心
+	Push          bool                   `protobuf:"varint,4,opt,name=push,proto3" json:"push,omitempty"`                // 实时推送
+	Email         bool                   `protobuf:"varint,5,opt,name=email,proto3" json:"email,omitempty"`              // 邮件
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationKindPreference) Reset() {
+	*x = NotificationKindPreference{}
+	mi := &file_super_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationKindPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationKindPreference) ProtoMessage() {}
+
+func (x *NotificationKindPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationKindPreference.ProtoReflect.Descriptor instead.
+func (*NotificationKindPreference) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *NotificationKindPreference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *NotificationKindPreference) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *NotificationKindPreference) GetInApp() bool {
+	if x != nil {
+		return x.InApp
+	}
+	return false
+}
+
+func (x *NotificationKindPreference) GetPush() bool {
+	if x != nil {
+		return x.Push
+	}
+	return false
+}
+
+func (x *NotificationKindPreference) GetEmail() bool {
+	if x != nil {
+		return x.Email
+	}
+	return false
+}
+
+type NotificationQuietHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Enabled       bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"` // HH:MM（用户时区）
+	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`     // HH:MM，可跨零点
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationQuietHours) Reset() {
+	*x = NotificationQuietHours{}
+	mi := &file_super_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationQuietHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationQuietHours) ProtoMessage() {}
+
+func (x *NotificationQuietHours) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationQuietHours.ProtoReflect.Descriptor instead.
+func (*NotificationQuietHours) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *NotificationQuietHours) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *NotificationQuietHours) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *NotificationQuietHours) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type NotificationMute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetType    string                 `protobuf:"bytes,1,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"` // post / user
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationMute) Reset() {
+	*x = NotificationMute{}
+	mi := &file_super_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationMute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationMute) ProtoMessage() {}
+
+func (x *NotificationMute) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationMute.ProtoReflect.Descriptor instead.
+func (*NotificationMute) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *NotificationMute) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *NotificationMute) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *NotificationMute) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type NotificationPreferences struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Kinds         []*NotificationKindPreference `protobuf:"bytes,1,rep,name=kinds,proto3" json:"kinds,omitempty"`       // 全部通知类型的生效设置
+	Timezone      string                        `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA 时区名
+	QuietHours    *NotificationQuietHours       `protobuf:"bytes,3,opt,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty"`
+	Mutes         []*NotificationMute           `protobuf:"bytes,4,rep,name=mutes,proto3" json:"mutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreferences) Reset() {
+	*x = NotificationPreferences{}
+	mi := &file_super_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferences) ProtoMessage() {}
+
+func (x *NotificationPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferences.ProtoReflect.Descriptor instead.
+func (*NotificationPreferences) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *NotificationPreferences) GetKinds() []*NotificationKindPreference {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *NotificationPreferences) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *NotificationPreferences) GetQuietHours() *NotificationQuietHours {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *NotificationPreferences) GetMutes() []*NotificationMute {
+	if x != nil {
+		return x.Mutes
+	}
+	return nil
+}
+
+type GetNotificationPreferencesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesReq) Reset() {
+	*x = GetNotificationPreferencesReq{}
+	mi := &file_super_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesReq) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesReq.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *GetNotificationPreferencesReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UpdateNotificationPreferencesReq struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	UserId        string                        `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kinds         []*NotificationKindPreference `protobuf:"bytes,2,rep,name=kinds,proto3" json:"kinds,omitempty"`                             // 只需包含要修改的类型
+	Timezone      string                        `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`                       // 为空表示不修改
+	QuietHours    *NotificationQuietHours       `protobuf:"bytes,4,opt,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty"` // 为空表示不修改
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferencesReq) Reset() {
+	*x = UpdateNotificationPreferencesReq{}
+	mi := &file_super_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferencesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferencesReq) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferencesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferencesReq.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferencesReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *UpdateNotificationPreferencesReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateNotificationPreferencesReq) GetKinds() []*NotificationKindPreference {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+func (x *UpdateNotificationPreferencesReq) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UpdateNotificationPreferencesReq) GetQuietHours() *NotificationQuietHours {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+type NotificationPreferencesResp struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Preferences   *NotificationPreferences `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPreferencesResp) Reset() {
+	*x = NotificationPreferencesResp{}
+	mi := &file_super_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferencesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferencesResp) ProtoMessage() {}
+
+func (x *NotificationPreferencesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferencesResp.ProtoReflect.Descriptor instead.
+func (*NotificationPreferencesResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *NotificationPreferencesResp) GetPreferences() *NotificationPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+type SetNotificationMuteReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TargetType    string                 `protobuf:"bytes,2,opt,name=target_type,json=targetType,proto3" json:"target_type,omitempty"` // post / user
+	TargetId      string                 `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Muted         bool                   `protobuf:"varint,4,opt,name=muted,proto3" json:"muted,omitempty"` // false 表示取消屏蔽
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationMuteReq) Reset() {
+	*x = SetNotificationMuteReq{}
+	mi := &file_super_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationMuteReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationMuteReq) ProtoMessage() {}
+
+func (x *SetNotificationMuteReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationMuteReq.ProtoReflect.Descriptor instead.
+func (*SetNotificationMuteReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *SetNotificationMuteReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetNotificationMuteReq) GetTargetType() string {
+	if x != nil {
+		return x.TargetType
+	}
+	return ""
+}
+
+func (x *SetNotificationMuteReq) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *SetNotificationMuteReq) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+type SetNotificationMuteResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNotificationMuteResp) Reset() {
+	*x = SetNotificationMuteResp{}
+	mi := &file_super_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNotificationMuteResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationMuteResp) ProtoMessage() {}
+
+func (x *SetNotificationMuteResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationMuteResp.ProtoReflect.Descriptor instead.
+func (*SetNotificationMuteResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{154}
+}
+
+// 系统通知推送活动（管理员）
+type NotificationCampaign struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatorId     string                 `protobuf:"bytes,2,opt,name=creator_id,json=creatorId,proto3" json:"creator_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	Segment       string                 `protobuf:"bytes,5,opt,name=segment,proto3" json:"segment,omitempty"` // all / vip / level / inactive
+	MinLevel      int32                  `protobuf:"varint,6,opt,name=min_level,json=minLevel,proto3" json:"min_level,omitempty"`
+	InactiveDays  int32                  `protobuf:"varint,7,opt,name=inactive_days,json=inactiveDays,proto3" json:"inactive_days,omitempty"`
+	ScheduledAt   string                 `protobuf:"bytes,8,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // 为空表示立即发送
+	Status        string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`                              // scheduled / sending / completed / cancelled / failed
+	Error         string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	Recipients    int32                  `protobuf:"varint,11,opt,name=recipients,proto3" json:"recipients,omitempty"` // 命中人群的用户数
+	Delivered     int32                  `protobuf:"varint,12,opt,name=delivered,proto3" json:"delivered,omitempty"`   // 写入通知中心的条数
+	Read          int32                  `protobuf:"varint,13,opt,name=read,proto3" json:"read,omitempty"`             // 其中已读的条数
+	StartedAt     string                 `protobuf:"bytes,14,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	CompletedAt   string                 `protobuf:"bytes,15,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationCampaign) Reset() {
+	*x = NotificationCampaign{}
+	mi := &file_super_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationCampaign) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationCampaign) ProtoMessage() {}
+
+func (x *NotificationCampaign) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationCampaign.ProtoReflect.Descriptor instead.
+func (*NotificationCampaign) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *NotificationCampaign) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NotificationCampaign) GetCreatorId() string {
+	if x != nil {
+		return x.CreatorId
+	}
+	return ""
+}
+
+func (x *NotificationCampaign) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NotificationCampaign) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *NotificationCampaign) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *NotificationCampaign) GetMinLevel() int32 {
+	if x != nil {
+		return x.MinLevel
+	}
+	return 0
+}
+
+func (x *NotificationCampaign) GetInactiveDays() int32 {
+	if x != nil {
+		return x.InactiveDays
+	}
+	return 0
+}
+
+func (x *NotificationCampaign) GetScheduledAt() string {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return ""
+}
+
+func (x *NotificationCampaign) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *NotificationCampaign) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *NotificationCampaign) GetRecipients() int32 {
+	if x != nil {
+		return x.Recipients
+	}
+	return 0
+}
+
+func (x *NotificationCampaign) GetDelivered() int32 {
+	if x != nil {
+		return x.Delivered
+	}
+	return 0
+}
+
+func (x *NotificationCampaign) GetRead() int32 {
+	if x != nil {
+		return x.Read
+	}
+	return 0
+}
+
+func (x *NotificationCampaign) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *NotificationCampaign) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+func (x *NotificationCampaign) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateNotificationCampaignReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"` // 发起的管理员
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Content       string                 `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Segment       string                 `protobuf:"bytes,4,opt,name=segment,proto3" json:"segment,omitempty"`
+	MinLevel      int32                  `protobuf:"varint,5,opt,name=min_level,json=minLevel,proto3" json:"min_level,omitempty"`
+	InactiveDays  int32                  `protobuf:"varint,6,opt,name=inactive_days,json=inactiveDays,proto3" json:"inactive_days,omitempty"`
+	ScheduledAt   string                 `protobuf:"bytes,7,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"` // "2006-01-02 15:04:05" 或 RFC3339，为空表示立即发送
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNotificationCampaignReq) Reset() {
+	*x = CreateNotificationCampaignReq{}
+	mi := &file_super_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNotificationCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNotificationCampaignReq) ProtoMessage() {}
+
+func (x *CreateNotificationCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNotificationCampaignReq.ProtoReflect.Descriptor instead.
+func (*CreateNotificationCampaignReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *CreateNotificationCampaignReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *CreateNotificationCampaignReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateNotificationCampaignReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CreateNotificationCampaignReq) GetSegment() string {
+	if x != nil {
+		return x.Segment
+	}
+	return ""
+}
+
+func (x *CreateNotificationCampaignReq) GetMinLevel() int32 {
+	if x != nil {
+		return x.MinLevel
+	}
+	return 0
+}
+
+func (x *CreateNotificationCampaignReq) GetInactiveDays() int32 {
+	if x != nil {
+		return x.InactiveDays
+	}
+	return 0
+}
+
+func (x *CreateNotificationCampaignReq) GetScheduledAt() string {
+	if x != nil {
+		return x.ScheduledAt
+	}
+	return ""
+}
+
+type NotificationCampaignResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaign      *NotificationCampaign  `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationCampaignResp) Reset() {
+	*x = NotificationCampaignResp{}
+	mi := &file_super_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationCampaignResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationCampaignResp) ProtoMessage() {}
+
+func (x *NotificationCampaignResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationCampaignResp.ProtoReflect.Descriptor instead.
+func (*NotificationCampaignResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *NotificationCampaignResp) GetCampaign() *NotificationCampaign {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type ListNotificationCampaignsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationCampaignsReq) Reset() {
+	*x = ListNotificationCampaignsReq{}
+	mi := &file_super_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationCampaignsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationCampaignsReq) ProtoMessage() {}
+
+func (x *ListNotificationCampaignsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationCampaignsReq.ProtoReflect.Descriptor instead.
+func (*ListNotificationCampaignsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *ListNotificationCampaignsReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *ListNotificationCampaignsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListNotificationCampaignsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListNotificationCampaignsResp struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Campaigns     []*NotificationCampaign `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns,omitempty"`
+	Total         int32                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNotificationCampaignsResp) Reset() {
+	*x = ListNotificationCampaignsResp{}
+	mi := &file_super_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNotificationCampaignsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationCampaignsResp) ProtoMessage() {}
+
+func (x *ListNotificationCampaignsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationCampaignsResp.ProtoReflect.Descriptor instead.
+func (*ListNotificationCampaignsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *ListNotificationCampaignsResp) GetCampaigns() []*NotificationCampaign {
+	if x != nil {
+		return x.Campaigns
+	}
+	return nil
+}
+
+func (x *ListNotificationCampaignsResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetNotificationCampaignReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationCampaignReq) Reset() {
+	*x = GetNotificationCampaignReq{}
+	mi := &file_super_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationCampaignReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationCampaignReq) ProtoMessage() {}
+
+func (x *GetNotificationCampaignReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationCampaignReq.ProtoReflect.Descriptor instead.
+func (*GetNotificationCampaignReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *GetNotificationCampaignReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *GetNotificationCampaignReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// 移动端设备推送令牌
+type RegisterDeviceReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`       // FCM registration token 或 APNs device token
+	Platform      string                 `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"` // android / ios / web
+	Provider      string                 `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"` // fcm / apns；为空时 ios 用 apns，其余用 fcm
+	AppVersion    string                 `protobuf:"bytes,5,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceReq) Reset() {
+	*x = RegisterDeviceReq{}
+	mi := &file_super_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceReq) ProtoMessage() {}
+
+func (x *RegisterDeviceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceReq.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *RegisterDeviceReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RegisterDeviceReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RegisterDeviceReq) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *RegisterDeviceReq) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *RegisterDeviceReq) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+type RegisterDeviceResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterDeviceResp) Reset() {
+	*x = RegisterDeviceResp{}
+	mi := &file_super_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterDeviceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterDeviceResp) ProtoMessage() {}
+
+func (x *RegisterDeviceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterDeviceResp.ProtoReflect.Descriptor instead.
+func (*RegisterDeviceResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{162}
+}
+
+type UnregisterDeviceReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterDeviceReq) Reset() {
+	*x = UnregisterDeviceReq{}
+	mi := &file_super_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterDeviceReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterDeviceReq) ProtoMessage() {}
+
+func (x *UnregisterDeviceReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterDeviceReq.ProtoReflect.Descriptor instead.
+func (*UnregisterDeviceReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *UnregisterDeviceReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnregisterDeviceReq) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type UnregisterDeviceResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterDeviceResp) Reset() {
+	*x = UnregisterDeviceResp{}
+	mi := &file_super_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterDeviceResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterDeviceResp) ProtoMessage() {}
+
+func (x *UnregisterDeviceResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterDeviceResp.ProtoReflect.Descriptor instead.
+func (*UnregisterDeviceResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{164}
+}
+
+// 给用户的所有设备发系统推送（离线私信、来电），按接收者的通知偏好过滤
+type SendDevicePushReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                                                         // 接收者
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`                                                      // 发起人
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`                                                                           // 通知类型标识：private_message / incoming_call ...
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`                                                                           // 为空时只显示标题
+	Data          map[string]string      `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 透传给客户端的数据
+	HighPriority  bool                   `protobuf:"varint,6,opt,name=high_priority,json=highPriority,proto3" json:"high_priority,omitempty"`
+	CollapseKey   string                 `protobuf:"bytes,7,opt,name=collapse_key,json=collapseKey,proto3" json:"collapse_key,omitempty"`
+	TtlSeconds    int32                  `protobuf:"varint,8,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendDevicePushReq) Reset() {
+	*x = SendDevicePushReq{}
+	mi := &file_super_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendDevicePushReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendDevicePushReq) ProtoMessage() {}
+
+func (x *SendDevicePushReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendDevicePushReq.ProtoReflect.Descriptor instead.
+func (*SendDevicePushReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *SendDevicePushReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SendDevicePushReq) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *SendDevicePushReq) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SendDevicePushReq) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *SendDevicePushReq) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SendDevicePushReq) GetHighPriority() bool {
+	if x != nil {
+		return x.HighPriority
+	}
+	return false
+}
+
+func (x *SendDevicePushReq) GetCollapseKey() string {
+	if x != nil {
+		return x.CollapseKey
+	}
+	return ""
+}
+
+func (x *SendDevicePushReq) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type SendDevicePushResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sent          int32                  `protobuf:"varint,1,opt,name=sent,proto3" json:"sent,omitempty"`       // 成功投递的设备数
+	Pruned        int32                  `protobuf:"varint,2,opt,name=pruned,proto3" json:"pruned,omitempty"`   // 清理掉的失效设备数
+	Skipped       bool                   `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"` // 被接收者偏好拦截（关闭推送、屏蔽、免打扰）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendDevicePushResp) Reset() {
+	*x = SendDevicePushResp{}
+	mi := &file_super_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendDevicePushResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendDevicePushResp) ProtoMessage() {}
+
+func (x *SendDevicePushResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendDevicePushResp.ProtoReflect.Descriptor instead.
+func (*SendDevicePushResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *SendDevicePushResp) GetSent() int32 {
+	if x != nil {
+		return x.Sent
+	}
+	return 0
+}
+
+func (x *SendDevicePushResp) GetPruned() int32 {
+	if x != nil {
+		return x.Pruned
+	}
+	return 0
+}
+
+func (x *SendDevicePushResp) GetSkipped() bool {
+	if x != nil {
+		return x.Skipped
+	}
+	return false
+}
+
+type UserMemory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Key           string                 `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserMemory) Reset() {
+	*x = UserMemory{}
+	mi := &file_super_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserMemory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserMemory) ProtoMessage() {}
+
+func (x *UserMemory) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserMemory.ProtoReflect.Descriptor instead.
+func (*UserMemory) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *UserMemory) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserMemory) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserMemory) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UserMemory) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *UserMemory) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *UserMemory) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type UpsertUserMemoryReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertUserMemoryReq) Reset() {
+	*x = UpsertUserMemoryReq{}
+	mi := &file_super_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertUserMemoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertUserMemoryReq) ProtoMessage() {}
+
+func (x *UpsertUserMemoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertUserMemoryReq.ProtoReflect.Descriptor instead.
+func (*UpsertUserMemoryReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *UpsertUserMemoryReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpsertUserMemoryReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UpsertUserMemoryReq) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type UpsertUserMemoryResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memory        *UserMemory            `protobuf:"bytes,1,opt,name=memory,proto3" json:"memory,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertUserMemoryResp) Reset() {
+	*x = UpsertUserMemoryResp{}
+	mi := &file_super_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertUserMemoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertUserMemoryResp) ProtoMessage() {}
+
+func (x *UpsertUserMemoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpsertUserMemoryResp.ProtoReflect.Descriptor instead.
+func (*UpsertUserMemoryResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *UpsertUserMemoryResp) GetMemory() *UserMemory {
+	if x != nil {
+		return x.Memory
+	}
+	return nil
+}
+
+type GetUserMemoriesReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserMemoriesReq) Reset() {
+	*x = GetUserMemoriesReq{}
+	mi := &file_super_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserMemoriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserMemoriesReq) ProtoMessage() {}
+
+func (x *GetUserMemoriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserMemoriesReq.ProtoReflect.Descriptor instead.
+func (*GetUserMemoriesReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *GetUserMemoriesReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserMemoriesResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Memories      []*UserMemory          `protobuf:"bytes,1,rep,name=memories,proto3" json:"memories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserMemoriesResp) Reset() {
+	*x = GetUserMemoriesResp{}
+	mi := &file_super_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserMemoriesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserMemoriesResp) ProtoMessage() {}
+
+func (x *GetUserMemoriesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserMemoriesResp.ProtoReflect.Descriptor instead.
+func (*GetUserMemoriesResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *GetUserMemoriesResp) GetMemories() []*UserMemory {
+	if x != nil {
+		return x.Memories
+	}
+	return nil
+}
+
+type DeleteUserMemoryReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserMemoryReq) Reset() {
+	*x = DeleteUserMemoryReq{}
+	mi := &file_super_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserMemoryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserMemoryReq) ProtoMessage() {}
+
+func (x *DeleteUserMemoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserMemoryReq.ProtoReflect.Descriptor instead.
+func (*DeleteUserMemoryReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *DeleteUserMemoryReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *DeleteUserMemoryReq) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type DeleteUserMemoryResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserMemoryResp) Reset() {
+	*x = DeleteUserMemoryResp{}
+	mi := &file_super_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserMemoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserMemoryResp) ProtoMessage() {}
+
+func (x *DeleteUserMemoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserMemoryResp.ProtoReflect.Descriptor instead.
+func (*DeleteUserMemoryResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{173}
+}
+
+// 好友申请（同意后互相关注）
+type FriendRequestView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromUser      *User                  `protobuf:"bytes,2,opt,name=from_user,json=fromUser,proto3" json:"from_user,omitempty"`
+	ToUser        *User                  `protobuf:"bytes,3,opt,name=to_user,json=toUser,proto3" json:"to_user,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendRequestView) Reset() {
+	*x = FriendRequestView{}
+	mi := &file_super_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendRequestView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendRequestView) ProtoMessage() {}
+
+func (x *FriendRequestView) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendRequestView.ProtoReflect.Descriptor instead.
+func (*FriendRequestView) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *FriendRequestView) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FriendRequestView) GetFromUser() *User {
+	if x != nil {
+		return x.FromUser
+	}
+	return nil
+}
+
+func (x *FriendRequestView) GetToUser() *User {
+	if x != nil {
+		return x.ToUser
+	}
+	return nil
+}
+
+func (x *FriendRequestView) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FriendRequestView) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SendFriendRequestReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	ToUserId      string                 `protobuf:"bytes,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`
+	ToMoeNo       string                 `protobuf:"bytes,3,opt,name=to_moe_no,json=toMoeNo,proto3" json:"to_moe_no,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendFriendRequestReq) Reset() {
+	*x = SendFriendRequestReq{}
+	mi := &file_super_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendFriendRequestReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendRequestReq) ProtoMessage() {}
+
+func (x *SendFriendRequestReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFriendRequestReq.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *SendFriendRequestReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *SendFriendRequestReq) GetToUserId() string {
+	if x != nil {
+		return x.ToUserId
+	}
+	return ""
+}
+
+func (x *SendFriendRequestReq) GetToMoeNo() string {
+	if x != nil {
+		return x.ToMoeNo
+	}
+	return ""
+}
+
+type SendFriendRequestResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          *FriendRequestView     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendFriendRequestResp) Reset() {
+	*x = SendFriendRequestResp{}
+	mi := &file_super_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendFriendRequestResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendFriendRequestResp) ProtoMessage() {}
+
+func (x *SendFriendRequestResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendFriendRequestResp.ProtoReflect.Descriptor instead.
+func (*SendFriendRequestResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *SendFriendRequestResp) GetData() *FriendRequestView {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListIncomingFriendRequestsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIncomingFriendRequestsReq) Reset() {
+	*x = ListIncomingFriendRequestsReq{}
+	mi := &file_super_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncomingFriendRequestsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncomingFriendRequestsReq) ProtoMessage() {}
+
+func (x *ListIncomingFriendRequestsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncomingFriendRequestsReq.ProtoReflect.Descriptor instead.
+func (*ListIncomingFriendRequestsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *ListIncomingFriendRequestsReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+type ListIncomingFriendRequestsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*FriendRequestView   `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIncomingFriendRequestsResp) Reset() {
+	*x = ListIncomingFriendRequestsResp{}
+	mi := &file_super_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIncomingFriendRequestsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIncomingFriendRequestsResp) ProtoMessage() {}
+
+func (x *ListIncomingFriendRequestsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIncomingFriendRequestsResp.ProtoReflect.Descriptor instead.
+func (*ListIncomingFriendRequestsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *ListIncomingFriendRequestsResp) GetData() []*FriendRequestView {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListOutgoingFriendRequestsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOutgoingFriendRequestsReq) Reset() {
+	*x = ListOutgoingFriendRequestsReq{}
+	mi := &file_super_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOutgoingFriendRequestsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutgoingFriendRequestsReq) ProtoMessage() {}
+
+func (x *ListOutgoingFriendRequestsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutgoingFriendRequestsReq.ProtoReflect.Descriptor instead.
+func (*ListOutgoingFriendRequestsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *ListOutgoingFriendRequestsReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+type ListOutgoingFriendRequestsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []*FriendRequestView   `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOutgoingFriendRequestsResp) Reset() {
+	*x = ListOutgoingFriendRequestsResp{}
+	mi := &file_super_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOutgoingFriendRequestsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOutgoingFriendRequestsResp) ProtoMessage() {}
+
+func (x *ListOutgoingFriendRequestsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOutgoingFriendRequestsResp.ProtoReflect.Descriptor instead.
+func (*ListOutgoingFriendRequestsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *ListOutgoingFriendRequestsResp) GetData() []*FriendRequestView {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AcceptFriendRequestReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptFriendRequestReq) Reset() {
+	*x = AcceptFriendRequestReq{}
+	mi := &file_super_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptFriendRequestReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptFriendRequestReq) ProtoMessage() {}
+
+func (x *AcceptFriendRequestReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptFriendRequestReq.ProtoReflect.Descriptor instead.
+func (*AcceptFriendRequestReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *AcceptFriendRequestReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *AcceptFriendRequestReq) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type AcceptFriendRequestResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptFriendRequestResp) Reset() {
+	*x = AcceptFriendRequestResp{}
+	mi := &file_super_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptFriendRequestResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptFriendRequestResp) ProtoMessage() {}
+
+func (x *AcceptFriendRequestResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptFriendRequestResp.ProtoReflect.Descriptor instead.
+func (*AcceptFriendRequestResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *AcceptFriendRequestResp) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type RejectFriendRequestReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectFriendRequestReq) Reset() {
+	*x = RejectFriendRequestReq{}
+	mi := &file_super_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFriendRequestReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFriendRequestReq) ProtoMessage() {}
+
+func (x *RejectFriendRequestReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFriendRequestReq.ProtoReflect.Descriptor instead.
+func (*RejectFriendRequestReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *RejectFriendRequestReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *RejectFriendRequestReq) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type RejectFriendRequestResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectFriendRequestResp) Reset() {
+	*x = RejectFriendRequestResp{}
+	mi := &file_super_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectFriendRequestResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectFriendRequestResp) ProtoMessage() {}
+
+func (x *RejectFriendRequestResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectFriendRequestResp.ProtoReflect.Descriptor instead.
+func (*RejectFriendRequestResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *RejectFriendRequestResp) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type ListFriendsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendsReq) Reset() {
+	*x = ListFriendsReq{}
+	mi := &file_super_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsReq) ProtoMessage() {}
+
+func (x *ListFriendsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsReq.ProtoReflect.Descriptor instead.
+func (*ListFriendsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *ListFriendsReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+type ListFriendsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFriendsResp) Reset() {
+	*x = ListFriendsResp{}
+	mi := &file_super_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFriendsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFriendsResp) ProtoMessage() {}
+
+func (x *ListFriendsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFriendsResp.ProtoReflect.Descriptor instead.
+func (*ListFriendsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *ListFriendsResp) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetFriendRelationReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	OtherUserId   string                 `protobuf:"bytes,2,opt,name=other_user_id,json=otherUserId,proto3" json:"other_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendRelationReq) Reset() {
+	*x = GetFriendRelationReq{}
+	mi := &file_super_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendRelationReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendRelationReq) ProtoMessage() {}
+
+func (x *GetFriendRelationReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendRelationReq.ProtoReflect.Descriptor instead.
+func (*GetFriendRelationReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *GetFriendRelationReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *GetFriendRelationReq) GetOtherUserId() string {
+	if x != nil {
+		return x.OtherUserId
+	}
+	return ""
+}
+
+type GetFriendRelationResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relation      string                 `protobuf:"bytes,1,opt,name=relation,proto3" json:"relation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFriendRelationResp) Reset() {
+	*x = GetFriendRelationResp{}
+	mi := &file_super_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFriendRelationResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFriendRelationResp) ProtoMessage() {}
+
+func (x *GetFriendRelationResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFriendRelationResp.ProtoReflect.Descriptor instead.
+func (*GetFriendRelationResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *GetFriendRelationResp) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+// 拉黑
+type BlockUserReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	TargetUserId  string                 `protobuf:"bytes,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserReq) Reset() {
+	*x = BlockUserReq{}
+	mi := &file_super_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserReq) ProtoMessage() {}
+
+func (x *BlockUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserReq.ProtoReflect.Descriptor instead.
+func (*BlockUserReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *BlockUserReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *BlockUserReq) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+type BlockUserResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserResp) Reset() {
+	*x = BlockUserResp{}
+	mi := &file_super_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserResp) ProtoMessage() {}
+
+func (x *BlockUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserResp.ProtoReflect.Descriptor instead.
+func (*BlockUserResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *BlockUserResp) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+type ListBlockedUsersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersReq) Reset() {
+	*x = ListBlockedUsersReq{}
+	mi := &file_super_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersReq) ProtoMessage() {}
+
+func (x *ListBlockedUsersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersReq.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *ListBlockedUsersReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *ListBlockedUsersReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListBlockedUsersReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type BlockedUser struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	BlockedAt     string                 `protobuf:"bytes,2,opt,name=blocked_at,json=blockedAt,proto3" json:"blocked_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockedUser) Reset() {
+	*x = BlockedUser{}
+	mi := &file_super_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedUser) ProtoMessage() {}
+
+func (x *BlockedUser) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedUser.ProtoReflect.Descriptor instead.
+func (*BlockedUser) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *BlockedUser) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *BlockedUser) GetBlockedAt() string {
+	if x != nil {
+		return x.BlockedAt
+	}
+	return ""
+}
+
+type ListBlockedUsersResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*BlockedUser         `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedUsersResp) Reset() {
+	*x = ListBlockedUsersResp{}
+	mi := &file_super_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedUsersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedUsersResp) ProtoMessage() {}
+
+func (x *ListBlockedUsersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedUsersResp.ProtoReflect.Descriptor instead.
+func (*ListBlockedUsersResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *ListBlockedUsersResp) GetUsers() []*BlockedUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListBlockedUsersResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 两个用户之间是否存在拉黑关系（任一方拉黑对方），API 层转发实时私信前调用
+type CheckUserBlockReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OtherUserId   string                 `protobuf:"bytes,2,opt,name=other_user_id,json=otherUserId,proto3" json:"other_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckUserBlockReq) Reset() {
+	*x = CheckUserBlockReq{}
+	mi := &file_super_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckUserBlockReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserBlockReq) ProtoMessage() {}
+
+func (x *CheckUserBlockReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUserBlockReq.ProtoReflect.Descriptor instead.
+func (*CheckUserBlockReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *CheckUserBlockReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckUserBlockReq) GetOtherUserId() string {
+	if x != nil {
+		return x.OtherUserId
+	}
+	return ""
+}
+
+type CheckUserBlockResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Blocked       bool                   `protobuf:"varint,1,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckUserBlockResp) Reset() {
+	*x = CheckUserBlockResp{}
+	mi := &file_super_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckUserBlockResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckUserBlockResp) ProtoMessage() {}
+
+func (x *CheckUserBlockResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckUserBlockResp.ProtoReflect.Descriptor instead.
+func (*CheckUserBlockResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *CheckUserBlockResp) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+// 与该用户存在拉黑关系的全部用户，在线匹配时排除
+type GetBlockedUserIdsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockedUserIdsReq) Reset() {
+	*x = GetBlockedUserIdsReq{}
+	mi := &file_super_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockedUserIdsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockedUserIdsReq) ProtoMessage() {}
+
+func (x *GetBlockedUserIdsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockedUserIdsReq.ProtoReflect.Descriptor instead.
+func (*GetBlockedUserIdsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *GetBlockedUserIdsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetBlockedUserIdsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []string               `protobuf:"bytes,1,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBlockedUserIdsResp) Reset() {
+	*x = GetBlockedUserIdsResp{}
+	mi := &file_super_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBlockedUserIdsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockedUserIdsResp) ProtoMessage() {}
+
+func (x *GetBlockedUserIdsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockedUserIdsResp.ProtoReflect.Descriptor instead.
+func (*GetBlockedUserIdsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *GetBlockedUserIdsResp) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+// 关注相关消息
+type FollowUserReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FollowingId   string                 `protobuf:"bytes,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowUserReq) Reset() {
+	*x = FollowUserReq{}
+	mi := &file_super_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserReq) ProtoMessage() {}
+
+func (x *FollowUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserReq.ProtoReflect.Descriptor instead.
+func (*FollowUserReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *FollowUserReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FollowUserReq) GetFollowingId() string {
+	if x != nil {
+		return x.FollowingId
+	}
+	return ""
+}
+
+type FollowUserResp struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Success bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// 对方是私密账号：已发送关注请求，等待对方批准
+	Pending       bool `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowUserResp) Reset() {
+	*x = FollowUserResp{}
+	mi := &file_super_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserResp) ProtoMessage() {}
+
+func (x *FollowUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserResp.ProtoReflect.Descriptor instead.
+func (*FollowUserResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *FollowUserResp) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *FollowUserResp) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
+type UnfollowUserReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FollowingId   string                 `protobuf:"bytes,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowUserReq) Reset() {
+	*x = UnfollowUserReq{}
+	mi := &file_super_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserReq) ProtoMessage() {}
+
+func (x *UnfollowUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserReq.ProtoReflect.Descriptor instead.
+func (*UnfollowUserReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *UnfollowUserReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnfollowUserReq) GetFollowingId() string {
+	if x != nil {
+		return x.FollowingId
+	}
+	return ""
+}
+
+type GetFollowingsReq struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page     int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// 查看者（未登录为空），用于隐私设置校验
+	ViewerUserId  string `protobuf:"bytes,4,opt,name=viewer_user_id,json=viewerUserId,proto3" json:"viewer_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowingsReq) Reset() {
+	*x = GetFollowingsReq{}
+	mi := &file_super_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowingsReq) ProtoMessage() {}
+
+func (x *GetFollowingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[201]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowingsReq.ProtoReflect.Descriptor instead.
+func (*GetFollowingsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *GetFollowingsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetFollowingsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetFollowingsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetFollowingsReq) GetViewerUserId() string {
+	if x != nil {
+		return x.ViewerUserId
+	}
+	return ""
+}
+
+type GetFollowingsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowingsResp) Reset() {
+	*x = GetFollowingsResp{}
+	mi := &file_super_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowingsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowingsResp) ProtoMessage() {}
+
+func (x *GetFollowingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[202]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowingsResp.ProtoReflect.Descriptor instead.
+func (*GetFollowingsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *GetFollowingsResp) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetFollowingsResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetFollowersReq struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page     int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// 查看者（未登录为空），用于隐私设置校验
+	ViewerUserId  string `protobuf:"bytes,4,opt,name=viewer_user_id,json=viewerUserId,proto3" json:"viewer_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowersReq) Reset() {
+	*x = GetFollowersReq{}
+	mi := &file_super_proto_msgTypes[203]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowersReq) ProtoMessage() {}
+
+func (x *GetFollowersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[203]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowersReq.ProtoReflect.Descriptor instead.
+func (*GetFollowersReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *GetFollowersReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetFollowersReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetFollowersReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetFollowersReq) GetViewerUserId() string {
+	if x != nil {
+		return x.ViewerUserId
+	}
+	return ""
+}
+
+type GetFollowersResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFollowersResp) Reset() {
+	*x = GetFollowersResp{}
+	mi := &file_super_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFollowersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFollowersResp) ProtoMessage() {}
+
+func (x *GetFollowersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[204]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFollowersResp.ProtoReflect.Descriptor instead.
+func (*GetFollowersResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *GetFollowersResp) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetFollowersResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CheckFollowReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FollowerId    string                 `protobuf:"bytes,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
+	FollowingId   string                 `protobuf:"bytes,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFollowReq) Reset() {
+	*x = CheckFollowReq{}
+	mi := &file_super_proto_msgTypes[205]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFollowReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFollowReq) ProtoMessage() {}
+
+func (x *CheckFollowReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[205]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFollowReq.ProtoReflect.Descriptor instead.
+func (*CheckFollowReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *CheckFollowReq) GetFollowerId() string {
+	if x != nil {
+		return x.FollowerId
+	}
+	return ""
+}
+
+func (x *CheckFollowReq) GetFollowingId() string {
+	if x != nil {
+		return x.FollowingId
+	}
+	return ""
+}
+
+type CheckFollowResp struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	IsFollowing bool                   `protobuf:"varint,1,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`
+	// 已向私密账号发送关注请求，尚未批准
+	Requested     bool `protobuf:"varint,2,opt,name=requested,proto3" json:"requested,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFollowResp) Reset() {
+	*x = CheckFollowResp{}
+	mi := &file_super_proto_msgTypes[206]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFollowResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFollowResp) ProtoMessage() {}
+
+func (x *CheckFollowResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[206]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFollowResp.ProtoReflect.Descriptor instead.
+func (*CheckFollowResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *CheckFollowResp) GetIsFollowing() bool {
+	if x != nil {
+		return x.IsFollowing
+	}
+	return false
+}
+
+func (x *CheckFollowResp) GetRequested() bool {
+	if x != nil {
+		return x.Requested
+	}
+	return false
+}
+
+// 隐私设置
+type PrivacySettings struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PrivateAccount    bool                   `protobuf:"varint,1,opt,name=private_account,json=privateAccount,proto3" json:"private_account,omitempty"`         // 私密账号：关注需要批准，动态与关注列表仅已批准的粉丝可见
+	DmPermission      string                 `protobuf:"bytes,2,opt,name=dm_permission,json=dmPermission,proto3" json:"dm_permission,omitempty"`                // 谁可以私信我：everyone / followers / friends / nobody
+	CommentPermission string                 `protobuf:"bytes,3,opt,name=comment_permission,json=commentPermission,proto3" json:"comment_permission,omitempty"` // 谁可以评论我的动态，取值同上
+	HideFollowLists   bool                   `protobuf:"varint,4,opt,name=hide_follow_lists,json=hideFollowLists,proto3" json:"hide_follow_lists,omitempty"`    // 他人不能查看我的关注与粉丝列表
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PrivacySettings) Reset() {
+	*x = PrivacySettings{}
+	mi := &file_super_proto_msgTypes[207]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacySettings) ProtoMessage() {}
+
+func (x *PrivacySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[207]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacySettings.ProtoReflect.Descriptor instead.
+func (*PrivacySettings) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *PrivacySettings) GetPrivateAccount() bool {
+	if x != nil {
+		return x.PrivateAccount
+	}
+	return false
+}
+
+func (x *PrivacySettings) GetDmPermission() string {
+	if x != nil {
+		return x.DmPermission
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetCommentPermission() string {
+	if x != nil {
+		return x.CommentPermission
+	}
+	return ""
+}
+
+func (x *PrivacySettings) GetHideFollowLists() bool {
+	if x != nil {
+		return x.HideFollowLists
+	}
+	return false
+}
+
+type GetPrivacySettingsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrivacySettingsReq) Reset() {
+	*x = GetPrivacySettingsReq{}
+	mi := &file_super_proto_msgTypes[208]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrivacySettingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrivacySettingsReq) ProtoMessage() {}
+
+func (x *GetPrivacySettingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[208]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrivacySettingsReq.ProtoReflect.Descriptor instead.
+func (*GetPrivacySettingsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *GetPrivacySettingsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// 整体替换；权限为空时按 everyone
+type UpdatePrivacySettingsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Settings      *PrivacySettings       `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePrivacySettingsReq) Reset() {
+	*x = UpdatePrivacySettingsReq{}
+	mi := &file_super_proto_msgTypes[209]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrivacySettingsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrivacySettingsReq) ProtoMessage() {}
+
+func (x *UpdatePrivacySettingsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[209]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrivacySettingsReq.ProtoReflect.Descriptor instead.
+func (*UpdatePrivacySettingsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *UpdatePrivacySettingsReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdatePrivacySettingsReq) GetSettings() *PrivacySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type PrivacySettingsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *PrivacySettings       `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrivacySettingsResp) Reset() {
+	*x = PrivacySettingsResp{}
+	mi := &file_super_proto_msgTypes[210]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrivacySettingsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacySettingsResp) ProtoMessage() {}
+
+func (x *PrivacySettingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[210]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacySettingsResp.ProtoReflect.Descriptor instead.
+func (*PrivacySettingsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{210}
+}
+
+func (x *PrivacySettingsResp) GetSettings() *PrivacySettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type FollowRequestView struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	User          *User                  `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"` // 请求关注的用户
+	CreatedAt     string                 `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowRequestView) Reset() {
+	*x = FollowRequestView{}
+	mi := &file_super_proto_msgTypes[211]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowRequestView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowRequestView) ProtoMessage() {}
+
+func (x *FollowRequestView) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[211]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowRequestView.ProtoReflect.Descriptor instead.
+func (*FollowRequestView) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{211}
+}
+
+func (x *FollowRequestView) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FollowRequestView) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *FollowRequestView) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListFollowRequestsReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowRequestsReq) Reset() {
+	*x = ListFollowRequestsReq{}
+	mi := &file_super_proto_msgTypes[212]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowRequestsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsReq) ProtoMessage() {}
+
+func (x *ListFollowRequestsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[212]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsReq.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{212}
+}
+
+func (x *ListFollowRequestsReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *ListFollowRequestsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFollowRequestsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListFollowRequestsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*FollowRequestView   `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowRequestsResp) Reset() {
+	*x = ListFollowRequestsResp{}
+	mi := &file_super_proto_msgTypes[213]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowRequestsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsResp) ProtoMessage() {}
+
+func (x *ListFollowRequestsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[213]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsResp.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{213}
+}
+
+func (x *ListFollowRequestsResp) GetRequests() []*FollowRequestView {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *ListFollowRequestsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RespondFollowRequestReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"` // true 批准，false 拒绝
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondFollowRequestReq) Reset() {
+	*x = RespondFollowRequestReq{}
+	mi := &file_super_proto_msgTypes[214]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondFollowRequestReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondFollowRequestReq) ProtoMessage() {}
+
+func (x *RespondFollowRequestReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[214]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondFollowRequestReq.ProtoReflect.Descriptor instead.
+func (*RespondFollowRequestReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *RespondFollowRequestReq) GetActorUserId() string {
+	if x != nil {
+		return x.ActorUserId
+	}
+	return ""
+}
+
+func (x *RespondFollowRequestReq) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *RespondFollowRequestReq) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+type RespondFollowRequestResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondFollowRequestResp) Reset() {
+	*x = RespondFollowRequestResp{}
+	mi := &file_super_proto_msgTypes[215]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondFollowRequestResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondFollowRequestResp) ProtoMessage() {}
+
+func (x *RespondFollowRequestResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[215]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondFollowRequestResp.ProtoReflect.Descriptor instead.
+func (*RespondFollowRequestResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{215}
+}
+
+// 发送方能否给接收方发私信（拉黑、对方的私信权限）；不能时返回 403 及原因
+type CheckDirectMessageReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderId      string                 `protobuf:"bytes,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	RecipientId   string                 `protobuf:"bytes,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDirectMessageReq) Reset() {
+	*x = CheckDirectMessageReq{}
+	mi := &file_super_proto_msgTypes[216]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDirectMessageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDirectMessageReq) ProtoMessage() {}
+
+func (x *CheckDirectMessageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[216]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDirectMessageReq.ProtoReflect.Descriptor instead.
+func (*CheckDirectMessageReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{216}
+}
+
+func (x *CheckDirectMessageReq) GetSenderId() string {
+	if x != nil {
+		return x.SenderId
+	}
+	return ""
+}
+
+func (x *CheckDirectMessageReq) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
+}
+
+type CheckDirectMessageResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDirectMessageResp) Reset() {
+	*x = CheckDirectMessageResp{}
+	mi := &file_super_proto_msgTypes[217]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDirectMessageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDirectMessageResp) ProtoMessage() {}
+
+func (x *CheckDirectMessageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[217]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDirectMessageResp.ProtoReflect.Descriptor instead.
+func (*CheckDirectMessageResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{217}
+}
+
+// Avatar相关消息
+type AvatarBaseConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FaceShape     string                 `protobuf:"bytes,1,opt,name=face_shape,json=faceShape,proto3" json:"face_shape,omitempty"`
+	SkinColor     string                 `protobuf:"bytes,2,opt,name=skin_color,json=skinColor,proto3" json:"skin_color,omitempty"`
+	EyeType       string                 `protobuf:"bytes,3,opt,name=eye_type,json=eyeType,proto3" json:"eye_type,omitempty"`
+	HairStyle     string                 `protobuf:"bytes,4,opt,name=hair_style,json=hairStyle,proto3" json:"hair_style,omitempty"`
+	HairColor     string                 `protobuf:"bytes,5,opt,name=hair_color,json=hairColor,proto3" json:"hair_color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvatarBaseConfig) Reset() {
+	*x = AvatarBaseConfig{}
+	mi := &file_super_proto_msgTypes[218]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvatarBaseConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvatarBaseConfig) ProtoMessage() {}
+
+func (x *AvatarBaseConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[218]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvatarBaseConfig.ProtoReflect.Descriptor instead.
+func (*AvatarBaseConfig) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{218}
+}
+
+func (x *AvatarBaseConfig) GetFaceShape() string {
+	if x != nil {
+		return x.FaceShape
+	}
+	return ""
+}
+
+func (x *AvatarBaseConfig) GetSkinColor() string {
+	if x != nil {
+		return x.SkinColor
+	}
+	return ""
+}
+
+func (x *AvatarBaseConfig) GetEyeType() string {
+	if x != nil {
+		return x.EyeType
+	}
+	return ""
+}
+
+func (x *AvatarBaseConfig) GetHairStyle() string {
+	if x != nil {
+		return x.HairStyle
+	}
+	return ""
+}
+
+func (x *AvatarBaseConfig) GetHairColor() string {
+	if x != nil {
+		return x.HairColor
+	}
+	return ""
+}
+
+type AvatarOutfitConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clothes       string                 `protobuf:"bytes,1,opt,name=clothes,proto3" json:"clothes,omitempty"`
+	Accessories   []string               `protobuf:"bytes,2,rep,name=accessories,proto3" json:"accessories,omitempty"`
+	Background    string                 `protobuf:"bytes,3,opt,name=background,proto3" json:"background,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AvatarOutfitConfig) Reset() {
+	*x = AvatarOutfitConfig{}
+	mi := &file_super_proto_msgTypes[219]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvatarOutfitConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvatarOutfitConfig) ProtoMessage() {}
+
+func (x *AvatarOutfitConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[219]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvatarOutfitConfig.ProtoReflect.Descriptor instead.
+func (*AvatarOutfitConfig) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{219}
+}
+
+func (x *AvatarOutfitConfig) GetClothes() string {
+	if x != nil {
+		return x.Clothes
+	}
+	return ""
+}
+
+func (x *AvatarOutfitConfig) GetAccessories() []string {
+	if x != nil {
+		return x.Accessories
+	}
+	return nil
+}
+
+func (x *AvatarOutfitConfig) GetBackground() string {
+	if x != nil {
+		return x.Background
+	}
+	return ""
+}
+
+type UserAvatarData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BaseConfig    *AvatarBaseConfig      `protobuf:"bytes,2,opt,name=base_config,json=baseConfig,proto3" json:"base_config,omitempty"`
+	CurrentOutfit *AvatarOutfitConfig    `protobuf:"bytes,3,opt,name=current_outfit,json=currentOutfit,proto3" json:"current_outfit,omitempty"`
+	OwnedOutfits  []string               `protobuf:"bytes,4,rep,name=owned_outfits,json=ownedOutfits,proto3" json:"owned_outfits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserAvatarData) Reset() {
+	*x = UserAvatarData{}
+	mi := &file_super_proto_msgTypes[220]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserAvatarData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAvatarData) ProtoMessage() {}
+
+func (x *UserAvatarData) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[220]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAvatarData.ProtoReflect.Descriptor instead.
+func (*UserAvatarData) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{220}
+}
+
+func (x *UserAvatarData) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserAvatarData) GetBaseConfig() *AvatarBaseConfig {
+	if x != nil {
+		return x.BaseConfig
+	}
+	return nil
+}
+
+func (x *UserAvatarData) GetCurrentOutfit() *AvatarOutfitConfig {
+	if x != nil {
+		return x.CurrentOutfit
+	}
+	return nil
+}
+
+func (x *UserAvatarData) GetOwnedOutfits() []string {
+	if x != nil {
+		return x.OwnedOutfits
+	}
+	return nil
+}
+
+// 获取用户虚拟形象请求
+type GetUserAvatarReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserAvatarReq) Reset() {
+	*x = GetUserAvatarReq{}
+	mi := &file_super_proto_msgTypes[221]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserAvatarReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserAvatarReq) ProtoMessage() {}
+
+func (x *GetUserAvatarReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[221]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserAvatarReq.ProtoReflect.Descriptor instead.
+func (*GetUserAvatarReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{221}
+}
+
+func (x *GetUserAvatarReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// 获取用户虚拟形象响应
+type GetUserAvatarResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Avatar        *UserAvatarData        `protobuf:"bytes,1,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserAvatarResp) Reset() {
+	*x = GetUserAvatarResp{}
+	mi := &file_super_proto_msgTypes[222]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserAvatarResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserAvatarResp) ProtoMessage() {}
+
+func (x *GetUserAvatarResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[222]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserAvatarResp.ProtoReflect.Descriptor instead.
+func (*GetUserAvatarResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{222}
+}
+
+func (x *GetUserAvatarResp) GetAvatar() *UserAvatarData {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+// 更新用户虚拟形象请求
+type UpdateUserAvatarReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	BaseConfig    *AvatarBaseConfig      `protobuf:"bytes,2,opt,name=base_config,json=baseConfig,proto3" json:"base_config,omitempty"`
+	CurrentOutfit *AvatarOutfitConfig    `protobuf:"bytes,3,opt,name=current_outfit,json=currentOutfit,proto3" json:"current_outfit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserAvatarReq) Reset() {
+	*x = UpdateUserAvatarReq{}
+	mi := &file_super_proto_msgTypes[223]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserAvatarReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserAvatarReq) ProtoMessage() {}
+
+func (x *UpdateUserAvatarReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[223]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserAvatarReq.ProtoReflect.Descriptor instead.
+func (*UpdateUserAvatarReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{223}
+}
+
+func (x *UpdateUserAvatarReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateUserAvatarReq) GetBaseConfig() *AvatarBaseConfig {
+	if x != nil {
+		return x.BaseConfig
+	}
+	return nil
+}
+
+func (x *UpdateUserAvatarReq) GetCurrentOutfit() *AvatarOutfitConfig {
+	if x != nil {
+		return x.CurrentOutfit
+	}
+	return nil
+}
+
+// 更新用户虚拟形象响应
+type UpdateUserAvatarResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Avatar        *UserAvatarData        `protobuf:"bytes,1,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserAvatarResp) Reset() {
+	*x = UpdateUserAvatarResp{}
+	mi := &file_super_proto_msgTypes[224]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserAvatarResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserAvatarResp) ProtoMessage() {}
+
+func (x *UpdateUserAvatarResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[224]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserAvatarResp.ProtoReflect.Descriptor instead.
+func (*UpdateUserAvatarResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{224}
+}
+
+func (x *UpdateUserAvatarResp) GetAvatar() *UserAvatarData {
+	if x != nil {
+		return x.Avatar
+	}
+	return nil
+}
+
+// 签到等级相关消息
+type UserLevelInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Level         int32                  `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
+	Experience    int32                  `protobuf:"varint,2,opt,name=experience,proto3" json:"experience,omitempty"`
+	TotalExp      int32                  `protobuf:"varint,3,opt,name=total_exp,json=totalExp,proto3" json:"total_exp,omitempty"`
+	NextLevelExp  int32                  `protobuf:"varint,4,opt,name=next_level_exp,json=nextLevelExp,proto3" json:"next_level_exp,omitempty"`
+	LevelTitle    string                 `protobuf:"bytes,5,opt,name=level_title,json=levelTitle,proto3" json:"level_title,omitempty"`
+	BadgeUrl      string                 `protobuf:"bytes,6,opt,name=badge_url,json=badgeUrl,proto3" json:"badge_url,omitempty"`
+	Progress      float64                `protobuf:"fixed64,7,opt,name=progress,proto3" json:"progress,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserLevelInfo) Reset() {
+	*x = UserLevelInfo{}
+	mi := &file_super_proto_msgTypes[225]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserLevelInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserLevelInfo) ProtoMessage() {}
+
+func (x *UserLevelInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[225]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserLevelInfo.ProtoReflect.Descriptor instead.
+func (*UserLevelInfo) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{225}
+}
+
+func (x *UserLevelInfo) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *UserLevelInfo) GetExperience() int32 {
+	if x != nil {
+		return x.Experience
+	}
+	return 0
+}
+
+func (x *UserLevelInfo) GetTotalExp() int32 {
+	if x != nil {
+		return x.TotalExp
+	}
+	return 0
+}
+
+func (x *UserLevelInfo) GetNextLevelExp() int32 {
+	if x != nil {
+		return x.NextLevelExp
+	}
+	return 0
+}
+
+func (x *UserLevelInfo) GetLevelTitle() string {
+	if x != nil {
+		return x.LevelTitle
+	}
+	return ""
+}
+
+func (x *UserLevelInfo) GetBadgeUrl() string {
+	if x != nil {
+		return x.BadgeUrl
+	}
+	return ""
+}
+
+func (x *UserLevelInfo) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+type CheckInStatus struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	HasCheckedToday bool                   `protobuf:"varint,1,opt,name=has_checked_today,json=hasCheckedToday,proto3" json:"has_checked_today,omitempty"`
+	ConsecutiveDays int32                  `protobuf:"varint,2,opt,name=consecutive_days,json=consecutiveDays,proto3" json:"consecutive_days,omitempty"`
+	TodayReward     int32                  `protobuf:"varint,3,opt,name=today_reward,json=todayReward,proto3" json:"today_reward,omitempty"`
+	NextDayReward   int32                  `protobuf:"varint,4,opt,name=next_day_reward,json=nextDayReward,proto3" json:"next_day_reward,omitempty"`
+	CanCheckIn      bool                   `protobuf:"varint,5,opt,name=can_check_in,json=canCheckIn,proto3" json:"can_check_in,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckInStatus) Reset() {
+	*x = CheckInStatus{}
+	mi := &file_super_proto_msgTypes[226]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInStatus) ProtoMessage() {}
+
+func (x *CheckInStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[226]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInStatus.ProtoReflect.Descriptor instead.
+func (*CheckInStatus) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{226}
+}
+
+func (x *CheckInStatus) GetHasCheckedToday() bool {
+	if x != nil {
+		return x.HasCheckedToday
+	}
+	return false
+}
+
+func (x *CheckInStatus) GetConsecutiveDays() int32 {
+	if x != nil {
+		return x.ConsecutiveDays
+	}
+	return 0
+}
+
+func (x *CheckInStatus) GetTodayReward() int32 {
+	if x != nil {
+		return x.TodayReward
+	}
+	return 0
+}
+
+func (x *CheckInStatus) GetNextDayReward() int32 {
+	if x != nil {
+		return x.NextDayReward
+	}
+	return 0
+}
+
+func (x *CheckInStatus) GetCanCheckIn() bool {
+	if x != nil {
+		return x.CanCheckIn
+	}
+	return false
+}
+
+type CheckInRecord struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CheckInDate       string                 `protobuf:"bytes,1,opt,name=check_in_date,json=checkInDate,proto3" json:"check_in_date,omitempty"`
+	ConsecutiveDays   int32                  `protobuf:"varint,2,opt,name=consecutive_days,json=consecutiveDays,proto3" json:"consecutive_days,omitempty"`
+	ExpReward         int32                  `protobuf:"varint,3,opt,name=exp_reward,json=expReward,proto3" json:"exp_reward,omitempty"`
+	IsSpecialReward   bool                   `protobuf:"varint,4,opt,name=is_special_reward,json=isSpecialReward,proto3" json:"is_special_reward,omitempty"`
+	SpecialRewardDesc string                 `protobuf:"bytes,5,opt,name=special_reward_desc,json=specialRewardDesc,proto3" json:"special_reward_desc,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CheckInRecord) Reset() {
+	*x = CheckInRecord{}
+	mi := &file_super_proto_msgTypes[227]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInRecord) ProtoMessage() {}
+
+func (x *CheckInRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[227]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInRecord.ProtoReflect.Descriptor instead.
+func (*CheckInRecord) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{227}
+}
+
+func (x *CheckInRecord) GetCheckInDate() string {
+	if x != nil {
+		return x.CheckInDate
+	}
+	return ""
+}
+
+func (x *CheckInRecord) GetConsecutiveDays() int32 {
+	if x != nil {
+		return x.ConsecutiveDays
+	}
+	return 0
+}
+
+func (x *CheckInRecord) GetExpReward() int32 {
+	if x != nil {
+		return x.ExpReward
+	}
+	return 0
+}
+
+func (x *CheckInRecord) GetIsSpecialReward() bool {
+	if x != nil {
+		return x.IsSpecialReward
+	}
+	return false
+}
+
+func (x *CheckInRecord) GetSpecialRewardDesc() string {
+	if x != nil {
+		return x.SpecialRewardDesc
+	}
+	return ""
+}
+
+type ExpLogRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExpChange     int32                  `protobuf:"varint,2,opt,name=exp_change,json=expChange,proto3" json:"exp_change,omitempty"`
+	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpLogRecord) Reset() {
+	*x = ExpLogRecord{}
+	mi := &file_super_proto_msgTypes[228]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpLogRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpLogRecord) ProtoMessage() {}
+
+func (x *ExpLogRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[228]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpLogRecord.ProtoReflect.Descriptor instead.
+func (*ExpLogRecord) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{228}
+}
+
+func (x *ExpLogRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ExpLogRecord) GetExpChange() int32 {
+	if x != nil {
+		return x.ExpChange
+	}
+	return 0
+}
+
+func (x *ExpLogRecord) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ExpLogRecord) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExpLogRecord) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 签到等级相关请求响应
+type CheckInReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInReq) Reset() {
+	*x = CheckInReq{}
+	mi := &file_super_proto_msgTypes[229]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInReq) ProtoMessage() {}
+
+func (x *CheckInReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[229]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInReq.ProtoReflect.Descriptor instead.
+func (*CheckInReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{229}
+}
+
+func (x *CheckInReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CheckInResp struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ExpGained       int32                  `protobuf:"varint,1,opt,name=exp_gained,json=expGained,proto3" json:"exp_gained,omitempty"`
+	NewLevel        int32                  `protobuf:"varint,2,opt,name=new_level,json=newLevel,proto3" json:"new_level,omitempty"`
+	ConsecutiveDays int32                  `protobuf:"varint,3,opt,name=consecutive_days,json=consecutiveDays,proto3" json:"consecutive_days,omitempty"`
+	LevelUp         bool                   `protobuf:"varint,4,opt,name=level_up,json=levelUp,proto3" json:"level_up,omitempty"`
+	SpecialReward   string                 `protobuf:"bytes,5,opt,name=special_reward,json=specialReward,proto3" json:"special_reward,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckInResp) Reset() {
+	*x = CheckInResp{}
+	mi := &file_super_proto_msgTypes[230]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInResp) ProtoMessage() {}
+
+func (x *CheckInResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[230]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInResp.ProtoReflect.Descriptor instead.
+func (*CheckInResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{230}
+}
+
+func (x *CheckInResp) GetExpGained() int32 {
+	if x != nil {
+		return x.ExpGained
+	}
+	return 0
+}
+
+func (x *CheckInResp) GetNewLevel() int32 {
+	if x != nil {
+		return x.NewLevel
+	}
+	return 0
+}
+
+func (x *CheckInResp) GetConsecutiveDays() int32 {
+	if x != nil {
+		return x.ConsecutiveDays
+	}
+	return 0
+}
+
+func (x *CheckInResp) GetLevelUp() bool {
+	if x != nil {
+		return x.LevelUp
+	}
+	return false
+}
+
+func (x *CheckInResp) GetSpecialReward() string {
+	if x != nil {
+		return x.SpecialReward
+	}
+	return ""
+}
+
+type GetUserLevelReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLevelReq) Reset() {
+	*x = GetUserLevelReq{}
+	mi := &file_super_proto_msgTypes[231]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLevelReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLevelReq) ProtoMessage() {}
+
+func (x *GetUserLevelReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[231]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLevelReq.ProtoReflect.Descriptor instead.
+func (*GetUserLevelReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{231}
+}
+
+func (x *GetUserLevelReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserLevelResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LevelInfo     *UserLevelInfo         `protobuf:"bytes,1,opt,name=level_info,json=levelInfo,proto3" json:"level_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserLevelResp) Reset() {
+	*x = GetUserLevelResp{}
+	mi := &file_super_proto_msgTypes[232]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserLevelResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserLevelResp) ProtoMessage() {}
+
+func (x *GetUserLevelResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[232]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserLevelResp.ProtoReflect.Descriptor instead.
+func (*GetUserLevelResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{232}
+}
+
+func (x *GetUserLevelResp) GetLevelInfo() *UserLevelInfo {
+	if x != nil {
+		return x.LevelInfo
+	}
+	return nil
+}
+
+type GetCheckInStatusReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCheckInStatusReq) Reset() {
+	*x = GetCheckInStatusReq{}
+	mi := &file_super_proto_msgTypes[233]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendFriendRequestReq) String() string {
+func (x *GetCheckInStatusReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendFriendRequestReq) ProtoMessage() {}
+func (*GetCheckInStatusReq) ProtoMessage() {}
 
-func (x *SendFriendRequestReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[95]
+func (x *GetCheckInStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[233]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5462,54 +13533,40 @@ func (x *SendFriendRequestReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendFriendRequestReq.ProtoReflect.Descriptor instead.
-func (*SendFriendRequestReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{95}
-}
-
-func (x *SendFriendRequestReq) GetActorUserId() string {
-	if x != nil {
-		return x.ActorUserId
-	}
-	return ""
-}
-
-func (x *SendFriendRequestReq) GetToUserId() string {
-	if x != nil {
-		return x.ToUserId
-	}
-	return ""
+// Deprecated: Use GetCheckInStatusReq.ProtoReflect.Descriptor instead.
+func (*GetCheckInStatusReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{233}
 }
 
-func (x *SendFriendRequestReq) GetToMoeNo() string {
+func (x *GetCheckInStatusReq) GetUserId() string {
 	if x != nil {
-		return x.ToMoeNo
+		return x.UserId
 	}
 	return ""
 }
 
-type SendFriendRequestResp struct {
+type GetCheckInStatusResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          *FriendRequestView     `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Status        *CheckInStatus         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SendFriendRequestResp) Reset() {
-	*x = SendFriendRequestResp{}
-	mi := &file_super_proto_msgTypes[96]
+func (x *GetCheckInStatusResp) Reset() {
+	*x = GetCheckInStatusResp{}
+	mi := &file_super_proto_msgTypes[234]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendFriendRequestResp) String() string {
+func (x *GetCheckInStatusResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendFriendRequestResp) ProtoMessage() {}
+func (*GetCheckInStatusResp) ProtoMessage() {}
 
-func (x *SendFriendRequestResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[96]
+func (x *GetCheckInStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[234]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5520,40 +13577,42 @@ func (x *SendFriendRequestResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendFriendRequestResp.ProtoReflect.Descriptor instead.
-func (*SendFriendRequestResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use GetCheckInStatusResp.ProtoReflect.Descriptor instead.
+func (*GetCheckInStatusResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{234}
 }
 
-func (x *SendFriendRequestResp) GetData() *FriendRequestView {
+func (x *GetCheckInStatusResp) GetStatus() *CheckInStatus {
 	if x != nil {
-		return x.Data
+		return x.Status
 	}
 	return nil
 }
 
-type ListIncomingFriendRequestsReq struct {
+type GetCheckInHistoryReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListIncomingFriendRequestsReq) Reset() {
-	*x = ListIncomingFriendRequestsReq{}
-	mi := &file_super_proto_msgTypes[97]
+func (x *GetCheckInHistoryReq) Reset() {
+	*x = GetCheckInHistoryReq{}
+	mi := &file_super_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIncomingFriendRequestsReq) String() string {
+func (x *GetCheckInHistoryReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIncomingFriendRequestsReq) ProtoMessage() {}
+func (*GetCheckInHistoryReq) ProtoMessage() {}
 
-func (x *ListIncomingFriendRequestsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[97]
+func (x *GetCheckInHistoryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5564,40 +13623,55 @@ func (x *ListIncomingFriendRequestsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIncomingFriendRequestsReq.ProtoReflect.Descriptor instead.
-func (*ListIncomingFriendRequestsReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{97}
+// Deprecated: Use GetCheckInHistoryReq.ProtoReflect.Descriptor instead.
+func (*GetCheckInHistoryReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{235}
 }
 
-func (x *ListIncomingFriendRequestsReq) GetActorUserId() string {
+func (x *GetCheckInHistoryReq) GetUserId() string {
 	if x != nil {
-		return x.ActorUserId
+		return x.UserId
 	}
 	return ""
 }
 
-type ListIncomingFriendRequestsResp struct {
+func (x *GetCheckInHistoryReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCheckInHistoryReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetCheckInHistoryResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*FriendRequestView   `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Records       []*CheckInRecord       `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListIncomingFriendRequestsResp) Reset() {
-	*x = ListIncomingFriendRequestsResp{}
-	mi := &file_super_proto_msgTypes[98]
+func (x *GetCheckInHistoryResp) Reset() {
+	*x = GetCheckInHistoryResp{}
+	mi := &file_super_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListIncomingFriendRequestsResp) String() string {
+func (x *GetCheckInHistoryResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListIncomingFriendRequestsResp) ProtoMessage() {}
+func (*GetCheckInHistoryResp) ProtoMessage() {}
 
-func (x *ListIncomingFriendRequestsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[98]
+func (x *GetCheckInHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5608,40 +13682,49 @@ func (x *ListIncomingFriendRequestsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListIncomingFriendRequestsResp.ProtoReflect.Descriptor instead.
-func (*ListIncomingFriendRequestsResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use GetCheckInHistoryResp.ProtoReflect.Descriptor instead.
+func (*GetCheckInHistoryResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{236}
 }
 
-func (x *ListIncomingFriendRequestsResp) GetData() []*FriendRequestView {
+func (x *GetCheckInHistoryResp) GetRecords() []*CheckInRecord {
 	if x != nil {
-		return x.Data
+		return x.Records
 	}
 	return nil
 }
 
-type ListOutgoingFriendRequestsReq struct {
+func (x *GetCheckInHistoryResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetExpLogsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOutgoingFriendRequestsReq) Reset() {
-	*x = ListOutgoingFriendRequestsReq{}
-	mi := &file_super_proto_msgTypes[99]
+func (x *GetExpLogsReq) Reset() {
+	*x = GetExpLogsReq{}
+	mi := &file_super_proto_msgTypes[237]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOutgoingFriendRequestsReq) String() string {
+func (x *GetExpLogsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOutgoingFriendRequestsReq) ProtoMessage() {}
+func (*GetExpLogsReq) ProtoMessage() {}
 
-func (x *ListOutgoingFriendRequestsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[99]
+func (x *GetExpLogsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[237]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5652,40 +13735,55 @@ func (x *ListOutgoingFriendRequestsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOutgoingFriendRequestsReq.ProtoReflect.Descriptor instead.
-func (*ListOutgoingFriendRequestsReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{99}
+// Deprecated: Use GetExpLogsReq.ProtoReflect.Descriptor instead.
+func (*GetExpLogsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{237}
 }
 
-func (x *ListOutgoingFriendRequestsReq) GetActorUserId() string {
+func (x *GetExpLogsReq) GetUserId() string {
 	if x != nil {
-		return x.ActorUserId
+		return x.UserId
 	}
 	return ""
 }
 
-type ListOutgoingFriendRequestsResp struct {
+func (x *GetExpLogsReq) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetExpLogsReq) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetExpLogsResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Data          []*FriendRequestView   `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	Logs          []*ExpLogRecord        `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListOutgoingFriendRequestsResp) Reset() {
-	*x = ListOutgoingFriendRequestsResp{}
-	mi := &file_super_proto_msgTypes[100]
+func (x *GetExpLogsResp) Reset() {
+	*x = GetExpLogsResp{}
+	mi := &file_super_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOutgoingFriendRequestsResp) String() string {
+func (x *GetExpLogsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOutgoingFriendRequestsResp) ProtoMessage() {}
+func (*GetExpLogsResp) ProtoMessage() {}
 
-func (x *ListOutgoingFriendRequestsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[100]
+func (x *GetExpLogsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5696,41 +13794,50 @@ func (x *ListOutgoingFriendRequestsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOutgoingFriendRequestsResp.ProtoReflect.Descriptor instead.
-func (*ListOutgoingFriendRequestsResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{100}
+// Deprecated: Use GetExpLogsResp.ProtoReflect.Descriptor instead.
+func (*GetExpLogsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{238}
 }
 
-func (x *ListOutgoingFriendRequestsResp) GetData() []*FriendRequestView {
+func (x *GetExpLogsResp) GetLogs() []*ExpLogRecord {
 	if x != nil {
-		return x.Data
+		return x.Logs
 	}
 	return nil
 }
 
-type AcceptFriendRequestReq struct {
+func (x *GetExpLogsResp) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 端到端加密相关消息（公钥与签名均为 base64，身份公钥为 Ed25519）
+type SignedPreKey struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
-	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	KeyId         uint32                 `protobuf:"varint,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	PublicKey     string                 `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	Signature     string                 `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"` // 身份私钥对 public_key 原始字节的签名
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AcceptFriendRequestReq) Reset() {
-	*x = AcceptFriendRequestReq{}
-	mi := &file_super_proto_msgTypes[101]
+func (x *SignedPreKey) Reset() {
+	*x = SignedPreKey{}
+	mi := &file_super_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptFriendRequestReq) String() string {
+func (x *SignedPreKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptFriendRequestReq) ProtoMessage() {}
+func (*SignedPreKey) ProtoMessage() {}
 
-func (x *AcceptFriendRequestReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[101]
+func (x *SignedPreKey) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5741,47 +13848,58 @@ func (x *AcceptFriendRequestReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptFriendRequestReq.ProtoReflect.Descriptor instead.
-func (*AcceptFriendRequestReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{101}
+// Deprecated: Use SignedPreKey.ProtoReflect.Descriptor instead.
+func (*SignedPreKey) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{239}
 }
 
-func (x *AcceptFriendRequestReq) GetActorUserId() string {
+func (x *SignedPreKey) GetKeyId() uint32 {
 	if x != nil {
-		return x.ActorUserId
+		return x.KeyId
+	}
+	return 0
+}
+
+func (x *SignedPreKey) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
 	}
 	return ""
 }
 
-func (x *AcceptFriendRequestReq) GetRequestId() string {
+func (x *SignedPreKey) GetSignature() string {
 	if x != nil {
-		return x.RequestId
+		return x.Signature
 	}
 	return ""
 }
 
-type AcceptFriendRequestResp struct {
+type PreKeyBundle struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	IdentityKey   string                 `protobuf:"bytes,3,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
+	SignedPreKey  *SignedPreKey          `protobuf:"bytes,4,opt,name=signed_pre_key,json=signedPreKey,proto3" json:"signed_pre_key,omitempty"`
+	OneTimePreKey *SignedPreKey          `protobuf:"bytes,5,opt,name=one_time_pre_key,json=oneTimePreKey,proto3" json:"one_time_pre_key,omitempty"` // 可能为空：一次性预共享公钥已耗尽
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AcceptFriendRequestResp) Reset() {
-	*x = AcceptFriendRequestResp{}
-	mi := &file_super_proto_msgTypes[102]
+func (x *PreKeyBundle) Reset() {
+	*x = PreKeyBundle{}
+	mi := &file_super_proto_msgTypes[240]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptFriendRequestResp) String() string {
+func (x *PreKeyBundle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptFriendRequestResp) ProtoMessage() {}
+func (*PreKeyBundle) ProtoMessage() {}
 
-func (x *AcceptFriendRequestResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[102]
+func (x *PreKeyBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[240]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5789,44 +13907,75 @@ func (x *AcceptFriendRequestResp) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreKeyBundle.ProtoReflect.Descriptor instead.
+func (*PreKeyBundle) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{240}
+}
+
+func (x *PreKeyBundle) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PreKeyBundle) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *PreKeyBundle) GetIdentityKey() string {
+	if x != nil {
+		return x.IdentityKey
+	}
+	return ""
 }
 
-// Deprecated: Use AcceptFriendRequestResp.ProtoReflect.Descriptor instead.
-func (*AcceptFriendRequestResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{102}
+func (x *PreKeyBundle) GetSignedPreKey() *SignedPreKey {
+	if x != nil {
+		return x.SignedPreKey
+	}
+	return nil
 }
 
-func (x *AcceptFriendRequestResp) GetOk() bool {
+func (x *PreKeyBundle) GetOneTimePreKey() *SignedPreKey {
 	if x != nil {
-		return x.Ok
+		return x.OneTimePreKey
 	}
-	return false
+	return nil
 }
 
-type RejectFriendRequestReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
-	RequestId     string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UploadPreKeyBundleReq struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId    string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	DeviceId       string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	IdentityKey    string                 `protobuf:"bytes,3,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
+	SignedPreKey   *SignedPreKey          `protobuf:"bytes,4,opt,name=signed_pre_key,json=signedPreKey,proto3" json:"signed_pre_key,omitempty"`
+	OneTimePreKeys []*SignedPreKey        `protobuf:"bytes,5,rep,name=one_time_pre_keys,json=oneTimePreKeys,proto3" json:"one_time_pre_keys,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RejectFriendRequestReq) Reset() {
-	*x = RejectFriendRequestReq{}
-	mi := &file_super_proto_msgTypes[103]
+func (x *UploadPreKeyBundleReq) Reset() {
+	*x = UploadPreKeyBundleReq{}
+	mi := &file_super_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RejectFriendRequestReq) String() string {
+func (x *UploadPreKeyBundleReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RejectFriendRequestReq) ProtoMessage() {}
+func (*UploadPreKeyBundleReq) ProtoMessage() {}
 
-func (x *RejectFriendRequestReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[103]
+func (x *UploadPreKeyBundleReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5837,47 +13986,68 @@ func (x *RejectFriendRequestReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RejectFriendRequestReq.ProtoReflect.Descriptor instead.
-func (*RejectFriendRequestReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{103}
+// Deprecated: Use UploadPreKeyBundleReq.ProtoReflect.Descriptor instead.
+func (*UploadPreKeyBundleReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{241}
 }
 
-func (x *RejectFriendRequestReq) GetActorUserId() string {
+func (x *UploadPreKeyBundleReq) GetActorUserId() string {
 	if x != nil {
 		return x.ActorUserId
 	}
 	return ""
 }
 
-func (x *RejectFriendRequestReq) GetRequestId() string {
+func (x *UploadPreKeyBundleReq) GetDeviceId() string {
 	if x != nil {
-		return x.RequestId
+		return x.DeviceId
 	}
 	return ""
 }
 
-type RejectFriendRequestResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ok            bool                   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UploadPreKeyBundleReq) GetIdentityKey() string {
+	if x != nil {
+		return x.IdentityKey
+	}
+	return ""
 }
 
-func (x *RejectFriendRequestResp) Reset() {
-	*x = RejectFriendRequestResp{}
-	mi := &file_super_proto_msgTypes[104]
+func (x *UploadPreKeyBundleReq) GetSignedPreKey() *SignedPreKey {
+	if x != nil {
+		return x.SignedPreKey
+	}
+	return nil
+}
+
+func (x *UploadPreKeyBundleReq) GetOneTimePreKeys() []*SignedPreKey {
+	if x != nil {
+		return x.OneTimePreKeys
+	}
+	return nil
+}
+
+type UploadPreKeyBundleResp struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	RemainingOneTimePreKeys int32                  `protobuf:"varint,1,opt,name=remaining_one_time_pre_keys,json=remainingOneTimePreKeys,proto3" json:"remaining_one_time_pre_keys,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UploadPreKeyBundleResp) Reset() {
+	*x = UploadPreKeyBundleResp{}
+	mi := &file_super_proto_msgTypes[242]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RejectFriendRequestResp) String() string {
+func (x *UploadPreKeyBundleResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RejectFriendRequestResp) ProtoMessage() {}
+func (*UploadPreKeyBundleResp) ProtoMessage() {}
 
-func (x *RejectFriendRequestResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[104]
+func (x *UploadPreKeyBundleResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[242]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5888,40 +14058,42 @@ func (x *RejectFriendRequestResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RejectFriendRequestResp.ProtoReflect.Descriptor instead.
-func (*RejectFriendRequestResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{104}
+// Deprecated: Use UploadPreKeyBundleResp.ProtoReflect.Descriptor instead.
+func (*UploadPreKeyBundleResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{242}
 }
 
-func (x *RejectFriendRequestResp) GetOk() bool {
+func (x *UploadPreKeyBundleResp) GetRemainingOneTimePreKeys() int32 {
 	if x != nil {
-		return x.Ok
+		return x.RemainingOneTimePreKeys
 	}
-	return false
+	return 0
 }
 
-type ListFriendsReq struct {
+type GetPreKeyBundlesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,3,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"` // 为空时返回该用户全部设备
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListFriendsReq) Reset() {
-	*x = ListFriendsReq{}
-	mi := &file_super_proto_msgTypes[105]
+func (x *GetPreKeyBundlesReq) Reset() {
+	*x = GetPreKeyBundlesReq{}
+	mi := &file_super_proto_msgTypes[243]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFriendsReq) String() string {
+func (x *GetPreKeyBundlesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFriendsReq) ProtoMessage() {}
+func (*GetPreKeyBundlesReq) ProtoMessage() {}
 
-func (x *ListFriendsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[105]
+func (x *GetPreKeyBundlesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[243]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5932,40 +14104,54 @@ func (x *ListFriendsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFriendsReq.ProtoReflect.Descriptor instead.
-func (*ListFriendsReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{105}
+// Deprecated: Use GetPreKeyBundlesReq.ProtoReflect.Descriptor instead.
+func (*GetPreKeyBundlesReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{243}
 }
 
-func (x *ListFriendsReq) GetActorUserId() string {
+func (x *GetPreKeyBundlesReq) GetActorUserId() string {
 	if x != nil {
 		return x.ActorUserId
 	}
 	return ""
 }
 
-type ListFriendsResp struct {
+func (x *GetPreKeyBundlesReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetPreKeyBundlesReq) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+type GetPreKeyBundlesResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Bundles       []*PreKeyBundle        `protobuf:"bytes,1,rep,name=bundles,proto3" json:"bundles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListFriendsResp) Reset() {
-	*x = ListFriendsResp{}
-	mi := &file_super_proto_msgTypes[106]
+func (x *GetPreKeyBundlesResp) Reset() {
+	*x = GetPreKeyBundlesResp{}
+	mi := &file_super_proto_msgTypes[244]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListFriendsResp) String() string {
+func (x *GetPreKeyBundlesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListFriendsResp) ProtoMessage() {}
+func (*GetPreKeyBundlesResp) ProtoMessage() {}
 
-func (x *ListFriendsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[106]
+func (x *GetPreKeyBundlesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[244]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5976,41 +14162,47 @@ func (x *ListFriendsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListFriendsResp.ProtoReflect.Descriptor instead.
-func (*ListFriendsResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{106}
+// Deprecated: Use GetPreKeyBundlesResp.ProtoReflect.Descriptor instead.
+func (*GetPreKeyBundlesResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{244}
 }
 
-func (x *ListFriendsResp) GetUsers() []*User {
+func (x *GetPreKeyBundlesResp) GetBundles() []*PreKeyBundle {
 	if x != nil {
-		return x.Users
+		return x.Bundles
 	}
 	return nil
 }
 
-type GetFriendRelationReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
-	OtherUserId   string                 `protobuf:"bytes,2,opt,name=other_user_id,json=otherUserId,proto3" json:"other_user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type EncryptedMessage struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SenderId          string                 `protobuf:"bytes,2,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`
+	SenderDeviceId    string                 `protobuf:"bytes,3,opt,name=sender_device_id,json=senderDeviceId,proto3" json:"sender_device_id,omitempty"`
+	RecipientId       string                 `protobuf:"bytes,4,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	RecipientDeviceId string                 `protobuf:"bytes,5,opt,name=recipient_device_id,json=recipientDeviceId,proto3" json:"recipient_device_id,omitempty"`
+	MessageType       int32                  `protobuf:"varint,6,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"` // 1: 携带预共享密钥的首条消息 2: 普通密文
+	Ciphertext        string                 `protobuf:"bytes,7,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetFriendRelationReq) Reset() {
-	*x = GetFriendRelationReq{}
-	mi := &file_super_proto_msgTypes[107]
+func (x *EncryptedMessage) Reset() {
+	*x = EncryptedMessage{}
+	mi := &file_super_proto_msgTypes[245]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFriendRelationReq) String() string {
+func (x *EncryptedMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFriendRelationReq) ProtoMessage() {}
+func (*EncryptedMessage) ProtoMessage() {}
 
-func (x *GetFriendRelationReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[107]
+func (x *EncryptedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[245]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6021,93 +14213,94 @@ func (x *GetFriendRelationReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFriendRelationReq.ProtoReflect.Descriptor instead.
-func (*GetFriendRelationReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{107}
+// Deprecated: Use EncryptedMessage.ProtoReflect.Descriptor instead.
+func (*EncryptedMessage) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{245}
 }
 
-func (x *GetFriendRelationReq) GetActorUserId() string {
+func (x *EncryptedMessage) GetId() string {
 	if x != nil {
-		return x.ActorUserId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetFriendRelationReq) GetOtherUserId() string {
+func (x *EncryptedMessage) GetSenderId() string {
 	if x != nil {
-		return x.OtherUserId
+		return x.SenderId
 	}
 	return ""
 }
 
-type GetFriendRelationResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Relation      string                 `protobuf:"bytes,1,opt,name=relation,proto3" json:"relation,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *EncryptedMessage) GetSenderDeviceId() string {
+	if x != nil {
+		return x.SenderDeviceId
+	}
+	return ""
 }
 
-func (x *GetFriendRelationResp) Reset() {
-	*x = GetFriendRelationResp{}
-	mi := &file_super_proto_msgTypes[108]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *EncryptedMessage) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
 }
 
-func (x *GetFriendRelationResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EncryptedMessage) GetRecipientDeviceId() string {
+	if x != nil {
+		return x.RecipientDeviceId
+	}
+	return ""
 }
 
-func (*GetFriendRelationResp) ProtoMessage() {}
-
-func (x *GetFriendRelationResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[108]
+func (x *EncryptedMessage) GetMessageType() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MessageType
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetFriendRelationResp.ProtoReflect.Descriptor instead.
-func (*GetFriendRelationResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{108}
+func (x *EncryptedMessage) GetCiphertext() string {
+	if x != nil {
+		return x.Ciphertext
+	}
+	return ""
 }
 
-func (x *GetFriendRelationResp) GetRelation() string {
+func (x *EncryptedMessage) GetCreatedAt() string {
 	if x != nil {
-		return x.Relation
+		return x.CreatedAt
 	}
 	return ""
 }
 
-// 关注相关消息
-type FollowUserReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FollowingId   string                 `protobuf:"bytes,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type StoreEncryptedMessageReq struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId       string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	SenderDeviceId    string                 `protobuf:"bytes,2,opt,name=sender_device_id,json=senderDeviceId,proto3" json:"sender_device_id,omitempty"` // 必须是发送者本人已登记公钥的设备
+	RecipientId       string                 `protobuf:"bytes,3,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	RecipientDeviceId string                 `protobuf:"bytes,4,opt,name=recipient_device_id,json=recipientDeviceId,proto3" json:"recipient_device_id,omitempty"` // 必填：密文按设备分别加密发送，投递状态也按设备确认
+	MessageType       int32                  `protobuf:"varint,5,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
+	Ciphertext        string                 `protobuf:"bytes,6,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *FollowUserReq) Reset() {
-	*x = FollowUserReq{}
-	mi := &file_super_proto_msgTypes[109]
+func (x *StoreEncryptedMessageReq) Reset() {
+	*x = StoreEncryptedMessageReq{}
+	mi := &file_super_proto_msgTypes[246]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FollowUserReq) String() string {
+func (x *StoreEncryptedMessageReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowUserReq) ProtoMessage() {}
+func (*StoreEncryptedMessageReq) ProtoMessage() {}
 
-func (x *FollowUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[109]
+func (x *StoreEncryptedMessageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[246]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6118,145 +14311,121 @@ func (x *FollowUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowUserReq.ProtoReflect.Descriptor instead.
-func (*FollowUserReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{109}
+// Deprecated: Use StoreEncryptedMessageReq.ProtoReflect.Descriptor instead.
+func (*StoreEncryptedMessageReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{246}
 }
 
-func (x *FollowUserReq) GetUserId() string {
+func (x *StoreEncryptedMessageReq) GetActorUserId() string {
 	if x != nil {
-		return x.UserId
+		return x.ActorUserId
 	}
 	return ""
 }
 
-func (x *FollowUserReq) GetFollowingId() string {
+func (x *StoreEncryptedMessageReq) GetSenderDeviceId() string {
 	if x != nil {
-		return x.FollowingId
+		return x.SenderDeviceId
 	}
 	return ""
 }
 
-type FollowUserResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *FollowUserResp) Reset() {
-	*x = FollowUserResp{}
-	mi := &file_super_proto_msgTypes[110]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *FollowUserResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StoreEncryptedMessageReq) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
+	}
+	return ""
 }
 
-func (*FollowUserResp) ProtoMessage() {}
-
-func (x *FollowUserResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[110]
+func (x *StoreEncryptedMessageReq) GetRecipientDeviceId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RecipientDeviceId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use FollowUserResp.ProtoReflect.Descriptor instead.
-func (*FollowUserResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{110}
+func (x *StoreEncryptedMessageReq) GetMessageType() int32 {
+	if x != nil {
+		return x.MessageType
+	}
+	return 0
 }
 
-func (x *FollowUserResp) GetSuccess() bool {
+func (x *StoreEncryptedMessageReq) GetCiphertext() string {
 	if x != nil {
-		return x.Success
+		return x.Ciphertext
 	}
-	return false
+	return ""
 }
 
-type UnfollowUserReq struct {
+type StoreEncryptedMessageResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	FollowingId   string                 `protobuf:"bytes,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+	Message       *EncryptedMessage      `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnfollowUserReq) Reset() {
-	*x = UnfollowUserReq{}
-	mi := &file_super_proto_msgTypes[111]
+func (x *StoreEncryptedMessageResp) Reset() {
+	*x = StoreEncryptedMessageResp{}
+	mi := &file_super_proto_msgTypes[247]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfollowUserReq) String() string {
+func (x *StoreEncryptedMessageResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfollowUserReq) ProtoMessage() {}
+func (*StoreEncryptedMessageResp) ProtoMessage() {}
 
-func (x *UnfollowUserReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[111]
+func (x *StoreEncryptedMessageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[247]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UnfollowUserReq.ProtoReflect.Descriptor instead.
-func (*UnfollowUserReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{111}
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *UnfollowUserReq) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use StoreEncryptedMessageResp.ProtoReflect.Descriptor instead.
+func (*StoreEncryptedMessageResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{247}
 }
 
-func (x *UnfollowUserReq) GetFollowingId() string {
+func (x *StoreEncryptedMessageResp) GetMessage() *EncryptedMessage {
 	if x != nil {
-		return x.FollowingId
+		return x.Message
 	}
-	return ""
+	return nil
 }
 
-type GetFollowingsReq struct {
+type ListPendingEncryptedMessagesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFollowingsReq) Reset() {
-	*x = GetFollowingsReq{}
-	mi := &file_super_proto_msgTypes[112]
+func (x *ListPendingEncryptedMessagesReq) Reset() {
+	*x = ListPendingEncryptedMessagesReq{}
+	mi := &file_super_proto_msgTypes[248]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowingsReq) String() string {
+func (x *ListPendingEncryptedMessagesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowingsReq) ProtoMessage() {}
+func (*ListPendingEncryptedMessagesReq) ProtoMessage() {}
 
-func (x *GetFollowingsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[112]
+func (x *ListPendingEncryptedMessagesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[248]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6267,55 +14436,54 @@ func (x *GetFollowingsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowingsReq.ProtoReflect.Descriptor instead.
-func (*GetFollowingsReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{112}
+// Deprecated: Use ListPendingEncryptedMessagesReq.ProtoReflect.Descriptor instead.
+func (*ListPendingEncryptedMessagesReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{248}
 }
 
-func (x *GetFollowingsReq) GetUserId() string {
+func (x *ListPendingEncryptedMessagesReq) GetActorUserId() string {
 	if x != nil {
-		return x.UserId
+		return x.ActorUserId
 	}
 	return ""
 }
 
-func (x *GetFollowingsReq) GetPage() int32 {
+func (x *ListPendingEncryptedMessagesReq) GetDeviceId() string {
 	if x != nil {
-		return x.Page
+		return x.DeviceId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetFollowingsReq) GetPageSize() int32 {
+func (x *ListPendingEncryptedMessagesReq) GetLimit() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.Limit
 	}
 	return 0
 }
 
-type GetFollowingsResp struct {
+type ListPendingEncryptedMessagesResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Messages      []*EncryptedMessage    `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFollowingsResp) Reset() {
-	*x = GetFollowingsResp{}
-	mi := &file_super_proto_msgTypes[113]
+func (x *ListPendingEncryptedMessagesResp) Reset() {
+	*x = ListPendingEncryptedMessagesResp{}
+	mi := &file_super_proto_msgTypes[249]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowingsResp) String() string {
+func (x *ListPendingEncryptedMessagesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowingsResp) ProtoMessage() {}
+func (*ListPendingEncryptedMessagesResp) ProtoMessage() {}
 
-func (x *GetFollowingsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[113]
+func (x *ListPendingEncryptedMessagesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[249]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6326,49 +14494,42 @@ func (x *GetFollowingsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowingsResp.ProtoReflect.Descriptor instead.
-func (*GetFollowingsResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{113}
+// Deprecated: Use ListPendingEncryptedMessagesResp.ProtoReflect.Descriptor instead.
+func (*ListPendingEncryptedMessagesResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{249}
 }
 
-func (x *GetFollowingsResp) GetUsers() []*User {
+func (x *ListPendingEncryptedMessagesResp) GetMessages() []*EncryptedMessage {
 	if x != nil {
-		return x.Users
+		return x.Messages
 	}
 	return nil
 }
 
-func (x *GetFollowingsResp) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-type GetFollowersReq struct {
+type AckEncryptedMessagesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	Ids           []string               `protobuf:"bytes,3,rep,name=ids,proto3" json:"ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFollowersReq) Reset() {
-	*x = GetFollowersReq{}
-	mi := &file_super_proto_msgTypes[114]
+func (x *AckEncryptedMessagesReq) Reset() {
+	*x = AckEncryptedMessagesReq{}
+	mi := &file_super_proto_msgTypes[250]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowersReq) String() string {
+func (x *AckEncryptedMessagesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowersReq) ProtoMessage() {}
+func (*AckEncryptedMessagesReq) ProtoMessage() {}
 
-func (x *GetFollowersReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[114]
+func (x *AckEncryptedMessagesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[250]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6379,55 +14540,54 @@ func (x *GetFollowersReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowersReq.ProtoReflect.Descriptor instead.
-func (*GetFollowersReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{114}
+// Deprecated: Use AckEncryptedMessagesReq.ProtoReflect.Descriptor instead.
+func (*AckEncryptedMessagesReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{250}
 }
 
-func (x *GetFollowersReq) GetUserId() string {
+func (x *AckEncryptedMessagesReq) GetActorUserId() string {
 	if x != nil {
-		return x.UserId
+		return x.ActorUserId
 	}
 	return ""
 }
 
-func (x *GetFollowersReq) GetPage() int32 {
+func (x *AckEncryptedMessagesReq) GetDeviceId() string {
 	if x != nil {
-		return x.Page
+		return x.DeviceId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetFollowersReq) GetPageSize() int32 {
+func (x *AckEncryptedMessagesReq) GetIds() []string {
 	if x != nil {
-		return x.PageSize
+		return x.Ids
 	}
-	return 0
+	return nil
 }
 
-type GetFollowersResp struct {
+type AckEncryptedMessagesResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Acked         int32                  `protobuf:"varint,1,opt,name=acked,proto3" json:"acked,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFollowersResp) Reset() {
-	*x = GetFollowersResp{}
-	mi := &file_super_proto_msgTypes[115]
+func (x *AckEncryptedMessagesResp) Reset() {
+	*x = AckEncryptedMessagesResp{}
+	mi := &file_super_proto_msgTypes[251]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFollowersResp) String() string {
+func (x *AckEncryptedMessagesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFollowersResp) ProtoMessage() {}
+func (*AckEncryptedMessagesResp) ProtoMessage() {}
 
-func (x *GetFollowersResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[115]
+func (x *AckEncryptedMessagesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[251]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6438,48 +14598,51 @@ func (x *GetFollowersResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFollowersResp.ProtoReflect.Descriptor instead.
-func (*GetFollowersResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{115}
-}
-
-func (x *GetFollowersResp) GetUsers() []*User {
-	if x != nil {
-		return x.Users
-	}
-	return nil
+// Deprecated: Use AckEncryptedMessagesResp.ProtoReflect.Descriptor instead.
+func (*AckEncryptedMessagesResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{251}
 }
 
-func (x *GetFollowersResp) GetTotal() int32 {
+func (x *AckEncryptedMessagesResp) GetAcked() int32 {
 	if x != nil {
-		return x.Total
+		return x.Acked
 	}
 	return 0
 }
 
-type CheckFollowReq struct {
+// 处罚：suspend 封禁（不能登录），restrict 限制（只能浏览）
+type Suspension struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	FollowerId    string                 `protobuf:"bytes,1,opt,name=follower_id,json=followerId,proto3" json:"follower_id,omitempty"`
-	FollowingId   string                 `protobuf:"bytes,2,opt,name=following_id,json=followingId,proto3" json:"following_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	HideContent   bool                   `protobuf:"varint,5,opt,name=hide_content,json=hideContent,proto3" json:"hide_content,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // 为空表示永久
+	CreatedBy     string                 `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LiftedAt      string                 `protobuf:"bytes,9,opt,name=lifted_at,json=liftedAt,proto3" json:"lifted_at,omitempty"` // 已解除时不为空
+	LiftReason    string                 `protobuf:"bytes,10,opt,name=lift_reason,json=liftReason,proto3" json:"lift_reason,omitempty"`
+	Active        bool                   `protobuf:"varint,11,opt,name=active,proto3" json:"active,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckFollowReq) Reset() {
-	*x = CheckFollowReq{}
-	mi := &file_super_proto_msgTypes[116]
+func (x *Suspension) Reset() {
+	*x = Suspension{}
+	mi := &file_super_proto_msgTypes[252]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckFollowReq) String() string {
+func (x *Suspension) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckFollowReq) ProtoMessage() {}
+func (*Suspension) ProtoMessage() {}
 
-func (x *CheckFollowReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[116]
+func (x *Suspension) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[252]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6490,96 +14653,115 @@ func (x *CheckFollowReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckFollowReq.ProtoReflect.Descriptor instead.
-func (*CheckFollowReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{116}
+// Deprecated: Use Suspension.ProtoReflect.Descriptor instead.
+func (*Suspension) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{252}
 }
 
-func (x *CheckFollowReq) GetFollowerId() string {
+func (x *Suspension) GetId() string {
 	if x != nil {
-		return x.FollowerId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CheckFollowReq) GetFollowingId() string {
+func (x *Suspension) GetUserId() string {
 	if x != nil {
-		return x.FollowingId
+		return x.UserId
 	}
 	return ""
 }
 
-type CheckFollowResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsFollowing   bool                   `protobuf:"varint,1,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Suspension) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
 }
 
-func (x *CheckFollowResp) Reset() {
-	*x = CheckFollowResp{}
-	mi := &file_super_proto_msgTypes[117]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Suspension) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *CheckFollowResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Suspension) GetHideContent() bool {
+	if x != nil {
+		return x.HideContent
+	}
+	return false
 }
 
-func (*CheckFollowResp) ProtoMessage() {}
+func (x *Suspension) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
 
-func (x *CheckFollowResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[117]
+func (x *Suspension) GetCreatedBy() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedBy
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CheckFollowResp.ProtoReflect.Descriptor instead.
-func (*CheckFollowResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{117}
+func (x *Suspension) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
 }
 
-func (x *CheckFollowResp) GetIsFollowing() bool {
+func (x *Suspension) GetLiftedAt() string {
 	if x != nil {
-		return x.IsFollowing
+		return x.LiftedAt
+	}
+	return ""
+}
+
+func (x *Suspension) GetLiftReason() string {
+	if x != nil {
+		return x.LiftReason
+	}
+	return ""
+}
+
+func (x *Suspension) GetActive() bool {
+	if x != nil {
+		return x.Active
 	}
 	return false
 }
 
-// Avatar相关消息
-type AvatarBaseConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FaceShape     string                 `protobuf:"bytes,1,opt,name=face_shape,json=faceShape,proto3" json:"face_shape,omitempty"`
-	SkinColor     string                 `protobuf:"bytes,2,opt,name=skin_color,json=skinColor,proto3" json:"skin_color,omitempty"`
-	EyeType       string                 `protobuf:"bytes,3,opt,name=eye_type,json=eyeType,proto3" json:"eye_type,omitempty"`
-	HairStyle     string                 `protobuf:"bytes,4,opt,name=hair_style,json=hairStyle,proto3" json:"hair_style,omitempty"`
-	HairColor     string                 `protobuf:"bytes,5,opt,name=hair_color,json=hairColor,proto3" json:"hair_color,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SuspendUserReq struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId     string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Kind            string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Reason          string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,5,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // 0 表示永久
+	HideContent     bool                   `protobuf:"varint,6,opt,name=hide_content,json=hideContent,proto3" json:"hide_content,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *AvatarBaseConfig) Reset() {
-	*x = AvatarBaseConfig{}
-	mi := &file_super_proto_msgTypes[118]
+func (x *SuspendUserReq) Reset() {
+	*x = SuspendUserReq{}
+	mi := &file_super_proto_msgTypes[253]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AvatarBaseConfig) String() string {
+func (x *SuspendUserReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvatarBaseConfig) ProtoMessage() {}
+func (*SuspendUserReq) ProtoMessage() {}
 
-func (x *AvatarBaseConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[118]
+func (x *SuspendUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[253]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6590,70 +14772,77 @@ func (x *AvatarBaseConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvatarBaseConfig.ProtoReflect.Descriptor instead.
-func (*AvatarBaseConfig) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use SuspendUserReq.ProtoReflect.Descriptor instead.
+func (*SuspendUserReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{253}
 }
 
-func (x *AvatarBaseConfig) GetFaceShape() string {
+func (x *SuspendUserReq) GetActorUserId() string {
 	if x != nil {
-		return x.FaceShape
+		return x.ActorUserId
 	}
 	return ""
 }
 
-func (x *AvatarBaseConfig) GetSkinColor() string {
+func (x *SuspendUserReq) GetUserId() string {
 	if x != nil {
-		return x.SkinColor
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *AvatarBaseConfig) GetEyeType() string {
+func (x *SuspendUserReq) GetKind() string {
 	if x != nil {
-		return x.EyeType
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *AvatarBaseConfig) GetHairStyle() string {
+func (x *SuspendUserReq) GetReason() string {
 	if x != nil {
-		return x.HairStyle
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *AvatarBaseConfig) GetHairColor() string {
+func (x *SuspendUserReq) GetDurationSeconds() int64 {
 	if x != nil {
-		return x.HairColor
+		return x.DurationSeconds
 	}
-	return ""
+	return 0
 }
 
-type AvatarOutfitConfig struct {
+func (x *SuspendUserReq) GetHideContent() bool {
+	if x != nil {
+		return x.HideContent
+	}
+	return false
+}
+
+type LiftSuspensionReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Clothes       string                 `protobuf:"bytes,1,opt,name=clothes,proto3" json:"clothes,omitempty"`
-	Accessories   []string               `protobuf:"bytes,2,rep,name=accessories,proto3" json:"accessories,omitempty"`
-	Background    string                 `protobuf:"bytes,3,opt,name=background,proto3" json:"background,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AvatarOutfitConfig) Reset() {
-	*x = AvatarOutfitConfig{}
-	mi := &file_super_proto_msgTypes[119]
+func (x *LiftSuspensionReq) Reset() {
+	*x = LiftSuspensionReq{}
+	mi := &file_super_proto_msgTypes[254]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AvatarOutfitConfig) String() string {
+func (x *LiftSuspensionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AvatarOutfitConfig) ProtoMessage() {}
+func (*LiftSuspensionReq) ProtoMessage() {}
 
-func (x *AvatarOutfitConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[119]
+func (x *LiftSuspensionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[254]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6664,57 +14853,54 @@ func (x *AvatarOutfitConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AvatarOutfitConfig.ProtoReflect.Descriptor instead.
-func (*AvatarOutfitConfig) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{119}
+// Deprecated: Use LiftSuspensionReq.ProtoReflect.Descriptor instead.
+func (*LiftSuspensionReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{254}
 }
 
-func (x *AvatarOutfitConfig) GetClothes() string {
+func (x *LiftSuspensionReq) GetActorUserId() string {
 	if x != nil {
-		return x.Clothes
+		return x.ActorUserId
 	}
 	return ""
 }
 
-func (x *AvatarOutfitConfig) GetAccessories() []string {
+func (x *LiftSuspensionReq) GetUserId() string {
 	if x != nil {
-		return x.Accessories
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-func (x *AvatarOutfitConfig) GetBackground() string {
+func (x *LiftSuspensionReq) GetReason() string {
 	if x != nil {
-		return x.Background
+		return x.Reason
 	}
 	return ""
 }
 
-type UserAvatarData struct {
+type GetUserSuspensionReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BaseConfig    *AvatarBaseConfig      `protobuf:"bytes,2,opt,name=base_config,json=baseConfig,proto3" json:"base_config,omitempty"`
-	CurrentOutfit *AvatarOutfitConfig    `protobuf:"bytes,3,opt,name=current_outfit,json=currentOutfit,proto3" json:"current_outfit,omitempty"`
-	OwnedOutfits  []string               `protobuf:"bytes,4,rep,name=owned_outfits,json=ownedOutfits,proto3" json:"owned_outfits,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserAvatarData) Reset() {
-	*x = UserAvatarData{}
-	mi := &file_super_proto_msgTypes[120]
+func (x *GetUserSuspensionReq) Reset() {
+	*x = GetUserSuspensionReq{}
+	mi := &file_super_proto_msgTypes[255]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserAvatarData) String() string {
+func (x *GetUserSuspensionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserAvatarData) ProtoMessage() {}
+func (*GetUserSuspensionReq) ProtoMessage() {}
 
-func (x *UserAvatarData) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[120]
+func (x *GetUserSuspensionReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[255]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6725,62 +14911,87 @@ func (x *UserAvatarData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserAvatarData.ProtoReflect.Descriptor instead.
-func (*UserAvatarData) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{120}
+// Deprecated: Use GetUserSuspensionReq.ProtoReflect.Descriptor instead.
+func (*GetUserSuspensionReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{255}
 }
 
-func (x *UserAvatarData) GetUserId() string {
+func (x *GetUserSuspensionReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UserAvatarData) GetBaseConfig() *AvatarBaseConfig {
-	if x != nil {
-		return x.BaseConfig
-	}
-	return nil
+type SuspensionResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suspension    *Suspension            `protobuf:"bytes,1,opt,name=suspension,proto3" json:"suspension,omitempty"` // 当前生效中的处罚，没有时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserAvatarData) GetCurrentOutfit() *AvatarOutfitConfig {
+func (x *SuspensionResp) Reset() {
+	*x = SuspensionResp{}
+	mi := &file_super_proto_msgTypes[256]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspensionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspensionResp) ProtoMessage() {}
+
+func (x *SuspensionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[256]
 	if x != nil {
-		return x.CurrentOutfit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *UserAvatarData) GetOwnedOutfits() []string {
+// Deprecated: Use SuspensionResp.ProtoReflect.Descriptor instead.
+func (*SuspensionResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{256}
+}
+
+func (x *SuspensionResp) GetSuspension() *Suspension {
 	if x != nil {
-		return x.OwnedOutfits
+		return x.Suspension
 	}
 	return nil
 }
 
-// 获取用户虚拟形象请求
-type GetUserAvatarReq struct {
+// 提交申诉：被限制的用户登录后用 user_id 提交；被封禁的用户无法登录，用登录被拒时返回的 appeal_token 提交
+type SubmitSuspensionAppealReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AppealToken   string                 `protobuf:"bytes,2,opt,name=appeal_token,json=appealToken,proto3" json:"appeal_token,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserAvatarReq) Reset() {
-	*x = GetUserAvatarReq{}
-	mi := &file_super_proto_msgTypes[121]
+func (x *SubmitSuspensionAppealReq) Reset() {
+	*x = SubmitSuspensionAppealReq{}
+	mi := &file_super_proto_msgTypes[257]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserAvatarReq) String() string {
+func (x *SubmitSuspensionAppealReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserAvatarReq) ProtoMessage() {}
+func (*SubmitSuspensionAppealReq) ProtoMessage() {}
 
-func (x *GetUserAvatarReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[121]
+func (x *SubmitSuspensionAppealReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[257]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6791,41 +15002,63 @@ func (x *GetUserAvatarReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserAvatarReq.ProtoReflect.Descriptor instead.
-func (*GetUserAvatarReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{121}
+// Deprecated: Use SubmitSuspensionAppealReq.ProtoReflect.Descriptor instead.
+func (*SubmitSuspensionAppealReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{257}
 }
 
-func (x *GetUserAvatarReq) GetUserId() string {
+func (x *SubmitSuspensionAppealReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-// 获取用户虚拟形象响应
-type GetUserAvatarResp struct {
+func (x *SubmitSuspensionAppealReq) GetAppealToken() string {
+	if x != nil {
+		return x.AppealToken
+	}
+	return ""
+}
+
+func (x *SubmitSuspensionAppealReq) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SuspensionAppeal struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Avatar        *UserAvatarData        `protobuf:"bytes,1,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SuspensionId  string                 `protobuf:"bytes,2,opt,name=suspension_id,json=suspensionId,proto3" json:"suspension_id,omitempty"`
+	User          *User                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // pending / accepted / rejected
+	ReviewNote    string                 `protobuf:"bytes,6,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,7,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt    string                 `protobuf:"bytes,8,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Suspension    *Suspension            `protobuf:"bytes,10,opt,name=suspension,proto3" json:"suspension,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserAvatarResp) Reset() {
-	*x = GetUserAvatarResp{}
-	mi := &file_super_proto_msgTypes[122]
+func (x *SuspensionAppeal) Reset() {
+	*x = SuspensionAppeal{}
+	mi := &file_super_proto_msgTypes[258]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserAvatarResp) String() string {
+func (x *SuspensionAppeal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserAvatarResp) ProtoMessage() {}
+func (*SuspensionAppeal) ProtoMessage() {}
 
-func (x *GetUserAvatarResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[122]
+func (x *SuspensionAppeal) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[258]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6836,102 +15069,103 @@ func (x *GetUserAvatarResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserAvatarResp.ProtoReflect.Descriptor instead.
-func (*GetUserAvatarResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{122}
+// Deprecated: Use SuspensionAppeal.ProtoReflect.Descriptor instead.
+func (*SuspensionAppeal) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{258}
 }
 
-func (x *GetUserAvatarResp) GetAvatar() *UserAvatarData {
+func (x *SuspensionAppeal) GetId() string {
 	if x != nil {
-		return x.Avatar
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-// 更新用户虚拟形象请求
-type UpdateUserAvatarReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	BaseConfig    *AvatarBaseConfig      `protobuf:"bytes,2,opt,name=base_config,json=baseConfig,proto3" json:"base_config,omitempty"`
-	CurrentOutfit *AvatarOutfitConfig    `protobuf:"bytes,3,opt,name=current_outfit,json=currentOutfit,proto3" json:"current_outfit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SuspensionAppeal) GetSuspensionId() string {
+	if x != nil {
+		return x.SuspensionId
+	}
+	return ""
 }
 
-func (x *UpdateUserAvatarReq) Reset() {
-	*x = UpdateUserAvatarReq{}
-	mi := &file_super_proto_msgTypes[123]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SuspensionAppeal) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
 }
 
-func (x *UpdateUserAvatarReq) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SuspensionAppeal) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (*UpdateUserAvatarReq) ProtoMessage() {}
+func (x *SuspensionAppeal) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
 
-func (x *UpdateUserAvatarReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[123]
+func (x *SuspensionAppeal) GetReviewNote() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ReviewNote
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use UpdateUserAvatarReq.ProtoReflect.Descriptor instead.
-func (*UpdateUserAvatarReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{123}
+func (x *SuspensionAppeal) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
 }
 
-func (x *UpdateUserAvatarReq) GetUserId() string {
+func (x *SuspensionAppeal) GetReviewedAt() string {
 	if x != nil {
-		return x.UserId
+		return x.ReviewedAt
 	}
 	return ""
 }
 
-func (x *UpdateUserAvatarReq) GetBaseConfig() *AvatarBaseConfig {
+func (x *SuspensionAppeal) GetCreatedAt() string {
 	if x != nil {
-		return x.BaseConfig
+		return x.CreatedAt
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateUserAvatarReq) GetCurrentOutfit() *AvatarOutfitConfig {
+func (x *SuspensionAppeal) GetSuspension() *Suspension {
 	if x != nil {
-		return x.CurrentOutfit
+		return x.Suspension
 	}
 	return nil
 }
 
-// 更新用户虚拟形象响应
-type UpdateUserAvatarResp struct {
+type SuspensionAppealResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Avatar        *UserAvatarData        `protobuf:"bytes,1,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Appeal        *SuspensionAppeal      `protobuf:"bytes,1,opt,name=appeal,proto3" json:"appeal,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserAvatarResp) Reset() {
-	*x = UpdateUserAvatarResp{}
-	mi := &file_super_proto_msgTypes[124]
+func (x *SuspensionAppealResp) Reset() {
+	*x = SuspensionAppealResp{}
+	mi := &file_super_proto_msgTypes[259]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserAvatarResp) String() string {
+func (x *SuspensionAppealResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserAvatarResp) ProtoMessage() {}
+func (*SuspensionAppealResp) ProtoMessage() {}
 
-func (x *UpdateUserAvatarResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[124]
+func (x *SuspensionAppealResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[259]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6942,47 +15176,43 @@ func (x *UpdateUserAvatarResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserAvatarResp.ProtoReflect.Descriptor instead.
-func (*UpdateUserAvatarResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{124}
+// Deprecated: Use SuspensionAppealResp.ProtoReflect.Descriptor instead.
+func (*SuspensionAppealResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{259}
 }
 
-func (x *UpdateUserAvatarResp) GetAvatar() *UserAvatarData {
+func (x *SuspensionAppealResp) GetAppeal() *SuspensionAppeal {
 	if x != nil {
-		return x.Avatar
+		return x.Appeal
 	}
 	return nil
 }
 
-// 签到等级相关消息
-type UserLevelInfo struct {
+type ListSuspensionAppealsReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Level         int32                  `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
-	Experience    int32                  `protobuf:"varint,2,opt,name=experience,proto3" json:"experience,omitempty"`
-	TotalExp      int32                  `protobuf:"varint,3,opt,name=total_exp,json=totalExp,proto3" json:"total_exp,omitempty"`
-	NextLevelExp  int32                  `protobuf:"varint,4,opt,name=next_level_exp,json=nextLevelExp,proto3" json:"next_level_exp,omitempty"`
-	LevelTitle    string                 `protobuf:"bytes,5,opt,name=level_title,json=levelTitle,proto3" json:"level_title,omitempty"`
-	BadgeUrl      string                 `protobuf:"bytes,6,opt,name=badge_url,json=badgeUrl,proto3" json:"badge_url,omitempty"`
-	Progress      float64                `protobuf:"fixed64,7,opt,name=progress,proto3" json:"progress,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // 为空时返回全部
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserLevelInfo) Reset() {
-	*x = UserLevelInfo{}
-	mi := &file_super_proto_msgTypes[125]
+func (x *ListSuspensionAppealsReq) Reset() {
+	*x = ListSuspensionAppealsReq{}
+	mi := &file_super_proto_msgTypes[260]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserLevelInfo) String() string {
+func (x *ListSuspensionAppealsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserLevelInfo) ProtoMessage() {}
+func (*ListSuspensionAppealsReq) ProtoMessage() {}
 
-func (x *UserLevelInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[125]
+func (x *ListSuspensionAppealsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[260]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6993,86 +15223,117 @@ func (x *UserLevelInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserLevelInfo.ProtoReflect.Descriptor instead.
-func (*UserLevelInfo) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{125}
+// Deprecated: Use ListSuspensionAppealsReq.ProtoReflect.Descriptor instead.
+func (*ListSuspensionAppealsReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{260}
 }
 
-func (x *UserLevelInfo) GetLevel() int32 {
+func (x *ListSuspensionAppealsReq) GetActorUserId() string {
 	if x != nil {
-		return x.Level
+		return x.ActorUserId
 	}
-	return 0
+	return ""
 }
 
-func (x *UserLevelInfo) GetExperience() int32 {
+func (x *ListSuspensionAppealsReq) GetStatus() string {
 	if x != nil {
-		return x.Experience
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *UserLevelInfo) GetTotalExp() int32 {
+func (x *ListSuspensionAppealsReq) GetPage() int32 {
 	if x != nil {
-		return x.TotalExp
+		return x.Page
 	}
 	return 0
 }
 
-func (x *UserLevelInfo) GetNextLevelExp() int32 {
+func (x *ListSuspensionAppealsReq) GetPageSize() int32 {
 	if x != nil {
-		return x.NextLevelExp
+		return x.PageSize
 	}
 	return 0
 }
 
-func (x *UserLevelInfo) GetLevelTitle() string {
+type ListSuspensionAppealsResp struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Appeals       []*SuspensionAppeal    `protobuf:"bytes,1,rep,name=appeals,proto3" json:"appeals,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuspensionAppealsResp) Reset() {
+	*x = ListSuspensionAppealsResp{}
+	mi := &file_super_proto_msgTypes[261]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuspensionAppealsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuspensionAppealsResp) ProtoMessage() {}
+
+func (x *ListSuspensionAppealsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[261]
 	if x != nil {
-		return x.LevelTitle
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UserLevelInfo) GetBadgeUrl() string {
+// Deprecated: Use ListSuspensionAppealsResp.ProtoReflect.Descriptor instead.
+func (*ListSuspensionAppealsResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{261}
+}
+
+func (x *ListSuspensionAppealsResp) GetAppeals() []*SuspensionAppeal {
 	if x != nil {
-		return x.BadgeUrl
+		return x.Appeals
 	}
-	return ""
+	return nil
 }
 
-func (x *UserLevelInfo) GetProgress() float64 {
+func (x *ListSuspensionAppealsResp) GetTotal() int64 {
 	if x != nil {
-		return x.Progress
+		return x.Total
 	}
 	return 0
 }
 
-type CheckInStatus struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	HasCheckedToday bool                   `protobuf:"varint,1,opt,name=has_checked_today,json=hasCheckedToday,proto3" json:"has_checked_today,omitempty"`
-	ConsecutiveDays int32                  `protobuf:"varint,2,opt,name=consecutive_days,json=consecutiveDays,proto3" json:"consecutive_days,omitempty"`
-	TodayReward     int32                  `protobuf:"varint,3,opt,name=today_reward,json=todayReward,proto3" json:"today_reward,omitempty"`
-	NextDayReward   int32                  `protobuf:"varint,4,opt,name=next_day_reward,json=nextDayReward,proto3" json:"next_day_reward,omitempty"`
-	CanCheckIn      bool                   `protobuf:"varint,5,opt,name=can_check_in,json=canCheckIn,proto3" json:"can_check_in,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// 处理申诉：通过时解除处罚
+type ReviewSuspensionAppealReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	AppealId      string                 `protobuf:"bytes,2,opt,name=appeal_id,json=appealId,proto3" json:"appeal_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckInStatus) Reset() {
-	*x = CheckInStatus{}
-	mi := &file_super_proto_msgTypes[126]
+func (x *ReviewSuspensionAppealReq) Reset() {
+	*x = ReviewSuspensionAppealReq{}
+	mi := &file_super_proto_msgTypes[262]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckInStatus) String() string {
+func (x *ReviewSuspensionAppealReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckInStatus) ProtoMessage() {}
+func (*ReviewSuspensionAppealReq) ProtoMessage() {}
 
-func (x *CheckInStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[126]
+func (x *ReviewSuspensionAppealReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[262]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7083,72 +15344,63 @@ func (x *CheckInStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckInStatus.ProtoReflect.Descriptor instead.
-func (*CheckInStatus) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{126}
-}
-
-func (x *CheckInStatus) GetHasCheckedToday() bool {
-	if x != nil {
-		return x.HasCheckedToday
-	}
-	return false
+// Deprecated: Use ReviewSuspensionAppealReq.ProtoReflect.Descriptor instead.
+func (*ReviewSuspensionAppealReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{262}
 }
 
-func (x *CheckInStatus) GetConsecutiveDays() int32 {
+func (x *ReviewSuspensionAppealReq) GetActorUserId() string {
 	if x != nil {
-		return x.ConsecutiveDays
+		return x.ActorUserId
 	}
-	return 0
+	return ""
 }
 
-func (x *CheckInStatus) GetTodayReward() int32 {
+func (x *ReviewSuspensionAppealReq) GetAppealId() string {
 	if x != nil {
-		return x.TodayReward
+		return x.AppealId
 	}
-	return 0
+	return ""
 }
 
-func (x *CheckInStatus) GetNextDayReward() int32 {
+func (x *ReviewSuspensionAppealReq) GetApprove() bool {
 	if x != nil {
-		return x.NextDayReward
+		return x.Approve
 	}
-	return 0
+	return false
 }
 
-func (x *CheckInStatus) GetCanCheckIn() bool {
+func (x *ReviewSuspensionAppealReq) GetNote() string {
 	if x != nil {
-		return x.CanCheckIn
+		return x.Note
 	}
-	return false
+	return ""
 }
 
-type CheckInRecord struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	CheckInDate       string                 `protobuf:"bytes,1,opt,name=check_in_date,json=checkInDate,proto3" json:"check_in_date,omitempty"`
-	ConsecutiveDays   int32                  `protobuf:"varint,2,opt,name=consecutive_days,json=consecutiveDays,proto3" json:"consecutive_days,omitempty"`
-	ExpReward         int32                  `protobuf:"varint,3,opt,name=exp_reward,json=expReward,proto3" json:"exp_reward,omitempty"`
-	IsSpecialReward   bool                   `protobuf:"varint,4,opt,name=is_special_reward,json=isSpecialReward,proto3" json:"is_special_reward,omitempty"`
-	SpecialRewardDesc string                 `protobuf:"bytes,5,opt,name=special_reward_desc,json=specialRewardDesc,proto3" json:"special_reward_desc,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// 第三方登录提供方
+type OidcProvider struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckInRecord) Reset() {
-	*x = CheckInRecord{}
-	mi := &file_super_proto_msgTypes[127]
+func (x *OidcProvider) Reset() {
+	*x = OidcProvider{}
+	mi := &file_super_proto_msgTypes[263]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckInRecord) String() string {
+func (x *OidcProvider) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckInRecord) ProtoMessage() {}
+func (*OidcProvider) ProtoMessage() {}
 
-func (x *CheckInRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[127]
+func (x *OidcProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[263]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7159,72 +15411,83 @@ func (x *CheckInRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckInRecord.ProtoReflect.Descriptor instead.
-func (*CheckInRecord) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{127}
+// Deprecated: Use OidcProvider.ProtoReflect.Descriptor instead.
+func (*OidcProvider) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{263}
 }
 
-func (x *CheckInRecord) GetCheckInDate() string {
+func (x *OidcProvider) GetName() string {
 	if x != nil {
-		return x.CheckInDate
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CheckInRecord) GetConsecutiveDays() int32 {
+func (x *OidcProvider) GetDisplayName() string {
 	if x != nil {
-		return x.ConsecutiveDays
+		return x.DisplayName
 	}
-	return 0
+	return ""
 }
 
-func (x *CheckInRecord) GetExpReward() int32 {
-	if x != nil {
-		return x.ExpReward
-	}
-	return 0
+type ListOidcProvidersReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckInRecord) GetIsSpecialReward() bool {
-	if x != nil {
-		return x.IsSpecialReward
-	}
-	return false
+func (x *ListOidcProvidersReq) Reset() {
+	*x = ListOidcProvidersReq{}
+	mi := &file_super_proto_msgTypes[264]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckInRecord) GetSpecialRewardDesc() string {
+func (x *ListOidcProvidersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOidcProvidersReq) ProtoMessage() {}
+
+func (x *ListOidcProvidersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[264]
 	if x != nil {
-		return x.SpecialRewardDesc
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type ExpLogRecord struct {
+// Deprecated: Use ListOidcProvidersReq.ProtoReflect.Descriptor instead.
+func (*ListOidcProvidersReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{264}
+}
+
+type ListOidcProvidersResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ExpChange     int32                  `protobuf:"varint,2,opt,name=exp_change,json=expChange,proto3" json:"exp_change,omitempty"`
-	Source        string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Providers     []*OidcProvider        `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExpLogRecord) Reset() {
-	*x = ExpLogRecord{}
-	mi := &file_super_proto_msgTypes[128]
+func (x *ListOidcProvidersResp) Reset() {
+	*x = ListOidcProvidersResp{}
+	mi := &file_super_proto_msgTypes[265]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExpLogRecord) String() string {
+func (x *ListOidcProvidersResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExpLogRecord) ProtoMessage() {}
+func (*ListOidcProvidersResp) ProtoMessage() {}
 
-func (x *ExpLogRecord) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[128]
+func (x *ListOidcProvidersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[265]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7235,69 +15498,103 @@ func (x *ExpLogRecord) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExpLogRecord.ProtoReflect.Descriptor instead.
-func (*ExpLogRecord) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{128}
+// Deprecated: Use ListOidcProvidersResp.ProtoReflect.Descriptor instead.
+func (*ListOidcProvidersResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{265}
 }
 
-func (x *ExpLogRecord) GetId() string {
+func (x *ListOidcProvidersResp) GetProviders() []*OidcProvider {
 	if x != nil {
-		return x.Id
+		return x.Providers
 	}
-	return ""
+	return nil
 }
 
-func (x *ExpLogRecord) GetExpChange() int32 {
+// 发起第三方授权：purpose 为 login（未登录）或 link（绑定到 actor_user_id）
+type StartOidcAuthReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Purpose       string                 `protobuf:"bytes,2,opt,name=purpose,proto3" json:"purpose,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,3,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartOidcAuthReq) Reset() {
+	*x = StartOidcAuthReq{}
+	mi := &file_super_proto_msgTypes[266]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartOidcAuthReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartOidcAuthReq) ProtoMessage() {}
+
+func (x *StartOidcAuthReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[266]
 	if x != nil {
-		return x.ExpChange
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ExpLogRecord) GetSource() string {
+// Deprecated: Use StartOidcAuthReq.ProtoReflect.Descriptor instead.
+func (*StartOidcAuthReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{266}
+}
+
+func (x *StartOidcAuthReq) GetProvider() string {
 	if x != nil {
-		return x.Source
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *ExpLogRecord) GetDescription() string {
+func (x *StartOidcAuthReq) GetPurpose() string {
 	if x != nil {
-		return x.Description
+		return x.Purpose
 	}
 	return ""
 }
 
-func (x *ExpLogRecord) GetCreatedAt() string {
+func (x *StartOidcAuthReq) GetActorUserId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.ActorUserId
 	}
 	return ""
 }
 
-// 签到等级相关请求响应
-type CheckInReq struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type StartOidcAuthResp struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"` // 客户端在浏览器中打开，授权后提供方带 code 与 state 跳回 redirect_uri
+	State            string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	ExpiresIn        int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"` // state 有效期（秒）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CheckInReq) Reset() {
-	*x = CheckInReq{}
-	mi := &file_super_proto_msgTypes[129]
+func (x *StartOidcAuthResp) Reset() {
+	*x = StartOidcAuthResp{}
+	mi := &file_super_proto_msgTypes[267]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckInReq) String() string {
+func (x *StartOidcAuthResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckInReq) ProtoMessage() {}
+func (*StartOidcAuthResp) ProtoMessage() {}
 
-func (x *CheckInReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[129]
+func (x *StartOidcAuthResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[267]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7308,44 +15605,59 @@ func (x *CheckInReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckInReq.ProtoReflect.Descriptor instead.
-func (*CheckInReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{129}
+// Deprecated: Use StartOidcAuthResp.ProtoReflect.Descriptor instead.
+func (*StartOidcAuthResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{267}
 }
 
-func (x *CheckInReq) GetUserId() string {
+func (x *StartOidcAuthResp) GetAuthorizationUrl() string {
 	if x != nil {
-		return x.UserId
+		return x.AuthorizationUrl
 	}
 	return ""
 }
 
-type CheckInResp struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ExpGained       int32                  `protobuf:"varint,1,opt,name=exp_gained,json=expGained,proto3" json:"exp_gained,omitempty"`
-	NewLevel        int32                  `protobuf:"varint,2,opt,name=new_level,json=newLevel,proto3" json:"new_level,omitempty"`
-	ConsecutiveDays int32                  `protobuf:"varint,3,opt,name=consecutive_days,json=consecutiveDays,proto3" json:"consecutive_days,omitempty"`
-	LevelUp         bool                   `protobuf:"varint,4,opt,name=level_up,json=levelUp,proto3" json:"level_up,omitempty"`
-	SpecialReward   string                 `protobuf:"bytes,5,opt,name=special_reward,json=specialReward,proto3" json:"special_reward,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *StartOidcAuthResp) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
 }
 
-func (x *CheckInResp) Reset() {
-	*x = CheckInResp{}
-	mi := &file_super_proto_msgTypes[130]
+func (x *StartOidcAuthResp) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// 第三方登录：提交回调中的 code 与 state；第三方账号未绑定时按其邮箱自动注册
+type OidcLoginReq struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,4,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,5,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OidcLoginReq) Reset() {
+	*x = OidcLoginReq{}
+	mi := &file_super_proto_msgTypes[268]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckInResp) String() string {
+func (x *OidcLoginReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckInResp) ProtoMessage() {}
+func (*OidcLoginReq) ProtoMessage() {}
 
-func (x *CheckInResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[130]
+func (x *OidcLoginReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[268]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7356,68 +15668,73 @@ func (x *CheckInResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckInResp.ProtoReflect.Descriptor instead.
-func (*CheckInResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{130}
+// Deprecated: Use OidcLoginReq.ProtoReflect.Descriptor instead.
+func (*OidcLoginReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{268}
 }
 
-func (x *CheckInResp) GetExpGained() int32 {
+func (x *OidcLoginReq) GetProvider() string {
 	if x != nil {
-		return x.ExpGained
+		return x.Provider
 	}
-	return 0
+	return ""
 }
 
-func (x *CheckInResp) GetNewLevel() int32 {
+func (x *OidcLoginReq) GetState() string {
 	if x != nil {
-		return x.NewLevel
+		return x.State
 	}
-	return 0
+	return ""
 }
 
-func (x *CheckInResp) GetConsecutiveDays() int32 {
+func (x *OidcLoginReq) GetCode() string {
 	if x != nil {
-		return x.ConsecutiveDays
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-func (x *CheckInResp) GetLevelUp() bool {
+func (x *OidcLoginReq) GetClientIp() string {
 	if x != nil {
-		return x.LevelUp
+		return x.ClientIp
 	}
-	return false
+	return ""
 }
 
-func (x *CheckInResp) GetSpecialReward() string {
+func (x *OidcLoginReq) GetUserAgent() string {
 	if x != nil {
-		return x.SpecialReward
+		return x.UserAgent
 	}
 	return ""
 }
 
-type GetUserLevelReq struct {
+type UserIdentity struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	DisplayName   string                 `protobuf:"bytes,4,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastLoginAt   string                 `protobuf:"bytes,6,opt,name=last_login_at,json=lastLoginAt,proto3" json:"last_login_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserLevelReq) Reset() {
-	*x = GetUserLevelReq{}
-	mi := &file_super_proto_msgTypes[131]
+func (x *UserIdentity) Reset() {
+	*x = UserIdentity{}
+	mi := &file_super_proto_msgTypes[269]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserLevelReq) String() string {
+func (x *UserIdentity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserLevelReq) ProtoMessage() {}
+func (*UserIdentity) ProtoMessage() {}
 
-func (x *GetUserLevelReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[131]
+func (x *UserIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[269]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7428,84 +15745,81 @@ func (x *GetUserLevelReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserLevelReq.ProtoReflect.Descriptor instead.
-func (*GetUserLevelReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{131}
+// Deprecated: Use UserIdentity.ProtoReflect.Descriptor instead.
+func (*UserIdentity) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{269}
 }
 
-func (x *GetUserLevelReq) GetUserId() string {
+func (x *UserIdentity) GetId() string {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return ""
 }
 
-type GetUserLevelResp struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	LevelInfo     *UserLevelInfo         `protobuf:"bytes,1,opt,name=level_info,json=levelInfo,proto3" json:"level_info,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetUserLevelResp) Reset() {
-	*x = GetUserLevelResp{}
-	mi := &file_super_proto_msgTypes[132]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *UserIdentity) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
 }
 
-func (x *GetUserLevelResp) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *UserIdentity) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
 }
 
-func (*GetUserLevelResp) ProtoMessage() {}
-
-func (x *GetUserLevelResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[132]
+func (x *UserIdentity) GetDisplayName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.DisplayName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetUserLevelResp.ProtoReflect.Descriptor instead.
-func (*GetUserLevelResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{132}
+func (x *UserIdentity) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
 }
 
-func (x *GetUserLevelResp) GetLevelInfo() *UserLevelInfo {
+func (x *UserIdentity) GetLastLoginAt() string {
 	if x != nil {
-		return x.LevelInfo
+		return x.LastLoginAt
 	}
-	return nil
+	return ""
 }
 
-type GetCheckInStatusReq struct {
+// 绑定第三方账号：state 必须由同一用户以 link 发起
+type LinkUserIdentityReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Code          string                 `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,5,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,6,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCheckInStatusReq) Reset() {
-	*x = GetCheckInStatusReq{}
-	mi := &file_super_proto_msgTypes[133]
+func (x *LinkUserIdentityReq) Reset() {
+	*x = LinkUserIdentityReq{}
+	mi := &file_super_proto_msgTypes[270]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCheckInStatusReq) String() string {
+func (x *LinkUserIdentityReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCheckInStatusReq) ProtoMessage() {}
+func (*LinkUserIdentityReq) ProtoMessage() {}
 
-func (x *GetCheckInStatusReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[133]
+func (x *LinkUserIdentityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[270]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7516,40 +15830,75 @@ func (x *GetCheckInStatusReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCheckInStatusReq.ProtoReflect.Descriptor instead.
-func (*GetCheckInStatusReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{133}
+// Deprecated: Use LinkUserIdentityReq.ProtoReflect.Descriptor instead.
+func (*LinkUserIdentityReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{270}
 }
 
-func (x *GetCheckInStatusReq) GetUserId() string {
+func (x *LinkUserIdentityReq) GetActorUserId() string {
 	if x != nil {
-		return x.UserId
+		return x.ActorUserId
 	}
 	return ""
 }
 
-type GetCheckInStatusResp struct {
+func (x *LinkUserIdentityReq) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *LinkUserIdentityReq) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *LinkUserIdentityReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LinkUserIdentityReq) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *LinkUserIdentityReq) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+type UserIdentityResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        *CheckInStatus         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Identity      *UserIdentity          `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCheckInStatusResp) Reset() {
-	*x = GetCheckInStatusResp{}
-	mi := &file_super_proto_msgTypes[134]
+func (x *UserIdentityResp) Reset() {
+	*x = UserIdentityResp{}
+	mi := &file_super_proto_msgTypes[271]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCheckInStatusResp) String() string {
+func (x *UserIdentityResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCheckInStatusResp) ProtoMessage() {}
+func (*UserIdentityResp) ProtoMessage() {}
 
-func (x *GetCheckInStatusResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[134]
+func (x *UserIdentityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[271]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7560,42 +15909,40 @@ func (x *GetCheckInStatusResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCheckInStatusResp.ProtoReflect.Descriptor instead.
-func (*GetCheckInStatusResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{134}
+// Deprecated: Use UserIdentityResp.ProtoReflect.Descriptor instead.
+func (*UserIdentityResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{271}
 }
 
-func (x *GetCheckInStatusResp) GetStatus() *CheckInStatus {
+func (x *UserIdentityResp) GetIdentity() *UserIdentity {
 	if x != nil {
-		return x.Status
+		return x.Identity
 	}
 	return nil
 }
 
-type GetCheckInHistoryReq struct {
+type ListUserIdentitiesReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCheckInHistoryReq) Reset() {
-	*x = GetCheckInHistoryReq{}
-	mi := &file_super_proto_msgTypes[135]
+func (x *ListUserIdentitiesReq) Reset() {
+	*x = ListUserIdentitiesReq{}
+	mi := &file_super_proto_msgTypes[272]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCheckInHistoryReq) String() string {
+func (x *ListUserIdentitiesReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCheckInHistoryReq) ProtoMessage() {}
+func (*ListUserIdentitiesReq) ProtoMessage() {}
 
-func (x *GetCheckInHistoryReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[135]
+func (x *ListUserIdentitiesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[272]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7606,55 +15953,41 @@ func (x *GetCheckInHistoryReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCheckInHistoryReq.ProtoReflect.Descriptor instead.
-func (*GetCheckInHistoryReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{135}
+// Deprecated: Use ListUserIdentitiesReq.ProtoReflect.Descriptor instead.
+func (*ListUserIdentitiesReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{272}
 }
 
-func (x *GetCheckInHistoryReq) GetUserId() string {
+func (x *ListUserIdentitiesReq) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetCheckInHistoryReq) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetCheckInHistoryReq) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-type GetCheckInHistoryResp struct {
+type ListUserIdentitiesResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Records       []*CheckInRecord       `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Identities    []*UserIdentity        `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	HasPassword   bool                   `protobuf:"varint,2,opt,name=has_password,json=hasPassword,proto3" json:"has_password,omitempty"` // 自动注册的账号没有密码，解绑最后一个第三方账号前需要先设置密码
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCheckInHistoryResp) Reset() {
-	*x = GetCheckInHistoryResp{}
-	mi := &file_super_proto_msgTypes[136]
+func (x *ListUserIdentitiesResp) Reset() {
+	*x = ListUserIdentitiesResp{}
+	mi := &file_super_proto_msgTypes[273]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCheckInHistoryResp) String() string {
+func (x *ListUserIdentitiesResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCheckInHistoryResp) ProtoMessage() {}
+func (*ListUserIdentitiesResp) ProtoMessage() {}
 
-func (x *GetCheckInHistoryResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[136]
+func (x *ListUserIdentitiesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[273]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7665,49 +15998,50 @@ func (x *GetCheckInHistoryResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCheckInHistoryResp.ProtoReflect.Descriptor instead.
-func (*GetCheckInHistoryResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{136}
+// Deprecated: Use ListUserIdentitiesResp.ProtoReflect.Descriptor instead.
+func (*ListUserIdentitiesResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{273}
 }
 
-func (x *GetCheckInHistoryResp) GetRecords() []*CheckInRecord {
+func (x *ListUserIdentitiesResp) GetIdentities() []*UserIdentity {
 	if x != nil {
-		return x.Records
+		return x.Identities
 	}
 	return nil
 }
 
-func (x *GetCheckInHistoryResp) GetTotal() int32 {
+func (x *ListUserIdentitiesResp) GetHasPassword() bool {
 	if x != nil {
-		return x.Total
+		return x.HasPassword
 	}
-	return 0
+	return false
 }
 
-type GetExpLogsReq struct {
+type UnlinkUserIdentityReq struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	ActorUserId   string                 `protobuf:"bytes,1,opt,name=actor_user_id,json=actorUserId,proto3" json:"actor_user_id,omitempty"`
+	IdentityId    string                 `protobuf:"bytes,2,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExpLogsReq) Reset() {
-	*x = GetExpLogsReq{}
-	mi := &file_super_proto_msgTypes[137]
+func (x *UnlinkUserIdentityReq) Reset() {
+	*x = UnlinkUserIdentityReq{}
+	mi := &file_super_proto_msgTypes[274]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExpLogsReq) String() string {
+func (x *UnlinkUserIdentityReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExpLogsReq) ProtoMessage() {}
+func (*UnlinkUserIdentityReq) ProtoMessage() {}
 
-func (x *GetExpLogsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[137]
+func (x *UnlinkUserIdentityReq) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[274]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7718,55 +16052,60 @@ func (x *GetExpLogsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExpLogsReq.ProtoReflect.Descriptor instead.
-func (*GetExpLogsReq) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{137}
+// Deprecated: Use UnlinkUserIdentityReq.ProtoReflect.Descriptor instead.
+func (*UnlinkUserIdentityReq) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{274}
 }
 
-func (x *GetExpLogsReq) GetUserId() string {
+func (x *UnlinkUserIdentityReq) GetActorUserId() string {
 	if x != nil {
-		return x.UserId
+		return x.ActorUserId
 	}
 	return ""
 }
 
-func (x *GetExpLogsReq) GetPage() int32 {
+func (x *UnlinkUserIdentityReq) GetIdentityId() string {
 	if x != nil {
-		return x.Page
+		return x.IdentityId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetExpLogsReq) GetPageSize() int32 {
+func (x *UnlinkUserIdentityReq) GetClientIp() string {
 	if x != nil {
-		return x.PageSize
+		return x.ClientIp
 	}
-	return 0
+	return ""
 }
 
-type GetExpLogsResp struct {
+func (x *UnlinkUserIdentityReq) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+type UnlinkUserIdentityResp struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Logs          []*ExpLogRecord        `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExpLogsResp) Reset() {
-	*x = GetExpLogsResp{}
-	mi := &file_super_proto_msgTypes[138]
+func (x *UnlinkUserIdentityResp) Reset() {
+	*x = UnlinkUserIdentityResp{}
+	mi := &file_super_proto_msgTypes[275]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExpLogsResp) String() string {
+func (x *UnlinkUserIdentityResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExpLogsResp) ProtoMessage() {}
+func (*UnlinkUserIdentityResp) ProtoMessage() {}
 
-func (x *GetExpLogsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_super_proto_msgTypes[138]
+func (x *UnlinkUserIdentityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_super_proto_msgTypes[275]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7777,30 +16116,16 @@ func (x *GetExpLogsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExpLogsResp.ProtoReflect.Descriptor instead.
-func (*GetExpLogsResp) Descriptor() ([]byte, []int) {
-	return file_super_proto_rawDescGZIP(), []int{138}
-}
-
-func (x *GetExpLogsResp) GetLogs() []*ExpLogRecord {
-	if x != nil {
-		return x.Logs
-	}
-	return nil
-}
-
-func (x *GetExpLogsResp) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use UnlinkUserIdentityResp.ProtoReflect.Descriptor instead.
+func (*UnlinkUserIdentityResp) Descriptor() ([]byte, []int) {
+	return file_super_proto_rawDescGZIP(), []int{275}
 }
 
 var File_super_proto protoreflect.FileDescriptor
 
 const file_super_proto_rawDesc = "" +
 	"\n" +
-	"\vsuper.proto\x12\x05super\"\xc7\x03\n" +
+	"\vsuper.proto\x12\x05super\"\x93\x04\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x14\n" +
@@ -7821,20 +16146,100 @@ const file_super_proto_rawDesc = "" +
 	"\abalance\x18\r \x01(\x02R\abalance\x12\x1c\n" +
 	"\tinventory\x18\x0e \x01(\tR\tinventory\x12*\n" +
 	"\x11equipped_frame_id\x18\x0f \x01(\tR\x0fequippedFrameId\x12\x15\n" +
-	"\x06moe_no\x18\x10 \x01(\tR\x05moeNo\"[\n" +
+	"\x06moe_no\x18\x10 \x01(\tR\x05moeNo\x12%\n" +
+	"\x0eemail_verified\x18\x11 \x01(\bR\remailVerified\x12#\n" +
+	"\rpending_email\x18\x12 \x01(\tR\fpendingEmail\"o\n" +
 	"\vRegisterReq\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\"/\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04lang\x18\x04 \x01(\tR\x04lang\"/\n" +
 	"\fRegisterResp\x12\x1f\n" +
-	"\x04user\x18\x01 \x01(\v2\v.super.UserR\x04user\"X\n" +
+	"\x04user\x18\x01 \x01(\v2\v.super.UserR\x04user\"\xb9\x01\n" +
 	"\bLoginReq\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"B\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x1b\n" +
+	"\tclient_ip\x18\x04 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\x12#\n" +
+	"\rcaptcha_token\x18\x06 \x01(\tR\fcaptchaToken\"\x8d\x03\n" +
 	"\tLoginResp\x12\x1f\n" +
 	"\x04user\x18\x01 \x01(\v2\v.super.UserR\x04user\x12\x14\n" +
-	"\x05token\x18\x02 \x01(\tR\x05token\")\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x04 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x05 \x01(\x03R\texpiresIn\x12!\n" +
+	"\fmfa_required\x18\x06 \x01(\bR\vmfaRequired\x12\x1b\n" +
+	"\tmfa_token\x18\a \x01(\tR\bmfaToken\x12$\n" +
+	"\x0emfa_expires_in\x18\b \x01(\x03R\fmfaExpiresIn\x12,\n" +
+	"\x12mfa_setup_required\x18\t \x01(\bR\x10mfaSetupRequired\x122\n" +
+	"\x15deletion_scheduled_at\x18\n" +
+	" \x01(\tR\x13deletionScheduledAt\x12\x1e\n" +
+	"\n" +
+	"registered\x18\v \x01(\bR\n" +
+	"registered\"\x80\x01\n" +
+	"\x11VerifyLoginMfaReq\x12\x1b\n" +
+	"\tmfa_token\x18\x01 \x01(\tR\bmfaToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x1b\n" +
+	"\tclient_ip\x18\x03 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\"*\n" +
+	"\x0fGetMfaStatusReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xa1\x01\n" +
+	"\x10GetMfaStatusResp\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x1a\n" +
+	"\brequired\x18\x02 \x01(\bR\brequired\x128\n" +
+	"\x18recovery_codes_remaining\x18\x03 \x01(\x05R\x16recoveryCodesRemaining\x12\x1d\n" +
+	"\n" +
+	"enabled_at\x18\x04 \x01(\tR\tenabledAt\"1\n" +
+	"\x16BeginTotpEnrollmentReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"R\n" +
+	"\x17BeginTotpEnrollmentResp\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x1f\n" +
+	"\votpauth_uri\x18\x02 \x01(\tR\n" +
+	"otpauthUri\"\x83\x01\n" +
+	"\x18ConfirmTotpEnrollmentReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x1b\n" +
+	"\tclient_ip\x18\x03 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\":\n" +
+	"\x11RecoveryCodesResp\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"\x95\x01\n" +
+	"\x0eDisableTotpReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x1b\n" +
+	"\tclient_ip\x18\x04 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\"\x11\n" +
+	"\x0fDisableTotpResp\"\x85\x01\n" +
+	"\x1aRegenerateRecoveryCodesReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x1b\n" +
+	"\tclient_ip\x18\x03 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\"y\n" +
+	"\tMfaPolicy\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x1a\n" +
+	"\brequired\x18\x02 \x01(\bR\brequired\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x03 \x01(\tR\tupdatedBy\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\tR\tupdatedAt\"8\n" +
+	"\x12ListMfaPoliciesReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\"C\n" +
+	"\x13ListMfaPoliciesResp\x12,\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x10.super.MfaPolicyR\bpolicies\"e\n" +
+	"\x0fSetMfaPolicyReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\"<\n" +
+	"\x10SetMfaPolicyResp\x12(\n" +
+	"\x06policy\x18\x01 \x01(\v2\x10.super.MfaPolicyR\x06policy\")\n" +
 	"\x0eGetUserInfoReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"2\n" +
 	"\x0fGetUserInfoResp\x12\x1f\n" +
@@ -7861,19 +16266,179 @@ const file_super_proto_rawDesc = "" +
 	"\x14clear_equipped_frame\x18\n" +
 	" \x01(\bR\x12clearEquippedFrame\"5\n" +
 	"\x12UpdateUserInfoResp\x12\x1f\n" +
-	"\x04user\x18\x01 \x01(\v2\v.super.UserR\x04user\"v\n" +
+	"\x04user\x18\x01 \x01(\v2\v.super.UserR\x04user\"\xb2\x01\n" +
 	"\x15UpdateUserPasswordReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
 	"\fold_password\x18\x02 \x01(\tR\voldPassword\x12!\n" +
-	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"\x18\n" +
-	"\x16UpdateUserPasswordResp\"K\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\x12\x1b\n" +
+	"\tclient_ip\x18\x04 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\"\x18\n" +
+	"\x16UpdateUserPasswordResp\"`\n" +
+	"\x17RequestPasswordResetReq\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1b\n" +
+	"\tclient_ip\x18\x02 \x01(\tR\bclientIp\x12\x12\n" +
+	"\x04lang\x18\x03 \x01(\tR\x04lang\"\x1a\n" +
+	"\x18RequestPasswordResetResp\"\xb1\x01\n" +
 	"\x10ResetPasswordReq\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12!\n" +
-	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"\x13\n" +
-	"\x11ResetPasswordResp\"(\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\x12\x1b\n" +
+	"\tclient_ip\x18\x05 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x06 \x01(\tR\tuserAgent\",\n" +
+	"\x11ResetPasswordResp\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\".\n" +
+	"\x13GetUserAuthStateReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xbd\x01\n" +
+	"\x14GetUserAuthStateResp\x12#\n" +
+	"\rtoken_version\x18\x01 \x01(\x03R\ftokenVersion\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12.\n" +
+	"\x13revoked_session_ids\x18\x03 \x03(\tR\x11revokedSessionIds\x12\x1c\n" +
+	"\tsuspended\x18\x04 \x01(\bR\tsuspended\x12\x1e\n" +
+	"\n" +
+	"restricted\x18\x05 \x01(\bR\n" +
+	"restricted\"t\n" +
+	"\x11RefreshSessionReq\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x12\x1b\n" +
+	"\tclient_ip\x18\x02 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\"\xa6\x01\n" +
+	"\x12RefreshSessionResp\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x04 \x01(\tR\tsessionId\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x05 \x01(\x03R\texpiresIn\"\xb9\x01\n" +
+	"\vUserSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x02 \x01(\tR\tuserAgent\x12\x1b\n" +
+	"\tclient_ip\x18\x03 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\tR\tcreatedAt\x12 \n" +
+	"\flast_used_at\x18\x05 \x01(\tR\n" +
+	"lastUsedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\".\n" +
+	"\x13ListUserSessionsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"F\n" +
+	"\x14ListUserSessionsResp\x12.\n" +
+	"\bsessions\x18\x01 \x03(\v2\x12.super.UserSessionR\bsessions\"N\n" +
+	"\x14RevokeUserSessionReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"\x17\n" +
+	"\x15RevokeUserSessionResp\"3\n" +
+	"\x18RevokeAllUserSessionsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"<\n" +
+	"\x19RevokeAllUserSessionsResp\x12\x1f\n" +
+	"\vsession_ids\x18\x01 \x03(\tR\n" +
+	"sessionIds\"f\n" +
+	"\x10IssueWsTicketReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\"J\n" +
+	"\x11IssueWsTicketResp\x12\x16\n" +
+	"\x06ticket\x18\x01 \x01(\tR\x06ticket\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x02 \x01(\x03R\texpiresIn\"G\n" +
+	"\x11RedeemWsTicketReq\x12\x16\n" +
+	"\x06ticket\x18\x01 \x01(\tR\x06ticket\x12\x1a\n" +
+	"\bendpoint\x18\x02 \x01(\tR\bendpoint\"L\n" +
+	"\x12RedeemWsTicketResp\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"G\n" +
+	"\x18SendEmailVerificationReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04lang\x18\x02 \x01(\tR\x04lang\"1\n" +
+	"\x19SendEmailVerificationResp\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\"S\n" +
+	"\x0eVerifyEmailReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"2\n" +
+	"\x0fVerifyEmailResp\x12\x1f\n" +
+	"\x04user\x18\x01 \x01(\v2\v.super.UserR\x04user\"\x99\x01\n" +
+	"\x11UpdateUserRoleReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1b\n" +
+	"\tclient_ip\x18\x05 \x01(\tR\bclientIp\"f\n" +
+	"\x12UpdateUserRoleResp\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12#\n" +
+	"\rprevious_role\x18\x03 \x01(\tR\fpreviousRole\"\xfd\x01\n" +
+	"\fRoleAuditLog\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\"\n" +
+	"\ractor_user_id\x18\x03 \x01(\tR\vactorUserId\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x1b\n" +
+	"\tfrom_role\x18\x05 \x01(\tR\bfromRole\x12\x17\n" +
+	"\ato_role\x18\x06 \x01(\tR\x06toRole\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x1b\n" +
+	"\tclient_ip\x18\b \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"\x84\x01\n" +
+	"\x14ListRoleAuditLogsReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"V\n" +
+	"\x15ListRoleAuditLogsResp\x12'\n" +
+	"\x04logs\x18\x01 \x03(\v2\x13.super.RoleAuditLogR\x04logs\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xa6\x01\n" +
+	"\rSecurityEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tclient_ip\x18\x03 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\x12\x16\n" +
+	"\x06detail\x18\x05 \x01(\tR\x06detail\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"a\n" +
+	"\x15ListSecurityEventsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\\\n" +
+	"\x16ListSecurityEventsResp\x12,\n" +
+	"\x06events\x18\x01 \x03(\v2\x14.super.SecurityEventR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\x88\x01\n" +
 	"\rDeleteUserReq\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x10\n" +
-	"\x0eDeleteUserResp\"c\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\ractor_user_id\x18\x02 \x01(\tR\vactorUserId\x12\x1b\n" +
+	"\tclient_ip\x18\x03 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\"D\n" +
+	"\x0eDeleteUserResp\x122\n" +
+	"\bdeletion\x18\x01 \x01(\v2\x16.super.AccountDeletionR\bdeletion\"\x90\x01\n" +
+	"\x0fAccountDeletion\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12!\n" +
+	"\fscheduled_at\x18\x02 \x01(\tR\vscheduledAt\x12!\n" +
+	"\frequested_at\x18\x03 \x01(\tR\vrequestedAt\x12\x1f\n" +
+	"\vcanceled_at\x18\x04 \x01(\tR\n" +
+	"canceledAt\"0\n" +
+	"\x15GetAccountDeletionReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"L\n" +
+	"\x16GetAccountDeletionResp\x122\n" +
+	"\bdeletion\x18\x01 \x01(\v2\x16.super.AccountDeletionR\bdeletion\"o\n" +
+	"\x18CancelAccountDeletionReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tclient_ip\x18\x02 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\"\x1b\n" +
+	"\x19CancelAccountDeletionResp\",\n" +
+	"\x11ExportUserDataReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"5\n" +
+	"\vExportChunk\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"c\n" +
 	"\x10UpdateUserVipReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x15\n" +
 	"\x06is_vip\x18\x02 \x01(\bR\x05isVip\x12\x1f\n" +
@@ -8011,7 +16576,7 @@ const file_super_proto_rawDesc = "" +
 	"\bTopicTag\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05color\x18\x03 \x01(\tR\x05color\"\xbd\x03\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\"\xf2\x03\n" +
 	"\x04Post\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
@@ -8030,7 +16595,9 @@ const file_super_proto_rawDesc = "" +
 	"created_at\x18\v \x01(\tR\tcreatedAt\x12$\n" +
 	"\x0ehand_draw_card\x18\f \x01(\tR\fhandDrawCard\x12-\n" +
 	"\x13hand_draw_thumb_url\x18\r \x01(\tR\x10handDrawThumbUrl\x12+\n" +
-	"\x11moderation_status\x18\x0e \x01(\tR\x10moderationStatus\"\xc9\x01\n" +
+	"\x11moderation_status\x18\x0e \x01(\tR\x10moderationStatus\x12\x16\n" +
+	"\x06edited\x18\x0f \x01(\bR\x06edited\x12\x1b\n" +
+	"\tedited_at\x18\x10 \x01(\tR\beditedAt\"\xc9\x01\n" +
 	"\vGetPostsReq\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12$\n" +
@@ -8062,7 +16629,21 @@ const file_super_proto_rawDesc = "" +
 	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x10\n" +
 	"\x0eReportPostResp\"1\n" +
 	"\x0eCreatePostResp\x12\x1f\n" +
-	"\x04post\x18\x01 \x01(\v2\v.super.PostR\x04post\"?\n" +
+	"\x04post\x18\x01 \x01(\v2\v.super.PostR\x04post\"\xa3\x01\n" +
+	"\rUpdatePostReq\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x16\n" +
+	"\x06images\x18\x04 \x03(\tR\x06images\x12.\n" +
+	"\n" +
+	"topic_tags\x18\x05 \x03(\v2\x0f.super.TopicTagR\ttopicTags\"1\n" +
+	"\x0eUpdatePostResp\x12\x1f\n" +
+	"\x04post\x18\x01 \x01(\v2\v.super.PostR\x04post\"d\n" +
+	"\rDeletePostReq\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\"\n" +
+	"\ractor_user_id\x18\x02 \x01(\tR\vactorUserId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x10\n" +
+	"\x0eDeletePostResp\"?\n" +
 	"\vLikePostReq\x12\x17\n" +
 	"\apost_id\x18\x01 \x01(\tR\x06postId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"/\n" +
@@ -8099,7 +16680,7 @@ const file_super_proto_rawDesc = "" +
 	"comment_id\x18\x01 \x01(\tR\tcommentId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\";\n" +
 	"\x0fLikeCommentResp\x12(\n" +
-	"\acomment\x18\x01 \x01(\v2\x0e.super.CommentR\acomment\"\x99\x02\n" +
+	"\acomment\x18\x01 \x01(\v2\x0e.super.CommentR\acomment\"\x89\x04\n" +
 	"\fNotification\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
@@ -8113,7 +16694,22 @@ const file_super_proto_rawDesc = "" +
 	"\ais_read\x18\t \x01(\bR\x06isRead\x12\x1d\n" +
 	"\n" +
 	"created_at\x18\n" +
-	" \x01(\tR\tcreatedAt\"_\n" +
+	" \x01(\tR\tcreatedAt\x12\x12\n" +
+	"\x04kind\x18\v \x01(\tR\x04kind\x12\x14\n" +
+	"\x05title\x18\f \x01(\tR\x05title\x12\x1f\n" +
+	"\vtarget_type\x18\r \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x0e \x01(\tR\btargetId\x12\x14\n" +
+	"\x05route\x18\x0f \x01(\tR\x05route\x12\x1f\n" +
+	"\vactor_count\x18\x10 \x01(\x05R\n" +
+	"actorCount\x120\n" +
+	"\x06actors\x18\x11 \x03(\v2\x18.super.NotificationActorR\x06actors\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x12 \x01(\tR\tupdatedAt\"O\n" +
+	"\x11NotificationActor\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06avatar\x18\x03 \x01(\tR\x06avatar\"_\n" +
 	"\x13GetNotificationsReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
@@ -8131,15 +16727,139 @@ const file_super_proto_rawDesc = "" +
 	"\x14ReadNotificationResp\"2\n" +
 	"\x17ReadAllNotificationsReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x1a\n" +
-	"\x18ReadAllNotificationsResp\"\x94\x01\n" +
+	"\x18ReadAllNotificationsResp\"\xc5\x01\n" +
 	"\x15CreateNotificationReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
 	"\tsender_id\x18\x02 \x01(\tR\bsenderId\x12\x12\n" +
 	"\x04type\x18\x03 \x01(\x05R\x04type\x12\x17\n" +
 	"\apost_id\x18\x04 \x01(\tR\x06postId\x12\x18\n" +
-	"\acontent\x18\x05 \x01(\tR\acontent\"Q\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\x12\x12\n" +
+	"\x04kind\x18\x06 \x01(\tR\x04kind\x12\x1b\n" +
+	"\ttarget_id\x18\a \x01(\tR\btargetId\"Q\n" +
 	"\x16CreateNotificationResp\x127\n" +
-	"\fnotification\x18\x01 \x01(\v2\x13.super.NotificationR\fnotification\"\x9b\x01\n" +
+	"\fnotification\x18\x01 \x01(\v2\x13.super.NotificationR\fnotification\"8\n" +
+	"\x15WatchNotificationsReq\x12\x1f\n" +
+	"\vinstance_id\x18\x01 \x01(\tR\n" +
+	"instanceId\"\x97\x01\n" +
+	"\x11NotificationEvent\x127\n" +
+	"\fnotification\x18\x01 \x01(\v2\x13.super.NotificationR\fnotification\x12!\n" +
+	"\funread_count\x18\x02 \x01(\x05R\vunreadCount\x12&\n" +
+	"\x04kick\x18\x03 \x01(\v2\x12.super.SessionKickR\x04kick\"G\n" +
+	"\vSessionKick\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vsession_ids\x18\x02 \x03(\tR\n" +
+	"sessionIds\"\x87\x01\n" +
+	"\x1aNotificationKindPreference\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x15\n" +
+	"\x06in_app\x18\x03 \x01(\bR\x05inApp\x12\x12\n" +
+	"\x04push\x18\x04 \x01(\bR\x04push\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\bR\x05email\"Z\n" +
+	"\x16NotificationQuietHours\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x14\n" +
+	"\x05start\x18\x02 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x03 \x01(\tR\x03end\"o\n" +
+	"\x10NotificationMute\x12\x1f\n" +
+	"\vtarget_type\x18\x01 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\"\xdd\x01\n" +
+	"\x17NotificationPreferences\x127\n" +
+	"\x05kinds\x18\x01 \x03(\v2!.super.NotificationKindPreferenceR\x05kinds\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x12>\n" +
+	"\vquiet_hours\x18\x03 \x01(\v2\x1d.super.NotificationQuietHoursR\n" +
+	"quietHours\x12-\n" +
+	"\x05mutes\x18\x04 \x03(\v2\x17.super.NotificationMuteR\x05mutes\"8\n" +
+	"\x1dGetNotificationPreferencesReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xd0\x01\n" +
+	" UpdateNotificationPreferencesReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\x05kinds\x18\x02 \x03(\v2!.super.NotificationKindPreferenceR\x05kinds\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12>\n" +
+	"\vquiet_hours\x18\x04 \x01(\v2\x1d.super.NotificationQuietHoursR\n" +
+	"quietHours\"_\n" +
+	"\x1bNotificationPreferencesResp\x12@\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x1e.super.NotificationPreferencesR\vpreferences\"\x85\x01\n" +
+	"\x16SetNotificationMuteReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vtarget_type\x18\x02 \x01(\tR\n" +
+	"targetType\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x14\n" +
+	"\x05muted\x18\x04 \x01(\bR\x05muted\"\x19\n" +
+	"\x17SetNotificationMuteResp\"\xd5\x03\n" +
+	"\x14NotificationCampaign\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"creator_id\x18\x02 \x01(\tR\tcreatorId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x04 \x01(\tR\acontent\x12\x18\n" +
+	"\asegment\x18\x05 \x01(\tR\asegment\x12\x1b\n" +
+	"\tmin_level\x18\x06 \x01(\x05R\bminLevel\x12#\n" +
+	"\rinactive_days\x18\a \x01(\x05R\finactiveDays\x12!\n" +
+	"\fscheduled_at\x18\b \x01(\tR\vscheduledAt\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12\x1e\n" +
+	"\n" +
+	"recipients\x18\v \x01(\x05R\n" +
+	"recipients\x12\x1c\n" +
+	"\tdelivered\x18\f \x01(\x05R\tdelivered\x12\x12\n" +
+	"\x04read\x18\r \x01(\x05R\x04read\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\x0e \x01(\tR\tstartedAt\x12!\n" +
+	"\fcompleted_at\x18\x0f \x01(\tR\vcompletedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\tR\tcreatedAt\"\xf2\x01\n" +
+	"\x1dCreateNotificationCampaignReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x18\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\x12\x18\n" +
+	"\asegment\x18\x04 \x01(\tR\asegment\x12\x1b\n" +
+	"\tmin_level\x18\x05 \x01(\x05R\bminLevel\x12#\n" +
+	"\rinactive_days\x18\x06 \x01(\x05R\finactiveDays\x12!\n" +
+	"\fscheduled_at\x18\a \x01(\tR\vscheduledAt\"S\n" +
+	"\x18NotificationCampaignResp\x127\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x1b.super.NotificationCampaignR\bcampaign\"s\n" +
+	"\x1cListNotificationCampaignsReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"p\n" +
+	"\x1dListNotificationCampaignsResp\x129\n" +
+	"\tcampaigns\x18\x01 \x03(\v2\x1b.super.NotificationCampaignR\tcampaigns\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"P\n" +
+	"\x1aGetNotificationCampaignReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x9b\x01\n" +
+	"\x11RegisterDeviceReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12\x1a\n" +
+	"\bplatform\x18\x03 \x01(\tR\bplatform\x12\x1a\n" +
+	"\bprovider\x18\x04 \x01(\tR\bprovider\x12\x1f\n" +
+	"\vapp_version\x18\x05 \x01(\tR\n" +
+	"appVersion\"\x14\n" +
+	"\x12RegisterDeviceResp\"D\n" +
+	"\x13UnregisterDeviceReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x16\n" +
+	"\x14UnregisterDeviceResp\"\xc9\x02\n" +
+	"\x11SendDevicePushReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\x126\n" +
+	"\x04data\x18\x05 \x03(\v2\".super.SendDevicePushReq.DataEntryR\x04data\x12#\n" +
+	"\rhigh_priority\x18\x06 \x01(\bR\fhighPriority\x12!\n" +
+	"\fcollapse_key\x18\a \x01(\tR\vcollapseKey\x12\x1f\n" +
+	"\vttl_seconds\x18\b \x01(\x05R\n" +
+	"ttlSeconds\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Z\n" +
+	"\x12SendDevicePushResp\x12\x12\n" +
+	"\x04sent\x18\x01 \x01(\x05R\x04sent\x12\x16\n" +
+	"\x06pruned\x18\x02 \x01(\x05R\x06pruned\x12\x18\n" +
+	"\askipped\x18\x03 \x01(\bR\askipped\"\x9b\x01\n" +
 	"\n" +
 	"UserMemory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
@@ -8206,35 +16926,98 @@ const file_super_proto_rawDesc = "" +
 	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\"\n" +
 	"\rother_user_id\x18\x02 \x01(\tR\votherUserId\"3\n" +
 	"\x15GetFriendRelationResp\x12\x1a\n" +
-	"\brelation\x18\x01 \x01(\tR\brelation\"K\n" +
+	"\brelation\x18\x01 \x01(\tR\brelation\"X\n" +
+	"\fBlockUserReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12$\n" +
+	"\x0etarget_user_id\x18\x02 \x01(\tR\ftargetUserId\"\x1f\n" +
+	"\rBlockUserResp\x12\x0e\n" +
+	"\x02ok\x18\x01 \x01(\bR\x02ok\"j\n" +
+	"\x13ListBlockedUsersReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"M\n" +
+	"\vBlockedUser\x12\x1f\n" +
+	"\x04user\x18\x01 \x01(\v2\v.super.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"blocked_at\x18\x02 \x01(\tR\tblockedAt\"V\n" +
+	"\x14ListBlockedUsersResp\x12(\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.super.BlockedUserR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"P\n" +
+	"\x11CheckUserBlockReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\"\n" +
+	"\rother_user_id\x18\x02 \x01(\tR\votherUserId\".\n" +
+	"\x12CheckUserBlockResp\x12\x18\n" +
+	"\ablocked\x18\x01 \x01(\bR\ablocked\"/\n" +
+	"\x14GetBlockedUserIdsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"2\n" +
+	"\x15GetBlockedUserIdsResp\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\tR\auserIds\"K\n" +
 	"\rFollowUserReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
-	"\ffollowing_id\x18\x02 \x01(\tR\vfollowingId\"*\n" +
+	"\ffollowing_id\x18\x02 \x01(\tR\vfollowingId\"D\n" +
 	"\x0eFollowUserResp\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"M\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\apending\x18\x02 \x01(\bR\apending\"M\n" +
 	"\x0fUnfollowUserReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
-	"\ffollowing_id\x18\x02 \x01(\tR\vfollowingId\"\\\n" +
+	"\ffollowing_id\x18\x02 \x01(\tR\vfollowingId\"\x82\x01\n" +
 	"\x10GetFollowingsReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"L\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12$\n" +
+	"\x0eviewer_user_id\x18\x04 \x01(\tR\fviewerUserId\"L\n" +
 	"\x11GetFollowingsResp\x12!\n" +
 	"\x05users\x18\x01 \x03(\v2\v.super.UserR\x05users\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"[\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x81\x01\n" +
 	"\x0fGetFollowersReq\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"K\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12$\n" +
+	"\x0eviewer_user_id\x18\x04 \x01(\tR\fviewerUserId\"K\n" +
 	"\x10GetFollowersResp\x12!\n" +
 	"\x05users\x18\x01 \x03(\v2\v.super.UserR\x05users\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"T\n" +
 	"\x0eCheckFollowReq\x12\x1f\n" +
 	"\vfollower_id\x18\x01 \x01(\tR\n" +
 	"followerId\x12!\n" +
-	"\ffollowing_id\x18\x02 \x01(\tR\vfollowingId\"4\n" +
+	"\ffollowing_id\x18\x02 \x01(\tR\vfollowingId\"R\n" +
 	"\x0fCheckFollowResp\x12!\n" +
-	"\fis_following\x18\x01 \x01(\bR\visFollowing\"\xa9\x01\n" +
+	"\fis_following\x18\x01 \x01(\bR\visFollowing\x12\x1c\n" +
+	"\trequested\x18\x02 \x01(\bR\trequested\"\xba\x01\n" +
+	"\x0fPrivacySettings\x12'\n" +
+	"\x0fprivate_account\x18\x01 \x01(\bR\x0eprivateAccount\x12#\n" +
+	"\rdm_permission\x18\x02 \x01(\tR\fdmPermission\x12-\n" +
+	"\x12comment_permission\x18\x03 \x01(\tR\x11commentPermission\x12*\n" +
+	"\x11hide_follow_lists\x18\x04 \x01(\bR\x0fhideFollowLists\"0\n" +
+	"\x15GetPrivacySettingsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"g\n" +
+	"\x18UpdatePrivacySettingsReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x122\n" +
+	"\bsettings\x18\x02 \x01(\v2\x16.super.PrivacySettingsR\bsettings\"I\n" +
+	"\x13PrivacySettingsResp\x122\n" +
+	"\bsettings\x18\x01 \x01(\v2\x16.super.PrivacySettingsR\bsettings\"c\n" +
+	"\x11FollowRequestView\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\x04user\x18\x02 \x01(\v2\v.super.UserR\x04user\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\tR\tcreatedAt\"l\n" +
+	"\x15ListFollowRequestsReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"d\n" +
+	"\x16ListFollowRequestsResp\x124\n" +
+	"\brequests\x18\x01 \x03(\v2\x18.super.FollowRequestViewR\brequests\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"v\n" +
+	"\x17RespondFollowRequestReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\"\x1a\n" +
+	"\x18RespondFollowRequestResp\"W\n" +
+	"\x15CheckDirectMessageReq\x12\x1b\n" +
+	"\tsender_id\x18\x01 \x01(\tR\bsenderId\x12!\n" +
+	"\frecipient_id\x18\x02 \x01(\tR\vrecipientId\"\x18\n" +
+	"\x16CheckDirectMessageResp\"\xa9\x01\n" +
 	"\x10AvatarBaseConfig\x12\x1d\n" +
 	"\n" +
 	"face_shape\x18\x01 \x01(\tR\tfaceShape\x12\x1d\n" +
@@ -8333,7 +17116,194 @@ const file_super_proto_rawDesc = "" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"O\n" +
 	"\x0eGetExpLogsResp\x12'\n" +
 	"\x04logs\x18\x01 \x03(\v2\x13.super.ExpLogRecordR\x04logs\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total2\x90!\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"b\n" +
+	"\fSignedPreKey\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\rR\x05keyId\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x02 \x01(\tR\tpublicKey\x12\x1c\n" +
+	"\tsignature\x18\x03 \x01(\tR\tsignature\"\xe0\x01\n" +
+	"\fPreKeyBundle\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12!\n" +
+	"\fidentity_key\x18\x03 \x01(\tR\videntityKey\x129\n" +
+	"\x0esigned_pre_key\x18\x04 \x01(\v2\x13.super.SignedPreKeyR\fsignedPreKey\x12<\n" +
+	"\x10one_time_pre_key\x18\x05 \x01(\v2\x13.super.SignedPreKeyR\roneTimePreKey\"\xf6\x01\n" +
+	"\x15UploadPreKeyBundleReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12!\n" +
+	"\fidentity_key\x18\x03 \x01(\tR\videntityKey\x129\n" +
+	"\x0esigned_pre_key\x18\x04 \x01(\v2\x13.super.SignedPreKeyR\fsignedPreKey\x12>\n" +
+	"\x11one_time_pre_keys\x18\x05 \x03(\v2\x13.super.SignedPreKeyR\x0eoneTimePreKeys\"V\n" +
+	"\x16UploadPreKeyBundleResp\x12<\n" +
+	"\x1bremaining_one_time_pre_keys\x18\x01 \x01(\x05R\x17remainingOneTimePreKeys\"o\n" +
+	"\x13GetPreKeyBundlesReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tdevice_id\x18\x03 \x01(\tR\bdeviceId\"E\n" +
+	"\x14GetPreKeyBundlesResp\x12-\n" +
+	"\abundles\x18\x01 \x03(\v2\x13.super.PreKeyBundleR\abundles\"\x9e\x02\n" +
+	"\x10EncryptedMessage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tsender_id\x18\x02 \x01(\tR\bsenderId\x12(\n" +
+	"\x10sender_device_id\x18\x03 \x01(\tR\x0esenderDeviceId\x12!\n" +
+	"\frecipient_id\x18\x04 \x01(\tR\vrecipientId\x12.\n" +
+	"\x13recipient_device_id\x18\x05 \x01(\tR\x11recipientDeviceId\x12!\n" +
+	"\fmessage_type\x18\x06 \x01(\x05R\vmessageType\x12\x1e\n" +
+	"\n" +
+	"ciphertext\x18\a \x01(\tR\n" +
+	"ciphertext\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\xfe\x01\n" +
+	"\x18StoreEncryptedMessageReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12(\n" +
+	"\x10sender_device_id\x18\x02 \x01(\tR\x0esenderDeviceId\x12!\n" +
+	"\frecipient_id\x18\x03 \x01(\tR\vrecipientId\x12.\n" +
+	"\x13recipient_device_id\x18\x04 \x01(\tR\x11recipientDeviceId\x12!\n" +
+	"\fmessage_type\x18\x05 \x01(\x05R\vmessageType\x12\x1e\n" +
+	"\n" +
+	"ciphertext\x18\x06 \x01(\tR\n" +
+	"ciphertext\"N\n" +
+	"\x19StoreEncryptedMessageResp\x121\n" +
+	"\amessage\x18\x01 \x01(\v2\x17.super.EncryptedMessageR\amessage\"x\n" +
+	"\x1fListPendingEncryptedMessagesReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"W\n" +
+	" ListPendingEncryptedMessagesResp\x123\n" +
+	"\bmessages\x18\x01 \x03(\v2\x17.super.EncryptedMessageR\bmessages\"l\n" +
+	"\x17AckEncryptedMessagesReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\x12\x10\n" +
+	"\x03ids\x18\x03 \x03(\tR\x03ids\"0\n" +
+	"\x18AckEncryptedMessagesResp\x12\x14\n" +
+	"\x05acked\x18\x01 \x01(\x05R\x05acked\"\xb7\x02\n" +
+	"\n" +
+	"Suspension\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12!\n" +
+	"\fhide_content\x18\x05 \x01(\bR\vhideContent\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\tlifted_at\x18\t \x01(\tR\bliftedAt\x12\x1f\n" +
+	"\vlift_reason\x18\n" +
+	" \x01(\tR\n" +
+	"liftReason\x12\x16\n" +
+	"\x06active\x18\v \x01(\bR\x06active\"\xc7\x01\n" +
+	"\x0eSuspendUserReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12)\n" +
+	"\x10duration_seconds\x18\x05 \x01(\x03R\x0fdurationSeconds\x12!\n" +
+	"\fhide_content\x18\x06 \x01(\bR\vhideContent\"h\n" +
+	"\x11LiftSuspensionReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"/\n" +
+	"\x14GetUserSuspensionReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"C\n" +
+	"\x0eSuspensionResp\x121\n" +
+	"\n" +
+	"suspension\x18\x01 \x01(\v2\x11.super.SuspensionR\n" +
+	"suspension\"q\n" +
+	"\x19SubmitSuspensionAppealReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\fappeal_token\x18\x02 \x01(\tR\vappealToken\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xcf\x02\n" +
+	"\x10SuspensionAppeal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rsuspension_id\x18\x02 \x01(\tR\fsuspensionId\x12\x1f\n" +
+	"\x04user\x18\x03 \x01(\v2\v.super.UserR\x04user\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x1f\n" +
+	"\vreview_note\x18\x06 \x01(\tR\n" +
+	"reviewNote\x12\x1f\n" +
+	"\vreviewed_by\x18\a \x01(\tR\n" +
+	"reviewedBy\x12\x1f\n" +
+	"\vreviewed_at\x18\b \x01(\tR\n" +
+	"reviewedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x121\n" +
+	"\n" +
+	"suspension\x18\n" +
+	" \x01(\v2\x11.super.SuspensionR\n" +
+	"suspension\"G\n" +
+	"\x14SuspensionAppealResp\x12/\n" +
+	"\x06appeal\x18\x01 \x01(\v2\x17.super.SuspensionAppealR\x06appeal\"\x87\x01\n" +
+	"\x18ListSuspensionAppealsReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"d\n" +
+	"\x19ListSuspensionAppealsResp\x121\n" +
+	"\aappeals\x18\x01 \x03(\v2\x17.super.SuspensionAppealR\aappeals\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\x8a\x01\n" +
+	"\x19ReviewSuspensionAppealReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x1b\n" +
+	"\tappeal_id\x18\x02 \x01(\tR\bappealId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"E\n" +
+	"\fOidcProvider\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\"\x16\n" +
+	"\x14ListOidcProvidersReq\"J\n" +
+	"\x15ListOidcProvidersResp\x121\n" +
+	"\tproviders\x18\x01 \x03(\v2\x13.super.OidcProviderR\tproviders\"l\n" +
+	"\x10StartOidcAuthReq\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x18\n" +
+	"\apurpose\x18\x02 \x01(\tR\apurpose\x12\"\n" +
+	"\ractor_user_id\x18\x03 \x01(\tR\vactorUserId\"u\n" +
+	"\x11StartOidcAuthResp\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"\x90\x01\n" +
+	"\fOidcLoginReq\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x1b\n" +
+	"\tclient_ip\x18\x04 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x05 \x01(\tR\tuserAgent\"\xb6\x01\n" +
+	"\fUserIdentity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12!\n" +
+	"\fdisplay_name\x18\x04 \x01(\tR\vdisplayName\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\x12\"\n" +
+	"\rlast_login_at\x18\x06 \x01(\tR\vlastLoginAt\"\xbb\x01\n" +
+	"\x13LinkUserIdentityReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\x12\x1b\n" +
+	"\tclient_ip\x18\x05 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x06 \x01(\tR\tuserAgent\"C\n" +
+	"\x10UserIdentityResp\x12/\n" +
+	"\bidentity\x18\x01 \x01(\v2\x13.super.UserIdentityR\bidentity\"0\n" +
+	"\x15ListUserIdentitiesReq\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"p\n" +
+	"\x16ListUserIdentitiesResp\x123\n" +
+	"\n" +
+	"identities\x18\x01 \x03(\v2\x13.super.UserIdentityR\n" +
+	"identities\x12!\n" +
+	"\fhas_password\x18\x02 \x01(\bR\vhasPassword\"\x98\x01\n" +
+	"\x15UnlinkUserIdentityReq\x12\"\n" +
+	"\ractor_user_id\x18\x01 \x01(\tR\vactorUserId\x12\x1f\n" +
+	"\videntity_id\x18\x02 \x01(\tR\n" +
+	"identityId\x12\x1b\n" +
+	"\tclient_ip\x18\x03 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\"\x18\n" +
+	"\x16UnlinkUserIdentityResp2\xeaH\n" +
 	"\x05Super\x123\n" +
 	"\bRegister\x12\x12.super.RegisterReq\x1a\x13.super.RegisterResp\x12*\n" +
 	"\x05Login\x12\x0f.super.LoginReq\x1a\x10.super.LoginResp\x12<\n" +
@@ -8341,10 +17311,34 @@ const file_super_proto_rawDesc = "" +
 	"\aGetUser\x12\x11.super.GetUserReq\x1a\x12.super.GetUserResp\x12E\n" +
 	"\x0eGetUserByEmail\x12\x18.super.GetUserByEmailReq\x1a\x19.super.GetUserByEmailResp\x12E\n" +
 	"\x0eUpdateUserInfo\x12\x18.super.UpdateUserInfoReq\x1a\x19.super.UpdateUserInfoResp\x12Q\n" +
-	"\x12UpdateUserPassword\x12\x1c.super.UpdateUserPasswordReq\x1a\x1d.super.UpdateUserPasswordResp\x12B\n" +
-	"\rResetPassword\x12\x17.super.ResetPasswordReq\x1a\x18.super.ResetPasswordResp\x129\n" +
+	"\x12UpdateUserPassword\x12\x1c.super.UpdateUserPasswordReq\x1a\x1d.super.UpdateUserPasswordResp\x12W\n" +
+	"\x14RequestPasswordReset\x12\x1e.super.RequestPasswordResetReq\x1a\x1f.super.RequestPasswordResetResp\x12B\n" +
+	"\rResetPassword\x12\x17.super.ResetPasswordReq\x1a\x18.super.ResetPasswordResp\x12K\n" +
+	"\x10GetUserAuthState\x12\x1a.super.GetUserAuthStateReq\x1a\x1b.super.GetUserAuthStateResp\x12E\n" +
+	"\x0eRefreshSession\x12\x18.super.RefreshSessionReq\x1a\x19.super.RefreshSessionResp\x12K\n" +
+	"\x10ListUserSessions\x12\x1a.super.ListUserSessionsReq\x1a\x1b.super.ListUserSessionsResp\x12N\n" +
+	"\x11RevokeUserSession\x12\x1b.super.RevokeUserSessionReq\x1a\x1c.super.RevokeUserSessionResp\x12Z\n" +
+	"\x15RevokeAllUserSessions\x12\x1f.super.RevokeAllUserSessionsReq\x1a .super.RevokeAllUserSessionsResp\x12B\n" +
+	"\rIssueWsTicket\x12\x17.super.IssueWsTicketReq\x1a\x18.super.IssueWsTicketResp\x12E\n" +
+	"\x0eRedeemWsTicket\x12\x18.super.RedeemWsTicketReq\x1a\x19.super.RedeemWsTicketResp\x12Z\n" +
+	"\x15SendEmailVerification\x12\x1f.super.SendEmailVerificationReq\x1a .super.SendEmailVerificationResp\x12<\n" +
+	"\vVerifyEmail\x12\x15.super.VerifyEmailReq\x1a\x16.super.VerifyEmailResp\x12E\n" +
+	"\x0eUpdateUserRole\x12\x18.super.UpdateUserRoleReq\x1a\x19.super.UpdateUserRoleResp\x12N\n" +
+	"\x11ListRoleAuditLogs\x12\x1b.super.ListRoleAuditLogsReq\x1a\x1c.super.ListRoleAuditLogsResp\x12Q\n" +
+	"\x12ListSecurityEvents\x12\x1c.super.ListSecurityEventsReq\x1a\x1d.super.ListSecurityEventsResp\x12<\n" +
+	"\x0eVerifyLoginMfa\x12\x18.super.VerifyLoginMfaReq\x1a\x10.super.LoginResp\x12?\n" +
+	"\fGetMfaStatus\x12\x16.super.GetMfaStatusReq\x1a\x17.super.GetMfaStatusResp\x12T\n" +
+	"\x13BeginTotpEnrollment\x12\x1d.super.BeginTotpEnrollmentReq\x1a\x1e.super.BeginTotpEnrollmentResp\x12R\n" +
+	"\x15ConfirmTotpEnrollment\x12\x1f.super.ConfirmTotpEnrollmentReq\x1a\x18.super.RecoveryCodesResp\x12<\n" +
+	"\vDisableTotp\x12\x15.super.DisableTotpReq\x1a\x16.super.DisableTotpResp\x12V\n" +
+	"\x17RegenerateRecoveryCodes\x12!.super.RegenerateRecoveryCodesReq\x1a\x18.super.RecoveryCodesResp\x12H\n" +
+	"\x0fListMfaPolicies\x12\x19.super.ListMfaPoliciesReq\x1a\x1a.super.ListMfaPoliciesResp\x12?\n" +
+	"\fSetMfaPolicy\x12\x16.super.SetMfaPolicyReq\x1a\x17.super.SetMfaPolicyResp\x129\n" +
 	"\n" +
-	"DeleteUser\x12\x14.super.DeleteUserReq\x1a\x15.super.DeleteUserResp\x12B\n" +
+	"DeleteUser\x12\x14.super.DeleteUserReq\x1a\x15.super.DeleteUserResp\x12Q\n" +
+	"\x12GetAccountDeletion\x12\x1c.super.GetAccountDeletionReq\x1a\x1d.super.GetAccountDeletionResp\x12Z\n" +
+	"\x15CancelAccountDeletion\x12\x1f.super.CancelAccountDeletionReq\x1a .super.CancelAccountDeletionResp\x12@\n" +
+	"\x0eExportUserData\x12\x18.super.ExportUserDataReq\x1a\x12.super.ExportChunk0\x01\x12B\n" +
 	"\rUpdateUserVip\x12\x17.super.UpdateUserVipReq\x1a\x18.super.UpdateUserVipResp\x123\n" +
 	"\bGetUsers\x12\x12.super.GetUsersReq\x1a\x13.super.GetUsersResp\x12?\n" +
 	"\fGetUserCount\x12\x16.super.GetUserCountReq\x1a\x17.super.GetUserCountResp\x12K\n" +
@@ -8368,6 +17362,10 @@ const file_super_proto_rawDesc = "" +
 	"\n" +
 	"CreatePost\x12\x14.super.CreatePostReq\x1a\x15.super.CreatePostResp\x129\n" +
 	"\n" +
+	"UpdatePost\x12\x14.super.UpdatePostReq\x1a\x15.super.UpdatePostResp\x129\n" +
+	"\n" +
+	"DeletePost\x12\x14.super.DeletePostReq\x1a\x15.super.DeletePostResp\x129\n" +
+	"\n" +
 	"ReportPost\x12\x14.super.ReportPostReq\x1a\x15.super.ReportPostResp\x123\n" +
 	"\bLikePost\x12\x12.super.LikePostReq\x1a\x13.super.LikePostResp\x12H\n" +
 	"\x0fGetPostComments\x12\x19.super.GetPostCommentsReq\x1a\x1a.super.GetPostCommentsResp\x12B\n" +
@@ -8377,7 +17375,18 @@ const file_super_proto_rawDesc = "" +
 	"\x0eGetUnreadCount\x12\x18.super.GetUnreadCountReq\x1a\x19.super.GetUnreadCountResp\x12K\n" +
 	"\x10ReadNotification\x12\x1a.super.ReadNotificationReq\x1a\x1b.super.ReadNotificationResp\x12W\n" +
 	"\x14ReadAllNotifications\x12\x1e.super.ReadAllNotificationsReq\x1a\x1f.super.ReadAllNotificationsResp\x12Q\n" +
-	"\x12CreateNotification\x12\x1c.super.CreateNotificationReq\x1a\x1d.super.CreateNotificationResp\x123\n" +
+	"\x12CreateNotification\x12\x1c.super.CreateNotificationReq\x1a\x1d.super.CreateNotificationResp\x12N\n" +
+	"\x12WatchNotifications\x12\x1c.super.WatchNotificationsReq\x1a\x18.super.NotificationEvent0\x01\x12f\n" +
+	"\x1aGetNotificationPreferences\x12$.super.GetNotificationPreferencesReq\x1a\".super.NotificationPreferencesResp\x12l\n" +
+	"\x1dUpdateNotificationPreferences\x12'.super.UpdateNotificationPreferencesReq\x1a\".super.NotificationPreferencesResp\x12T\n" +
+	"\x13SetNotificationMute\x12\x1d.super.SetNotificationMuteReq\x1a\x1e.super.SetNotificationMuteResp\x12c\n" +
+	"\x1aCreateNotificationCampaign\x12$.super.CreateNotificationCampaignReq\x1a\x1f.super.NotificationCampaignResp\x12f\n" +
+	"\x19ListNotificationCampaigns\x12#.super.ListNotificationCampaignsReq\x1a$.super.ListNotificationCampaignsResp\x12]\n" +
+	"\x17GetNotificationCampaign\x12!.super.GetNotificationCampaignReq\x1a\x1f.super.NotificationCampaignResp\x12`\n" +
+	"\x1aCancelNotificationCampaign\x12!.super.GetNotificationCampaignReq\x1a\x1f.super.NotificationCampaignResp\x12E\n" +
+	"\x0eRegisterDevice\x12\x18.super.RegisterDeviceReq\x1a\x19.super.RegisterDeviceResp\x12K\n" +
+	"\x10UnregisterDevice\x12\x1a.super.UnregisterDeviceReq\x1a\x1b.super.UnregisterDeviceResp\x12E\n" +
+	"\x0eSendDevicePush\x12\x18.super.SendDevicePushReq\x1a\x19.super.SendDevicePushResp\x123\n" +
 	"\bRecharge\x12\x12.super.RechargeReq\x1a\x13.super.RechargeResp\x12H\n" +
 	"\x0fGetTransactions\x12\x19.super.GetTransactionsReq\x1a\x1a.super.GetTransactionsResp\x12E\n" +
 	"\x0eGetTransaction\x12\x18.super.GetTransactionReq\x1a\x19.super.GetTransactionResp\x129\n" +
@@ -8386,14 +17395,36 @@ const file_super_proto_rawDesc = "" +
 	"\fUnfollowUser\x12\x16.super.UnfollowUserReq\x1a\x15.super.FollowUserResp\x12B\n" +
 	"\rGetFollowings\x12\x17.super.GetFollowingsReq\x1a\x18.super.GetFollowingsResp\x12?\n" +
 	"\fGetFollowers\x12\x16.super.GetFollowersReq\x1a\x17.super.GetFollowersResp\x12<\n" +
-	"\vCheckFollow\x12\x15.super.CheckFollowReq\x1a\x16.super.CheckFollowResp\x12N\n" +
+	"\vCheckFollow\x12\x15.super.CheckFollowReq\x1a\x16.super.CheckFollowResp\x12Q\n" +
+	"\x12ListFollowRequests\x12\x1c.super.ListFollowRequestsReq\x1a\x1d.super.ListFollowRequestsResp\x12W\n" +
+	"\x14RespondFollowRequest\x12\x1e.super.RespondFollowRequestReq\x1a\x1f.super.RespondFollowRequestResp\x12N\n" +
+	"\x12GetPrivacySettings\x12\x1c.super.GetPrivacySettingsReq\x1a\x1a.super.PrivacySettingsResp\x12T\n" +
+	"\x15UpdatePrivacySettings\x12\x1f.super.UpdatePrivacySettingsReq\x1a\x1a.super.PrivacySettingsResp\x12Q\n" +
+	"\x12CheckDirectMessage\x12\x1c.super.CheckDirectMessageReq\x1a\x1d.super.CheckDirectMessageResp\x12N\n" +
 	"\x11SendFriendRequest\x12\x1b.super.SendFriendRequestReq\x1a\x1c.super.SendFriendRequestResp\x12i\n" +
 	"\x1aListIncomingFriendRequests\x12$.super.ListIncomingFriendRequestsReq\x1a%.super.ListIncomingFriendRequestsResp\x12i\n" +
 	"\x1aListOutgoingFriendRequests\x12$.super.ListOutgoingFriendRequestsReq\x1a%.super.ListOutgoingFriendRequestsResp\x12T\n" +
 	"\x13AcceptFriendRequest\x12\x1d.super.AcceptFriendRequestReq\x1a\x1e.super.AcceptFriendRequestResp\x12T\n" +
 	"\x13RejectFriendRequest\x12\x1d.super.RejectFriendRequestReq\x1a\x1e.super.RejectFriendRequestResp\x12<\n" +
 	"\vListFriends\x12\x15.super.ListFriendsReq\x1a\x16.super.ListFriendsResp\x12N\n" +
-	"\x11GetFriendRelation\x12\x1b.super.GetFriendRelationReq\x1a\x1c.super.GetFriendRelationResp\x12B\n" +
+	"\x11GetFriendRelation\x12\x1b.super.GetFriendRelationReq\x1a\x1c.super.GetFriendRelationResp\x126\n" +
+	"\tBlockUser\x12\x13.super.BlockUserReq\x1a\x14.super.BlockUserResp\x128\n" +
+	"\vUnblockUser\x12\x13.super.BlockUserReq\x1a\x14.super.BlockUserResp\x12K\n" +
+	"\x10ListBlockedUsers\x12\x1a.super.ListBlockedUsersReq\x1a\x1b.super.ListBlockedUsersResp\x12E\n" +
+	"\x0eCheckUserBlock\x12\x18.super.CheckUserBlockReq\x1a\x19.super.CheckUserBlockResp\x12N\n" +
+	"\x11GetBlockedUserIds\x12\x1b.super.GetBlockedUserIdsReq\x1a\x1c.super.GetBlockedUserIdsResp\x12;\n" +
+	"\vSuspendUser\x12\x15.super.SuspendUserReq\x1a\x15.super.SuspensionResp\x12A\n" +
+	"\x0eLiftSuspension\x12\x18.super.LiftSuspensionReq\x1a\x15.super.SuspensionResp\x12G\n" +
+	"\x11GetUserSuspension\x12\x1b.super.GetUserSuspensionReq\x1a\x15.super.SuspensionResp\x12W\n" +
+	"\x16SubmitSuspensionAppeal\x12 .super.SubmitSuspensionAppealReq\x1a\x1b.super.SuspensionAppealResp\x12Z\n" +
+	"\x15ListSuspensionAppeals\x12\x1f.super.ListSuspensionAppealsReq\x1a .super.ListSuspensionAppealsResp\x12W\n" +
+	"\x16ReviewSuspensionAppeal\x12 .super.ReviewSuspensionAppealReq\x1a\x1b.super.SuspensionAppealResp\x12N\n" +
+	"\x11ListOidcProviders\x12\x1b.super.ListOidcProvidersReq\x1a\x1c.super.ListOidcProvidersResp\x12B\n" +
+	"\rStartOidcAuth\x12\x17.super.StartOidcAuthReq\x1a\x18.super.StartOidcAuthResp\x122\n" +
+	"\tOidcLogin\x12\x13.super.OidcLoginReq\x1a\x10.super.LoginResp\x12G\n" +
+	"\x10LinkUserIdentity\x12\x1a.super.LinkUserIdentityReq\x1a\x17.super.UserIdentityResp\x12Q\n" +
+	"\x12ListUserIdentities\x12\x1c.super.ListUserIdentitiesReq\x1a\x1d.super.ListUserIdentitiesResp\x12Q\n" +
+	"\x12UnlinkUserIdentity\x12\x1c.super.UnlinkUserIdentityReq\x1a\x1d.super.UnlinkUserIdentityResp\x12B\n" +
 	"\rGetUserAvatar\x12\x17.super.GetUserAvatarReq\x1a\x18.super.GetUserAvatarResp\x12K\n" +
 	"\x10UpdateUserAvatar\x12\x1a.super.UpdateUserAvatarReq\x1a\x1b.super.UpdateUserAvatarResp\x120\n" +
 	"\aCheckIn\x12\x11.super.CheckInReq\x1a\x12.super.CheckInResp\x12?\n" +
@@ -8401,7 +17432,12 @@ const file_super_proto_rawDesc = "" +
 	"\x10GetCheckInStatus\x12\x1a.super.GetCheckInStatusReq\x1a\x1b.super.GetCheckInStatusResp\x12N\n" +
 	"\x11GetCheckInHistory\x12\x1b.super.GetCheckInHistoryReq\x1a\x1c.super.GetCheckInHistoryResp\x129\n" +
 	"\n" +
-	"GetExpLogs\x12\x14.super.GetExpLogsReq\x1a\x15.super.GetExpLogsRespB\x16Z\x14backend/rpc/pb/superb\x06proto3"
+	"GetExpLogs\x12\x14.super.GetExpLogsReq\x1a\x15.super.GetExpLogsResp\x12Q\n" +
+	"\x12UploadPreKeyBundle\x12\x1c.super.UploadPreKeyBundleReq\x1a\x1d.super.UploadPreKeyBundleResp\x12K\n" +
+	"\x10GetPreKeyBundles\x12\x1a.super.GetPreKeyBundlesReq\x1a\x1b.super.GetPreKeyBundlesResp\x12Z\n" +
+	"\x15StoreEncryptedMessage\x12\x1f.super.StoreEncryptedMessageReq\x1a .super.StoreEncryptedMessageResp\x12o\n" +
+	"\x1cListPendingEncryptedMessages\x12&.super.ListPendingEncryptedMessagesReq\x1a'.super.ListPendingEncryptedMessagesResp\x12W\n" +
+	"\x14AckEncryptedMessages\x12\x1e.super.AckEncryptedMessagesReq\x1a\x1f.super.AckEncryptedMessagesRespB\x16Z\x14backend/rpc/pb/superb\x06proto3"
 
 var (
 	file_super_proto_rawDescOnce sync.Once
@@ -8415,324 +17451,633 @@ func file_super_proto_rawDescGZIP() []byte {
 	return file_super_proto_rawDescData
 }
 
-var file_super_proto_msgTypes = make([]protoimpl.MessageInfo, 139)
+var file_super_proto_msgTypes = make([]protoimpl.MessageInfo, 277)
 var file_super_proto_goTypes = []any{
-	(*User)(nil),                           // 0: super.User
-	(*RegisterReq)(nil),                    // 1: super.RegisterReq
-	(*RegisterResp)(nil),                   // 2: super.RegisterResp
-	(*LoginReq)(nil),                       // 3: super.LoginReq
-	(*LoginResp)(nil),                      // 4: super.LoginResp
-	(*GetUserInfoReq)(nil),                 // 5: super.GetUserInfoReq
-	(*GetUserInfoResp)(nil),                // 6: super.GetUserInfoResp
-	(*GetUserReq)(nil),                     // 7: super.GetUserReq
-	(*GetUserResp)(nil),                    // 8: super.GetUserResp
-	(*GetUserByEmailReq)(nil),              // 9: super.GetUserByEmailReq
-	(*GetUserByEmailResp)(nil),             // 10: super.GetUserByEmailResp
-	(*UpdateUserInfoReq)(nil),              // 11: super.UpdateUserInfoReq
-	(*UpdateUserInfoResp)(nil),             // 12: super.UpdateUserInfoResp
-	(*UpdateUserPasswordReq)(nil),          // 13: super.UpdateUserPasswordReq
-	(*UpdateUserPasswordResp)(nil),         // 14: super.UpdateUserPasswordResp
-	(*ResetPasswordReq)(nil),               // 15: super.ResetPasswordReq
-	(*ResetPasswordResp)(nil),              // 16: super.ResetPasswordResp
-	(*DeleteUserReq)(nil),                  // 17: super.DeleteUserReq
-	(*DeleteUserResp)(nil),                 // 18: super.DeleteUserResp
-	(*UpdateUserVipReq)(nil),               // 19: super.UpdateUserVipReq
-	(*UpdateUserVipResp)(nil),              // 20: super.UpdateUserVipResp
-	(*GetUsersReq)(nil),                    // 21: super.GetUsersReq
-	(*GetUsersResp)(nil),                   // 22: super.GetUsersResp
-	(*GetUserCountReq)(nil),                // 23: super.GetUserCountReq
-	(*GetUserCountResp)(nil),               // 24: super.GetUserCountResp
-	(*VipPlan)(nil),                        // 25: super.VipPlan
-	(*GetVipPlanReq)(nil),                  // 26: super.GetVipPlanReq
-	(*GetVipPlanResp)(nil),                 // 27: super.GetVipPlanResp
-	(*CreateVipPlanReq)(nil),               // 28: super.CreateVipPlanReq
-	(*CreateVipPlanResp)(nil),              // 29: super.CreateVipPlanResp
-	(*GetVipPlansReq)(nil),                 // 30: super.GetVipPlansReq
-	(*GetVipPlansResp)(nil),                // 31: super.GetVipPlansResp
-	(*VipOrder)(nil),                       // 32: super.VipOrder
-	(*CreateVipOrderReq)(nil),              // 33: super.CreateVipOrderReq
-	(*CreateVipOrderResp)(nil),             // 34: super.CreateVipOrderResp
-	(*GetVipOrdersReq)(nil),                // 35: super.GetVipOrdersReq
-	(*GetVipOrdersResp)(nil),               // 36: super.GetVipOrdersResp
-	(*VipRecord)(nil),                      // 37: super.VipRecord
-	(*GetVipRecordsReq)(nil),               // 38: super.GetVipRecordsReq
-	(*GetVipRecordsResp)(nil),              // 39: super.GetVipRecordsResp
-	(*GetUserActiveVipRecordReq)(nil),      // 40: super.GetUserActiveVipRecordReq
-	(*GetUserActiveVipRecordResp)(nil),     // 41: super.GetUserActiveVipRecordResp
-	(*GetUserVipStatusReq)(nil),            // 42: super.GetUserVipStatusReq
-	(*GetUserVipStatusResp)(nil),           // 43: super.GetUserVipStatusResp
-	(*CheckUserVipReq)(nil),                // 44: super.CheckUserVipReq
-	(*CheckUserVipResp)(nil),               // 45: super.CheckUserVipResp
-	(*UpdateAutoRenewReq)(nil),             // 46: super.UpdateAutoRenewReq
-	(*UpdateAutoRenewResp)(nil),            // 47: super.UpdateAutoRenewResp
-	(*SyncUserVipStatusReq)(nil),           // 48: super.SyncUserVipStatusReq
-	(*SyncUserVipStatusResp)(nil),          // 49: super.SyncUserVipStatusResp
-	(*RechargeReq)(nil),                    // 50: super.RechargeReq
-	(*RechargeResp)(nil),                   // 51: super.RechargeResp
-	(*GetTransactionsReq)(nil),             // 52: super.GetTransactionsReq
-	(*Transaction)(nil),                    // 53: super.Transaction
-	(*GetTransactionsResp)(nil),            // 54: super.GetTransactionsResp
-	(*GetTransactionReq)(nil),              // 55: super.GetTransactionReq
-	(*GetTransactionResp)(nil),             // 56: super.GetTransactionResp
-	(*TopicTag)(nil),                       // 57: super.TopicTag
-	(*Post)(nil),                           // 58: super.Post
-	(*GetPostsReq)(nil),                    // 59: super.GetPostsReq
-	(*GetPostsResp)(nil),                   // 60: super.GetPostsResp
-	(*GetPostReq)(nil),                     // 61: super.GetPostReq
-	(*GetPostResp)(nil),                    // 62: super.GetPostResp
-	(*CreatePostReq)(nil),                  // 63: super.CreatePostReq
-	(*ReportPostReq)(nil),                  // 64: super.ReportPostReq
-	(*ReportPostResp)(nil),                 // 65: super.ReportPostResp
-	(*CreatePostResp)(nil),                 // 66: super.CreatePostResp
-	(*LikePostReq)(nil),                    // 67: super.LikePostReq
-	(*LikePostResp)(nil),                   // 68: super.LikePostResp
-	(*GetPostCommentsReq)(nil),             // 69: super.GetPostCommentsReq
-	(*GetPostCommentsResp)(nil),            // 70: super.GetPostCommentsResp
-	(*Comment)(nil),                        // 71: super.Comment
-	(*CreateCommentReq)(nil),               // 72: super.CreateCommentReq
-	(*CreateCommentResp)(nil),              // 73: super.CreateCommentResp
-	(*LikeCommentReq)(nil),                 // 74: super.LikeCommentReq
-	(*LikeCommentResp)(nil),                // 75: super.LikeCommentResp
-	(*Notification)(nil),                   // 76: super.Notification
-	(*GetNotificationsReq)(nil),            // 77: super.GetNotificationsReq
-	(*GetNotificationsResp)(nil),           // 78: super.GetNotificationsResp
-	(*GetUnreadCountReq)(nil),              // 79: super.GetUnreadCountReq
-	(*GetUnreadCountResp)(nil),             // 80: super.GetUnreadCountResp
-	(*ReadNotificationReq)(nil),            // 81: super.ReadNotificationReq
-	(*ReadNotificationResp)(nil),           // 82: super.ReadNotificationResp
-	(*ReadAllNotificationsReq)(nil),        // 83: super.ReadAllNotificationsReq
-	(*ReadAllNotificationsResp)(nil),       // 84: super.ReadAllNotificationsResp
-	(*CreateNotificationReq)(nil),          // 85: super.CreateNotificationReq
-	(*CreateNotificationResp)(nil),         // 86: super.CreateNotificationResp
-	(*UserMemory)(nil),                     // 87: super.UserMemory
-	(*UpsertUserMemoryReq)(nil),            // 88: super.UpsertUserMemoryReq
-	(*UpsertUserMemoryResp)(nil),           // 89: super.UpsertUserMemoryResp
-	(*GetUserMemoriesReq)(nil),             // 90: super.GetUserMemoriesReq
-	(*GetUserMemoriesResp)(nil),            // 91: super.GetUserMemoriesResp
-	(*DeleteUserMemoryReq)(nil),            // 92: super.DeleteUserMemoryReq
-	(*DeleteUserMemoryResp)(nil),           // 93: super.DeleteUserMemoryResp
-	(*FriendRequestView)(nil),              // 94: super.FriendRequestView
-	(*SendFriendRequestReq)(nil),           // 95: super.SendFriendRequestReq
-	(*SendFriendRequestResp)(nil),          // 96: super.SendFriendRequestResp
-	(*ListIncomingFriendRequestsReq)(nil),  // 97: super.ListIncomingFriendRequestsReq
-	(*ListIncomingFriendRequestsResp)(nil), // 98: super.ListIncomingFriendRequestsResp
-	(*ListOutgoingFriendRequestsReq)(nil),  // 99: super.ListOutgoingFriendRequestsReq
-	(*ListOutgoingFriendRequestsResp)(nil), // 100: super.ListOutgoingFriendRequestsResp
-	(*AcceptFriendRequestReq)(nil),         // 101: super.AcceptFriendRequestReq
-	(*AcceptFriendRequestResp)(nil),        // 102: super.AcceptFriendRequestResp
-	(*RejectFriendRequestReq)(nil),         // 103: super.RejectFriendRequestReq
-	(*RejectFriendRequestResp)(nil),        // 104: super.RejectFriendRequestResp
-	(*ListFriendsReq)(nil),                 // 105: super.ListFriendsReq
-	(*ListFriendsResp)(nil),                // 106: super.ListFriendsResp
-	(*GetFriendRelationReq)(nil),           // 107: super.GetFriendRelationReq
-	(*GetFriendRelationResp)(nil),          // 108: super.GetFriendRelationResp
-	(*FollowUserReq)(nil),                  // 109: super.FollowUserReq
-	(*FollowUserResp)(nil),                 // 110: super.FollowUserResp
-	(*UnfollowUserReq)(nil),                // 111: super.UnfollowUserReq
-	(*GetFollowingsReq)(nil),               // 112: super.GetFollowingsReq
-	(*GetFollowingsResp)(nil),              // 113: super.GetFollowingsResp
-	(*GetFollowersReq)(nil),                // 114: super.GetFollowersReq
-	(*GetFollowersResp)(nil),               // 115: super.GetFollowersResp
-	(*CheckFollowReq)(nil),                 // 116: super.CheckFollowReq
-	(*CheckFollowResp)(nil),                // 117: super.CheckFollowResp
-	(*AvatarBaseConfig)(nil),               // 118: super.AvatarBaseConfig
-	(*AvatarOutfitConfig)(nil),             // 119: super.AvatarOutfitConfig
-	(*UserAvatarData)(nil),                 // 120: super.UserAvatarData
-	(*GetUserAvatarReq)(nil),               // 121: super.GetUserAvatarReq
-	(*GetUserAvatarResp)(nil),              // 122: super.GetUserAvatarResp
-	(*UpdateUserAvatarReq)(nil),            // 123: super.UpdateUserAvatarReq
-	(*UpdateUserAvatarResp)(nil),           // 124: super.UpdateUserAvatarResp
-	(*UserLevelInfo)(nil),                  // 125: super.UserLevelInfo
-	(*CheckInStatus)(nil),                  // 126: super.CheckInStatus
-	(*CheckInRecord)(nil),                  // 127: super.CheckInRecord
-	(*ExpLogRecord)(nil),                   // 128: super.ExpLogRecord
-	(*CheckInReq)(nil),                     // 129: super.CheckInReq
-	(*CheckInResp)(nil),                    // 130: super.CheckInResp
-	(*GetUserLevelReq)(nil),                // 131: super.GetUserLevelReq
-	(*GetUserLevelResp)(nil),               // 132: super.GetUserLevelResp
-	(*GetCheckInStatusReq)(nil),            // 133: super.GetCheckInStatusReq
-	(*GetCheckInStatusResp)(nil),           // 134: super.GetCheckInStatusResp
-	(*GetCheckInHistoryReq)(nil),           // 135: super.GetCheckInHistoryReq
-	(*GetCheckInHistoryResp)(nil),          // 136: super.GetCheckInHistoryResp
-	(*GetExpLogsReq)(nil),                  // 137: super.GetExpLogsReq
-	(*GetExpLogsResp)(nil),                 // 138: super.GetExpLogsResp
+	(*User)(nil),                             // 0: super.User
+	(*RegisterReq)(nil),                      // 1: super.RegisterReq
+	(*RegisterResp)(nil),                     // 2: super.RegisterResp
+	(*LoginReq)(nil),                         // 3: super.LoginReq
+	(*LoginResp)(nil),                        // 4: super.LoginResp
+	(*VerifyLoginMfaReq)(nil),                // 5: super.VerifyLoginMfaReq
+	(*GetMfaStatusReq)(nil),                  // 6: super.GetMfaStatusReq
+	(*GetMfaStatusResp)(nil),                 // 7: super.GetMfaStatusResp
+	(*BeginTotpEnrollmentReq)(nil),           // 8: super.BeginTotpEnrollmentReq
+	(*BeginTotpEnrollmentResp)(nil),          // 9: super.BeginTotpEnrollmentResp
+	(*ConfirmTotpEnrollmentReq)(nil),         // 10: super.ConfirmTotpEnrollmentReq
+	(*RecoveryCodesResp)(nil),                // 11: super.RecoveryCodesResp
+	(*DisableTotpReq)(nil),                   // 12: super.DisableTotpReq
+	(*DisableTotpResp)(nil),                  // 13: super.DisableTotpResp
+	(*RegenerateRecoveryCodesReq)(nil),       // 14: super.RegenerateRecoveryCodesReq
+	(*MfaPolicy)(nil),                        // 15: super.MfaPolicy
+	(*ListMfaPoliciesReq)(nil),               // 16: super.ListMfaPoliciesReq
+	(*ListMfaPoliciesResp)(nil),              // 17: super.ListMfaPoliciesResp
+	(*SetMfaPolicyReq)(nil),                  // 18: super.SetMfaPolicyReq
+	(*SetMfaPolicyResp)(nil),                 // 19: super.SetMfaPolicyResp
+	(*GetUserInfoReq)(nil),                   // 20: super.GetUserInfoReq
+	(*GetUserInfoResp)(nil),                  // 21: super.GetUserInfoResp
+	(*GetUserReq)(nil),                       // 22: super.GetUserReq
+	(*GetUserResp)(nil),                      // 23: super.GetUserResp
+	(*GetUserByEmailReq)(nil),                // 24: super.GetUserByEmailReq
+	(*GetUserByEmailResp)(nil),               // 25: super.GetUserByEmailResp
+	(*UpdateUserInfoReq)(nil),                // 26: super.UpdateUserInfoReq
+	(*UpdateUserInfoResp)(nil),               // 27: super.UpdateUserInfoResp
+	(*UpdateUserPasswordReq)(nil),            // 28: super.UpdateUserPasswordReq
+	(*UpdateUserPasswordResp)(nil),           // 29: super.UpdateUserPasswordResp
+	(*RequestPasswordResetReq)(nil),          // 30: super.RequestPasswordResetReq
+	(*RequestPasswordResetResp)(nil),         // 31: super.RequestPasswordResetResp
+	(*ResetPasswordReq)(nil),                 // 32: super.ResetPasswordReq
+	(*ResetPasswordResp)(nil),                // 33: super.ResetPasswordResp
+	(*GetUserAuthStateReq)(nil),              // 34: super.GetUserAuthStateReq
+	(*GetUserAuthStateResp)(nil),             // 35: super.GetUserAuthStateResp
+	(*RefreshSessionReq)(nil),                // 36: super.RefreshSessionReq
+	(*RefreshSessionResp)(nil),               // 37: super.RefreshSessionResp
+	(*UserSession)(nil),                      // 38: super.UserSession
+	(*ListUserSessionsReq)(nil),              // 39: super.ListUserSessionsReq
+	(*ListUserSessionsResp)(nil),             // 40: super.ListUserSessionsResp
+	(*RevokeUserSessionReq)(nil),             // 41: super.RevokeUserSessionReq
+	(*RevokeUserSessionResp)(nil),            // 42: super.RevokeUserSessionResp
+	(*RevokeAllUserSessionsReq)(nil),         // 43: super.RevokeAllUserSessionsReq
+	(*RevokeAllUserSessionsResp)(nil),        // 44: super.RevokeAllUserSessionsResp
+	(*IssueWsTicketReq)(nil),                 // 45: super.IssueWsTicketReq
+	(*IssueWsTicketResp)(nil),                // 46: super.IssueWsTicketResp
+	(*RedeemWsTicketReq)(nil),                // 47: super.RedeemWsTicketReq
+	(*RedeemWsTicketResp)(nil),               // 48: super.RedeemWsTicketResp
+	(*SendEmailVerificationReq)(nil),         // 49: super.SendEmailVerificationReq
+	(*SendEmailVerificationResp)(nil),        // 50: super.SendEmailVerificationResp
+	(*VerifyEmailReq)(nil),                   // 51: super.VerifyEmailReq
+	(*VerifyEmailResp)(nil),                  // 52: super.VerifyEmailResp
+	(*UpdateUserRoleReq)(nil),                // 53: super.UpdateUserRoleReq
+	(*UpdateUserRoleResp)(nil),               // 54: super.UpdateUserRoleResp
+	(*RoleAuditLog)(nil),                     // 55: super.RoleAuditLog
+	(*ListRoleAuditLogsReq)(nil),             // 56: super.ListRoleAuditLogsReq
+	(*ListRoleAuditLogsResp)(nil),            // 57: super.ListRoleAuditLogsResp
+	(*SecurityEvent)(nil),                    // 58: super.SecurityEvent
+	(*ListSecurityEventsReq)(nil),            // 59: super.ListSecurityEventsReq
+	(*ListSecurityEventsResp)(nil),           // 60: super.ListSecurityEventsResp
+	(*DeleteUserReq)(nil),                    // 61: super.DeleteUserReq
+	(*DeleteUserResp)(nil),                   // 62: super.DeleteUserResp
+	(*AccountDeletion)(nil),                  // 63: super.AccountDeletion
+	(*GetAccountDeletionReq)(nil),            // 64: super.GetAccountDeletionReq
+	(*GetAccountDeletionResp)(nil),           // 65: super.GetAccountDeletionResp
+	(*CancelAccountDeletionReq)(nil),         // 66: super.CancelAccountDeletionReq
+	(*CancelAccountDeletionResp)(nil),        // 67: super.CancelAccountDeletionResp
+	(*ExportUserDataReq)(nil),                // 68: super.ExportUserDataReq
+	(*ExportChunk)(nil),                      // 69: super.ExportChunk
+	(*UpdateUserVipReq)(nil),                 // 70: super.UpdateUserVipReq
+	(*UpdateUserVipResp)(nil),                // 71: super.UpdateUserVipResp
+	(*GetUsersReq)(nil),                      // 72: super.GetUsersReq
+	(*GetUsersResp)(nil),                     // 73: super.GetUsersResp
+	(*GetUserCountReq)(nil),                  // 74: super.GetUserCountReq
+	(*GetUserCountResp)(nil),                 // 75: super.GetUserCountResp
+	(*VipPlan)(nil),                          // 76: super.VipPlan
+	(*GetVipPlanReq)(nil),                    // 77: super.GetVipPlanReq
+	(*GetVipPlanResp)(nil),                   // 78: super.GetVipPlanResp
+	(*CreateVipPlanReq)(nil),                 // 79: super.CreateVipPlanReq
+	(*CreateVipPlanResp)(nil),                // 80: super.CreateVipPlanResp
+	(*GetVipPlansReq)(nil),                   // 81: super.GetVipPlansReq
+	(*GetVipPlansResp)(nil),                  // 82: super.GetVipPlansResp
+	(*VipOrder)(nil),                         // 83: super.VipOrder
+	(*CreateVipOrderReq)(nil),                // 84: super.CreateVipOrderReq
+	(*CreateVipOrderResp)(nil),               // 85: super.CreateVipOrderResp
+	(*GetVipOrdersReq)(nil),                  // 86: super.GetVipOrdersReq
+	(*GetVipOrdersResp)(nil),                 // 87: super.GetVipOrdersResp
+	(*VipRecord)(nil),                        // 88: super.VipRecord
+	(*GetVipRecordsReq)(nil),                 // 89: super.GetVipRecordsReq
+	(*GetVipRecordsResp)(nil),                // 90: super.GetVipRecordsResp
+	(*GetUserActiveVipRecordReq)(nil),        // 91: super.GetUserActiveVipRecordReq
+	(*GetUserActiveVipRecordResp)(nil),       // 92: super.GetUserActiveVipRecordResp
+	(*GetUserVipStatusReq)(nil),              // 93: super.GetUserVipStatusReq
+	(*GetUserVipStatusResp)(nil),             // 94: super.GetUserVipStatusResp
+	(*CheckUserVipReq)(nil),                  // 95: super.CheckUserVipReq
+	(*CheckUserVipResp)(nil),                 // 96: super.CheckUserVipResp
+	(*UpdateAutoRenewReq)(nil),               // 97: super.UpdateAutoRenewReq
+	(*UpdateAutoRenewResp)(nil),              // 98: super.UpdateAutoRenewResp
+	(*SyncUserVipStatusReq)(nil),             // 99: super.SyncUserVipStatusReq
+	(*SyncUserVipStatusResp)(nil),            // 100: super.SyncUserVipStatusResp
+	(*RechargeReq)(nil),                      // 101: super.RechargeReq
+	(*RechargeResp)(nil),                     // 102: super.RechargeResp
+	(*GetTransactionsReq)(nil),               // 103: super.GetTransactionsReq
+	(*Transaction)(nil),                      // 104: super.Transaction
+	(*GetTransactionsResp)(nil),              // 105: super.GetTransactionsResp
+	(*GetTransactionReq)(nil),                // 106: super.GetTransactionReq
+	(*GetTransactionResp)(nil),               // 107: super.GetTransactionResp
+	(*TopicTag)(nil),                         // 108: super.TopicTag
+	(*Post)(nil),                             // 109: super.Post
+	(*GetPostsReq)(nil),                      // 110: super.GetPostsReq
+	(*GetPostsResp)(nil),                     // 111: super.GetPostsResp
+	(*GetPostReq)(nil),                       // 112: super.GetPostReq
+	(*GetPostResp)(nil),                      // 113: super.GetPostResp
+	(*CreatePostReq)(nil),                    // 114: super.CreatePostReq
+	(*ReportPostReq)(nil),                    // 115: super.ReportPostReq
+	(*ReportPostResp)(nil),                   // 116: super.ReportPostResp
+	(*CreatePostResp)(nil),                   // 117: super.CreatePostResp
+	(*UpdatePostReq)(nil),                    // 118: super.UpdatePostReq
+	(*UpdatePostResp)(nil),                   // 119: super.UpdatePostResp
+	(*DeletePostReq)(nil),                    // 120: super.DeletePostReq
+	(*DeletePostResp)(nil),                   // 121: super.DeletePostResp
+	(*LikePostReq)(nil),                      // 122: super.LikePostReq
+	(*LikePostResp)(nil),                     // 123: super.LikePostResp
+	(*GetPostCommentsReq)(nil),               // 124: super.GetPostCommentsReq
+	(*GetPostCommentsResp)(nil),              // 125: super.GetPostCommentsResp
+	(*Comment)(nil),                          // 126: super.Comment
+	(*CreateCommentReq)(nil),                 // 127: super.CreateCommentReq
+	(*CreateCommentResp)(nil),                // 128: super.CreateCommentResp
+	(*LikeCommentReq)(nil),                   // 129: super.LikeCommentReq
+	(*LikeCommentResp)(nil),                  // 130: super.LikeCommentResp
+	(*Notification)(nil),                     // 131: super.Notification
+	(*NotificationActor)(nil),                // 132: super.NotificationActor
+	(*GetNotificationsReq)(nil),              // 133: super.GetNotificationsReq
+	(*GetNotificationsResp)(nil),             // 134: super.GetNotificationsResp
+	(*GetUnreadCountReq)(nil),                // 135: super.GetUnreadCountReq
+	(*GetUnreadCountResp)(nil),               // 136: super.GetUnreadCountResp
+	(*ReadNotificationReq)(nil),              // 137: super.ReadNotificationReq
+	(*ReadNotificationResp)(nil),             // 138: super.ReadNotificationResp
+	(*ReadAllNotificationsReq)(nil),          // 139: super.ReadAllNotificationsReq
+	(*ReadAllNotificationsResp)(nil),         // 140: super.ReadAllNotificationsResp
+	(*CreateNotificationReq)(nil),            // 141: super.CreateNotificationReq
+	(*CreateNotificationResp)(nil),           // 142: super.CreateNotificationResp
+	(*WatchNotificationsReq)(nil),            // 143: super.WatchNotificationsReq
+	(*NotificationEvent)(nil),                // 144: super.NotificationEvent
+	(*SessionKick)(nil),                      // 145: super.SessionKick
+	(*NotificationKindPreference)(nil),       // 146: super.NotificationKindPreference
+	(*NotificationQuietHours)(nil),           // 147: super.NotificationQuietHours
+	(*NotificationMute)(nil),                 // 148: super.NotificationMute
+	(*NotificationPreferences)(nil),          // 149: super.NotificationPreferences
+	(*GetNotificationPreferencesReq)(nil),    // 150: super.GetNotificationPreferencesReq
+	(*UpdateNotificationPreferencesReq)(nil), // 151: super.UpdateNotificationPreferencesReq
+	(*NotificationPreferencesResp)(nil),      // 152: super.NotificationPreferencesResp
+	(*SetNotificationMuteReq)(nil),           // 153: super.SetNotificationMuteReq
+	(*SetNotificationMuteResp)(nil),          // 154: super.SetNotificationMuteResp
+	(*NotificationCampaign)(nil),             // 155: super.NotificationCampaign
+	(*CreateNotificationCampaignReq)(nil),    // 156: super.CreateNotificationCampaignReq
+	(*NotificationCampaignResp)(nil),         // 157: super.NotificationCampaignResp
+	(*ListNotificationCampaignsReq)(nil),     // 158: super.ListNotificationCampaignsReq
+	(*ListNotificationCampaignsResp)(nil),    // 159: super.ListNotificationCampaignsResp
+	(*GetNotificationCampaignReq)(nil),       // 160: super.GetNotificationCampaignReq
+	(*RegisterDeviceReq)(nil),                // 161: super.RegisterDeviceReq
+	(*RegisterDeviceResp)(nil),               // 162: super.RegisterDeviceResp
+	(*UnregisterDeviceReq)(nil),              // 163: super.UnregisterDeviceReq
+	(*UnregisterDeviceResp)(nil),             // 164: super.UnregisterDeviceResp
+	(*SendDevicePushReq)(nil),                // 165: super.SendDevicePushReq
+	(*SendDevicePushResp)(nil),               // 166: super.SendDevicePushResp
+	(*UserMemory)(nil),                       // 167: super.UserMemory
+	(*UpsertUserMemoryReq)(nil),              // 168: super.UpsertUserMemoryReq
+	(*UpsertUserMemoryResp)(nil),             // 169: super.UpsertUserMemoryResp
+	(*GetUserMemoriesReq)(nil),               // 170: super.GetUserMemoriesReq
+	(*GetUserMemoriesResp)(nil),              // 171: super.GetUserMemoriesResp
+	(*DeleteUserMemoryReq)(nil),              // 172: super.DeleteUserMemoryReq
+	(*DeleteUserMemoryResp)(nil),             // 173: super.DeleteUserMemoryResp
+	(*FriendRequestView)(nil),                // 174: super.FriendRequestView
+	(*SendFriendRequestReq)(nil),             // 175: super.SendFriendRequestReq
+	(*SendFriendRequestResp)(nil),            // 176: super.SendFriendRequestResp
+	(*ListIncomingFriendRequestsReq)(nil),    // 177: super.ListIncomingFriendRequestsReq
+	(*ListIncomingFriendRequestsResp)(nil),   // 178: super.ListIncomingFriendRequestsResp
+	(*ListOutgoingFriendRequestsReq)(nil),    // 179: super.ListOutgoingFriendRequestsReq
+	(*ListOutgoingFriendRequestsResp)(nil),   // 180: super.ListOutgoingFriendRequestsResp
+	(*AcceptFriendRequestReq)(nil),           // 181: super.AcceptFriendRequestReq
+	(*AcceptFriendRequestResp)(nil),          // 182: super.AcceptFriendRequestResp
+	(*RejectFriendRequestReq)(nil),           // 183: super.RejectFriendRequestReq
+	(*RejectFriendRequestResp)(nil),          // 184: super.RejectFriendRequestResp
+	(*ListFriendsReq)(nil),                   // 185: super.ListFriendsReq
+	(*ListFriendsResp)(nil),                  // 186: super.ListFriendsResp
+	(*GetFriendRelationReq)(nil),             // 187: super.GetFriendRelationReq
+	(*GetFriendRelationResp)(nil),            // 188: super.GetFriendRelationResp
+	(*BlockUserReq)(nil),                     // 189: super.BlockUserReq
+	(*BlockUserResp)(nil),                    // 190: super.BlockUserResp
+	(*ListBlockedUsersReq)(nil),              // 191: super.ListBlockedUsersReq
+	(*BlockedUser)(nil),                      // 192: super.BlockedUser
+	(*ListBlockedUsersResp)(nil),             // 193: super.ListBlockedUsersResp
+	(*CheckUserBlockReq)(nil),                // 194: super.CheckUserBlockReq
+	(*CheckUserBlockResp)(nil),               // 195: super.CheckUserBlockResp
+	(*GetBlockedUserIdsReq)(nil),             // 196: super.GetBlockedUserIdsReq
+	(*GetBlockedUserIdsResp)(nil),            // 197: super.GetBlockedUserIdsResp
+	(*FollowUserReq)(nil),                    // 198: super.FollowUserReq
+	(*FollowUserResp)(nil),                   // 199: super.FollowUserResp
+	(*UnfollowUserReq)(nil),                  // 200: super.UnfollowUserReq
+	(*GetFollowingsReq)(nil),                 // 201: super.GetFollowingsReq
+	(*GetFollowingsResp)(nil),                // 202: super.GetFollowingsResp
+	(*GetFollowersReq)(nil),                  // 203: super.GetFollowersReq
+	(*GetFollowersResp)(nil),                 // 204: super.GetFollowersResp
+	(*CheckFollowReq)(nil),                   // 205: super.CheckFollowReq
+	(*CheckFollowResp)(nil),                  // 206: super.CheckFollowResp
+	(*PrivacySettings)(nil),                  // 207: super.PrivacySettings
+	(*GetPrivacySettingsReq)(nil),            // 208: super.GetPrivacySettingsReq
+	(*UpdatePrivacySettingsReq)(nil),         // 209: super.UpdatePrivacySettingsReq
+	(*PrivacySettingsResp)(nil),              // 210: super.PrivacySettingsResp
+	(*FollowRequestView)(nil),                // 211: super.FollowRequestView
+	(*ListFollowRequestsReq)(nil),            // 212: super.ListFollowRequestsReq
+	(*ListFollowRequestsResp)(nil),           // 213: super.ListFollowRequestsResp
+	(*RespondFollowRequestReq)(nil),          // 214: super.RespondFollowRequestReq
+	(*RespondFollowRequestResp)(nil),         // 215: super.RespondFollowRequestResp
+	(*CheckDirectMessageReq)(nil),            // 216: super.CheckDirectMessageReq
+	(*CheckDirectMessageResp)(nil),           // 217: super.CheckDirectMessageResp
+	(*AvatarBaseConfig)(nil),                 // 218: super.AvatarBaseConfig
+	(*AvatarOutfitConfig)(nil),               // 219: super.AvatarOutfitConfig
+	(*UserAvatarData)(nil),                   // 220: super.UserAvatarData
+	(*GetUserAvatarReq)(nil),                 // 221: super.GetUserAvatarReq
+	(*GetUserAvatarResp)(nil),                // 222: super.GetUserAvatarResp
+	(*UpdateUserAvatarReq)(nil),              // 223: super.UpdateUserAvatarReq
+	(*UpdateUserAvatarResp)(nil),             // 224: super.UpdateUserAvatarResp
+	(*UserLevelInfo)(nil),                    // 225: super.UserLevelInfo
+	(*CheckInStatus)(nil),                    // 226: super.CheckInStatus
+	(*CheckInRecord)(nil),                    // 227: super.CheckInRecord
+	(*ExpLogRecord)(nil),                     // 228: super.ExpLogRecord
+	(*CheckInReq)(nil),                       // 229: super.CheckInReq
+	(*CheckInResp)(nil),                      // 230: super.CheckInResp
+	(*GetUserLevelReq)(nil),                  // 231: super.GetUserLevelReq
+	(*GetUserLevelResp)(nil),                 // 232: super.GetUserLevelResp
+	(*GetCheckInStatusReq)(nil),              // 233: super.GetCheckInStatusReq
+	(*GetCheckInStatusResp)(nil),             // 234: super.GetCheckInStatusResp
+	(*GetCheckInHistoryReq)(nil),             // 235: super.GetCheckInHistoryReq
+	(*GetCheckInHistoryResp)(nil),            // 236: super.GetCheckInHistoryResp
+	(*GetExpLogsReq)(nil),                    // 237: super.GetExpLogsReq
+	(*GetExpLogsResp)(nil),                   // 238: super.GetExpLogsResp
+	(*SignedPreKey)(nil),                     // 239: super.SignedPreKey
+	(*PreKeyBundle)(nil),                     // 240: super.PreKeyBundle
+	(*UploadPreKeyBundleReq)(nil),            // 241: super.UploadPreKeyBundleReq
+	(*UploadPreKeyBundleResp)(nil),           // 242: super.UploadPreKeyBundleResp
+	(*GetPreKeyBundlesReq)(nil),              // 243: super.GetPreKeyBundlesReq
+	(*GetPreKeyBundlesResp)(nil),             // 244: super.GetPreKeyBundlesResp
+	(*EncryptedMessage)(nil),                 // 245: super.EncryptedMessage
+	(*StoreEncryptedMessageReq)(nil),         // 246: super.StoreEncryptedMessageReq
+	(*StoreEncryptedMessageResp)(nil),        // 247: super.StoreEncryptedMessageResp
+	(*ListPendingEncryptedMessagesReq)(nil),  // 248: super.ListPendingEncryptedMessagesReq
+	(*ListPendingEncryptedMessagesResp)(nil), // 249: super.ListPendingEncryptedMessagesResp
+	(*AckEncryptedMessagesReq)(nil),          // 250: super.AckEncryptedMessagesReq
+	(*AckEncryptedMessagesResp)(nil),         // 251: super.AckEncryptedMessagesResp
+	(*Suspension)(nil),                       // 252: super.Suspension
+	(*SuspendUserReq)(nil),                   // 253: super.SuspendUserReq
+	(*LiftSuspensionReq)(nil),                // 254: super.LiftSuspensionReq
+	(*GetUserSuspensionReq)(nil),             // 255: super.GetUserSuspensionReq
+	(*SuspensionResp)(nil),                   // 256: super.SuspensionResp
+	(*SubmitSuspensionAppealReq)(nil),        // 257: super.SubmitSuspensionAppealReq
+	(*SuspensionAppeal)(nil),                 // 258: super.SuspensionAppeal
+	(*SuspensionAppealResp)(nil),             // 259: super.SuspensionAppealResp
+	(*ListSuspensionAppealsReq)(nil),         // 260: super.ListSuspensionAppealsReq
+	(*ListSuspensionAppealsResp)(nil),        // 261: super.ListSuspensionAppealsResp
+	(*ReviewSuspensionAppealReq)(nil),        // 262: super.ReviewSuspensionAppealReq
+	(*OidcProvider)(nil),                     // 263: super.OidcProvider
+	(*ListOidcProvidersReq)(nil),             // 264: super.ListOidcProvidersReq
+	(*ListOidcProvidersResp)(nil),            // 265: super.ListOidcProvidersResp
+	(*StartOidcAuthReq)(nil),                 // 266: super.StartOidcAuthReq
+	(*StartOidcAuthResp)(nil),                // 267: super.StartOidcAuthResp
+	(*OidcLoginReq)(nil),                     // 268: super.OidcLoginReq
+	(*UserIdentity)(nil),                     // 269: super.UserIdentity
+	(*LinkUserIdentityReq)(nil),              // 270: super.LinkUserIdentityReq
+	(*UserIdentityResp)(nil),                 // 271: super.UserIdentityResp
+	(*ListUserIdentitiesReq)(nil),            // 272: super.ListUserIdentitiesReq
+	(*ListUserIdentitiesResp)(nil),           // 273: super.ListUserIdentitiesResp
+	(*UnlinkUserIdentityReq)(nil),            // 274: super.UnlinkUserIdentityReq
+	(*UnlinkUserIdentityResp)(nil),           // 275: super.UnlinkUserIdentityResp
+	nil,                                      // 276: super.SendDevicePushReq.DataEntry
 }
 var file_super_proto_depIdxs = []int32{
 	0,   // 0: super.RegisterResp.user:type_name -> super.User
 	0,   // 1: super.LoginResp.user:type_name -> super.User
-	0,   // 2: super.GetUserInfoResp.user:type_name -> super.User
-	0,   // 3: super.GetUserResp.user:type_name -> super.User
-	0,   // 4: super.GetUserByEmailResp.user:type_name -> super.User
-	0,   // 5: super.UpdateUserInfoResp.user:type_name -> super.User
-	0,   // 6: super.UpdateUserVipResp.user:type_name -> super.User
-	0,   // 7: super.GetUsersResp.users:type_name -> super.User
-	25,  // 8: super.GetVipPlanResp.plan:type_name -> super.VipPlan
-	25,  // 9: super.CreateVipPlanResp.plan:type_name -> super.VipPlan
-	25,  // 10: super.GetVipPlansResp.plans:type_name -> super.VipPlan
-	32,  // 11: super.CreateVipOrderResp.order:type_name -> super.VipOrder
-	32,  // 12: super.GetVipOrdersResp.orders:type_name -> super.VipOrder
-	37,  // 13: super.GetVipRecordsResp.records:type_name -> super.VipRecord
-	37,  // 14: super.GetUserActiveVipRecordResp.record:type_name -> super.VipRecord
-	53,  // 15: super.GetTransactionsResp.transactions:type_name -> super.Transaction
-	53,  // 16: super.GetTransactionResp.transaction:type_name -> super.Transaction
-	57,  // 17: super.Post.topic_tags:type_name -> super.TopicTag
-	58,  // 18: super.GetPostsResp.posts:type_name -> super.Post
-	58,  // 19: super.GetPostResp.post:type_name -> super.Post
-	57,  // 20: super.CreatePostReq.topic_tags:type_name -> super.TopicTag
-	58,  // 21: super.CreatePostResp.post:type_name -> super.Post
-	58,  // 22: super.LikePostResp.post:type_name -> super.Post
-	71,  // 23: super.GetPostCommentsResp.comments:type_name -> super.Comment
-	71,  // 24: super.CreateCommentResp.comment:type_name -> super.Comment
-	71,  // 25: super.LikeCommentResp.comment:type_name -> super.Comment
-	76,  // 26: super.GetNotificationsResp.notifications:type_name -> super.Notification
-	76,  // 27: super.CreateNotificationResp.notification:type_name -> super.Notification
-	87,  // 28: super.UpsertUserMemoryResp.memory:type_name -> super.UserMemory
-	87,  // 29: super.GetUserMemoriesResp.memories:type_name -> super.UserMemory
-	0,   // 30: super.FriendRequestView.from_user:type_name -> super.User
-	0,   // 31: super.FriendRequestView.to_user:type_name -> super.User
-	94,  // 32: super.SendFriendRequestResp.data:type_name -> super.FriendRequestView
-	94,  // 33: super.ListIncomingFriendRequestsResp.data:type_name -> super.FriendRequestView
-	94,  // 34: super.ListOutgoingFriendRequestsResp.data:type_name -> super.FriendRequestView
-	0,   // 35: super.ListFriendsResp.users:type_name -> super.User
-	0,   // 36: super.GetFollowingsResp.users:type_name -> super.User
-	0,   // 37: super.GetFollowersResp.users:type_name -> super.User
-	118, // 38: super.UserAvatarData.base_config:type_name -> super.AvatarBaseConfig
-	119, // 39: super.UserAvatarData.current_outfit:type_name -> super.AvatarOutfitConfig
-	120, // 40: super.GetUserAvatarResp.avatar:type_name -> super.UserAvatarData
-	118, // 41: super.UpdateUserAvatarReq.base_config:type_name -> super.AvatarBaseConfig
-	119, // 42: super.UpdateUserAvatarReq.current_outfit:type_name -> super.AvatarOutfitConfig
-	120, // 43: super.UpdateUserAvatarResp.avatar:type_name -> super.UserAvatarData
-	125, // 44: super.GetUserLevelResp.level_info:type_name -> super.UserLevelInfo
-	126, // 45: super.GetCheckInStatusResp.status:type_name -> super.CheckInStatus
-	127, // 46: super.GetCheckInHistoryResp.records:type_name -> super.CheckInRecord
-	128, // 47: super.GetExpLogsResp.logs:type_name -> super.ExpLogRecord
-	1,   // 48: super.Super.Register:input_type -> super.RegisterReq
-	3,   // 49: super.Super.Login:input_type -> super.LoginReq
-	5,   // 50: super.Super.GetUserInfo:input_type -> super.GetUserInfoReq
-	7,   // 51: super.Super.GetUser:input_type -> super.GetUserReq
-	9,   // 52: super.Super.GetUserByEmail:input_type -> super.GetUserByEmailReq
-	11,  // 53: super.Super.UpdateUserInfo:input_type -> super.UpdateUserInfoReq
-	13,  // 54: super.Super.UpdateUserPassword:input_type -> super.UpdateUserPasswordReq
-	15,  // 55: super.Super.ResetPassword:input_type -> super.ResetPasswordReq
-	17,  // 56: super.Super.DeleteUser:input_type -> super.DeleteUserReq
-	19,  // 57: super.Super.UpdateUserVip:input_type -> super.UpdateUserVipReq
-	21,  // 58: super.Super.GetUsers:input_type -> super.GetUsersReq
-	23,  // 59: super.Super.GetUserCount:input_type -> super.GetUserCountReq
-	88,  // 60: super.Super.UpsertUserMemory:input_type -> super.UpsertUserMemoryReq
-	90,  // 61: super.Super.GetUserMemories:input_type -> super.GetUserMemoriesReq
-	92,  // 62: super.Super.DeleteUserMemory:input_type -> super.DeleteUserMemoryReq
-	30,  // 63: super.Super.GetVipPlans:input_type -> super.GetVipPlansReq
-	26,  // 64: super.Super.GetVipPlan:input_type -> super.GetVipPlanReq
-	28,  // 65: super.Super.CreateVipPlan:input_type -> super.CreateVipPlanReq
-	33,  // 66: super.Super.CreateVipOrder:input_type -> super.CreateVipOrderReq
-	35,  // 67: super.Super.GetVipOrders:input_type -> super.GetVipOrdersReq
-	38,  // 68: super.Super.GetVipRecords:input_type -> super.GetVipRecordsReq
-	40,  // 69: super.Super.GetUserActiveVipRecord:input_type -> super.GetUserActiveVipRecordReq
-	42,  // 70: super.Super.GetUserVipStatus:input_type -> super.GetUserVipStatusReq
-	44,  // 71: super.Super.CheckUserVip:input_type -> super.CheckUserVipReq
-	46,  // 72: super.Super.UpdateAutoRenew:input_type -> super.UpdateAutoRenewReq
-	48,  // 73: super.Super.SyncUserVipStatus:input_type -> super.SyncUserVipStatusReq
-	59,  // 74: super.Super.GetPosts:input_type -> super.GetPostsReq
-	61,  // 75: super.Super.GetPost:input_type -> super.GetPostReq
-	63,  // 76: super.Super.CreatePost:input_type -> super.CreatePostReq
-	64,  // 77: super.Super.ReportPost:input_type -> super.ReportPostReq
-	67,  // 78: super.Super.LikePost:input_type -> super.LikePostReq
-	69,  // 79: super.Super.GetPostComments:input_type -> super.GetPostCommentsReq
-	72,  // 80: super.Super.CreateComment:input_type -> super.CreateCommentReq
-	74,  // 81: super.Super.LikeComment:input_type -> super.LikeCommentReq
-	77,  // 82: super.Super.GetNotifications:input_type -> super.GetNotificationsReq
-	79,  // 83: super.Super.GetUnreadCount:input_type -> super.GetUnreadCountReq
-	81,  // 84: super.Super.ReadNotification:input_type -> super.ReadNotificationReq
-	83,  // 85: super.Super.ReadAllNotifications:input_type -> super.ReadAllNotificationsReq
-	85,  // 86: super.Super.CreateNotification:input_type -> super.CreateNotificationReq
-	50,  // 87: super.Super.Recharge:input_type -> super.RechargeReq
-	52,  // 88: super.Super.GetTransactions:input_type -> super.GetTransactionsReq
-	55,  // 89: super.Super.GetTransaction:input_type -> super.GetTransactionReq
-	109, // 90: super.Super.FollowUser:input_type -> super.FollowUserReq
-	111, // 91: super.Super.UnfollowUser:input_type -> super.UnfollowUserReq
-	112, // 92: super.Super.GetFollowings:input_type -> super.GetFollowingsReq
-	114, // 93: super.Super.GetFollowers:input_type -> super.GetFollowersReq
-	116, // 94: super.Super.CheckFollow:input_type -> super.CheckFollowReq
-	95,  // 95: super.Super.SendFriendRequest:input_type -> super.SendFriendRequestReq
-	97,  // 96: super.Super.ListIncomingFriendRequests:input_type -> super.ListIncomingFriendRequestsReq
-	99,  // 97: super.Super.ListOutgoingFriendRequests:input_type -> super.ListOutgoingFriendRequestsReq
-	101, // 98: super.Super.AcceptFriendRequest:input_type -> super.AcceptFriendRequestReq
-	103, // 99: super.Super.RejectFriendRequest:input_type -> super.RejectFriendRequestReq
-	105, // 100: super.Super.ListFriends:input_type -> super.ListFriendsReq
-	107, // 101: super.Super.GetFriendRelation:input_type -> super.GetFriendRelationReq
-	121, // 102: super.Super.GetUserAvatar:input_type -> super.GetUserAvatarReq
-	123, // 103: super.Super.UpdateUserAvatar:input_type -> super.UpdateUserAvatarReq
-	129, // 104: super.Super.CheckIn:input_type -> super.CheckInReq
-	131, // 105: super.Super.GetUserLevel:input_type -> super.GetUserLevelReq
-	133, // 106: super.Super.GetCheckInStatus:input_type -> super.GetCheckInStatusReq
-	135, // 107: super.Super.GetCheckInHistory:input_type -> super.GetCheckInHistoryReq
-	137, // 108: super.Super.GetExpLogs:input_type -> super.GetExpLogsReq
-	2,   // 109: super.Super.Register:output_type -> super.RegisterResp
-	4,   // 110: super.Super.Login:output_type -> super.LoginResp
-	6,   // 111: super.Super.GetUserInfo:output_type -> super.GetUserInfoResp
-	8,   // 112: super.Super.GetUser:output_type -> super.GetUserResp
-	10,  // 113: super.Super.GetUserByEmail:output_type -> super.GetUserByEmailResp
-	12,  // 114: super.Super.UpdateUserInfo:output_type -> super.UpdateUserInfoResp
-	14,  // 115: super.Super.UpdateUserPassword:output_type -> super.UpdateUserPasswordResp
-	16,  // 116: super.Super.ResetPassword:output_type -> super.ResetPasswordResp
-	18,  // 117: super.Super.DeleteUser:output_type -> super.DeleteUserResp
-	20,  // 118: super.Super.UpdateUserVip:output_type -> super.UpdateUserVipResp
-	22,  // 119: super.Super.GetUsers:output_type -> super.GetUsersResp
-	24,  // 120: super.Super.GetUserCount:output_type -> super.GetUserCountResp
-	89,  // 121: super.Super.UpsertUserMemory:output_type -> super.UpsertUserMemoryResp
-	91,  // 122: super.Super.GetUserMemories:output_type -> super.GetUserMemoriesResp
-	93,  // 123: super.Super.DeleteUserMemory:output_type -> super.DeleteUserMemoryResp
-	31,  // 124: super.Super.GetVipPlans:output_type -> super.GetVipPlansResp
-	27,  // 125: super.Super.GetVipPlan:output_type -> super.GetVipPlanResp
-	29,  // 126: super.Super.CreateVipPlan:output_type -> super.CreateVipPlanResp
-	34,  // 127: super.Super.CreateVipOrder:output_type -> super.CreateVipOrderResp
-	36,  // 128: super.Super.GetVipOrders:output_type -> super.GetVipOrdersResp
-	39,  // 129: super.Super.GetVipRecords:output_type -> super.GetVipRecordsResp
-	41,  // 130: super.Super.GetUserActiveVipRecord:output_type -> super.GetUserActiveVipRecordResp
-	43,  // 131: super.Super.GetUserVipStatus:output_type -> super.GetUserVipStatusResp
-	45,  // 132: super.Super.CheckUserVip:output_type -> super.CheckUserVipResp
-	47,  // 133: super.Super.UpdateAutoRenew:output_type -> super.UpdateAutoRenewResp
-	49,  // 134: super.Super.SyncUserVipStatus:output_type -> super.SyncUserVipStatusResp
-	60,  // 135: super.Super.GetPosts:output_type -> super.GetPostsResp
-	62,  // 136: super.Super.GetPost:output_type -> super.GetPostResp
-	66,  // 137: super.Super.CreatePost:output_type -> super.CreatePostResp
-	65,  // 138: super.Super.ReportPost:output_type -> super.ReportPostResp
-	68,  // 139: super.Super.LikePost:output_type -> super.LikePostResp
-	70,  // 140: super.Super.GetPostComments:output_type -> super.GetPostCommentsResp
-	73,  // 141: super.Super.CreateComment:output_type -> super.CreateCommentResp
-	75,  // 142: super.Super.LikeComment:output_type -> super.LikeCommentResp
-	78,  // 143: super.Super.GetNotifications:output_type -> super.GetNotificationsResp
-	80,  // 144: super.Super.GetUnreadCount:output_type -> super.GetUnreadCountResp
-	82,  // 145: super.Super.ReadNotification:output_type -> super.ReadNotificationResp
-	84,  // 146: super.Super.ReadAllNotifications:output_type -> super.ReadAllNotificationsResp
-	86,  // 147: super.Super.CreateNotification:output_type -> super.CreateNotificationResp
-	51,  // 148: super.Super.Recharge:output_type -> super.RechargeResp
-	54,  // 149: super.Super.GetTransactions:output_type -> super.GetTransactionsResp
-	56,  // 150: super.Super.GetTransaction:output_type -> super.GetTransactionResp
-	110, // 151: super.Super.FollowUser:output_type -> super.FollowUserResp
-	110, // 152: super.Super.UnfollowUser:output_type -> super.FollowUserResp
-	113, // 153: super.Super.GetFollowings:output_type -> super.GetFollowingsResp
-	115, // 154: super.Super.GetFollowers:output_type -> super.GetFollowersResp
-	117, // 155: super.Super.CheckFollow:output_type -> super.CheckFollowResp
-	96,  // 156: super.Super.SendFriendRequest:output_type -> super.SendFriendRequestResp
-	98,  // 157: super.Super.ListIncomingFriendRequests:output_type -> super.ListIncomingFriendRequestsResp
-	100, // 158: super.Super.ListOutgoingFriendRequests:output_type -> super.ListOutgoingFriendRequestsResp
-	102, // 159: super.Super.AcceptFriendRequest:output_type -> super.AcceptFriendRequestResp
-	104, // 160: super.Super.RejectFriendRequest:output_type -> super.RejectFriendRequestResp
-	106, // 161: super.Super.ListFriends:output_type -> super.ListFriendsResp
-	108, // 162: super.Super.GetFriendRelation:output_type -> super.GetFriendRelationResp
-	122, // 163: super.Super.GetUserAvatar:output_type -> super.GetUserAvatarResp
-	124, // 164: super.Super.UpdateUserAvatar:output_type -> super.UpdateUserAvatarResp
-	130, // 165: super.Super.CheckIn:output_type -> super.CheckInResp
-	132, // 166: super.Super.GetUserLevel:output_type -> super.GetUserLevelResp
-	134, // 167: super.Super.GetCheckInStatus:output_type -> super.GetCheckInStatusResp
-	136, // 168: super.Super.GetCheckInHistory:output_type -> super.GetCheckInHistoryResp
-	138, // 169: super.Super.GetExpLogs:output_type -> super.GetExpLogsResp
-	109, // [109:170] is the sub-list for method output_type
-	48,  // [48:109] is the sub-list for method input_type
-	48,  // [48:48] is the sub-list for extension type_name
-	48,  // [48:48] is the sub-list for extension extendee
-	0,   // [0:48] is the sub-list for field type_name
+	15,  // 2: super.ListMfaPoliciesResp.policies:type_name -> super.MfaPolicy
+	15,  // 3: super.SetMfaPolicyResp.policy:type_name -> super.MfaPolicy
+	0,   // 4: super.GetUserInfoResp.user:type_name -> super.User
+	0,   // 5: super.GetUserResp.user:type_name -> super.User
+	0,   // 6: super.GetUserByEmailResp.user:type_name -> super.User
+	0,   // 7: super.UpdateUserInfoResp.user:type_name -> super.User
+	38,  // 8: super.ListUserSessionsResp.sessions:type_name -> super.UserSession
+	0,   // 9: super.VerifyEmailResp.user:type_name -> super.User
+	55,  // 10: super.ListRoleAuditLogsResp.logs:type_name -> super.RoleAuditLog
+	58,  // 11: super.ListSecurityEventsResp.events:type_name -> super.SecurityEvent
+	63,  // 12: super.DeleteUserResp.deletion:type_name -> super.AccountDeletion
+	63,  // 13: super.GetAccountDeletionResp.deletion:type_name -> super.AccountDeletion
+	0,   // 14: super.UpdateUserVipResp.user:type_name -> super.User
+	0,   // 15: super.GetUsersResp.users:type_name -> super.User
+	76,  // 16: super.GetVipPlanResp.plan:type_name -> super.VipPlan
+	76,  // 17: super.CreateVipPlanResp.plan:type_name -> super.VipPlan
+	76,  // 18: super.GetVipPlansResp.plans:type_name -> super.VipPlan
+	83,  // 19: super.CreateVipOrderResp.order:type_name -> super.VipOrder
+	83,  // 20: super.GetVipOrdersResp.orders:type_name -> super.VipOrder
+	88,  // 21: super.GetVipRecordsResp.records:type_name -> super.VipRecord
+	88,  // 22: super.GetUserActiveVipRecordResp.record:type_name -> super.VipRecord
+	104, // 23: super.GetTransactionsResp.transactions:type_name -> super.Transaction
+	104, // 24: super.GetTransactionResp.transaction:type_name -> super.Transaction
+	108, // 25: super.Post.topic_tags:type_name -> super.TopicTag
+	109, // 26: super.GetPostsResp.posts:type_name -> super.Post
+	109, // 27: super.GetPostResp.post:type_name -> super.Post
+	108, // 28: super.CreatePostReq.topic_tags:type_name -> super.TopicTag
+	109, // 29: super.CreatePostResp.post:type_name -> super.Post
+	108, // 30: super.UpdatePostReq.topic_tags:type_name -> super.TopicTag
+	109, // 31: super.UpdatePostResp.post:type_name -> super.Post
+	109, // 32: super.LikePostResp.post:type_name -> super.Post
+	126, // 33: super.GetPostCommentsResp.comments:type_name -> super.Comment
+	126, // 34: super.CreateCommentResp.comment:type_name -> super.Comment
+	126, // 35: super.LikeCommentResp.comment:type_name -> super.Comment
+	132, // 36: super.Notification.actors:type_name -> super.NotificationActor
+	131, // 37: super.GetNotificationsResp.notifications:type_name -> super.Notification
+	131, // 38: super.CreateNotificationResp.notification:type_name -> super.Notification
+	131, // 39: super.NotificationEvent.notification:type_name -> super.Notification
+	145, // 40: super.NotificationEvent.kick:type_name -> super.SessionKick
+	146, // 41: super.NotificationPreferences.kinds:type_name -> super.NotificationKindPreference
+	147, // 42: super.NotificationPreferences.quiet_hours:type_name -> super.NotificationQuietHours
+	148, // 43: super.NotificationPreferences.mutes:type_name -> super.NotificationMute
+	146, // 44: super.UpdateNotificationPreferencesReq.kinds:type_name -> super.NotificationKindPreference
+	147, // 45: super.UpdateNotificationPreferencesReq.quiet_hours:type_name -> super.NotificationQuietHours
+	149, // 46: super.NotificationPreferencesResp.preferences:type_name -> super.NotificationPreferences
+	155, // 47: super.NotificationCampaignResp.campaign:type_name -> super.NotificationCampaign
+	155, // 48: super.ListNotificationCampaignsResp.campaigns:type_name -> super.NotificationCampaign
+	276, // 49: super.SendDevicePushReq.data:type_name -> super.SendDevicePushReq.DataEntry
+	167, // 50: super.UpsertUserMemoryResp.memory:type_name -> super.UserMemory
+	167, // 51: super.GetUserMemoriesResp.memories:type_name -> super.UserMemory
+	0,   // 52: super.FriendRequestView.from_user:type_name -> super.User
+	0,   // 53: super.FriendRequestView.to_user:type_name -> super.User
+	174, // 54: super.SendFriendRequestResp.data:type_name -> super.FriendRequestView
+	174, // 55: super.ListIncomingFriendRequestsResp.data:type_name -> super.FriendRequestView
+	174, // 56: super.ListOutgoingFriendRequestsResp.data:type_name -> super.FriendRequestView
+	0,   // 57: super.ListFriendsResp.users:type_name -> super.User
+	0,   // 58: super.BlockedUser.user:type_name -> super.User
+	192, // 59: super.ListBlockedUsersResp.users:type_name -> super.BlockedUser
+	0,   // 60: super.GetFollowingsResp.users:type_name -> super.User
+	0,   // 61: super.GetFollowersResp.users:type_name -> super.User
+	207, // 62: super.UpdatePrivacySettingsReq.settings:type_name -> super.PrivacySettings
+	207, // 63: super.PrivacySettingsResp.settings:type_name -> super.PrivacySettings
+	0,   // 64: super.FollowRequestView.user:type_name -> super.User
+	211, // 65: super.ListFollowRequestsResp.requests:type_name -> super.FollowRequestView
+	218, // 66: super.UserAvatarData.base_config:type_name -> super.AvatarBaseConfig
+	219, // 67: super.UserAvatarData.current_outfit:type_name -> super.AvatarOutfitConfig
+	220, // 68: super.GetUserAvatarResp.avatar:type_name -> super.UserAvatarData
+	218, // 69: super.UpdateUserAvatarReq.base_config:type_name -> super.AvatarBaseConfig
+	219, // 70: super.UpdateUserAvatarReq.current_outfit:type_name -> super.AvatarOutfitConfig
+	220, // 71: super.UpdateUserAvatarResp.avatar:type_name -> super.UserAvatarData
+	225, // 72: super.GetUserLevelResp.level_info:type_name -> super.UserLevelInfo
+	226, // 73: super.GetCheckInStatusResp.status:type_name -> super.CheckInStatus
+	227, // 74: super.GetCheckInHistoryResp.records:type_name -> super.CheckInRecord
+	228, // 75: super.GetExpLogsResp.logs:type_name -> super.ExpLogRecord
+	239, // 76: super.PreKeyBundle.signed_pre_key:type_name -> super.SignedPreKey
+	239, // 77: super.PreKeyBundle.one_time_pre_key:type_name -> super.SignedPreKey
+	239, // 78: super.UploadPreKeyBundleReq.signed_pre_key:type_name -> super.SignedPreKey
+	239, // 79: super.UploadPreKeyBundleReq.one_time_pre_keys:type_name -> super.SignedPreKey
+	240, // 80: super.GetPreKeyBundlesResp.bundles:type_name -> super.PreKeyBundle
+	245, // 81: super.StoreEncryptedMessageResp.message:type_name -> super.EncryptedMessage
+	245, // 82: super.ListPendingEncryptedMessagesResp.messages:type_name -> super.EncryptedMessage
+	252, // 83: super.SuspensionResp.suspension:type_name -> super.Suspension
+	0,   // 84: super.SuspensionAppeal.user:type_name -> super.User
+	252, // 85: super.SuspensionAppeal.suspension:type_name -> super.Suspension
+	258, // 86: super.SuspensionAppealResp.appeal:type_name -> super.SuspensionAppeal
+	258, // 87: super.ListSuspensionAppealsResp.appeals:type_name -> super.SuspensionAppeal
+	263, // 88: super.ListOidcProvidersResp.providers:type_name -> super.OidcProvider
+	269, // 89: super.UserIdentityResp.identity:type_name -> super.UserIdentity
+	269, // 90: super.ListUserIdentitiesResp.identities:type_name -> super.UserIdentity
+	1,   // 91: super.Super.Register:input_type -> super.RegisterReq
+	3,   // 92: super.Super.Login:input_type -> super.LoginReq
+	20,  // 93: super.Super.GetUserInfo:input_type -> super.GetUserInfoReq
+	22,  // 94: super.Super.GetUser:input_type -> super.GetUserReq
+	24,  // 95: super.Super.GetUserByEmail:input_type -> super.GetUserByEmailReq
+	26,  // 96: super.Super.UpdateUserInfo:input_type -> super.UpdateUserInfoReq
+	28,  // 97: super.Super.UpdateUserPassword:input_type -> super.UpdateUserPasswordReq
+	30,  // 98: super.Super.RequestPasswordReset:input_type -> super.RequestPasswordResetReq
+	32,  // 99: super.Super.ResetPassword:input_type -> super.ResetPasswordReq
+	34,  // 100: super.Super.GetUserAuthState:input_type -> super.GetUserAuthStateReq
+	36,  // 101: super.Super.RefreshSession:input_type -> super.RefreshSessionReq
+	39,  // 102: super.Super.ListUserSessions:input_type -> super.ListUserSessionsReq
+	41,  // 103: super.Super.RevokeUserSession:input_type -> super.RevokeUserSessionReq
+	43,  // 104: super.Super.RevokeAllUserSessions:input_type -> super.RevokeAllUserSessionsReq
+	45,  // 105: super.Super.IssueWsTicket:input_type -> super.IssueWsTicketReq
+	47,  // 106: super.Super.RedeemWsTicket:input_type -> super.RedeemWsTicketReq
+	49,  // 107: super.Super.SendEmailVerification:input_type -> super.SendEmailVerificationReq
+	51,  // 108: super.Super.VerifyEmail:input_type -> super.VerifyEmailReq
+	53,  // 109: super.Super.UpdateUserRole:input_type -> super.UpdateUserRoleReq
+	56,  // 110: super.Super.ListRoleAuditLogs:input_type -> super.ListRoleAuditLogsReq
+	59,  // 111: super.Super.ListSecurityEvents:input_type -> super.ListSecurityEventsReq
+	5,   // 112: super.Super.VerifyLoginMfa:input_type -> super.VerifyLoginMfaReq
+	6,   // 113: super.Super.GetMfaStatus:input_type -> super.GetMfaStatusReq
+	8,   // 114: super.Super.BeginTotpEnrollment:input_type -> super.BeginTotpEnrollmentReq
+	10,  // 115: super.Super.ConfirmTotpEnrollment:input_type -> super.ConfirmTotpEnrollmentReq
+	12,  // 116: super.Super.DisableTotp:input_type -> super.DisableTotpReq
+	14,  // 117: super.Super.RegenerateRecoveryCodes:input_type -> super.RegenerateRecoveryCodesReq
+	16,  // 118: super.Super.ListMfaPolicies:input_type -> super.ListMfaPoliciesReq
+	18,  // 119: super.Super.SetMfaPolicy:input_type -> super.SetMfaPolicyReq
+	61,  // 120: super.Super.DeleteUser:input_type -> super.DeleteUserReq
+	64,  // 121: super.Super.GetAccountDeletion:input_type -> super.GetAccountDeletionReq
+	66,  // 122: super.Super.CancelAccountDeletion:input_type -> super.CancelAccountDeletionReq
+	68,  // 123: super.Super.ExportUserData:input_type -> super.ExportUserDataReq
+	70,  // 124: super.Super.UpdateUserVip:input_type -> super.UpdateUserVipReq
+	72,  // 125: super.Super.GetUsers:input_type -> super.GetUsersReq
+	74,  // 126: super.Super.GetUserCount:input_type -> super.GetUserCountReq
+	168, // 127: super.Super.UpsertUserMemory:input_type -> super.UpsertUserMemoryReq
+	170, // 128: super.Super.GetUserMemories:input_type -> super.GetUserMemoriesReq
+	172, // 129: super.Super.DeleteUserMemory:input_type -> super.DeleteUserMemoryReq
+	81,  // 130: super.Super.GetVipPlans:input_type -> super.GetVipPlansReq
+	77,  // 131: super.Super.GetVipPlan:input_type -> super.GetVipPlanReq
+	79,  // 132: super.Super.CreateVipPlan:input_type -> super.CreateVipPlanReq
+	84,  // 133: super.Super.CreateVipOrder:input_type -> super.CreateVipOrderReq
+	86,  // 134: super.Super.GetVipOrders:input_type -> super.GetVipOrdersReq
+	89,  // 135: super.Super.GetVipRecords:input_type -> super.GetVipRecordsReq
+	91,  // 136: super.Super.GetUserActiveVipRecord:input_type -> super.GetUserActiveVipRecordReq
+	93,  // 137: super.Super.GetUserVipStatus:input_type -> super.GetUserVipStatusReq
+	95,  // 138: super.Super.CheckUserVip:input_type -> super.CheckUserVipReq
+	97,  // 139: super.Super.UpdateAutoRenew:input_type -> super.UpdateAutoRenewReq
+	99,  // 140: super.Super.SyncUserVipStatus:input_type -> super.SyncUserVipStatusReq
+	110, // 141: super.Super.GetPosts:input_type -> super.GetPostsReq
+	112, // 142: super.Super.GetPost:input_type -> super.GetPostReq
+	114, // 143: super.Super.CreatePost:input_type -> super.CreatePostReq
+	118, // 144: super.Super.UpdatePost:input_type -> super.UpdatePostReq
+	120, // 145: super.Super.DeletePost:input_type -> super.DeletePostReq
+	115, // 146: super.Super.ReportPost:input_type -> super.ReportPostReq
+	122, // 147: super.Super.LikePost:input_type -> super.LikePostReq
+	124, // 148: super.Super.GetPostComments:input_type -> super.GetPostCommentsReq
+	127, // 149: super.Super.CreateComment:input_type -> super.CreateCommentReq
+	129, // 150: super.Super.LikeComment:input_type -> super.LikeCommentReq
+	133, // 151: super.Super.GetNotifications:input_type -> super.GetNotificationsReq
+	135, // 152: super.Super.GetUnreadCount:input_type -> super.GetUnreadCountReq
+	137, // 153: super.Super.ReadNotification:input_type -> super.ReadNotificationReq
+	139, // 154: super.Super.ReadAllNotifications:input_type -> super.ReadAllNotificationsReq
+	141, // 155: super.Super.CreateNotification:input_type -> super.CreateNotificationReq
+	143, // 156: super.Super.WatchNotifications:input_type -> super.WatchNotificationsReq
+	150, // 157: super.Super.GetNotificationPreferences:input_type -> super.GetNotificationPreferencesReq
+	151, // 158: super.Super.UpdateNotificationPreferences:input_type -> super.UpdateNotificationPreferencesReq
+	153, // 159: super.Super.SetNotificationMute:input_type -> super.SetNotificationMuteReq
+	156, // 160: super.Super.CreateNotificationCampaign:input_type -> super.CreateNotificationCampaignReq
+	158, // 161: super.Super.ListNotificationCampaigns:input_type -> super.ListNotificationCampaignsReq
+	160, // 162: super.Super.GetNotificationCampaign:input_type -> super.GetNotificationCampaignReq
+	160, // 163: super.Super.CancelNotificationCampaign:input_type -> super.GetNotificationCampaignReq
+	161, // 164: super.Super.RegisterDevice:input_type -> super.RegisterDeviceReq
+	163, // 165: super.Super.UnregisterDevice:input_type -> super.UnregisterDeviceReq
+	165, // 166: super.Super.SendDevicePush:input_type -> super.SendDevicePushReq
+	101, // 167: super.Super.Recharge:input_type -> super.RechargeReq
+	103, // 168: super.Super.GetTransactions:input_type -> super.GetTransactionsReq
+	106, // 169: super.Super.GetTransaction:input_type -> super.GetTransactionReq
+	198, // 170: super.Super.FollowUser:input_type -> super.FollowUserReq
+	200, // 171: super.Super.UnfollowUser:input_type -> super.UnfollowUserReq
+	201, // 172: super.Super.GetFollowings:input_type -> super.GetFollowingsReq
+	203, // 173: super.Super.GetFollowers:input_type -> super.GetFollowersReq
+	205, // 174: super.Super.CheckFollow:input_type -> super.CheckFollowReq
+	212, // 175: super.Super.ListFollowRequests:input_type -> super.ListFollowRequestsReq
+	214, // 176: super.Super.RespondFollowRequest:input_type -> super.RespondFollowRequestReq
+	208, // 177: super.Super.GetPrivacySettings:input_type -> super.GetPrivacySettingsReq
+	209, // 178: super.Super.UpdatePrivacySettings:input_type -> super.UpdatePrivacySettingsReq
+	216, // 179: super.Super.CheckDirectMessage:input_type -> super.CheckDirectMessageReq
+	175, // 180: super.Super.SendFriendRequest:input_type -> super.SendFriendRequestReq
+	177, // 181: super.Super.ListIncomingFriendRequests:input_type -> super.ListIncomingFriendRequestsReq
+	179, // 182: super.Super.ListOutgoingFriendRequests:input_type -> super.ListOutgoingFriendRequestsReq
+	181, // 183: super.Super.AcceptFriendRequest:input_type -> super.AcceptFriendRequestReq
+	183, // 184: super.Super.RejectFriendRequest:input_type -> super.RejectFriendRequestReq
+	185, // 185: super.Super.ListFriends:input_type -> super.ListFriendsReq
+	187, // 186: super.Super.GetFriendRelation:input_type -> super.GetFriendRelationReq
+	189, // 187: super.Super.BlockUser:input_type -> super.BlockUserReq
+	189, // 188: super.Super.UnblockUser:input_type -> super.BlockUserReq
+	191, // 189: super.Super.ListBlockedUsers:input_type -> super.ListBlockedUsersReq
+	194, // 190: super.Super.CheckUserBlock:input_type -> super.CheckUserBlockReq
+	196, // 191: super.Super.GetBlockedUserIds:input_type -> super.GetBlockedUserIdsReq
+	253, // 192: super.Super.SuspendUser:input_type -> super.SuspendUserReq
+	254, // 193: super.Super.LiftSuspension:input_type -> super.LiftSuspensionReq
+	255, // 194: super.Super.GetUserSuspension:input_type -> super.GetUserSuspensionReq
+	257, // 195: super.Super.SubmitSuspensionAppeal:input_type -> super.SubmitSuspensionAppealReq
+	260, // 196: super.Super.ListSuspensionAppeals:input_type -> super.ListSuspensionAppealsReq
+	262, // 197: super.Super.ReviewSuspensionAppeal:input_type -> super.ReviewSuspensionAppealReq
+	264, // 198: super.Super.ListOidcProviders:input_type -> super.ListOidcProvidersReq
+	266, // 199: super.Super.StartOidcAuth:input_type -> super.StartOidcAuthReq
+	268, // 200: super.Super.OidcLogin:input_type -> super.OidcLoginReq
+	270, // 201: super.Super.LinkUserIdentity:input_type -> super.LinkUserIdentityReq
+	272, // 202: super.Super.ListUserIdentities:input_type -> super.ListUserIdentitiesReq
+	274, // 203: super.Super.UnlinkUserIdentity:input_type -> super.UnlinkUserIdentityReq
+	221, // 204: super.Super.GetUserAvatar:input_type -> super.GetUserAvatarReq
+	223, // 205: super.Super.UpdateUserAvatar:input_type -> super.UpdateUserAvatarReq
+	229, // 206: super.Super.CheckIn:input_type -> super.CheckInReq
+	231, // 207: super.Super.GetUserLevel:input_type -> super.GetUserLevelReq
+	233, // 208: super.Super.GetCheckInStatus:input_type -> super.GetCheckInStatusReq
+	235, // 209: super.Super.GetCheckInHistory:input_type -> super.GetCheckInHistoryReq
+	237, // 210: super.Super.GetExpLogs:input_type -> super.GetExpLogsReq
+	241, // 211: super.Super.UploadPreKeyBundle:input_type -> super.UploadPreKeyBundleReq
+	243, // 212: super.Super.GetPreKeyBundles:input_type -> super.GetPreKeyBundlesReq
+	246, // 213: super.Super.StoreEncryptedMessage:input_type -> super.StoreEncryptedMessageReq
+	248, // 214: super.Super.ListPendingEncryptedMessages:input_type -> super.ListPendingEncryptedMessagesReq
+	250, // 215: super.Super.AckEncryptedMessages:input_type -> super.AckEncryptedMessagesReq
+	2,   // 216: super.Super.Register:output_type -> super.RegisterResp
+	4,   // 217: super.Super.Login:output_type -> super.LoginResp
+	21,  // 218: super.Super.GetUserInfo:output_type -> super.GetUserInfoResp
+	23,  // 219: super.Super.GetUser:output_type -> super.GetUserResp
+	25,  // 220: super.Super.GetUserByEmail:output_type -> super.GetUserByEmailResp
+	27,  // 221: super.Super.UpdateUserInfo:output_type -> super.UpdateUserInfoResp
+	29,  // 222: super.Super.UpdateUserPassword:output_type -> super.UpdateUserPasswordResp
+	31,  // 223: super.Super.RequestPasswordReset:output_type -> super.RequestPasswordResetResp
+	33,  // 224: super.Super.ResetPassword:output_type -> super.ResetPasswordResp
+	35,  // 225: super.Super.GetUserAuthState:output_type -> super.GetUserAuthStateResp
+	37,  // 226: super.Super.RefreshSession:output_type -> super.RefreshSessionResp
+	40,  // 227: super.Super.ListUserSessions:output_type -> super.ListUserSessionsResp
+	42,  // 228: super.Super.RevokeUserSession:output_type -> super.RevokeUserSessionResp
+	44,  // 229: super.Super.RevokeAllUserSessions:output_type -> super.RevokeAllUserSessionsResp
+	46,  // 230: super.Super.IssueWsTicket:output_type -> super.IssueWsTicketResp
+	48,  // 231: super.Super.RedeemWsTicket:output_type -> super.RedeemWsTicketResp
+	50,  // 232: super.Super.SendEmailVerification:output_type -> super.SendEmailVerificationResp
+	52,  // 233: super.Super.VerifyEmail:output_type -> super.VerifyEmailResp
+	54,  // 234: super.Super.UpdateUserRole:output_type -> super.UpdateUserRoleResp
+	57,  // 235: super.Super.ListRoleAuditLogs:output_type -> super.ListRoleAuditLogsResp
+	60,  // 236: super.Super.ListSecurityEvents:output_type -> super.ListSecurityEventsResp
+	4,   // 237: super.Super.VerifyLoginMfa:output_type -> super.LoginResp
+	7,   // 238: super.Super.GetMfaStatus:output_type -> super.GetMfaStatusResp
+	9,   // 239: super.Super.BeginTotpEnrollment:output_type -> super.BeginTotpEnrollmentResp
+	11,  // 240: super.Super.ConfirmTotpEnrollment:output_type -> super.RecoveryCodesResp
+	13,  // 241: super.Super.DisableTotp:output_type -> super.DisableTotpResp
+	11,  // 242: super.Super.RegenerateRecoveryCodes:output_type -> super.RecoveryCodesResp
+	17,  // 243: super.Super.ListMfaPolicies:output_type -> super.ListMfaPoliciesResp
+	19,  // 244: super.Super.SetMfaPolicy:output_type -> super.SetMfaPolicyResp
+	62,  // 245: super.Super.DeleteUser:output_type -> super.DeleteUserResp
+	65,  // 246: super.Super.GetAccountDeletion:output_type -> super.GetAccountDeletionResp
+	67,  // 247: super.Super.CancelAccountDeletion:output_type -> super.CancelAccountDeletionResp
+	69,  // 248: super.Super.ExportUserData:output_type -> super.ExportChunk
+	71,  // 249: super.Super.UpdateUserVip:output_type -> super.UpdateUserVipResp
+	73,  // 250: super.Super.GetUsers:output_type -> super.GetUsersResp
+	75,  // 251: super.Super.GetUserCount:output_type -> super.GetUserCountResp
+	169, // 252: super.Super.UpsertUserMemory:output_type -> super.UpsertUserMemoryResp
+	171, // 253: super.Super.GetUserMemories:output_type -> super.GetUserMemoriesResp
+	173, // 254: super.Super.DeleteUserMemory:output_type -> super.DeleteUserMemoryResp
+	82,  // 255: super.Super.GetVipPlans:output_type -> super.GetVipPlansResp
+	78,  // 256: super.Super.GetVipPlan:output_type -> super.GetVipPlanResp
+	80,  // 257: super.Super.CreateVipPlan:output_type -> super.CreateVipPlanResp
+	85,  // 258: super.Super.CreateVipOrder:output_type -> super.CreateVipOrderResp
+	87,  // 259: super.Super.GetVipOrders:output_type -> super.GetVipOrdersResp
+	90,  // 260: super.Super.GetVipRecords:output_type -> super.GetVipRecordsResp
+	92,  // 261: super.Super.GetUserActiveVipRecord:output_type -> super.GetUserActiveVipRecordResp
+	94,  // 262: super.Super.GetUserVipStatus:output_type -> super.GetUserVipStatusResp
+	96,  // 263: super.Super.CheckUserVip:output_type -> super.CheckUserVipResp
+	98,  // 264: super.Super.UpdateAutoRenew:output_type -> super.UpdateAutoRenewResp
+	100, // 265: super.Super.SyncUserVipStatus:output_type -> super.SyncUserVipStatusResp
+	111, // 266: super.Super.GetPosts:output_type -> super.GetPostsResp
+	113, // 267: super.Super.GetPost:output_type -> super.GetPostResp
+	117, // 268: super.Super.CreatePost:output_type -> super.CreatePostResp
+	119, // 269: super.Super.UpdatePost:output_type -> super.UpdatePostResp
+	121, // 270: super.Super.DeletePost:output_type -> super.DeletePostResp
+	116, // 271: super.Super.ReportPost:output_type -> super.ReportPostResp
+	123, // 272: super.Super.LikePost:output_type -> super.LikePostResp
+	125, // 273: super.Super.GetPostComments:output_type -> super.GetPostCommentsResp
+	128, // 274: super.Super.CreateComment:output_type -> super.CreateCommentResp
+	130, // 275: super.Super.LikeComment:output_type -> super.LikeCommentResp
+	134, // 276: super.Super.GetNotifications:output_type -> super.GetNotificationsResp
+	136, // 277: super.Super.GetUnreadCount:output_type -> super.GetUnreadCountResp
+	138, // 278: super.Super.ReadNotification:output_type -> super.ReadNotificationResp
+	140, // 279: super.Super.ReadAllNotifications:output_type -> super.ReadAllNotificationsResp
+	142, // 280: super.Super.CreateNotification:output_type -> super.CreateNotificationResp
+	144, // 281: super.Super.WatchNotifications:output_type -> super.NotificationEvent
+	152, // 282: super.Super.GetNotificationPreferences:output_type -> super.NotificationPreferencesResp
+	152, // 283: super.Super.UpdateNotificationPreferences:output_type -> super.NotificationPreferencesResp
+	154, // 284: super.Super.SetNotificationMute:output_type -> super.SetNotificationMuteResp
+	157, // 285: super.Super.CreateNotificationCampaign:output_type -> super.NotificationCampaignResp
+	159, // 286: super.Super.ListNotificationCampaigns:output_type -> super.ListNotificationCampaignsResp
+	157, // 287: super.Super.GetNotificationCampaign:output_type -> super.NotificationCampaignResp
+	157, // 288: super.Super.CancelNotificationCampaign:output_type -> super.NotificationCampaignResp
+	162, // 289: super.Super.RegisterDevice:output_type -> super.RegisterDeviceResp
+	164, // 290: super.Super.UnregisterDevice:output_type -> super.UnregisterDeviceResp
+	166, // 291: super.Super.SendDevicePush:output_type -> super.SendDevicePushResp
+	102, // 292: super.Super.Recharge:output_type -> super.RechargeResp
+	105, // 293: super.Super.GetTransactions:output_type -> super.GetTransactionsResp
+	107, // 294: super.Super.GetTransaction:output_type -> super.GetTransactionResp
+	199, // 295: super.Super.FollowUser:output_type -> super.FollowUserResp
+	199, // 296: super.Super.UnfollowUser:output_type -> super.FollowUserResp
+	202, // 297: super.Super.GetFollowings:output_type -> super.GetFollowingsResp
+	204, // 298: super.Super.GetFollowers:output_type -> super.GetFollowersResp
+	206, // 299: super.Super.CheckFollow:output_type -> super.CheckFollowResp
+	213, // 300: super.Super.ListFollowRequests:output_type -> super.ListFollowRequestsResp
+	215, // 301: super.Super.RespondFollowRequest:output_type -> super.RespondFollowRequestResp
+	210, // 302: super.Super.GetPrivacySettings:output_type -> super.PrivacySettingsResp
+	210, // 303: super.Super.UpdatePrivacySettings:output_type -> super.PrivacySettingsResp
+	217, // 304: super.Super.CheckDirectMessage:output_type -> super.CheckDirectMessageResp
+	176, // 305: super.Super.SendFriendRequest:output_type -> super.SendFriendRequestResp
+	178, // 306: super.Super.ListIncomingFriendRequests:output_type -> super.ListIncomingFriendRequestsResp
+	180, // 307: super.Super.ListOutgoingFriendRequests:output_type -> super.ListOutgoingFriendRequestsResp
+	182, // 308: super.Super.AcceptFriendRequest:output_type -> super.AcceptFriendRequestResp
+	184, // 309: super.Super.RejectFriendRequest:output_type -> super.RejectFriendRequestResp
+	186, // 310: super.Super.ListFriends:output_type -> super.ListFriendsResp
+	188, // 311: super.Super.GetFriendRelation:output_type -> super.GetFriendRelationResp
+	190, // 312: super.Super.BlockUser:output_type -> super.BlockUserResp
+	190, // 313: super.Super.UnblockUser:output_type -> super.BlockUserResp
+	193, // 314: super.Super.ListBlockedUsers:output_type -> super.ListBlockedUsersResp
+	195, // 315: super.Super.CheckUserBlock:output_type -> super.CheckUserBlockResp
+	197, // 316: super.Super.GetBlockedUserIds:output_type -> super.GetBlockedUserIdsResp
+	256, // 317: super.Super.SuspendUser:output_type -> super.SuspensionResp
+	256, // 318: super.Super.LiftSuspension:output_type -> super.SuspensionResp
+	256, // 319: super.Super.GetUserSuspension:output_type -> super.SuspensionResp
+	259, // 320: super.Super.SubmitSuspensionAppeal:output_type -> super.SuspensionAppealResp
+	261, // 321: super.Super.ListSuspensionAppeals:output_type -> super.ListSuspensionAppealsResp
+	259, // 322: super.Super.ReviewSuspensionAppeal:output_type -> super.SuspensionAppealResp
+	265, // 323: super.Super.ListOidcProviders:output_type -> super.ListOidcProvidersResp
+	267, // 324: super.Super.StartOidcAuth:output_type -> super.StartOidcAuthResp
+	4,   // 325: super.Super.OidcLogin:output_type -> super.LoginResp
+	271, // 326: super.Super.LinkUserIdentity:output_type -> super.UserIdentityResp
+	273, // 327: super.Super.ListUserIdentities:output_type -> super.ListUserIdentitiesResp
+	275, // 328: super.Super.UnlinkUserIdentity:output_type -> super.UnlinkUserIdentityResp
+	222, // 329: super.Super.GetUserAvatar:output_type -> super.GetUserAvatarResp
+	224, // 330: super.Super.UpdateUserAvatar:output_type -> super.UpdateUserAvatarResp
+	230, // 331: super.Super.CheckIn:output_type -> super.CheckInResp
+	232, // 332: super.Super.GetUserLevel:output_type -> super.GetUserLevelResp
+	234, // 333: super.Super.GetCheckInStatus:output_type -> super.GetCheckInStatusResp
+	236, // 334: super.Super.GetCheckInHistory:output_type -> super.GetCheckInHistoryResp
+	238, // 335: super.Super.GetExpLogs:output_type -> super.GetExpLogsResp
+	242, // 336: super.Super.UploadPreKeyBundle:output_type -> super.UploadPreKeyBundleResp
+	244, // 337: super.Super.GetPreKeyBundles:output_type -> super.GetPreKeyBundlesResp
+	247, // 338: super.Super.StoreEncryptedMessage:output_type -> super.StoreEncryptedMessageResp
+	249, // 339: super.Super.ListPendingEncryptedMessages:output_type -> super.ListPendingEncryptedMessagesResp
+	251, // 340: super.Super.AckEncryptedMessages:output_type -> super.AckEncryptedMessagesResp
+	216, // [216:341] is the sub-list for method output_type
+	91,  // [91:216] is the sub-list for method input_type
+	91,  // [91:91] is the sub-list for extension type_name
+	91,  // [91:91] is the sub-list for extension extendee
+	0,   // [0:91] is the sub-list for field type_name
 }
 
 func init() { file_super_proto_init() }
@@ -8746,7 +18091,7 @@ func file_super_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_super_proto_rawDesc), len(file_super_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   139,
+			NumMessages:   277,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,67 +19,131 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Super_Register_FullMethodName                   = "/super.Super/Register"
-	Super_Login_FullMethodName                      = "/super.Super/Login"
-	Super_GetUserInfo_FullMethodName                = "/super.Super/GetUserInfo"
-	Super_GetUser_FullMethodName                    = "/super.Super/GetUser"
-	Super_GetUserByEmail_FullMethodName             = "/super.Super/GetUserByEmail"
-	Super_UpdateUserInfo_FullMethodName             = "/super.Super/UpdateUserInfo"
-	Super_UpdateUserPassword_FullMethodName         = "/super.Super/UpdateUserPassword"
-	Super_ResetPassword_FullMethodName              = "/super.Super/ResetPassword"
-	Super_DeleteUser_FullMethodName                 = "/super.Super/DeleteUser"
-	Super_UpdateUserVip_FullMethodName              = "/super.Super/UpdateUserVip"
-	Super_GetUsers_FullMethodName                   = "/super.Super/GetUsers"
-	Super_GetUserCount_FullMethodName               = "/super.Super/GetUserCount"
-	Super_UpsertUserMemory_FullMethodName           = "/super.Super/UpsertUserMemory"
-	Super_GetUserMemories_FullMethodName            = "/super.Super/GetUserMemories"
-	Super_DeleteUserMemory_FullMethodName           = "/super.Super/DeleteUserMemory"
-	Super_GetVipPlans_FullMethodName                = "/super.Super/GetVipPlans"
-	Super_GetVipPlan_FullMethodName                 = "/super.Super/GetVipPlan"
-	Super_CreateVipPlan_FullMethodName              = "/super.Super/CreateVipPlan"
-	Super_CreateVipOrder_FullMethodName             = "/super.Super/CreateVipOrder"
-	Super_GetVipOrders_FullMethodName               = "/super.Super/GetVipOrders"
-	Super_GetVipRecords_FullMethodName              = "/super.Super/GetVipRecords"
-	Super_GetUserActiveVipRecord_FullMethodName     = "/super.Super/GetUserActiveVipRecord"
-	Super_GetUserVipStatus_FullMethodName           = "/super.Super/GetUserVipStatus"
-	Super_CheckUserVip_FullMethodName               = "/super.Super/CheckUserVip"
-	Super_UpdateAutoRenew_FullMethodName            = "/super.Super/UpdateAutoRenew"
-	Super_SyncUserVipStatus_FullMethodName          = "/super.Super/SyncUserVipStatus"
-	Super_GetPosts_FullMethodName                   = "/super.Super/GetPosts"
-	Super_GetPost_FullMethodName                    = "/super.Super/GetPost"
-	Super_CreatePost_FullMethodName                 = "/super.Super/CreatePost"
-	Super_ReportPost_FullMethodName                 = "/super.Super/ReportPost"
-	Super_LikePost_FullMethodName                   = "/super.Super/LikePost"
-	Super_GetPostComments_FullMethodName            = "/super.Super/GetPostComments"
-	Super_CreateComment_FullMethodName              = "/super.Super/CreateComment"
-	Super_LikeComment_FullMethodName                = "/super.Super/LikeComment"
-	Super_GetNotifications_FullMethodName           = "/super.Super/GetNotifications"
-	Super_GetUnreadCount_FullMethodName             = "/super.Super/GetUnreadCount"
-	Super_ReadNotification_FullMethodName           = "/super.Super/ReadNotification"
-	Super_ReadAllNotifications_FullMethodName       = "/super.Super/ReadAllNotifications"
-	Super_CreateNotification_FullMethodName         = "/super.Super/CreateNotification"
-	Super_Recharge_FullMethodName                   = "/super.Super/Recharge"
-	Super_GetTransactions_FullMethodName            = "/super.Super/GetTransactions"
-	Super_GetTransaction_FullMethodName             = "/super.Super/GetTransaction"
-	Super_FollowUser_FullMethodName                 = "/super.Super/FollowUser"
-	Super_UnfollowUser_FullMethodName               = "/super.Super/UnfollowUser"
-	Super_GetFollowings_FullMethodName              = "/super.Super/GetFollowings"
-	Super_GetFollowers_FullMethodName               = "/super.Super/GetFollowers"
-	Super_CheckFollow_FullMethodName                = "/super.Super/CheckFollow"
-	Super_SendFriendRequest_FullMethodName          = "/super.Super/SendFriendRequest"
-	Super_ListIncomingFriendRequests_FullMethodName = "/super.Super/ListIncomingFriendRequests"
-	Super_ListOutgoingFriendRequests_FullMethodName = "/super.Super/ListOutgoingFriendRequests"
-	Super_AcceptFriendRequest_FullMethodName        = "/super.Super/AcceptFriendRequest"
-	Super_RejectFriendRequest_FullMethodName        = "/super.Super/RejectFriendRequest"
-	Super_ListFriends_FullMethodName                = "/super.Super/ListFriends"
-	Super_GetFriendRelation_FullMethodName          = "/super.Super/GetFriendRelation"
-	Super_GetUserAvatar_FullMethodName              = "/super.Super/GetUserAvatar"
-	Super_UpdateUserAvatar_FullMethodName           = "/super.Super/UpdateUserAvatar"
-	Super_CheckIn_FullMethodName                    = "/super.Super/CheckIn"
-	Super_GetUserLevel_FullMethodName               = "/super.Super/GetUserLevel"
-	Super_GetCheckInStatus_FullMethodName           = "/super.Super/GetCheckInStatus"
-	Super_GetCheckInHistory_FullMethodName          = "/super.Super/GetCheckInHistory"
-	Super_GetExpLogs_FullMethodName                 = "/super.Super/GetExpLogs"
+	Super_Register_FullMethodName                      = "/super.Super/Register"
+	Super_Login_FullMethodName                         = "/super.Super/Login"
+	Super_GetUserInfo_FullMethodName                   = "/super.Super/GetUserInfo"
+	Super_GetUser_FullMethodName                       = "/super.Super/GetUser"
+	Super_GetUserByEmail_FullMethodName                = "/super.Super/GetUserByEmail"
+	Super_UpdateUserInfo_FullMethodName                = "/super.Super/UpdateUserInfo"
+	Super_UpdateUserPassword_FullMethodName            = "/super.Super/UpdateUserPassword"
+	Super_RequestPasswordReset_FullMethodName          = "/super.Super/RequestPasswordReset"
+	Super_ResetPassword_FullMethodName                 = "/super.Super/ResetPassword"
+	Super_GetUserAuthState_FullMethodName              = "/super.Super/GetUserAuthState"
+	Super_RefreshSession_FullMethodName                = "/super.Super/RefreshSession"
+	Super_ListUserSessions_FullMethodName              = "/super.Super/ListUserSessions"
+	Super_RevokeUserSession_FullMethodName             = "/super.Super/RevokeUserSession"
+	Super_RevokeAllUserSessions_FullMethodName         = "/super.Super/RevokeAllUserSessions"
+	Super_IssueWsTicket_FullMethodName                 = "/super.Super/IssueWsTicket"
+	Super_RedeemWsTicket_FullMethodName                = "/super.Super/RedeemWsTicket"
+	Super_SendEmailVerification_FullMethodName         = "/super.Super/SendEmailVerification"
+	Super_VerifyEmail_FullMethodName                   = "/super.Super/VerifyEmail"
+	Super_UpdateUserRole_FullMethodName                = "/super.Super/UpdateUserRole"
+	Super_ListRoleAuditLogs_FullMethodName             = "/super.Super/ListRoleAuditLogs"
+	Super_ListSecurityEvents_FullMethodName            = "/super.Super/ListSecurityEvents"
+	Super_VerifyLoginMfa_FullMethodName                = "/super.Super/VerifyLoginMfa"
+	Super_GetMfaStatus_FullMethodName                  = "/super.Super/GetMfaStatus"
+	Super_BeginTotpEnrollment_FullMethodName           = "/super.Super/BeginTotpEnrollment"
+	Super_ConfirmTotpEnrollment_FullMethodName         = "/super.Super/ConfirmTotpEnrollment"
+	Super_DisableTotp_FullMethodName                   = "/super.Super/DisableTotp"
+	Super_RegenerateRecoveryCodes_FullMethodName       = "/super.Super/RegenerateRecoveryCodes"
+	Super_ListMfaPolicies_FullMethodName               = "/super.Super/ListMfaPolicies"
+	Super_SetMfaPolicy_FullMethodName                  = "/super.Super/SetMfaPolicy"
+	Super_DeleteUser_FullMethodName                    = "/super.Super/DeleteUser"
+	Super_GetAccountDeletion_FullMethodName            = "/super.Super/GetAccountDeletion"
+	Super_CancelAccountDeletion_FullMethodName         = "/super.Super/CancelAccountDeletion"
+	Super_ExportUserData_FullMethodName                = "/super.Super/ExportUserData"
+	Super_UpdateUserVip_FullMethodName                 = "/super.Super/UpdateUserVip"
+	Super_GetUsers_FullMethodName                      = "/super.Super/GetUsers"
+	Super_GetUserCount_FullMethodName                  = "/super.Super/GetUserCount"
+	Super_UpsertUserMemory_FullMethodName              = "/super.Super/UpsertUserMemory"
+	Super_GetUserMemories_FullMethodName               = "/super.Super/GetUserMemories"
+	Super_DeleteUserMemory_FullMethodName              = "/super.Super/DeleteUserMemory"
+	Super_GetVipPlans_FullMethodName                   = "/super.Super/GetVipPlans"
+	Super_GetVipPlan_FullMethodName                    = "/super.Super/GetVipPlan"
+	Super_CreateVipPlan_FullMethodName                 = "/super.Super/CreateVipPlan"
+	Super_CreateVipOrder_FullMethodName                = "/super.Super/CreateVipOrder"
+	Super_GetVipOrders_FullMethodName                  = "/super.Super/GetVipOrders"
+	Super_GetVipRecords_FullMethodName                 = "/super.Super/GetVipRecords"
+	Super_GetUserActiveVipRecord_FullMethodName        = "/super.Super/GetUserActiveVipRecord"
+	Super_GetUserVipStatus_FullMethodName              = "/super.Super/GetUserVipStatus"
+	Super_CheckUserVip_FullMethodName                  = "/super.Super/CheckUserVip"
+	Super_UpdateAutoRenew_FullMethodName               = "/super.Super/UpdateAutoRenew"
+	Super_SyncUserVipStatus_FullMethodName             = "/super.Super/SyncUserVipStatus"
+	Super_GetPosts_FullMethodName                      = "/super.Super/GetPosts"
+	Super_GetPost_FullMethodName                       = "/super.Super/GetPost"
+	Super_CreatePost_FullMethodName                    = "/super.Super/CreatePost"
+	Super_UpdatePost_FullMethodName                    = "/super.Super/UpdatePost"
+	Super_DeletePost_FullMethodName                    = "/super.Super/DeletePost"
+	Super_ReportPost_FullMethodName                    = "/super.Super/ReportPost"
+	Super_LikePost_FullMethodName                      = "/super.Super/LikePost"
+	Super_GetPostComments_FullMethodName               = "/super.Super/GetPostComments"
+	Super_CreateComment_FullMethodName                 = "/super.Super/CreateComment"
+	Super_LikeComment_FullMethodName                   = "/super.Super/LikeComment"
+	Super_GetNotifications_FullMethodName              = "/super.Super/GetNotifications"
+	Super_GetUnreadCount_FullMethodName                = "/super.Super/GetUnreadCount"
+	Super_ReadNotification_FullMethodName              = "/super.Super/ReadNotification"
+	Super_ReadAllNotifications_FullMethodName          = "/super.Super/ReadAllNotifications"
+	Super_CreateNotification_FullMethodName            = "/super.Super/CreateNotification"
+	Super_WatchNotifications_FullMethodName            = "/super.Super/WatchNotifications"
+	Super_GetNotificationPreferences_FullMethodName    = "/super.Super/GetNotificationPreferences"
+	Super_UpdateNotificationPreferences_FullMethodName = "/super.Super/UpdateNotificationPreferences"
+	Super_SetNotificationMute_FullMethodName           = "/super.Super/SetNotificationMute"
+	Super_CreateNotificationCampaign_FullMethodName    = "/super.Super/CreateNotificationCampaign"
+	Super_ListNotificationCampaigns_FullMethodName     = "/super.Super/ListNotificationCampaigns"
+	Super_GetNotificationCampaign_FullMethodName       = "/super.Super/GetNotificationCampaign"
+	Super_CancelNotificationCampaign_FullMethodName    = "/super.Super/CancelNotificationCampaign"
+	Super_RegisterDevice_FullMethodName                = "/super.Super/RegisterDevice"
+	Super_UnregisterDevice_FullMethodName              = "/super.Super/UnregisterDevice"
+	Super_SendDevicePush_FullMethodName                = "/super.Super/SendDevicePush"
+	Super_Recharge_FullMethodName                      = "/super.Super/Recharge"
+	Super_GetTransactions_FullMethodName               = "/super.Super/GetTransactions"
+	Super_GetTransaction_FullMethodName                = "/super.Super/GetTransaction"
+	Super_FollowUser_FullMethodName                    = "/super.Super/FollowUser"
+	Super_UnfollowUser_FullMethodName                  = "/super.Super/UnfollowUser"
+	Super_GetFollowings_FullMethodName                 = "/super.Super/GetFollowings"
+	Super_GetFollowers_FullMethodName                  = "/super.Super/GetFollowers"
+	Super_CheckFollow_FullMethodName                   = "/super.Super/CheckFollow"
+	Super_ListFollowRequests_FullMethodName            = "/super.Super/ListFollowRequests"
+	Super_RespondFollowRequest_FullMethodName          = "/super.Super/RespondFollowRequest"
+	Super_GetPrivacySettings_FullMethodName            = "/super.Super/GetPrivacySettings"
+	Super_UpdatePrivacySettings_FullMethodName         = "/super.Super/UpdatePrivacySettings"
+	Super_CheckDirectMessage_FullMethodName            = "/super.Super/CheckDirectMessage"
+	Super_SendFriendRequest_FullMethodName             = "/super.Super/SendFriendRequest"
+	Super_ListIncomingFriendRequests_FullMethodName    = "/super.Super/ListIncomingFriendRequests"
+	Super_ListOutgoingFriendRequests_FullMethodName    = "/super.Super/ListOutgoingFriendRequests"
+	Super_AcceptFriendRequest_FullMethodName           = "/super.Super/AcceptFriendRequest"
+	Super_RejectFriendRequest_FullMethodName           = "/super.Super/RejectFriendRequest"
+	Super_ListFriends_FullMethodName                   = "/super.Super/ListFriends"
+	Super_GetFriendRelation_FullMethodName             = "/super.Super/GetFriendRelation"
+	Super_BlockUser_FullMethodName                     = "/super.Super/BlockUser"
+	Super_UnblockUser_FullMethodName                   = "/super.Super/UnblockUser"
+	Super_ListBlockedUsers_FullMethodName              = "/super.Super/ListBlockedUsers"
+	Super_CheckUserBlock_FullMethodName                = "/super.Super/CheckUserBlock"
+	Super_GetBlockedUserIds_FullMethodName             = "/super.Super/GetBlockedUserIds"
+	Super_SuspendUser_FullMethodName                   = "/super.Super/SuspendUser"
+	Super_LiftSuspension_FullMethodName                = "/super.Super/LiftSuspension"
+	Super_GetUserSuspension_FullMethodName             = "/super.Super/GetUserSuspension"
+	Super_SubmitSuspensionAppeal_FullMethodName        = "/super.Super/SubmitSuspensionAppeal"
+	Super_ListSuspensionAppeals_FullMethodName         = "/super.Super/ListSuspensionAppeals"
+	Super_ReviewSuspensionAppeal_FullMethodName        = "/super.Super/ReviewSuspensionAppeal"
+	Super_ListOidcProviders_FullMethodName             = "/super.Super/ListOidcProviders"
+	Super_StartOidcAuth_FullMethodName                 = "/super.Super/StartOidcAuth"
+	Super_OidcLogin_FullMethodName                     = "/super.Super/OidcLogin"
+	Super_LinkUserIdentity_FullMethodName              = "/super.Super/LinkUserIdentity"
+	Super_ListUserIdentities_FullMethodName            = "/super.Super/ListUserIdentities"
+	Super_UnlinkUserIdentity_FullMethodName            = "/super.Super/UnlinkUserIdentity"
+	Super_GetUserAvatar_FullMethodName                 = "/super.Super/GetUserAvatar"
+	Super_UpdateUserAvatar_FullMethodName              = "/super.Super/UpdateUserAvatar"
+	Super_CheckIn_FullMethodName                       = "/super.Super/CheckIn"
+	Super_GetUserLevel_FullMethodName                  = "/super.Super/GetUserLevel"
+	Super_GetCheckInStatus_FullMethodName              = "/super.Super/GetCheckInStatus"
+	Super_GetCheckInHistory_FullMethodName             = "/super.Super/GetCheckInHistory"
+	Super_GetExpLogs_FullMethodName                    = "/super.Super/GetExpLogs"
+	Super_UploadPreKeyBundle_FullMethodName            = "/super.Super/UploadPreKeyBundle"
+	Super_GetPreKeyBundles_FullMethodName              = "/super.Super/GetPreKeyBundles"
+	Super_StoreEncryptedMessage_FullMethodName         = "/super.Super/StoreEncryptedMessage"
+	Super_ListPendingEncryptedMessages_FullMethodName  = "/super.Super/ListPendingEncryptedMessages"
+	Super_AckEncryptedMessages_FullMethodName          = "/super.Super/AckEncryptedMessages"
 )
 
 // SuperClient is the client API for Super service.
@@ -96,8 +160,32 @@ type SuperClient interface {
 	GetUserByEmail(ctx context.Context, in *GetUserByEmailReq, opts ...grpc.CallOption) (*GetUserByEmailResp, error)
 	UpdateUserInfo(ctx context.Context, in *UpdateUserInfoReq, opts ...grpc.CallOption) (*UpdateUserInfoResp, error)
 	UpdateUserPassword(ctx context.Context, in *UpdateUserPasswordReq, opts ...grpc.CallOption) (*UpdateUserPasswordResp, error)
+	RequestPasswordReset(ctx context.Context, in *RequestPasswordResetReq, opts ...grpc.CallOption) (*RequestPasswordResetResp, error)
 	ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error)
+	GetUserAuthState(ctx context.Context, in *GetUserAuthStateReq, opts ...grpc.CallOption) (*GetUserAuthStateResp, error)
+	RefreshSession(ctx context.Context, in *RefreshSessionReq, opts ...grpc.CallOption) (*RefreshSessionResp, error)
+	ListUserSessions(ctx context.Context, in *ListUserSessionsReq, opts ...grpc.CallOption) (*ListUserSessionsResp, error)
+	RevokeUserSession(ctx context.Context, in *RevokeUserSessionReq, opts ...grpc.CallOption) (*RevokeUserSessionResp, error)
+	RevokeAllUserSessions(ctx context.Context, in *RevokeAllUserSessionsReq, opts ...grpc.CallOption) (*RevokeAllUserSessionsResp, error)
+	IssueWsTicket(ctx context.Context, in *IssueWsTicketReq, opts ...grpc.CallOption) (*IssueWsTicketResp, error)
+	RedeemWsTicket(ctx context.Context, in *RedeemWsTicketReq, opts ...grpc.CallOption) (*RedeemWsTicketResp, error)
+	SendEmailVerification(ctx context.Context, in *SendEmailVerificationReq, opts ...grpc.CallOption) (*SendEmailVerificationResp, error)
+	VerifyEmail(ctx context.Context, in *VerifyEmailReq, opts ...grpc.CallOption) (*VerifyEmailResp, error)
+	UpdateUserRole(ctx context.Context, in *UpdateUserRoleReq, opts ...grpc.CallOption) (*UpdateUserRoleResp, error)
+	ListRoleAuditLogs(ctx context.Context, in *ListRoleAuditLogsReq, opts ...grpc.CallOption) (*ListRoleAuditLogsResp, error)
+	ListSecurityEvents(ctx context.Context, in *ListSecurityEventsReq, opts ...grpc.CallOption) (*ListSecurityEventsResp, error)
+	VerifyLoginMfa(ctx context.Context, in *VerifyLoginMfaReq, opts ...grpc.CallOption) (*LoginResp, error)
+	GetMfaStatus(ctx context.Context, in *GetMfaStatusReq, opts ...grpc.CallOption) (*GetMfaStatusResp, error)
+	BeginTotpEnrollment(ctx context.Context, in *BeginTotpEnrollmentReq, opts ...grpc.CallOption) (*BeginTotpEnrollmentResp, error)
+	ConfirmTotpEnrollment(ctx context.Context, in *ConfirmTotpEnrollmentReq, opts ...grpc.CallOption) (*RecoveryCodesResp, error)
+	DisableTotp(ctx context.Context, in *DisableTotpReq, opts ...grpc.CallOption) (*DisableTotpResp, error)
+	RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesReq, opts ...grpc.CallOption) (*RecoveryCodesResp, error)
+	ListMfaPolicies(ctx context.Context, in *ListMfaPoliciesReq, opts ...grpc.CallOption) (*ListMfaPoliciesResp, error)
+	SetMfaPolicy(ctx context.Context, in *SetMfaPolicyReq, opts ...grpc.CallOption) (*SetMfaPolicyResp, error)
 	DeleteUser(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*DeleteUserResp, error)
+	GetAccountDeletion(ctx context.Context, in *GetAccountDeletionReq, opts ...grpc.CallOption) (*GetAccountDeletionResp, error)
+	CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionReq, opts ...grpc.CallOption) (*CancelAccountDeletionResp, error)
+	ExportUserData(ctx context.Context, in *ExportUserDataReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
 	UpdateUserVip(ctx context.Context, in *UpdateUserVipReq, opts ...grpc.CallOption) (*UpdateUserVipResp, error)
 	GetUsers(ctx context.Context, in *GetUsersReq, opts ...grpc.CallOption) (*GetUsersResp, error)
 	GetUserCount(ctx context.Context, in *GetUserCountReq, opts ...grpc.CallOption) (*GetUserCountResp, error)
@@ -123,6 +211,8 @@ type SuperClient interface {
 	GetPosts(ctx context.Context, in *GetPostsReq, opts ...grpc.CallOption) (*GetPostsResp, error)
 	GetPost(ctx context.Context, in *GetPostReq, opts ...grpc.CallOption) (*GetPostResp, error)
 	CreatePost(ctx context.Context, in *CreatePostReq, opts ...grpc.CallOption) (*CreatePostResp, error)
+	UpdatePost(ctx context.Context, in *UpdatePostReq, opts ...grpc.CallOption) (*UpdatePostResp, error)
+	DeletePost(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*DeletePostResp, error)
 	ReportPost(ctx context.Context, in *ReportPostReq, opts ...grpc.CallOption) (*ReportPostResp, error)
 	LikePost(ctx context.Context, in *LikePostReq, opts ...grpc.CallOption) (*LikePostResp, error)
 	GetPostComments(ctx context.Context, in *GetPostCommentsReq, opts ...grpc.CallOption) (*GetPostCommentsResp, error)
@@ -135,6 +225,17 @@ type SuperClient interface {
 	ReadNotification(ctx context.Context, in *ReadNotificationReq, opts ...grpc.CallOption) (*ReadNotificationResp, error)
 	ReadAllNotifications(ctx context.Context, in *ReadAllNotificationsReq, opts ...grpc.CallOption) (*ReadAllNotificationsResp, error)
 	CreateNotification(ctx context.Context, in *CreateNotificationReq, opts ...grpc.CallOption) (*CreateNotificationResp, error)
+	WatchNotifications(ctx context.Context, in *WatchNotificationsReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NotificationEvent], error)
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesReq, opts ...grpc.CallOption) (*NotificationPreferencesResp, error)
+	UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesReq, opts ...grpc.CallOption) (*NotificationPreferencesResp, error)
+	SetNotificationMute(ctx context.Context, in *SetNotificationMuteReq, opts ...grpc.CallOption) (*SetNotificationMuteResp, error)
+	CreateNotificationCampaign(ctx context.Context, in *CreateNotificationCampaignReq, opts ...grpc.CallOption) (*NotificationCampaignResp, error)
+	ListNotificationCampaigns(ctx context.Context, in *ListNotificationCampaignsReq, opts ...grpc.CallOption) (*ListNotificationCampaignsResp, error)
+	GetNotificationCampaign(ctx context.Context, in *GetNotificationCampaignReq, opts ...grpc.CallOption) (*NotificationCampaignResp, error)
+	CancelNotificationCampaign(ctx context.Context, in *GetNotificationCampaignReq, opts ...grpc.CallOption) (*NotificationCampaignResp, error)
+	RegisterDevice(ctx context.Context, in *RegisterDeviceReq, opts ...grpc.CallOption) (*RegisterDeviceResp, error)
+	UnregisterDevice(ctx context.Context, in *UnregisterDeviceReq, opts ...grpc.CallOption) (*UnregisterDeviceResp, error)
+	SendDevicePush(ctx context.Context, in *SendDevicePushReq, opts ...grpc.CallOption) (*SendDevicePushResp, error)
 	// 钱包相关服务
 	Recharge(ctx context.Context, in *RechargeReq, opts ...grpc.CallOption) (*RechargeResp, error)
 	// 交易记录相关服务
@@ -146,6 +247,12 @@ type SuperClient interface {
 	GetFollowings(ctx context.Context, in *GetFollowingsReq, opts ...grpc.CallOption) (*GetFollowingsResp, error)
 	GetFollowers(ctx context.Context, in *GetFollowersReq, opts ...grpc.CallOption) (*GetFollowersResp, error)
 	CheckFollow(ctx context.Context, in *CheckFollowReq, opts ...grpc.CallOption) (*CheckFollowResp, error)
+	ListFollowRequests(ctx context.Context, in *ListFollowRequestsReq, opts ...grpc.CallOption) (*ListFollowRequestsResp, error)
+	RespondFollowRequest(ctx context.Context, in *RespondFollowRequestReq, opts ...grpc.CallOption) (*RespondFollowRequestResp, error)
+	// 隐私设置相关服务
+	GetPrivacySettings(ctx context.Context, in *GetPrivacySettingsReq, opts ...grpc.CallOption) (*PrivacySettingsResp, error)
+	UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsReq, opts ...grpc.CallOption) (*PrivacySettingsResp, error)
+	CheckDirectMessage(ctx context.Context, in *CheckDirectMessageReq, opts ...grpc.CallOption) (*CheckDirectMessageResp, error)
 	SendFriendRequest(ctx context.Context, in *SendFriendRequestReq, opts ...grpc.CallOption) (*SendFriendRequestResp, error)
 	ListIncomingFriendRequests(ctx context.Context, in *ListIncomingFriendRequestsReq, opts ...grpc.CallOption) (*ListIncomingFriendRequestsResp, error)
 	ListOutgoingFriendRequests(ctx context.Context, in *ListOutgoingFriendRequestsReq, opts ...grpc.CallOption) (*ListOutgoingFriendRequestsResp, error)
@@ -153,6 +260,26 @@ type SuperClient interface {
 	RejectFriendRequest(ctx context.Context, in *RejectFriendRequestReq, opts ...grpc.CallOption) (*RejectFriendRequestResp, error)
 	ListFriends(ctx context.Context, in *ListFriendsReq, opts ...grpc.CallOption) (*ListFriendsResp, error)
 	GetFriendRelation(ctx context.Context, in *GetFriendRelationReq, opts ...grpc.CallOption) (*GetFriendRelationResp, error)
+	// 拉黑相关服务
+	BlockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*BlockUserResp, error)
+	UnblockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*BlockUserResp, error)
+	ListBlockedUsers(ctx context.Context, in *ListBlockedUsersReq, opts ...grpc.CallOption) (*ListBlockedUsersResp, error)
+	CheckUserBlock(ctx context.Context, in *CheckUserBlockReq, opts ...grpc.CallOption) (*CheckUserBlockResp, error)
+	GetBlockedUserIds(ctx context.Context, in *GetBlockedUserIdsReq, opts ...grpc.CallOption) (*GetBlockedUserIdsResp, error)
+	// 处罚（封禁 / 限制）与申诉相关服务
+	SuspendUser(ctx context.Context, in *SuspendUserReq, opts ...grpc.CallOption) (*SuspensionResp, error)
+	LiftSuspension(ctx context.Context, in *LiftSuspensionReq, opts ...grpc.CallOption) (*SuspensionResp, error)
+	GetUserSuspension(ctx context.Context, in *GetUserSuspensionReq, opts ...grpc.CallOption) (*SuspensionResp, error)
+	SubmitSuspensionAppeal(ctx context.Context, in *SubmitSuspensionAppealReq, opts ...grpc.CallOption) (*SuspensionAppealResp, error)
+	ListSuspensionAppeals(ctx context.Context, in *ListSuspensionAppealsReq, opts ...grpc.CallOption) (*ListSuspensionAppealsResp, error)
+	ReviewSuspensionAppeal(ctx context.Context, in *ReviewSuspensionAppealReq, opts ...grpc.CallOption) (*SuspensionAppealResp, error)
+	// 第三方登录（OIDC）与账号绑定相关服务
+	ListOidcProviders(ctx context.Context, in *ListOidcProvidersReq, opts ...grpc.CallOption) (*ListOidcProvidersResp, error)
+	StartOidcAuth(ctx context.Context, in *StartOidcAuthReq, opts ...grpc.CallOption) (*StartOidcAuthResp, error)
+	OidcLogin(ctx context.Context, in *OidcLoginReq, opts ...grpc.CallOption) (*LoginResp, error)
+	LinkUserIdentity(ctx context.Context, in *LinkUserIdentityReq, opts ...grpc.CallOption) (*UserIdentityResp, error)
+	ListUserIdentities(ctx context.Context, in *ListUserIdentitiesReq, opts ...grpc.CallOption) (*ListUserIdentitiesResp, error)
+	UnlinkUserIdentity(ctx context.Context, in *UnlinkUserIdentityReq, opts ...grpc.CallOption) (*UnlinkUserIdentityResp, error)
 	// 虚拟形象相关服务
 	GetUserAvatar(ctx context.Context, in *GetUserAvatarReq, opts ...grpc.CallOption) (*GetUserAvatarResp, error)
 	UpdateUserAvatar(ctx context.Context, in *UpdateUserAvatarReq, opts ...grpc.CallOption) (*UpdateUserAvatarResp, error)
@@ -162,6 +289,12 @@ type SuperClient interface {
 	GetCheckInStatus(ctx context.Context, in *GetCheckInStatusReq, opts ...grpc.CallOption) (*GetCheckInStatusResp, error)
 	GetCheckInHistory(ctx context.Context, in *GetCheckInHistoryReq, opts ...grpc.CallOption) (*GetCheckInHistoryResp, error)
 	GetExpLogs(ctx context.Context, in *GetExpLogsReq, opts ...grpc.CallOption) (*GetExpLogsResp, error)
+	// 端到端加密（服务端只保存公钥与密文，不参与解密）
+	UploadPreKeyBundle(ctx context.Context, in *UploadPreKeyBundleReq, opts ...grpc.CallOption) (*UploadPreKeyBundleResp, error)
+	GetPreKeyBundles(ctx context.Context, in *GetPreKeyBundlesReq, opts ...grpc.CallOption) (*GetPreKeyBundlesResp, error)
+	StoreEncryptedMessage(ctx context.Context, in *StoreEncryptedMessageReq, opts ...grpc.CallOption) (*StoreEncryptedMessageResp, error)
+	ListPendingEncryptedMessages(ctx context.Context, in *ListPendingEncryptedMessagesReq, opts ...grpc.CallOption) (*ListPendingEncryptedMessagesResp, error)
+	AckEncryptedMessages(ctx context.Context, in *AckEncryptedMessagesReq, opts ...grpc.CallOption) (*AckEncryptedMessagesResp, error)
 }
 
 type superClient struct {
@@ -242,6 +375,16 @@ func (c *superClient) UpdateUserPassword(ctx context.Context, in *UpdateUserPass
 	return out, nil
 }
 
+func (c *superClient) RequestPasswordReset(ctx context.Context, in *RequestPasswordResetReq, opts ...grpc.CallOption) (*RequestPasswordResetResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestPasswordResetResp)
+	err := c.cc.Invoke(ctx, Super_RequestPasswordReset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *superClient) ResetPassword(ctx context.Context, in *ResetPasswordReq, opts ...grpc.CallOption) (*ResetPasswordResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResetPasswordResp)
@@ -252,6 +395,206 @@ func (c *superClient) ResetPassword(ctx context.Context, in *ResetPasswordReq, o
 	return out, nil
 }
 
+func (c *superClient) GetUserAuthState(ctx context.Context, in *GetUserAuthStateReq, opts ...grpc.CallOption) (*GetUserAuthStateResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserAuthStateResp)
+	err := c.cc.Invoke(ctx, Super_GetUserAuthState_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) RefreshSession(ctx context.Context, in *RefreshSessionReq, opts ...grpc.CallOption) (*RefreshSessionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshSessionResp)
+	err := c.cc.Invoke(ctx, Super_RefreshSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) ListUserSessions(ctx context.Context, in *ListUserSessionsReq, opts ...grpc.CallOption) (*ListUserSessionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserSessionsResp)
+	err := c.cc.Invoke(ctx, Super_ListUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) RevokeUserSession(ctx context.Context, in *RevokeUserSessionReq, opts ...grpc.CallOption) (*RevokeUserSessionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeUserSessionResp)
+	err := c.cc.Invoke(ctx, Super_RevokeUserSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) RevokeAllUserSessions(ctx context.Context, in *RevokeAllUserSessionsReq, opts ...grpc.CallOption) (*RevokeAllUserSessionsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAllUserSessionsResp)
+	err := c.cc.Invoke(ctx, Super_RevokeAllUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) IssueWsTicket(ctx context.Context, in *IssueWsTicketReq, opts ...grpc.CallOption) (*IssueWsTicketResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueWsTicketResp)
+	err := c.cc.Invoke(ctx, Super_IssueWsTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) RedeemWsTicket(ctx context.Context, in *RedeemWsTicketReq, opts ...grpc.CallOption) (*RedeemWsTicketResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemWsTicketResp)
+	err := c.cc.Invoke(ctx, Super_RedeemWsTicket_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) SendEmailVerification(ctx context.Context, in *SendEmailVerificationReq, opts ...grpc.CallOption) (*SendEmailVerificationResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendEmailVerificationResp)
+	err := c.cc.Invoke(ctx, Super_SendEmailVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) VerifyEmail(ctx context.Context, in *VerifyEmailReq, opts ...grpc.CallOption) (*VerifyEmailResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyEmailResp)
+	err := c.cc.Invoke(ctx, Super_VerifyEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) UpdateUserRole(ctx context.Context, in *UpdateUserRoleReq, opts ...grpc.CallOption) (*UpdateUserRoleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserRoleResp)
+	err := c.cc.Invoke(ctx, Super_UpdateUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) ListRoleAuditLogs(ctx context.Context, in *ListRoleAuditLogsReq, opts ...grpc.CallOption) (*ListRoleAuditLogsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRoleAuditLogsResp)
+	err := c.cc.Invoke(ctx, Super_ListRoleAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) ListSecurityEvents(ctx context.Context, in *ListSecurityEventsReq, opts ...grpc.CallOption) (*ListSecurityEventsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSecurityEventsResp)
+	err := c.cc.Invoke(ctx, Super_ListSecurityEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) VerifyLoginMfa(ctx context.Context, in *VerifyLoginMfaReq, opts ...grpc.CallOption) (*LoginResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResp)
+	err := c.cc.Invoke(ctx, Super_VerifyLoginMfa_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) GetMfaStatus(ctx context.Context, in *GetMfaStatusReq, opts ...grpc.CallOption) (*GetMfaStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMfaStatusResp)
+	err := c.cc.Invoke(ctx, Super_GetMfaStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) BeginTotpEnrollment(ctx context.Context, in *BeginTotpEnrollmentReq, opts ...grpc.CallOption) (*BeginTotpEnrollmentResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BeginTotpEnrollmentResp)
+	err := c.cc.Invoke(ctx, Super_BeginTotpEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) ConfirmTotpEnrollment(ctx context.Context, in *ConfirmTotpEnrollmentReq, opts ...grpc.CallOption) (*RecoveryCodesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecoveryCodesResp)
+	err := c.cc.Invoke(ctx, Super_ConfirmTotpEnrollment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) DisableTotp(ctx context.Context, in *DisableTotpReq, opts ...grpc.CallOption) (*DisableTotpResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisableTotpResp)
+	err := c.cc.Invoke(ctx, Super_DisableTotp_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) RegenerateRecoveryCodes(ctx context.Context, in *RegenerateRecoveryCodesReq, opts ...grpc.CallOption) (*RecoveryCodesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecoveryCodesResp)
+	err := c.cc.Invoke(ctx, Super_RegenerateRecoveryCodes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) ListMfaPolicies(ctx context.Context, in *ListMfaPoliciesReq, opts ...grpc.CallOption) (*ListMfaPoliciesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMfaPoliciesResp)
+	err := c.cc.Invoke(ctx, Super_ListMfaPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) SetMfaPolicy(ctx context.Context, in *SetMfaPolicyReq, opts ...grpc.CallOption) (*SetMfaPolicyResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMfaPolicyResp)
+	err := c.cc.Invoke(ctx, Super_SetMfaPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *superClient) DeleteUser(ctx context.Context, in *DeleteUserReq, opts ...grpc.CallOption) (*DeleteUserResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteUserResp)
@@ -262,6 +605,45 @@ func (c *superClient) DeleteUser(ctx context.Context, in *DeleteUserReq, opts ..
 	return out, nil
 }
 
+func (c *superClient) GetAccountDeletion(ctx context.Context, in *GetAccountDeletionReq, opts ...grpc.CallOption) (*GetAccountDeletionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountDeletionResp)
+	err := c.cc.Invoke(ctx, Super_GetAccountDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) CancelAccountDeletion(ctx context.Context, in *CancelAccountDeletionReq, opts ...grpc.CallOption) (*CancelAccountDeletionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelAccountDeletionResp)
+	err := c.cc.Invoke(ctx, Super_CancelAccountDeletion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) ExportUserData(ctx context.Context, in *ExportUserDataReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Super_ServiceDesc.Streams[0], Super_ExportUserData_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportUserDataReq, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Super_ExportUserDataClient = grpc.ServerStreamingClient[ExportChunk]
+
 func (c *superClient) UpdateUserVip(ctx context.Context, in *UpdateUserVipReq, opts ...grpc.CallOption) (*UpdateUserVipResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateUserVipResp)
@@ -462,6 +844,26 @@ func (c *superClient) CreatePost(ctx context.Context, in *CreatePostReq, opts ..
 	return out, nil
 }
 
+func (c *superClient) UpdatePost(ctx context.Context, in *UpdatePostReq, opts ...grpc.CallOption) (*UpdatePostResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePostResp)
+	err := c.cc.Invoke(ctx, Super_UpdatePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *superClient) DeletePost(ctx context.Context, in *DeletePostReq, opts ...grpc.CallOption) (*DeletePostResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePostResp)
+	err := c.cc.Invoke(ctx, Super_DeletePost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *superClient) ReportPost(ctx context.Context, in *ReportPostReq, opts ...grpc.CallOption) (*ReportPostResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReportPostResp)
@@ -562,1488 +964,3186 @@ func (c *superClient) CreateNotification(ctx context.Context, in *CreateNotifica
 	return out, nil
 }
 
-func (c *superClient) Recharge(ctx context.Context, in *RechargeReq, opts ...grpc.CallOption) (*RechargeResp, error) {
+func (c *superClient) WatchNotifications(ctx context.Context, in *WatchNotificationsReq, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NotificationEvent], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RechargeResp)
-	err := c.cc.Invoke(ctx, Super_Recharge_FullMethodName, in, out, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &Super_ServiceDesc.Streams[1], Super_WatchNotifications_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &grpc.GenericClientStream[WatchNotificationsReq, NotificationEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (c *superClient) GetTransactions(ctx context.Context, in *GetTransactionsReq, opts ...grpc.CallOption) (*GetTransactionsResp, error) {
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Super_WatchNotificationsClient = grpc.ServerStreamingClient[NotificationEvent]
+
+func (c *superClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesReq, opts ...grpc.CallOption) (*NotificationPreferencesResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetTransactionsResp)
-	err := c.cc.Invoke(ctx, Super_GetTransactions_FullMethodName, in, out, cOpts...)
+	out := new(NotificationPreferencesResp)
+	err := c.cc.Invoke(ctx, Super_GetNotificationPreferences_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) GetTransaction(ctx context.Context, in *GetTransactionReq, opts ...grpc.CallOption) (*GetTransactionResp, error) {
+func (c *superClient) UpdateNotificationPreferences(ctx context.Context, in *UpdateNotificationPreferencesReq, opts ...grpc.CallOption) (*NotificationPreferencesResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetTransactionResp)
-	err := c.cc.Invoke(ctx, Super_GetTransaction_FullMethodName, in, out, cOpts...)
+	out := new(NotificationPreferencesResp)
+	err := c.cc.Invoke(ctx, Super_UpdateNotificationPreferences_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) FollowUser(ctx context.Context, in *FollowUserReq, opts ...grpc.CallOption) (*FollowUserResp, error) {
+func (c *superClient) SetNotificationMute(ctx context.Context, in *SetNotificationMuteReq, opts ...grpc.CallOption) (*SetNotificationMuteResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(FollowUserResp)
-	err := c.cc.Invoke(ctx, Super_FollowUser_FullMethodName, in, out, cOpts...)
+	out := new(SetNotificationMuteResp)
+	err := c.cc.Invoke(ctx, Super_SetNotificationMute_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) UnfollowUser(ctx context.Context, in *UnfollowUserReq, opts ...grpc.CallOption) (*FollowUserResp, error) {
+func (c *superClient) CreateNotificationCampaign(ctx context.Context, in *CreateNotificationCampaignReq, opts ...grpc.CallOption) (*NotificationCampaignResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(FollowUserResp)
-	err := c.cc.Invoke(ctx, Super_UnfollowUser_FullMethodName, in, out, cOpts...)
+	out := new(NotificationCampaignResp)
+	err := c.cc.Invoke(ctx, Super_CreateNotificationCampaign_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) GetFollowings(ctx context.Context, in *GetFollowingsReq, opts ...grpc.CallOption) (*GetFollowingsResp, error) {
+func (c *superClient) ListNotificationCampaigns(ctx context.Context, in *ListNotificationCampaignsReq, opts ...grpc.CallOption) (*ListNotificationCampaignsResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetFollowingsResp)
-	err := c.cc.Invoke(ctx, Super_GetFollowings_FullMethodName, in, out, cOpts...)
+	out := new(ListNotificationCampaignsResp)
+	err := c.cc.Invoke(ctx, Super_ListNotificationCampaigns_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) GetFollowers(ctx context.Context, in *GetFollowersReq, opts ...grpc.CallOption) (*GetFollowersResp, error) {
+func (c *superClient) GetNotificationCampaign(ctx context.Context, in *GetNotificationCampaignReq, opts ...grpc.CallOption) (*NotificationCampaignResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetFollowersResp)
-	err := c.cc.Invoke(ctx, Super_GetFollowers_FullMethodName, in, out, cOpts...)
+	out := new(NotificationCampaignResp)
+	err := c.cc.Invoke(ctx, Super_GetNotificationCampaign_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) CheckFollow(ctx context.Context, in *CheckFollowReq, opts ...grpc.CallOption) (*CheckFollowResp, error) {
+func (c *superClient) CancelNotificationCampaign(ctx context.Context, in *GetNotificationCampaignReq, opts ...grpc.CallOption) (*NotificationCampaignResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CheckFollowResp)
-	err := c.cc.Invoke(ctx, Super_CheckFollow_FullMethodName, in, out, cOpts...)
+	out := new(NotificationCampaignResp)
+	err := c.cc.Invoke(ctx, Super_CancelNotificationCampaign_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) SendFriendRequest(ctx context.Context, in *SendFriendRequestReq, opts ...grpc.CallOption) (*SendFriendRequestResp, error) {
+func (c *superClient) RegisterDevice(ctx context.Context, in *RegisterDeviceReq, opts ...grpc.CallOption) (*RegisterDeviceResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(SendFriendRequestResp)
-	err := c.cc.Invoke(ctx, Super_SendFriendRequest_FullMethodName, in, out, cOpts...)
+	out := new(RegisterDeviceResp)
+	err := c.cc.Invoke(ctx, Super_RegisterDevice_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) ListIncomingFriendRequests(ctx context.Context, in *ListIncomingFriendRequestsReq, opts ...grpc.CallOption) (*ListIncomingFriendRequestsResp, error) {
+func (c *superClient) UnregisterDevice(ctx context.Context, in *UnregisterDeviceReq, opts ...grpc.CallOption) (*UnregisterDeviceResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListIncomingFriendRequestsResp)
-	err := c.cc.Invoke(ctx, Super_ListIncomingFriendRequests_FullMethodName, in, out, cOpts...)
+	out := new(UnregisterDeviceResp)
+	err := c.cc.Invoke(ctx, Super_UnregisterDevice_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) ListOutgoingFriendRequests(ctx context.Context, in *ListOutgoingFriendRequestsReq, opts ...grpc.CallOption) (*ListOutgoingFriendRequestsResp, error) {
+func (c *superClient) SendDevicePush(ctx context.Context, in *SendDevicePushReq, opts ...grpc.CallOption) (*SendDevicePushResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListOutgoingFriendRequestsResp)
-	err := c.cc.Invoke(ctx, Super_ListOutgoingFriendRequests_FullMethodName, in, out, cOpts...)
+	out := new(SendDevicePushResp)
+	err := c.cc.Invoke(ctx, Super_SendDevicePush_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) AcceptFriendRequest(ctx context.Context, in *AcceptFriendRequestReq, opts ...grpc.CallOption) (*AcceptFriendRequestResp, error) {
+func (c *superClient) Recharge(ctx context.Context, in *RechargeReq, opts ...grpc.CallOption) (*RechargeResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(AcceptFriendRequestResp)
-	err := c.cc.Invoke(ctx, Super_AcceptFriendRequest_FullMethodName, in, out, cOpts...)
+	out := new(RechargeResp)
+	err := c.cc.Invoke(ctx, Super_Recharge_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) RejectFriendRequest(ctx context.Context, in *RejectFriendRequestReq, opts ...grpc.CallOption) (*RejectFriendRequestResp, error) {
+func (c *superClient) GetTransactions(ctx context.Context, in *GetTransactionsReq, opts ...grpc.CallOption) (*GetTransactionsResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(RejectFriendRequestResp)
-	err := c.cc.Invoke(ctx, Super_RejectFriendRequest_FullMethodName, in, out, cOpts...)
+	out := new(GetTransactionsResp)
+	err := c.cc.Invoke(ctx, Super_GetTransactions_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) ListFriends(ctx context.Context, in *ListFriendsReq, opts ...grpc.CallOption) (*ListFriendsResp, error) {
+func (c *superClient) GetTransaction(ctx context.Context, in *GetTransactionReq, opts ...grpc.CallOption) (*GetTransactionResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListFriendsResp)
-	err := c.cc.Invoke(ctx, Super_ListFriends_FullMethodName, in, out, cOpts...)
+	out := new(GetTransactionResp)
+	err := c.cc.Invoke(ctx, Super_GetTransaction_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) GetFriendRelation(ctx context.Context, in *GetFriendRelationReq, opts ...grpc.CallOption) (*GetFriendRelationResp, error) {
+func (c *superClient) FollowUser(ctx context.Context, in *FollowUserReq, opts ...grpc.CallOption) (*FollowUserResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetFriendRelationResp)
-	err := c.cc.Invoke(ctx, Super_GetFriendRelation_FullMethodName, in, out, cOpts...)
+	out := new(FollowUserResp)
+	err := c.cc.Invoke(ctx, Super_FollowUser_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) GetUserAvatar(ctx context.Context, in *GetUserAvatarReq, opts ...grpc.CallOption) (*GetUserAvatarResp, error) {
+func (c *superClient) UnfollowUser(ctx context.Context, in *UnfollowUserReq, opts ...grpc.CallOption) (*FollowUserResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetUserAvatarResp)
-	err := c.cc.Invoke(ctx, Super_GetUserAvatar_FullMethodName, in, out, cOpts...)
+	out := new(FollowUserResp)
+	err := c.cc.Invoke(ctx, Super_UnfollowUser_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) UpdateUserAvatar(ctx context.Context, in *UpdateUserAvatarReq, opts ...grpc.CallOption) (*UpdateUserAvatarResp, error) {
+func (c *superClient) GetFollowings(ctx context.Context, in *GetFollowingsReq, opts ...grpc.CallOption) (*GetFollowingsResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateUserAvatarResp)
-	err := c.cc.Invoke(ctx, Super_UpdateUserAvatar_FullMethodName, in, out, cOpts...)
+	out := new(GetFollowingsResp)
+	err := c.cc.Invoke(ctx, Super_GetFollowings_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) CheckIn(ctx context.Context, in *CheckInReq, opts ...grpc.CallOption) (*CheckInResp, error) {
+func (c *superClient) GetFollowers(ctx context.Context, in *GetFollowersReq, opts ...grpc.CallOption) (*GetFollowersResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CheckInResp)
-	err := c.cc.Invoke(ctx, Super_CheckIn_FullMethodName, in, out, cOpts...)
+	out := new(GetFollowersResp)
+	err := c.cc.Invoke(ctx, Super_GetFollowers_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) GetUserLevel(ctx context.Context, in *GetUserLevelReq, opts ...grpc.CallOption) (*GetUserLevelResp, error) {
+func (c *superClient) CheckFollow(ctx context.Context, in *CheckFollowReq, opts ...grpc.CallOption) (*CheckFollowResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetUserLevelResp)
-	err := c.cc.Invoke(ctx, Super_GetUserLevel_FullMethodName, in, out, cOpts...)
+	out := new(CheckFollowResp)
+	err := c.cc.Invoke(ctx, Super_CheckFollow_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) GetCheckInStatus(ctx context.Context, in *GetCheckInStatusReq, opts ...grpc.CallOption) (*GetCheckInStatusResp, error) {
+func (c *superClient) ListFollowRequests(ctx context.Context, in *ListFollowRequestsReq, opts ...grpc.CallOption) (*ListFollowRequestsResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetCheckInStatusResp)
-	err := c.cc.Invoke(ctx, Super_GetCheckInStatus_FullMethodName, in, out, cOpts...)
+	out := new(ListFollowRequestsResp)
+	err := c.cc.Invoke(ctx, Super_ListFollowRequests_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) GetCheckInHistory(ctx context.Context, in *GetCheckInHistoryReq, opts ...grpc.CallOption) (*GetCheckInHistoryResp, error) {
+func (c *superClient) RespondFollowRequest(ctx context.Context, in *RespondFollowRequestReq, opts ...grpc.CallOption) (*RespondFollowRequestResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetCheckInHistoryResp)
-	err := c.cc.Invoke(ctx, Super_GetCheckInHistory_FullMethodName, in, out, cOpts...)
+	out := new(RespondFollowRequestResp)
+	err := c.cc.Invoke(ctx, Super_RespondFollowRequest_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *superClient) GetExpLogs(ctx context.Context, in *GetExpLogsReq, opts ...grpc.CallOption) (*GetExpLogsResp, error) {
+func (c *superClient) GetPrivacySettings(ctx context.Context, in *GetPrivacySettingsReq, opts ...grpc.CallOption) (*PrivacySettingsResp, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GetExpLogsResp)
-	err := c.cc.Invoke(ctx, Super_GetExpLogs_FullMethodName, in, out, cOpts...)
+	out := new(PrivacySettingsResp)
+	err := c.cc.Invoke(ctx, Super_GetPrivacySettings_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// SuperServer is the server API for Super service.
-// All implementations must embed UnimplementedSuperServer
-// for forward compatibility.
-//
-// 服务定义
-type SuperServer interface {
-	// 用户相关服务
-	Register(context.Context, *RegisterReq) (*RegisterResp, error)
-	Login(context.Context, *LoginReq) (*LoginResp, error)
-	GetUserInfo(context.Context, *GetUserInfoReq) (*GetUserInfoResp, error)
-	GetUser(context.Context, *GetUserReq) (*GetUserResp, error)
-	GetUserByEmail(context.Context, *GetUserByEmailReq) (*GetUserByEmailResp, error)
-	UpdateUserInfo(context.Context, *UpdateUserInfoReq) (*UpdateUserInfoResp, error)
-	UpdateUserPassword(context.Context, *UpdateUserPasswordReq) (*UpdateUserPasswordResp, error)
-	ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error)
-	DeleteUser(context.Context, *DeleteUserReq) (*DeleteUserResp, error)
-	UpdateUserVip(context.Context, *UpdateUserVipReq) (*UpdateUserVipResp, error)
-	GetUsers(context.Context, *GetUsersReq) (*GetUsersResp, error)
-	GetUserCount(context.Context, *GetUserCountReq) (*GetUserCountResp, error)
-	UpsertUserMemory(context.Context, *UpsertUserMemoryReq) (*UpsertUserMemoryResp, error)
-	GetUserMemories(context.Context, *GetUserMemoriesReq) (*GetUserMemoriesResp, error)
-	DeleteUserMemory(context.Context, *DeleteUserMemoryReq) (*DeleteUserMemoryResp, error)
-	// VIP套餐相关服务
-	GetVipPlans(context.Context, *GetVipPlansReq) (*GetVipPlansResp, error)
-	GetVipPlan(context.Context, *GetVipPlanReq) (*GetVipPlanResp, error)
-	CreateVipPlan(context.Context, *CreateVipPlanReq) (*CreateVipPlanResp, error)
-	// VIP订单相关服务
-	CreateVipOrder(context.Context, *CreateVipOrderReq) (*CreateVipOrderResp, error)
-	GetVipOrders(context.Context, *GetVipOrdersReq) (*GetVipOrdersResp, error)
-	// VIP记录相关服务
-	GetVipRecords(context.Context, *GetVipRecordsReq) (*GetVipRecordsResp, error)
-	GetUserActiveVipRecord(context.Context, *GetUserActiveVipRecordReq) (*GetUserActiveVipRecordResp, error)
-	// VIP状态相关服务
-	GetUserVipStatus(context.Context, *GetUserVipStatusReq) (*GetUserVipStatusResp, error)
-	CheckUserVip(context.Context, *CheckUserVipReq) (*CheckUserVipResp, error)
-	UpdateAutoRenew(context.Context, *UpdateAutoRenewReq) (*UpdateAutoRenewResp, error)
-	SyncUserVipStatus(context.Context, *SyncUserVipStatusReq) (*SyncUserVipStatusResp, error)
-	// 帖子相关服务
-	GetPosts(context.Context, *GetPostsReq) (*GetPostsResp, error)
-	GetPost(context.Context, *GetPostReq) (*GetPostResp, error)
-	CreatePost(context.Context, *CreatePostReq) (*CreatePostResp, error)
-	ReportPost(context.Context, *ReportPostReq) (*ReportPostResp, error)
-	LikePost(context.Context, *LikePostReq) (*LikePostResp, error)
-	GetPostComments(context.Context, *GetPostCommentsReq) (*GetPostCommentsResp, error)
-	// 评论相关服务
-	CreateComment(context.Context, *CreateCommentReq) (*CreateCommentResp, error)
-	LikeComment(context.Context, *LikeCommentReq) (*LikeCommentResp, error)
-	// 通知相关服务
-	GetNotifications(context.Context, *GetNotificationsReq) (*GetNotificationsResp, error)
-	GetUnreadCount(context.Context, *GetUnreadCountReq) (*GetUnreadCountResp, error)
-	ReadNotification(context.Context, *ReadNotificationReq) (*ReadNotificationResp, error)
-	ReadAllNotifications(context.Context, *ReadAllNotificationsReq) (*ReadAllNotificationsResp, error)
-	CreateNotification(context.Context, *CreateNotificationReq) (*CreateNotificationResp, error)
-	// 钱包相关服务
-	Recharge(context.Context, *RechargeReq) (*RechargeResp, error)
-	// 交易记录相关服务
-	GetTransactions(context.Context, *GetTransactionsReq) (*GetTransactionsResp, error)
-	GetTransaction(context.Context, *GetTransactionReq) (*GetTransactionResp, error)
-	// 关注相关服务
-	FollowUser(context.Context, *FollowUserReq) (*FollowUserResp, error)
-	UnfollowUser(context.Context, *UnfollowUserReq) (*FollowUserResp, error)
-	GetFollowings(context.Context, *GetFollowingsReq) (*GetFollowingsResp, error)
-	GetFollowers(context.Context, *GetFollowersReq) (*GetFollowersResp, error)
-	CheckFollow(context.Context, *CheckFollowReq) (*CheckFollowResp, error)
-	SendFriendRequest(context.Context, *SendFriendRequestReq) (*SendFriendRequestResp, error)
-	ListIncomingFriendRequests(context.Context, *ListIncomingFriendRequestsReq) (*ListIncomingFriendRequestsResp, error)
-	ListOutgoingFriendRequests(context.Context, *ListOutgoingFriendRequestsReq) (*ListOutgoingFriendRequestsResp, error)
-	AcceptFriendRequest(context.Context, *AcceptFriendRequestReq) (*AcceptFriendRequestResp, error)
-	RejectFriendRequest(context.Context, *RejectFriendRequestReq) (*RejectFriendRequestResp, error)
-	ListFriends(context.Context, *ListFriendsReq) (*ListFriendsResp, error)
-	GetFriendRelation(context.Context, *GetFriendRelationReq) (*GetFriendRelationResp, error)
-	// 虚拟形象相关服务
-	GetUserAvatar(context.Context, *GetUserAvatarReq) (*GetUserAvatarResp, error)
-	UpdateUserAvatar(context.Context, *UpdateUserAvatarReq) (*UpdateUserAvatarResp, error)
-	// 签到等级相关服务
-	CheckIn(context.Context, *CheckInReq) (*CheckInResp, error)
-	GetUserLevel(context.Context, *GetUserLevelReq) (*GetUserLevelResp, error)
-	GetCheckInStatus(context.Context, *GetCheckInStatusReq) (*GetCheckInStatusResp, error)
-	GetCheckInHistory(context.Context, *GetCheckInHistoryReq) (*GetCheckInHistoryResp, error)
-	GetExpLogs(context.Context, *GetExpLogsReq) (*GetExpLogsResp, error)
-	mustEmbedUnimplementedSuperServer()
+func (c *superClient) UpdatePrivacySettings(ctx context.Context, in *UpdatePrivacySettingsReq, opts ...grpc.CallOption) (*PrivacySettingsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrivacySettingsResp)
+	err := c.cc.Invoke(ctx, Super_UpdatePrivacySettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedSuperServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedSuperServer struct{}
-
-func (UnimplementedSuperServer) Register(context.Context, *RegisterReq) (*RegisterResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
-}
-func (UnimplementedSuperServer) Login(context.Context, *LoginReq) (*LoginResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+func (c *superClient) CheckDirectMessage(ctx context.Context, in *CheckDirectMessageReq, opts ...grpc.CallOption) (*CheckDirectMessageResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckDirectMessageResp)
+	err := c.cc.Invoke(ctx, Super_CheckDirectMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) GetUserInfo(context.Context, *GetUserInfoReq) (*GetUserInfoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserInfo not implemented")
+
+func (c *superClient) SendFriendRequest(ctx context.Context, in *SendFriendRequestReq, opts ...grpc.CallOption) (*SendFriendRequestResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendFriendRequestResp)
+	err := c.cc.Invoke(ctx, Super_SendFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) GetUser(context.Context, *GetUserReq) (*GetUserResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+
+func (c *superClient) ListIncomingFriendRequests(ctx context.Context, in *ListIncomingFriendRequestsReq, opts ...grpc.CallOption) (*ListIncomingFriendRequestsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIncomingFriendRequestsResp)
+	err := c.cc.Invoke(ctx, Super_ListIncomingFriendRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) GetUserByEmail(context.Context, *GetUserByEmailReq) (*GetUserByEmailResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserByEmail not implemented")
+
+func (c *superClient) ListOutgoingFriendRequests(ctx context.Context, in *ListOutgoingFriendRequestsReq, opts ...grpc.CallOption) (*ListOutgoingFriendRequestsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOutgoingFriendRequestsResp)
+	err := c.cc.Invoke(ctx, Super_ListOutgoingFriendRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) UpdateUserInfo(context.Context, *UpdateUserInfoReq) (*UpdateUserInfoResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserInfo not implemented")
+
+func (c *superClient) AcceptFriendRequest(ctx context.Context, in *AcceptFriendRequestReq, opts ...grpc.CallOption) (*AcceptFriendRequestResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptFriendRequestResp)
+	err := c.cc.Invoke(ctx, Super_AcceptFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) UpdateUserPassword(context.Context, *UpdateUserPasswordReq) (*UpdateUserPasswordResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPassword not implemented")
+
+func (c *superClient) RejectFriendRequest(ctx context.Context, in *RejectFriendRequestReq, opts ...grpc.CallOption) (*RejectFriendRequestResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectFriendRequestResp)
+	err := c.cc.Invoke(ctx, Super_RejectFriendRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+
+func (c *superClient) ListFriends(ctx context.Context, in *ListFriendsReq, opts ...grpc.CallOption) (*ListFriendsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFriendsResp)
+	err := c.cc.Invoke(ctx, Super_ListFriends_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) DeleteUser(context.Context, *DeleteUserReq) (*DeleteUserResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+
+func (c *superClient) GetFriendRelation(ctx context.Context, in *GetFriendRelationReq, opts ...grpc.CallOption) (*GetFriendRelationResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFriendRelationResp)
+	err := c.cc.Invoke(ctx, Super_GetFriendRelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) UpdateUserVip(context.Context, *UpdateUserVipReq) (*UpdateUserVipResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserVip not implemented")
+
+func (c *superClient) BlockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*BlockUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserResp)
+	err := c.cc.Invoke(ctx, Super_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) GetUsers(context.Context, *GetUsersReq) (*GetUsersResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
+
+func (c *superClient) UnblockUser(ctx context.Context, in *BlockUserReq, opts ...grpc.CallOption) (*BlockUserResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserResp)
+	err := c.cc.Invoke(ctx, Super_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) GetUserCount(context.Context, *GetUserCountReq) (*GetUserCountResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserCount not implemented")
+
+func (c *superClient) ListBlockedUsers(ctx context.Context, in *ListBlockedUsersReq, opts ...grpc.CallOption) (*ListBlockedUsersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedUsersResp)
+	err := c.cc.Invoke(ctx, Super_ListBlockedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) UpsertUserMemory(context.Context, *UpsertUserMemoryReq) (*UpsertUserMemoryResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpsertUserMemory not implemented")
+
+func (c *superClient) CheckUserBlock(ctx context.Context, in *CheckUserBlockReq, opts ...grpc.CallOption) (*CheckUserBlockResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckUserBlockResp)
+	err := c.cc.Invoke(ctx, Super_CheckUserBlock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) GetUserMemories(context.Context, *GetUserMemoriesReq) (*GetUserMemoriesResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserMemories not implemented")
+
+func (c *superClient) GetBlockedUserIds(ctx context.Context, in *GetBlockedUserIdsReq, opts ...grpc.CallOption) (*GetBlockedUserIdsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBlockedUserIdsResp)
+	err := c.cc.Invoke(ctx, Super_GetBlockedUserIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) DeleteUserMemory(context.Context, *DeleteUserMemoryReq) (*DeleteUserMemoryResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserMemory not implemented")
+
+func (c *superClient) SuspendUser(ctx context.Context, in *SuspendUserReq, opts ...grpc.CallOption) (*SuspensionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspensionResp)
+	err := c.cc.Invoke(ctx, Super_SuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) GetVipPlans(context.Context, *GetVipPlansReq) (*GetVipPlansResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVipPlans not implemented")
-}
-func (UnimplementedSuperServer) GetVipPlan(context.Context, *GetVipPlanReq) (*GetVipPlanResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVipPlan not implemented")
-}
-func (UnimplementedSuperServer) CreateVipPlan(context.Context, *CreateVipPlanReq) (*CreateVipPlanResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVipPlan not implemented")
-}
-func (UnimplementedSuperServer) CreateVipOrder(context.Context, *CreateVipOrderReq) (*CreateVipOrderResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateVipOrder not implemented")
-}
-func (UnimplementedSuperServer) GetVipOrders(context.Context, *GetVipOrdersReq) (*GetVipOrdersResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVipOrders not implemented")
-}
-func (UnimplementedSuperServer) GetVipRecords(context.Context, *GetVipRecordsReq) (*GetVipRecordsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVipRecords not implemented")
-}
-func (UnimplementedSuperServer) GetUserActiveVipRecord(context.Context, *GetUserActiveVipRecordReq) (*GetUserActiveVipRecordResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserActiveVipRecord not implemented")
-}
-func (UnimplementedSuperServer) GetUserVipStatus(context.Context, *GetUserVipStatusReq) (*GetUserVipStatusResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserVipStatus not implemented")
-}
-func (UnimplementedSuperServer) CheckUserVip(context.Context, *CheckUserVipReq) (*CheckUserVipResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckUserVip not implemented")
-}
-func (UnimplementedSuperServer) UpdateAutoRenew(context.Context, *UpdateAutoRenewReq) (*UpdateAutoRenewResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAutoRenew not implemented")
-}
-func (UnimplementedSuperServer) SyncUserVipStatus(context.Context, *SyncUserVipStatusReq) (*SyncUserVipStatusResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SyncUserVipStatus not implemented")
-}
-func (UnimplementedSuperServer) GetPosts(context.Context, *GetPostsReq) (*GetPostsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPosts not implemented")
-}
-func (UnimplementedSuperServer) GetPost(context.Context, *GetPostReq) (*GetPostResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
-}
-func (UnimplementedSuperServer) CreatePost(context.Context, *CreatePostReq) (*CreatePostResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
-}
-func (UnimplementedSuperServer) ReportPost(context.Context, *ReportPostReq) (*ReportPostResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReportPost not implemented")
+
+func (c *superClient) LiftSuspension(ctx context.Context, in *LiftSuspensionReq, opts ...grpc.CallOption) (*SuspensionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspensionResp)
+	err := c.cc.Invoke(ctx, Super_LiftSuspension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) LikePost(context.Context, *LikePostReq) (*LikePostResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+
+func (c *superClient) GetUserSuspension(ctx context.Context, in *GetUserSuspensionReq, opts ...grpc.CallOption) (*SuspensionResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspensionResp)
+	err := c.cc.Invoke(ctx, Super_GetUserSuspension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) GetPostComments(context.Context, *GetPostCommentsReq) (*GetPostCommentsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPostComments not implemented")
+
+func (c *superClient) SubmitSuspensionAppeal(ctx context.Context, in *SubmitSuspensionAppealReq, opts ...grpc.CallOption) (*SuspensionAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspensionAppealResp)
+	err := c.cc.Invoke(ctx, Super_SubmitSuspensionAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) CreateComment(context.Context, *CreateCommentReq) (*CreateCommentResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+
+func (c *superClient) ListSuspensionAppeals(ctx context.Context, in *ListSuspensionAppealsReq, opts ...grpc.CallOption) (*ListSuspensionAppealsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSuspensionAppealsResp)
+	err := c.cc.Invoke(ctx, Super_ListSuspensionAppeals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) LikeComment(context.Context, *LikeCommentReq) (*LikeCommentResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LikeComment not implemented")
+
+func (c *superClient) ReviewSuspensionAppeal(ctx context.Context, in *ReviewSuspensionAppealReq, opts ...grpc.CallOption) (*SuspensionAppealResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspensionAppealResp)
+	err := c.cc.Invoke(ctx, Super_ReviewSuspensionAppeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) GetNotifications(context.Context, *GetNotificationsReq) (*GetNotificationsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
+
+func (c *superClient) ListOidcProviders(ctx context.Context, in *ListOidcProvidersReq, opts ...grpc.CallOption) (*ListOidcProvidersResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOidcProvidersResp)
+	err := c.cc.Invoke(ctx, Super_ListOidcProviders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) GetUnreadCount(context.Context, *GetUnreadCountReq) (*GetUnreadCountResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCount not implemented")
+
+func (c *superClient) StartOidcAuth(ctx context.Context, in *StartOidcAuthReq, opts ...grpc.CallOption) (*StartOidcAuthResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartOidcAuthResp)
+	err := c.cc.Invoke(ctx, Super_StartOidcAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) ReadNotification(context.Context, *ReadNotificationReq) (*ReadNotificationResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadNotification not implemented")
+
+func (c *superClient) OidcLogin(ctx context.Context, in *OidcLoginReq, opts ...grpc.CallOption) (*LoginResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResp)
+	err := c.cc.Invoke(ctx, Super_OidcLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) ReadAllNotifications(context.Context, *ReadAllNotificationsReq) (*ReadAllNotificationsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReadAllNotifications not implemented")
+
+func (c *superClient) LinkUserIdentity(ctx context.Context, in *LinkUserIdentityReq, opts ...grpc.CallOption) (*UserIdentityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserIdentityResp)
+	err := c.cc.Invoke(ctx, Super_LinkUserIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) CreateNotification(context.Context, *CreateNotificationReq) (*CreateNotificationResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateNotification not implemented")
+
+func (c *superClient) ListUserIdentities(ctx context.Context, in *ListUserIdentitiesReq, opts ...grpc.CallOption) (*ListUserIdentitiesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserIdentitiesResp)
+	err := c.cc.Invoke(ctx, Super_ListUserIdentities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) Recharge(context.Context, *RechargeReq) (*RechargeResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Recharge not implemented")
+
+func (c *superClient) UnlinkUserIdentity(ctx context.Context, in *UnlinkUserIdentityReq, opts ...grpc.CallOption) (*UnlinkUserIdentityResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkUserIdentityResp)
+	err := c.cc.Invoke(ctx, Super_UnlinkUserIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) GetTransactions(context.Context, *GetTransactionsReq) (*GetTransactionsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+
+func (c *superClient) GetUserAvatar(ctx context.Context, in *GetUserAvatarReq, opts ...grpc.CallOption) (*GetUserAvatarResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserAvatarResp)
+	err := c.cc.Invoke(ctx, Super_GetUserAvatar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) GetTransaction(context.Context, *GetTransactionReq) (*GetTransactionResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+
+func (c *superClient) UpdateUserAvatar(ctx context.Context, in *UpdateUserAvatarReq, opts ...grpc.CallOption) (*UpdateUserAvatarResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateUserAvatarResp)
+	err := c.cc.Invoke(ctx, Super_UpdateUserAvatar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) FollowUser(context.Context, *FollowUserReq) (*FollowUserResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+
+func (c *superClient) CheckIn(ctx context.Context, in *CheckInReq, opts ...grpc.CallOption) (*CheckInResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInResp)
+	err := c.cc.Invoke(ctx, Super_CheckIn_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) UnfollowUser(context.Context, *UnfollowUserReq) (*FollowUserResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+
+func (c *superClient) GetUserLevel(ctx context.Context, in *GetUserLevelReq, opts ...grpc.CallOption) (*GetUserLevelResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserLevelResp)
+	err := c.cc.Invoke(ctx, Super_GetUserLevel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) GetFollowings(context.Context, *GetFollowingsReq) (*GetFollowingsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFollowings not implemented")
+
+func (c *superClient) GetCheckInStatus(ctx context.Context, in *GetCheckInStatusReq, opts ...grpc.CallOption) (*GetCheckInStatusResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCheckInStatusResp)
+	err := c.cc.Invoke(ctx, Super_GetCheckInStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) GetFollowers(context.Context, *GetFollowersReq) (*GetFollowersResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFollowers not implemented")
+
+func (c *superClient) GetCheckInHistory(ctx context.Context, in *GetCheckInHistoryReq, opts ...grpc.CallOption) (*GetCheckInHistoryResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCheckInHistoryResp)
+	err := c.cc.Invoke(ctx, Super_GetCheckInHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) CheckFollow(context.Context, *CheckFollowReq) (*CheckFollowResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckFollow not implemented")
+
+func (c *superClient) GetExpLogs(ctx context.Context, in *GetExpLogsReq, opts ...grpc.CallOption) (*GetExpLogsResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetExpLogsResp)
+	err := c.cc.Invoke(ctx, Super_GetExpLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) SendFriendRequest(context.Context, *SendFriendRequestReq) (*SendFriendRequestResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendFriendRequest not implemented")
+
+func (c *superClient) UploadPreKeyBundle(ctx context.Context, in *UploadPreKeyBundleReq, opts ...grpc.CallOption) (*UploadPreKeyBundleResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadPreKeyBundleResp)
+	err := c.cc.Invoke(ctx, Super_UploadPreKeyBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) ListIncomingFriendRequests(context.Context, *ListIncomingFriendRequestsReq) (*ListIncomingFriendRequestsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListIncomingFriendRequests not implemented")
+
+func (c *superClient) GetPreKeyBundles(ctx context.Context, in *GetPreKeyBundlesReq, opts ...grpc.CallOption) (*GetPreKeyBundlesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPreKeyBundlesResp)
+	err := c.cc.Invoke(ctx, Super_GetPreKeyBundles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) ListOutgoingFriendRequests(context.Context, *ListOutgoingFriendRequestsReq) (*ListOutgoingFriendRequestsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListOutgoingFriendRequests not implemented")
+
+func (c *superClient) StoreEncryptedMessage(ctx context.Context, in *StoreEncryptedMessageReq, opts ...grpc.CallOption) (*StoreEncryptedMessageResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StoreEncryptedMessageResp)
+	err := c.cc.Invoke(ctx, Super_StoreEncryptedMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) AcceptFriendRequest(context.Context, *AcceptFriendRequestReq) (*AcceptFriendRequestResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcceptFriendRequest not implemented")
+
+func (c *superClient) ListPendingEncryptedMessages(ctx context.Context, in *ListPendingEncryptedMessagesReq, opts ...grpc.CallOption) (*ListPendingEncryptedMessagesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingEncryptedMessagesResp)
+	err := c.cc.Invoke(ctx, Super_ListPendingEncryptedMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) RejectFriendRequest(context.Context, *RejectFriendRequestReq) (*RejectFriendRequestResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RejectFriendRequest not implemented")
+
+func (c *superClient) AckEncryptedMessages(ctx context.Context, in *AckEncryptedMessagesReq, opts ...grpc.CallOption) (*AckEncryptedMessagesResp, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AckEncryptedMessagesResp)
+	err := c.cc.Invoke(ctx, Super_AckEncryptedMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedSuperServer) ListFriends(context.Context, *ListFriendsReq) (*ListFriendsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListFriends not implemented")
+
+// SuperServer is the server API for Super service.
+// All implementations must embed UnimplementedSuperServer
+// for forward compatibility.
+//
+// 服务定义
+type SuperServer interface {
+	// 用户相关服务
+	Register(context.Context, *RegisterReq) (*RegisterResp, error)
+	Login(context.Context, *LoginReq) (*LoginResp, error)
+	GetUserInfo(context.Context, *GetUserInfoReq) (*GetUserInfoResp, error)
+	GetUser(context.Context, *GetUserReq) (*GetUserResp, error)
+	GetUserByEmail(context.Context, *GetUserByEmailReq) (*GetUserByEmailResp, error)
+	UpdateUserInfo(context.Context, *UpdateUserInfoReq) (*UpdateUserInfoResp, error)
+	UpdateUserPassword(context.Context, *UpdateUserPasswordReq) (*UpdateUserPasswordResp, error)
+	RequestPasswordReset(context.Context, *RequestPasswordResetReq) (*RequestPasswordResetResp, error)
+	ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error)
+	GetUserAuthState(context.Context, *GetUserAuthStateReq) (*GetUserAuthStateResp, error)
+	RefreshSession(context.Context, *RefreshSessionReq) (*RefreshSessionResp, error)
+	ListUserSessions(context.Context, *ListUserSessionsReq) (*ListUserSessionsResp, error)
+	RevokeUserSession(context.Context, *RevokeUserSessionReq) (*RevokeUserSessionResp, error)
+	RevokeAllUserSessions(context.Context, *RevokeAllUserSessionsReq) (*RevokeAllUserSessionsResp, error)
+	IssueWsTicket(context.Context, *IssueWsTicketReq) (*IssueWsTicketResp, error)
+	RedeemWsTicket(context.Context, *RedeemWsTicketReq) (*RedeemWsTicketResp, error)
+	SendEmailVerification(context.Context, *SendEmailVerificationReq) (*SendEmailVerificationResp, error)
+	VerifyEmail(context.Context, *VerifyEmailReq) (*VerifyEmailResp, error)
+	UpdateUserRole(context.Context, *UpdateUserRoleReq) (*UpdateUserRoleResp, error)
+	ListRoleAuditLogs(context.Context, *ListRoleAuditLogsReq) (*ListRoleAuditLogsResp, error)
+	ListSecurityEvents(context.Context, *ListSecurityEventsReq) (*ListSecurityEventsResp, error)
+	VerifyLoginMfa(context.Context, *VerifyLoginMfaReq) (*LoginResp, error)
+	GetMfaStatus(context.Context, *GetMfaStatusReq) (*GetMfaStatusResp, error)
+	BeginTotpEnrollment(context.Context, *BeginTotpEnrollmentReq) (*BeginTotpEnrollmentResp, error)
+	ConfirmTotpEnrollment(context.Context, *ConfirmTotpEnrollmentReq) (*RecoveryCodesResp, error)
+	DisableTotp(context.Context, *DisableTotpReq) (*DisableTotpResp, error)
+	RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesReq) (*RecoveryCodesResp, error)
+	ListMfaPolicies(context.Context, *ListMfaPoliciesReq) (*ListMfaPoliciesResp, error)
+	SetMfaPolicy(context.Context, *SetMfaPolicyReq) (*SetMfaPolicyResp, error)
+	DeleteUser(context.Context, *DeleteUserReq) (*DeleteUserResp, error)
+	GetAccountDeletion(context.Context, *GetAccountDeletionReq) (*GetAccountDeletionResp, error)
+	CancelAccountDeletion(context.Context, *CancelAccountDeletionReq) (*CancelAccountDeletionResp, error)
+	ExportUserData(*ExportUserDataReq, grpc.ServerStreamingServer[ExportChunk]) error
+	UpdateUserVip(context.Context, *UpdateUserVipReq) (*UpdateUserVipResp, error)
+	GetUsers(context.Context, *GetUsersReq) (*GetUsersResp, error)
+	GetUserCount(context.Context, *GetUserCountReq) (*GetUserCountResp, error)
+	UpsertUserMemory(context.Context, *UpsertUserMemoryReq) (*UpsertUserMemoryResp, error)
+	GetUserMemories(context.Context, *GetUserMemoriesReq) (*GetUserMemoriesResp, error)
+	DeleteUserMemory(context.Context, *DeleteUserMemoryReq) (*DeleteUserMemoryResp, error)
+	// VIP套餐相关服务
+	GetVipPlans(context.Context, *GetVipPlansReq) (*GetVipPlansResp, error)
+	GetVipPlan(context.Context, *GetVipPlanReq) (*GetVipPlanResp, error)
+	CreateVipPlan(context.Context, *CreateVipPlanReq) (*CreateVipPlanResp, error)
+	// VIP订单相关服务
+	CreateVipOrder(context.Context, *CreateVipOrderReq) (*CreateVipOrderResp, error)
+	GetVipOrders(context.Context, *GetVipOrdersReq) (*GetVipOrdersResp, error)
+	// VIP记录相关服务
+	GetVipRecords(context.Context, *GetVipRecordsReq) (*GetVipRecordsResp, error)
+	GetUserActiveVipRecord(context.Context, *GetUserActiveVipRecordReq) (*GetUserActiveVipRecordResp, error)
+	// VIP状态相关服务
+	GetUserVipStatus(context.Context, *GetUserVipStatusReq) (*GetUserVipStatusResp, error)
+	CheckUserVip(context.Context, *CheckUserVipReq) (*CheckUserVipResp, error)
+	UpdateAutoRenew(context.Context, *UpdateAutoRenewReq) (*UpdateAutoRenewResp, error)
+	SyncUserVipStatus(context.Context, *SyncUserVipStatusReq) (*SyncUserVipStatusResp, error)
+	// 帖子相关服务
+	GetPosts(context.Context, *GetPostsReq) (*GetPostsResp, error)
+	GetPost(context.Context, *GetPostReq) (*GetPostResp, error)
+	CreatePost(context.Context, *CreatePostReq) (*CreatePostResp, error)
+	UpdatePost(context.Context, *UpdatePostReq) (*UpdatePostResp, error)
+	DeletePost(context.Context, *DeletePostReq) (*DeletePostResp, error)
+	ReportPost(context.Context, *ReportPostReq) (*ReportPostResp, error)
+	LikePost(context.Context, *LikePostReq) (*LikePostResp, error)
+	GetPostComments(context.Context, *GetPostCommentsReq) (*GetPostCommentsResp, error)
+	// 评论相关服务
+	CreateComment(context.Context, *CreateCommentReq) (*CreateCommentResp, error)
+	LikeComment(context.Context, *LikeCommentReq) (*LikeCommentResp, error)
+	// 通知相关服务
+	GetNotifications(context.Context, *GetNotificationsReq) (*GetNotificationsResp, error)
+	GetUnreadCount(context.Context, *GetUnreadCountReq) (*GetUnreadCountResp, error)
+	ReadNotification(context.Context, *ReadNotificationReq) (*ReadNotificationResp, error)
+	ReadAllNotifications(context.Context, *ReadAllNotificationsReq) (*ReadAllNotificationsResp, error)
+	CreateNotification(context.Context, *CreateNotificationReq) (*CreateNotificationResp, error)
+	WatchNotifications(*WatchNotificationsReq, grpc.ServerStreamingServer[NotificationEvent]) error
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesReq) (*NotificationPreferencesResp, error)
+	UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesReq) (*NotificationPreferencesResp, error)
+	SetNotificationMute(context.Context, *SetNotificationMuteReq) (*SetNotificationMuteResp, error)
+	CreateNotificationCampaign(context.Context, *CreateNotificationCampaignReq) (*NotificationCampaignResp, error)
+	ListNotificationCampaigns(context.Context, *ListNotificationCampaignsReq) (*ListNotificationCampaignsResp, error)
+	GetNotificationCampaign(context.Context, *GetNotificationCampaignReq) (*NotificationCampaignResp, error)
+	CancelNotificationCampaign(context.Context, *GetNotificationCampaignReq) (*NotificationCampaignResp, error)
+	RegisterDevice(context.Context, *RegisterDeviceReq) (*RegisterDeviceResp, error)
+	UnregisterDevice(context.Context, *UnregisterDeviceReq) (*UnregisterDeviceResp, error)
+	SendDevicePush(context.Context, *SendDevicePushReq) (*SendDevicePushResp, error)
+	// 钱包相关服务
+	Recharge(context.Context, *RechargeReq) (*RechargeResp, error)
+	// 交易记录相关服务
+	GetTransactions(context.Context, *GetTransactionsReq) (*GetTransactionsResp, error)
+	GetTransaction(context.Context, *GetTransactionReq) (*GetTransactionResp, error)
+	// 关注相关服务
+	FollowUser(context.Context, *FollowUserReq) (*FollowUserResp, error)
+	UnfollowUser(context.Context, *UnfollowUserReq) (*FollowUserResp, error)
+	GetFollowings(context.Context, *GetFollowingsReq) (*GetFollowingsResp, error)
+	GetFollowers(context.Context, *GetFollowersReq) (*GetFollowersResp, error)
+	CheckFollow(context.Context, *CheckFollowReq) (*CheckFollowResp, error)
+	ListFollowRequests(context.Context, *ListFollowRequestsReq) (*ListFollowRequestsResp, error)
+	RespondFollowRequest(context.Context, *RespondFollowRequestReq) (*RespondFollowRequestResp, error)
+	// 隐私设置相关服务
+	GetPrivacySettings(context.Context, *GetPrivacySettingsReq) (*PrivacySettingsResp, error)
+	UpdatePrivacySettings(context.Context, *UpdatePrivacySettingsReq) (*PrivacySettingsResp, error)
+	CheckDirectMessage(context.Context, *CheckDirectMessageReq) (*CheckDirectMessageResp, error)
+	SendFriendRequest(context.Context, *SendFriendRequestReq) (*SendFriendRequestResp, error)
+	ListIncomingFriendRequests(context.Context, *ListIncomingFriendRequestsReq) (*ListIncomingFriendRequestsResp, error)
+	ListOutgoingFriendRequests(context.Context, *ListOutgoingFriendRequestsReq) (*ListOutgoingFriendRequestsResp, error)
+	AcceptFriendRequest(context.Context, *AcceptFriendRequestReq) (*AcceptFriendRequestResp, error)
+	RejectFriendRequest(context.Context, *RejectFriendRequestReq) (*RejectFriendRequestResp, error)
+	ListFriends(context.Context, *ListFriendsReq) (*ListFriendsResp, error)
+	GetFriendRelation(context.Context, *GetFriendRelationReq) (*GetFriendRelationResp, error)
+	// 拉黑相关服务
+	BlockUser(context.Context, *BlockUserReq) (*BlockUserResp, error)
+	UnblockUser(context.Context, *BlockUserReq) (*BlockUserResp, error)
+	ListBlockedUsers(context.Context, *ListBlockedUsersReq) (*ListBlockedUsersResp, error)
+	CheckUserBlock(context.Context, *CheckUserBlockReq) (*CheckUserBlockResp, error)
+	GetBlockedUserIds(context.Context, *GetBlockedUserIdsReq) (*GetBlockedUserIdsResp, error)
+	// 处罚（封禁 / 限制）与申诉相关服务
+	SuspendUser(context.Context, *SuspendUserReq) (*SuspensionResp, error)
+	LiftSuspension(context.Context, *LiftSuspensionReq) (*SuspensionResp, error)
+	GetUserSuspension(context.Context, *GetUserSuspensionReq) (*SuspensionResp, error)
+	SubmitSuspensionAppeal(context.Context, *SubmitSuspensionAppealReq) (*SuspensionAppealResp, error)
+	ListSuspensionAppeals(context.Context, *ListSuspensionAppealsReq) (*ListSuspensionAppealsResp, error)
+	ReviewSuspensionAppeal(context.Context, *ReviewSuspensionAppealReq) (*SuspensionAppealResp, error)
+	// 第三方登录（OIDC）与账号绑定相关服务
+	ListOidcProviders(context.Context, *ListOidcProvidersReq) (*ListOidcProvidersResp, error)
+	StartOidcAuth(context.Context, *StartOidcAuthReq) (*StartOidcAuthResp, error)
+	OidcLogin(context.Context, *OidcLoginReq) (*LoginResp, error)
+	LinkUserIdentity(context.Context, *LinkUserIdentityReq) (*UserIdentityResp, error)
+	ListUserIdentities(context.Context, *ListUserIdentitiesReq) (*ListUserIdentitiesResp, error)
+	UnlinkUserIdentity(context.Context, *UnlinkUserIdentityReq) (*UnlinkUserIdentityResp, error)
+	// 虚拟形象相关服务
+	GetUserAvatar(context.Context, *GetUserAvatarReq) (*GetUserAvatarResp, error)
+	UpdateUserAvatar(context.Context, *UpdateUserAvatarReq) (*UpdateUserAvatarResp, error)
+	// 签到等级相关服务
+	CheckIn(context.Context, *CheckInReq) (*CheckInResp, error)
+	GetUserLevel(context.Context, *GetUserLevelReq) (*GetUserLevelResp, error)
+	GetCheckInStatus(context.Context, *GetCheckInStatusReq) (*GetCheckInStatusResp, error)
+	GetCheckInHistory(context.Context, *GetCheckInHistoryReq) (*GetCheckInHistoryResp, error)
+	GetExpLogs(context.Context, *GetExpLogsReq) (*GetExpLogsResp, error)
+	// 端到端加密（服务端只保存公钥与密文，不参与解密）
+	UploadPreKeyBundle(context.Context, *UploadPreKeyBundleReq) (*UploadPreKeyBundleResp, error)
+	GetPreKeyBundles(context.Context, *GetPreKeyBundlesReq) (*GetPreKeyBundlesResp, error)
+	StoreEncryptedMessage(context.Context, *StoreEncryptedMessageReq) (*StoreEncryptedMessageResp, error)
+	ListPendingEncryptedMessages(context.Context, *ListPendingEncryptedMessagesReq) (*ListPendingEncryptedMessagesResp, error)
+	AckEncryptedMessages(context.Context, *AckEncryptedMessagesReq) (*AckEncryptedMessagesResp, error)
+	mustEmbedUnimplementedSuperServer()
+}
+
+// UnimplementedSuperServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSuperServer struct{}
+
+func (UnimplementedSuperServer) Register(context.Context, *RegisterReq) (*RegisterResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedSuperServer) Login(context.Context, *LoginReq) (*LoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedSuperServer) GetUserInfo(context.Context, *GetUserInfoReq) (*GetUserInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserInfo not implemented")
+}
+func (UnimplementedSuperServer) GetUser(context.Context, *GetUserReq) (*GetUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedSuperServer) GetUserByEmail(context.Context, *GetUserByEmailReq) (*GetUserByEmailResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByEmail not implemented")
+}
+func (UnimplementedSuperServer) UpdateUserInfo(context.Context, *UpdateUserInfoReq) (*UpdateUserInfoResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserInfo not implemented")
+}
+func (UnimplementedSuperServer) UpdateUserPassword(context.Context, *UpdateUserPasswordReq) (*UpdateUserPasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserPassword not implemented")
+}
+func (UnimplementedSuperServer) RequestPasswordReset(context.Context, *RequestPasswordResetReq) (*RequestPasswordResetResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPasswordReset not implemented")
+}
+func (UnimplementedSuperServer) ResetPassword(context.Context, *ResetPasswordReq) (*ResetPasswordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetPassword not implemented")
+}
+func (UnimplementedSuperServer) GetUserAuthState(context.Context, *GetUserAuthStateReq) (*GetUserAuthStateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserAuthState not implemented")
+}
+func (UnimplementedSuperServer) RefreshSession(context.Context, *RefreshSessionReq) (*RefreshSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshSession not implemented")
+}
+func (UnimplementedSuperServer) ListUserSessions(context.Context, *ListUserSessionsReq) (*ListUserSessionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserSessions not implemented")
+}
+func (UnimplementedSuperServer) RevokeUserSession(context.Context, *RevokeUserSessionReq) (*RevokeUserSessionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeUserSession not implemented")
+}
+func (UnimplementedSuperServer) RevokeAllUserSessions(context.Context, *RevokeAllUserSessionsReq) (*RevokeAllUserSessionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllUserSessions not implemented")
+}
+func (UnimplementedSuperServer) IssueWsTicket(context.Context, *IssueWsTicketReq) (*IssueWsTicketResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueWsTicket not implemented")
+}
+func (UnimplementedSuperServer) RedeemWsTicket(context.Context, *RedeemWsTicketReq) (*RedeemWsTicketResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemWsTicket not implemented")
+}
+func (UnimplementedSuperServer) SendEmailVerification(context.Context, *SendEmailVerificationReq) (*SendEmailVerificationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendEmailVerification not implemented")
+}
+func (UnimplementedSuperServer) VerifyEmail(context.Context, *VerifyEmailReq) (*VerifyEmailResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyEmail not implemented")
+}
+func (UnimplementedSuperServer) UpdateUserRole(context.Context, *UpdateUserRoleReq) (*UpdateUserRoleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserRole not implemented")
+}
+func (UnimplementedSuperServer) ListRoleAuditLogs(context.Context, *ListRoleAuditLogsReq) (*ListRoleAuditLogsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleAuditLogs not implemented")
+}
+func (UnimplementedSuperServer) ListSecurityEvents(context.Context, *ListSecurityEventsReq) (*ListSecurityEventsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecurityEvents not implemented")
+}
+func (UnimplementedSuperServer) VerifyLoginMfa(context.Context, *VerifyLoginMfaReq) (*LoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyLoginMfa not implemented")
+}
+func (UnimplementedSuperServer) GetMfaStatus(context.Context, *GetMfaStatusReq) (*GetMfaStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMfaStatus not implemented")
+}
+func (UnimplementedSuperServer) BeginTotpEnrollment(context.Context, *BeginTotpEnrollmentReq) (*BeginTotpEnrollmentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginTotpEnrollment not implemented")
+}
+func (UnimplementedSuperServer) ConfirmTotpEnrollment(context.Context, *ConfirmTotpEnrollmentReq) (*RecoveryCodesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTotpEnrollment not implemented")
+}
+func (UnimplementedSuperServer) DisableTotp(context.Context, *DisableTotpReq) (*DisableTotpResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableTotp not implemented")
+}
+func (UnimplementedSuperServer) RegenerateRecoveryCodes(context.Context, *RegenerateRecoveryCodesReq) (*RecoveryCodesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedSuperServer) ListMfaPolicies(context.Context, *ListMfaPoliciesReq) (*ListMfaPoliciesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMfaPolicies not implemented")
+}
+func (UnimplementedSuperServer) SetMfaPolicy(context.Context, *SetMfaPolicyReq) (*SetMfaPolicyResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMfaPolicy not implemented")
+}
+func (UnimplementedSuperServer) DeleteUser(context.Context, *DeleteUserReq) (*DeleteUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedSuperServer) GetAccountDeletion(context.Context, *GetAccountDeletionReq) (*GetAccountDeletionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountDeletion not implemented")
+}
+func (UnimplementedSuperServer) CancelAccountDeletion(context.Context, *CancelAccountDeletionReq) (*CancelAccountDeletionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAccountDeletion not implemented")
+}
+func (UnimplementedSuperServer) ExportUserData(*ExportUserDataReq, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
+func (UnimplementedSuperServer) UpdateUserVip(context.Context, *UpdateUserVipReq) (*UpdateUserVipResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserVip not implemented")
+}
+func (UnimplementedSuperServer) GetUsers(context.Context, *GetUsersReq) (*GetUsersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
+}
+func (UnimplementedSuperServer) GetUserCount(context.Context, *GetUserCountReq) (*GetUserCountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserCount not implemented")
+}
+func (UnimplementedSuperServer) UpsertUserMemory(context.Context, *UpsertUserMemoryReq) (*UpsertUserMemoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertUserMemory not implemented")
+}
+func (UnimplementedSuperServer) GetUserMemories(context.Context, *GetUserMemoriesReq) (*GetUserMemoriesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserMemories not implemented")
+}
+func (UnimplementedSuperServer) DeleteUserMemory(context.Context, *DeleteUserMemoryReq) (*DeleteUserMemoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserMemory not implemented")
+}
+func (UnimplementedSuperServer) GetVipPlans(context.Context, *GetVipPlansReq) (*GetVipPlansResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVipPlans not implemented")
+}
+func (UnimplementedSuperServer) GetVipPlan(context.Context, *GetVipPlanReq) (*GetVipPlanResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVipPlan not implemented")
+}
+func (UnimplementedSuperServer) CreateVipPlan(context.Context, *CreateVipPlanReq) (*CreateVipPlanResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVipPlan not implemented")
+}
+func (UnimplementedSuperServer) CreateVipOrder(context.Context, *CreateVipOrderReq) (*CreateVipOrderResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVipOrder not implemented")
+}
+func (UnimplementedSuperServer) GetVipOrders(context.Context, *GetVipOrdersReq) (*GetVipOrdersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVipOrders not implemented")
+}
+func (UnimplementedSuperServer) GetVipRecords(context.Context, *GetVipRecordsReq) (*GetVipRecordsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVipRecords not implemented")
+}
+func (UnimplementedSuperServer) GetUserActiveVipRecord(context.Context, *GetUserActiveVipRecordReq) (*GetUserActiveVipRecordResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserActiveVipRecord not implemented")
+}
+func (UnimplementedSuperServer) GetUserVipStatus(context.Context, *GetUserVipStatusReq) (*GetUserVipStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserVipStatus not implemented")
+}
+func (UnimplementedSuperServer) CheckUserVip(context.Context, *CheckUserVipReq) (*CheckUserVipResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUserVip not implemented")
+}
+func (UnimplementedSuperServer) UpdateAutoRenew(context.Context, *UpdateAutoRenewReq) (*UpdateAutoRenewResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAutoRenew not implemented")
+}
+func (UnimplementedSuperServer) SyncUserVipStatus(context.Context, *SyncUserVipStatusReq) (*SyncUserVipStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncUserVipStatus not implemented")
+}
+func (UnimplementedSuperServer) GetPosts(context.Context, *GetPostsReq) (*GetPostsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPosts not implemented")
+}
+func (UnimplementedSuperServer) GetPost(context.Context, *GetPostReq) (*GetPostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPost not implemented")
+}
+func (UnimplementedSuperServer) CreatePost(context.Context, *CreatePostReq) (*CreatePostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePost not implemented")
+}
+func (UnimplementedSuperServer) UpdatePost(context.Context, *UpdatePostReq) (*UpdatePostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePost not implemented")
+}
+func (UnimplementedSuperServer) DeletePost(context.Context, *DeletePostReq) (*DeletePostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
+}
+func (UnimplementedSuperServer) ReportPost(context.Context, *ReportPostReq) (*ReportPostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPost not implemented")
+}
+func (UnimplementedSuperServer) LikePost(context.Context, *LikePostReq) (*LikePostResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikePost not implemented")
+}
+func (UnimplementedSuperServer) GetPostComments(context.Context, *GetPostCommentsReq) (*GetPostCommentsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPostComments not implemented")
+}
+func (UnimplementedSuperServer) CreateComment(context.Context, *CreateCommentReq) (*CreateCommentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedSuperServer) LikeComment(context.Context, *LikeCommentReq) (*LikeCommentResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LikeComment not implemented")
+}
+func (UnimplementedSuperServer) GetNotifications(context.Context, *GetNotificationsReq) (*GetNotificationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
+}
+func (UnimplementedSuperServer) GetUnreadCount(context.Context, *GetUnreadCountReq) (*GetUnreadCountResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCount not implemented")
+}
+func (UnimplementedSuperServer) ReadNotification(context.Context, *ReadNotificationReq) (*ReadNotificationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadNotification not implemented")
+}
+func (UnimplementedSuperServer) ReadAllNotifications(context.Context, *ReadAllNotificationsReq) (*ReadAllNotificationsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadAllNotifications not implemented")
+}
+func (UnimplementedSuperServer) CreateNotification(context.Context, *CreateNotificationReq) (*CreateNotificationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNotification not implemented")
+}
+func (UnimplementedSuperServer) WatchNotifications(*WatchNotificationsReq, grpc.ServerStreamingServer[NotificationEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchNotifications not implemented")
+}
+func (UnimplementedSuperServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesReq) (*NotificationPreferencesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedSuperServer) UpdateNotificationPreferences(context.Context, *UpdateNotificationPreferencesReq) (*NotificationPreferencesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationPreferences not implemented")
+}
+func (UnimplementedSuperServer) SetNotificationMute(context.Context, *SetNotificationMuteReq) (*SetNotificationMuteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNotificationMute not implemented")
+}
+func (UnimplementedSuperServer) CreateNotificationCampaign(context.Context, *CreateNotificationCampaignReq) (*NotificationCampaignResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNotificationCampaign not implemented")
+}
+func (UnimplementedSuperServer) ListNotificationCampaigns(context.Context, *ListNotificationCampaignsReq) (*ListNotificationCampaignsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotificationCampaigns not implemented")
+}
+func (UnimplementedSuperServer) GetNotificationCampaign(context.Context, *GetNotificationCampaignReq) (*NotificationCampaignResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationCampaign not implemented")
+}
+func (UnimplementedSuperServer) CancelNotificationCampaign(context.Context, *GetNotificationCampaignReq) (*NotificationCampaignResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelNotificationCampaign not implemented")
+}
+func (UnimplementedSuperServer) RegisterDevice(context.Context, *RegisterDeviceReq) (*RegisterDeviceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDevice not implemented")
+}
+func (UnimplementedSuperServer) UnregisterDevice(context.Context, *UnregisterDeviceReq) (*UnregisterDeviceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterDevice not implemented")
+}
+func (UnimplementedSuperServer) SendDevicePush(context.Context, *SendDevicePushReq) (*SendDevicePushResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendDevicePush not implemented")
+}
+func (UnimplementedSuperServer) Recharge(context.Context, *RechargeReq) (*RechargeResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Recharge not implemented")
+}
+func (UnimplementedSuperServer) GetTransactions(context.Context, *GetTransactionsReq) (*GetTransactionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactions not implemented")
+}
+func (UnimplementedSuperServer) GetTransaction(context.Context, *GetTransactionReq) (*GetTransactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransaction not implemented")
+}
+func (UnimplementedSuperServer) FollowUser(context.Context, *FollowUserReq) (*FollowUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+}
+func (UnimplementedSuperServer) UnfollowUser(context.Context, *UnfollowUserReq) (*FollowUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+}
+func (UnimplementedSuperServer) GetFollowings(context.Context, *GetFollowingsReq) (*GetFollowingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowings not implemented")
+}
+func (UnimplementedSuperServer) GetFollowers(context.Context, *GetFollowersReq) (*GetFollowersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowers not implemented")
+}
+func (UnimplementedSuperServer) CheckFollow(context.Context, *CheckFollowReq) (*CheckFollowResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckFollow not implemented")
+}
+func (UnimplementedSuperServer) ListFollowRequests(context.Context, *ListFollowRequestsReq) (*ListFollowRequestsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowRequests not implemented")
+}
+func (UnimplementedSuperServer) RespondFollowRequest(context.Context, *RespondFollowRequestReq) (*RespondFollowRequestResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondFollowRequest not implemented")
+}
+func (UnimplementedSuperServer) GetPrivacySettings(context.Context, *GetPrivacySettingsReq) (*PrivacySettingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPrivacySettings not implemented")
+}
+func (UnimplementedSuperServer) UpdatePrivacySettings(context.Context, *UpdatePrivacySettingsReq) (*PrivacySettingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrivacySettings not implemented")
+}
+func (UnimplementedSuperServer) CheckDirectMessage(context.Context, *CheckDirectMessageReq) (*CheckDirectMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckDirectMessage not implemented")
+}
+func (UnimplementedSuperServer) SendFriendRequest(context.Context, *SendFriendRequestReq) (*SendFriendRequestResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFriendRequest not implemented")
+}
+func (UnimplementedSuperServer) ListIncomingFriendRequests(context.Context, *ListIncomingFriendRequestsReq) (*ListIncomingFriendRequestsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIncomingFriendRequests not implemented")
+}
+func (UnimplementedSuperServer) ListOutgoingFriendRequests(context.Context, *ListOutgoingFriendRequestsReq) (*ListOutgoingFriendRequestsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutgoingFriendRequests not implemented")
+}
+func (UnimplementedSuperServer) AcceptFriendRequest(context.Context, *AcceptFriendRequestReq) (*AcceptFriendRequestResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptFriendRequest not implemented")
+}
+func (UnimplementedSuperServer) RejectFriendRequest(context.Context, *RejectFriendRequestReq) (*RejectFriendRequestResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFriendRequest not implemented")
+}
+func (UnimplementedSuperServer) ListFriends(context.Context, *ListFriendsReq) (*ListFriendsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFriends not implemented")
+}
+func (UnimplementedSuperServer) GetFriendRelation(context.Context, *GetFriendRelationReq) (*GetFriendRelationResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFriendRelation not implemented")
+}
+func (UnimplementedSuperServer) BlockUser(context.Context, *BlockUserReq) (*BlockUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedSuperServer) UnblockUser(context.Context, *BlockUserReq) (*BlockUserResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedSuperServer) ListBlockedUsers(context.Context, *ListBlockedUsersReq) (*ListBlockedUsersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockedUsers not implemented")
+}
+func (UnimplementedSuperServer) CheckUserBlock(context.Context, *CheckUserBlockReq) (*CheckUserBlockResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUserBlock not implemented")
+}
+func (UnimplementedSuperServer) GetBlockedUserIds(context.Context, *GetBlockedUserIdsReq) (*GetBlockedUserIdsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockedUserIds not implemented")
+}
+func (UnimplementedSuperServer) SuspendUser(context.Context, *SuspendUserReq) (*SuspensionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedSuperServer) LiftSuspension(context.Context, *LiftSuspensionReq) (*SuspensionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiftSuspension not implemented")
+}
+func (UnimplementedSuperServer) GetUserSuspension(context.Context, *GetUserSuspensionReq) (*SuspensionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserSuspension not implemented")
+}
+func (UnimplementedSuperServer) SubmitSuspensionAppeal(context.Context, *SubmitSuspensionAppealReq) (*SuspensionAppealResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSuspensionAppeal not implemented")
+}
+func (UnimplementedSuperServer) ListSuspensionAppeals(context.Context, *ListSuspensionAppealsReq) (*ListSuspensionAppealsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuspensionAppeals not implemented")
+}
+func (UnimplementedSuperServer) ReviewSuspensionAppeal(context.Context, *ReviewSuspensionAppealReq) (*SuspensionAppealResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewSuspensionAppeal not implemented")
+}
+func (UnimplementedSuperServer) ListOidcProviders(context.Context, *ListOidcProvidersReq) (*ListOidcProvidersResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOidcProviders not implemented")
+}
+func (UnimplementedSuperServer) StartOidcAuth(context.Context, *StartOidcAuthReq) (*StartOidcAuthResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartOidcAuth not implemented")
+}
+func (UnimplementedSuperServer) OidcLogin(context.Context, *OidcLoginReq) (*LoginResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OidcLogin not implemented")
+}
+func (UnimplementedSuperServer) LinkUserIdentity(context.Context, *LinkUserIdentityReq) (*UserIdentityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkUserIdentity not implemented")
+}
+func (UnimplementedSuperServer) ListUserIdentities(context.Context, *ListUserIdentitiesReq) (*ListUserIdentitiesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserIdentities not implemented")
+}
+func (UnimplementedSuperServer) UnlinkUserIdentity(context.Context, *UnlinkUserIdentityReq) (*UnlinkUserIdentityResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkUserIdentity not implemented")
+}
+func (UnimplementedSuperServer) GetUserAvatar(context.Context, *GetUserAvatarReq) (*GetUserAvatarResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserAvatar not implemented")
+}
+func (UnimplementedSuperServer) UpdateUserAvatar(context.Context, *UpdateUserAvatarReq) (*UpdateUserAvatarResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserAvatar not implemented")
+}
+func (UnimplementedSuperServer) CheckIn(context.Context, *CheckInReq) (*CheckInResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+}
+func (UnimplementedSuperServer) GetUserLevel(context.Context, *GetUserLevelReq) (*GetUserLevelResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserLevel not implemented")
+}
+func (UnimplementedSuperServer) GetCheckInStatus(context.Context, *GetCheckInStatusReq) (*GetCheckInStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCheckInStatus not implemented")
+}
+func (UnimplementedSuperServer) GetCheckInHistory(context.Context, *GetCheckInHistoryReq) (*GetCheckInHistoryResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCheckInHistory not implemented")
+}
+func (UnimplementedSuperServer) GetExpLogs(context.Context, *GetExpLogsReq) (*GetExpLogsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExpLogs not implemented")
+}
+func (UnimplementedSuperServer) UploadPreKeyBundle(context.Context, *UploadPreKeyBundleReq) (*UploadPreKeyBundleResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadPreKeyBundle not implemented")
+}
+func (UnimplementedSuperServer) GetPreKeyBundles(context.Context, *GetPreKeyBundlesReq) (*GetPreKeyBundlesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreKeyBundles not implemented")
+}
+func (UnimplementedSuperServer) StoreEncryptedMessage(context.Context, *StoreEncryptedMessageReq) (*StoreEncryptedMessageResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreEncryptedMessage not implemented")
+}
+func (UnimplementedSuperServer) ListPendingEncryptedMessages(context.Context, *ListPendingEncryptedMessagesReq) (*ListPendingEncryptedMessagesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingEncryptedMessages not implemented")
+}
+func (UnimplementedSuperServer) AckEncryptedMessages(context.Context, *AckEncryptedMessagesReq) (*AckEncryptedMessagesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckEncryptedMessages not implemented")
+}
+func (UnimplementedSuperServer) mustEmbedUnimplementedSuperServer() {}
+func (UnimplementedSuperServer) testEmbeddedByValue()               {}
+
+// UnsafeSuperServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SuperServer will
+// result in compilation errors.
+type UnsafeSuperServer interface {
+	mustEmbedUnimplementedSuperServer()
+}
+
+func RegisterSuperServer(s grpc.ServiceRegistrar, srv SuperServer) {
+	// If the following call pancis, it indicates UnimplementedSuperServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Super_ServiceDesc, srv)
+}
+
+func _Super_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).Register(ctx, req.(*RegisterReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).Login(ctx, req.(*LoginReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetUserInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetUserInfo(ctx, req.(*GetUserInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetUser(ctx, req.(*GetUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetUserByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByEmailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetUserByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetUserByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetUserByEmail(ctx, req.(*GetUserByEmailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_UpdateUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).UpdateUserInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_UpdateUserInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).UpdateUserInfo(ctx, req.(*UpdateUserInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_UpdateUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).UpdateUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_UpdateUserPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).UpdateUserPassword(ctx, req.(*UpdateUserPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_RequestPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPasswordResetReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).RequestPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_RequestPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).RequestPasswordReset(ctx, req.(*RequestPasswordResetReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).ResetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_ResetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).ResetPassword(ctx, req.(*ResetPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetUserAuthState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserAuthStateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetUserAuthState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetUserAuthState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetUserAuthState(ctx, req.(*GetUserAuthStateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_RefreshSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).RefreshSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_RefreshSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).RefreshSession(ctx, req.(*RefreshSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_ListUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserSessionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).ListUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_ListUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).ListUserSessions(ctx, req.(*ListUserSessionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_RevokeUserSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeUserSessionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).RevokeUserSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_RevokeUserSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).RevokeUserSession(ctx, req.(*RevokeUserSessionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_RevokeAllUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAllUserSessionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).RevokeAllUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_RevokeAllUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).RevokeAllUserSessions(ctx, req.(*RevokeAllUserSessionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_IssueWsTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueWsTicketReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).IssueWsTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_IssueWsTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).IssueWsTicket(ctx, req.(*IssueWsTicketReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_RedeemWsTicket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemWsTicketReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).RedeemWsTicket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_RedeemWsTicket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).RedeemWsTicket(ctx, req.(*RedeemWsTicketReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_SendEmailVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendEmailVerificationReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).SendEmailVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_SendEmailVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).SendEmailVerification(ctx, req.(*SendEmailVerificationReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_VerifyEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyEmailReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).VerifyEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_VerifyEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).VerifyEmail(ctx, req.(*VerifyEmailReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_UpdateUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRoleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).UpdateUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_UpdateUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).UpdateUserRole(ctx, req.(*UpdateUserRoleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_ListRoleAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleAuditLogsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).ListRoleAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_ListRoleAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).ListRoleAuditLogs(ctx, req.(*ListRoleAuditLogsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_ListSecurityEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecurityEventsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).ListSecurityEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_ListSecurityEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).ListSecurityEvents(ctx, req.(*ListSecurityEventsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_VerifyLoginMfa_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyLoginMfaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).VerifyLoginMfa(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_VerifyLoginMfa_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).VerifyLoginMfa(ctx, req.(*VerifyLoginMfaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetMfaStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMfaStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetMfaStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetMfaStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetMfaStatus(ctx, req.(*GetMfaStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_BeginTotpEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeginTotpEnrollmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).BeginTotpEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_BeginTotpEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).BeginTotpEnrollment(ctx, req.(*BeginTotpEnrollmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_ConfirmTotpEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTotpEnrollmentReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).ConfirmTotpEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_ConfirmTotpEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).ConfirmTotpEnrollment(ctx, req.(*ConfirmTotpEnrollmentReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_DisableTotp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableTotpReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).DisableTotp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_DisableTotp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).DisableTotp(ctx, req.(*DisableTotpReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateRecoveryCodesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).RegenerateRecoveryCodes(ctx, req.(*RegenerateRecoveryCodesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_ListMfaPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMfaPoliciesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).ListMfaPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_ListMfaPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).ListMfaPolicies(ctx, req.(*ListMfaPoliciesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_SetMfaPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMfaPolicyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).SetMfaPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_SetMfaPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).SetMfaPolicy(ctx, req.(*SetMfaPolicyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).DeleteUser(ctx, req.(*DeleteUserReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountDeletionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetAccountDeletion(ctx, req.(*GetAccountDeletionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_CancelAccountDeletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAccountDeletionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).CancelAccountDeletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_CancelAccountDeletion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).CancelAccountDeletion(ctx, req.(*CancelAccountDeletionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_ExportUserData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportUserDataReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SuperServer).ExportUserData(m, &grpc.GenericServerStream[ExportUserDataReq, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Super_ExportUserDataServer = grpc.ServerStreamingServer[ExportChunk]
+
+func _Super_UpdateUserVip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserVipReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).UpdateUserVip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_UpdateUserVip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).UpdateUserVip(ctx, req.(*UpdateUserVipReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetUsers(ctx, req.(*GetUsersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetUserCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserCountReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetUserCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetUserCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetUserCount(ctx, req.(*GetUserCountReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_UpsertUserMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertUserMemoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).UpsertUserMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_UpsertUserMemory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).UpsertUserMemory(ctx, req.(*UpsertUserMemoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetUserMemories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserMemoriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetUserMemories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetUserMemories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetUserMemories(ctx, req.(*GetUserMemoriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_DeleteUserMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserMemoryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).DeleteUserMemory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_DeleteUserMemory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).DeleteUserMemory(ctx, req.(*DeleteUserMemoryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetVipPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVipPlansReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetVipPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetVipPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetVipPlans(ctx, req.(*GetVipPlansReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetVipPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVipPlanReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetVipPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetVipPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetVipPlan(ctx, req.(*GetVipPlanReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_CreateVipPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVipPlanReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).CreateVipPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_CreateVipPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).CreateVipPlan(ctx, req.(*CreateVipPlanReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_CreateVipOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVipOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).CreateVipOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_CreateVipOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).CreateVipOrder(ctx, req.(*CreateVipOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetVipOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVipOrdersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetVipOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetVipOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetVipOrders(ctx, req.(*GetVipOrdersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetVipRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVipRecordsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetVipRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetVipRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetVipRecords(ctx, req.(*GetVipRecordsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetUserActiveVipRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserActiveVipRecordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetUserActiveVipRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetUserActiveVipRecord_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetUserActiveVipRecord(ctx, req.(*GetUserActiveVipRecordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetUserVipStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserVipStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetUserVipStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetUserVipStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetUserVipStatus(ctx, req.(*GetUserVipStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_CheckUserVip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUserVipReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).CheckUserVip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_CheckUserVip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).CheckUserVip(ctx, req.(*CheckUserVipReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSuperServer) GetFriendRelation(context.Context, *GetFriendRelationReq) (*GetFriendRelationResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFriendRelation not implemented")
+
+func _Super_UpdateAutoRenew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAutoRenewReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).UpdateAutoRenew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_UpdateAutoRenew_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).UpdateAutoRenew(ctx, req.(*UpdateAutoRenewReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSuperServer) GetUserAvatar(context.Context, *GetUserAvatarReq) (*GetUserAvatarResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserAvatar not implemented")
+
+func _Super_SyncUserVipStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncUserVipStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).SyncUserVipStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_SyncUserVipStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).SyncUserVipStatus(ctx, req.(*SyncUserVipStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSuperServer) UpdateUserAvatar(context.Context, *UpdateUserAvatarReq) (*UpdateUserAvatarResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserAvatar not implemented")
+
+func _Super_GetPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetPosts(ctx, req.(*GetPostsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSuperServer) CheckIn(context.Context, *CheckInReq) (*CheckInResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckIn not implemented")
+
+func _Super_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetPost(ctx, req.(*GetPostReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSuperServer) GetUserLevel(context.Context, *GetUserLevelReq) (*GetUserLevelResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserLevel not implemented")
+
+func _Super_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).CreatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_CreatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).CreatePost(ctx, req.(*CreatePostReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSuperServer) GetCheckInStatus(context.Context, *GetCheckInStatusReq) (*GetCheckInStatusResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCheckInStatus not implemented")
+
+func _Super_UpdatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).UpdatePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_UpdatePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).UpdatePost(ctx, req.(*UpdatePostReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSuperServer) GetCheckInHistory(context.Context, *GetCheckInHistoryReq) (*GetCheckInHistoryResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCheckInHistory not implemented")
+
+func _Super_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).DeletePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_DeletePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).DeletePost(ctx, req.(*DeletePostReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSuperServer) GetExpLogs(context.Context, *GetExpLogsReq) (*GetExpLogsResp, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExpLogs not implemented")
+
+func _Super_ReportPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportPostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).ReportPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_ReportPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).ReportPost(ctx, req.(*ReportPostReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedSuperServer) mustEmbedUnimplementedSuperServer() {}
-func (UnimplementedSuperServer) testEmbeddedByValue()               {}
 
-// UnsafeSuperServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to SuperServer will
-// result in compilation errors.
-type UnsafeSuperServer interface {
-	mustEmbedUnimplementedSuperServer()
+func _Super_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikePostReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).LikePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_LikePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).LikePost(ctx, req.(*LikePostReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterSuperServer(s grpc.ServiceRegistrar, srv SuperServer) {
-	// If the following call pancis, it indicates UnimplementedSuperServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _Super_GetPostComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPostCommentsReq)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&Super_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(SuperServer).GetPostComments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetPostComments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetPostComments(ctx, req.(*GetPostCommentsReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterReq)
+func _Super_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommentReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).Register(ctx, in)
+		return srv.(SuperServer).CreateComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_Register_FullMethodName,
+		FullMethod: Super_CreateComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).Register(ctx, req.(*RegisterReq))
+		return srv.(SuperServer).CreateComment(ctx, req.(*CreateCommentReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginReq)
+func _Super_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LikeCommentReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).Login(ctx, in)
+		return srv.(SuperServer).LikeComment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_Login_FullMethodName,
+		FullMethod: Super_LikeComment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).Login(ctx, req.(*LoginReq))
+		return srv.(SuperServer).LikeComment(ctx, req.(*LikeCommentReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserInfoReq)
+func _Super_GetNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetUserInfo(ctx, in)
+		return srv.(SuperServer).GetNotifications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetUserInfo_FullMethodName,
+		FullMethod: Super_GetNotifications_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetUserInfo(ctx, req.(*GetUserInfoReq))
+		return srv.(SuperServer).GetNotifications(ctx, req.(*GetNotificationsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserReq)
+func _Super_GetUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadCountReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetUser(ctx, in)
+		return srv.(SuperServer).GetUnreadCount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetUser_FullMethodName,
+		FullMethod: Super_GetUnreadCount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetUser(ctx, req.(*GetUserReq))
+		return srv.(SuperServer).GetUnreadCount(ctx, req.(*GetUnreadCountReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetUserByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserByEmailReq)
+func _Super_ReadNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadNotificationReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetUserByEmail(ctx, in)
+		return srv.(SuperServer).ReadNotification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetUserByEmail_FullMethodName,
+		FullMethod: Super_ReadNotification_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetUserByEmail(ctx, req.(*GetUserByEmailReq))
+		return srv.(SuperServer).ReadNotification(ctx, req.(*ReadNotificationReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_UpdateUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserInfoReq)
+func _Super_ReadAllNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadAllNotificationsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).UpdateUserInfo(ctx, in)
+		return srv.(SuperServer).ReadAllNotifications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_UpdateUserInfo_FullMethodName,
+		FullMethod: Super_ReadAllNotifications_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).UpdateUserInfo(ctx, req.(*UpdateUserInfoReq))
+		return srv.(SuperServer).ReadAllNotifications(ctx, req.(*ReadAllNotificationsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_UpdateUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserPasswordReq)
+func _Super_CreateNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotificationReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).UpdateUserPassword(ctx, in)
+		return srv.(SuperServer).CreateNotification(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_UpdateUserPassword_FullMethodName,
+		FullMethod: Super_CreateNotification_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).UpdateUserPassword(ctx, req.(*UpdateUserPasswordReq))
+		return srv.(SuperServer).CreateNotification(ctx, req.(*CreateNotificationReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_ResetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetPasswordReq)
+func _Super_WatchNotifications_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchNotificationsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SuperServer).WatchNotifications(m, &grpc.GenericServerStream[WatchNotificationsReq, NotificationEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Super_WatchNotificationsServer = grpc.ServerStreamingServer[NotificationEvent]
+
+func _Super_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).ResetPassword(ctx, in)
+		return srv.(SuperServer).GetNotificationPreferences(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_ResetPassword_FullMethodName,
+		FullMethod: Super_GetNotificationPreferences_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).ResetPassword(ctx, req.(*ResetPasswordReq))
+		return srv.(SuperServer).GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserReq)
+func _Super_UpdateNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferencesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).DeleteUser(ctx, in)
+		return srv.(SuperServer).UpdateNotificationPreferences(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_DeleteUser_FullMethodName,
+		FullMethod: Super_UpdateNotificationPreferences_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).DeleteUser(ctx, req.(*DeleteUserReq))
+		return srv.(SuperServer).UpdateNotificationPreferences(ctx, req.(*UpdateNotificationPreferencesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_UpdateUserVip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserVipReq)
+func _Super_SetNotificationMute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotificationMuteReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).UpdateUserVip(ctx, in)
+		return srv.(SuperServer).SetNotificationMute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_UpdateUserVip_FullMethodName,
+		FullMethod: Super_SetNotificationMute_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).UpdateUserVip(ctx, req.(*UpdateUserVipReq))
+		return srv.(SuperServer).SetNotificationMute(ctx, req.(*SetNotificationMuteReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUsersReq)
+func _Super_CreateNotificationCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNotificationCampaignReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetUsers(ctx, in)
+		return srv.(SuperServer).CreateNotificationCampaign(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetUsers_FullMethodName,
+		FullMethod: Super_CreateNotificationCampaign_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetUsers(ctx, req.(*GetUsersReq))
+		return srv.(SuperServer).CreateNotificationCampaign(ctx, req.(*CreateNotificationCampaignReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetUserCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserCountReq)
+func _Super_ListNotificationCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationCampaignsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetUserCount(ctx, in)
+		return srv.(SuperServer).ListNotificationCampaigns(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetUserCount_FullMethodName,
+		FullMethod: Super_ListNotificationCampaigns_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetUserCount(ctx, req.(*GetUserCountReq))
+		return srv.(SuperServer).ListNotificationCampaigns(ctx, req.(*ListNotificationCampaignsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_UpsertUserMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpsertUserMemoryReq)
+func _Super_GetNotificationCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationCampaignReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).UpsertUserMemory(ctx, in)
+		return srv.(SuperServer).GetNotificationCampaign(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_UpsertUserMemory_FullMethodName,
+		FullMethod: Super_GetNotificationCampaign_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).UpsertUserMemory(ctx, req.(*UpsertUserMemoryReq))
+		return srv.(SuperServer).GetNotificationCampaign(ctx, req.(*GetNotificationCampaignReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetUserMemories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserMemoriesReq)
+func _Super_CancelNotificationCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationCampaignReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetUserMemories(ctx, in)
+		return srv.(SuperServer).CancelNotificationCampaign(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetUserMemories_FullMethodName,
+		FullMethod: Super_CancelNotificationCampaign_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetUserMemories(ctx, req.(*GetUserMemoriesReq))
+		return srv.(SuperServer).CancelNotificationCampaign(ctx, req.(*GetNotificationCampaignReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_DeleteUserMemory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserMemoryReq)
+func _Super_RegisterDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterDeviceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).DeleteUserMemory(ctx, in)
+		return srv.(SuperServer).RegisterDevice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_DeleteUserMemory_FullMethodName,
+		FullMethod: Super_RegisterDevice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).DeleteUserMemory(ctx, req.(*DeleteUserMemoryReq))
+		return srv.(SuperServer).RegisterDevice(ctx, req.(*RegisterDeviceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetVipPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVipPlansReq)
+func _Super_UnregisterDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterDeviceReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetVipPlans(ctx, in)
+		return srv.(SuperServer).UnregisterDevice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetVipPlans_FullMethodName,
+		FullMethod: Super_UnregisterDevice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetVipPlans(ctx, req.(*GetVipPlansReq))
+		return srv.(SuperServer).UnregisterDevice(ctx, req.(*UnregisterDeviceReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetVipPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVipPlanReq)
+func _Super_SendDevicePush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendDevicePushReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetVipPlan(ctx, in)
+		return srv.(SuperServer).SendDevicePush(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetVipPlan_FullMethodName,
+		FullMethod: Super_SendDevicePush_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetVipPlan(ctx, req.(*GetVipPlanReq))
+		return srv.(SuperServer).SendDevicePush(ctx, req.(*SendDevicePushReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_CreateVipPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateVipPlanReq)
+func _Super_Recharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RechargeReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).CreateVipPlan(ctx, in)
+		return srv.(SuperServer).Recharge(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_CreateVipPlan_FullMethodName,
+		FullMethod: Super_Recharge_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).CreateVipPlan(ctx, req.(*CreateVipPlanReq))
+		return srv.(SuperServer).Recharge(ctx, req.(*RechargeReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_CreateVipOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateVipOrderReq)
+func _Super_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).CreateVipOrder(ctx, in)
+		return srv.(SuperServer).GetTransactions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_CreateVipOrder_FullMethodName,
+		FullMethod: Super_GetTransactions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).CreateVipOrder(ctx, req.(*CreateVipOrderReq))
+		return srv.(SuperServer).GetTransactions(ctx, req.(*GetTransactionsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetVipOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVipOrdersReq)
+func _Super_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetVipOrders(ctx, in)
+		return srv.(SuperServer).GetTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetVipOrders_FullMethodName,
+		FullMethod: Super_GetTransaction_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetVipOrders(ctx, req.(*GetVipOrdersReq))
+		return srv.(SuperServer).GetTransaction(ctx, req.(*GetTransactionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetVipRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVipRecordsReq)
+func _Super_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowUserReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetVipRecords(ctx, in)
+		return srv.(SuperServer).FollowUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetVipRecords_FullMethodName,
+		FullMethod: Super_FollowUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetVipRecords(ctx, req.(*GetVipRecordsReq))
+		return srv.(SuperServer).FollowUser(ctx, req.(*FollowUserReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetUserActiveVipRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserActiveVipRecordReq)
+func _Super_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfollowUserReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetUserActiveVipRecord(ctx, in)
+		return srv.(SuperServer).UnfollowUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetUserActiveVipRecord_FullMethodName,
+		FullMethod: Super_UnfollowUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetUserActiveVipRecord(ctx, req.(*GetUserActiveVipRecordReq))
+		return srv.(SuperServer).UnfollowUser(ctx, req.(*UnfollowUserReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetUserVipStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserVipStatusReq)
+func _Super_GetFollowings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowingsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetUserVipStatus(ctx, in)
+		return srv.(SuperServer).GetFollowings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetUserVipStatus_FullMethodName,
+		FullMethod: Super_GetFollowings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetUserVipStatus(ctx, req.(*GetUserVipStatusReq))
+		return srv.(SuperServer).GetFollowings(ctx, req.(*GetFollowingsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_CheckUserVip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckUserVipReq)
+func _Super_GetFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFollowersReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).CheckUserVip(ctx, in)
+		return srv.(SuperServer).GetFollowers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_CheckUserVip_FullMethodName,
+		FullMethod: Super_GetFollowers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).CheckUserVip(ctx, req.(*CheckUserVipReq))
+		return srv.(SuperServer).GetFollowers(ctx, req.(*GetFollowersReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_UpdateAutoRenew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAutoRenewReq)
+func _Super_CheckFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckFollowReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).UpdateAutoRenew(ctx, in)
+		return srv.(SuperServer).CheckFollow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_UpdateAutoRenew_FullMethodName,
+		FullMethod: Super_CheckFollow_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).UpdateAutoRenew(ctx, req.(*UpdateAutoRenewReq))
+		return srv.(SuperServer).CheckFollow(ctx, req.(*CheckFollowReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_SyncUserVipStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SyncUserVipStatusReq)
+func _Super_ListFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowRequestsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).SyncUserVipStatus(ctx, in)
+		return srv.(SuperServer).ListFollowRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_SyncUserVipStatus_FullMethodName,
+		FullMethod: Super_ListFollowRequests_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).SyncUserVipStatus(ctx, req.(*SyncUserVipStatusReq))
+		return srv.(SuperServer).ListFollowRequests(ctx, req.(*ListFollowRequestsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostsReq)
+func _Super_RespondFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondFollowRequestReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetPosts(ctx, in)
+		return srv.(SuperServer).RespondFollowRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetPosts_FullMethodName,
+		FullMethod: Super_RespondFollowRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetPosts(ctx, req.(*GetPostsReq))
+		return srv.(SuperServer).RespondFollowRequest(ctx, req.(*RespondFollowRequestReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostReq)
+func _Super_GetPrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPrivacySettingsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetPost(ctx, in)
+		return srv.(SuperServer).GetPrivacySettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetPost_FullMethodName,
+		FullMethod: Super_GetPrivacySettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetPost(ctx, req.(*GetPostReq))
+		return srv.(SuperServer).GetPrivacySettings(ctx, req.(*GetPrivacySettingsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_CreatePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreatePostReq)
+func _Super_UpdatePrivacySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePrivacySettingsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).CreatePost(ctx, in)
+		return srv.(SuperServer).UpdatePrivacySettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_CreatePost_FullMethodName,
+		FullMethod: Super_UpdatePrivacySettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).CreatePost(ctx, req.(*CreatePostReq))
+		return srv.(SuperServer).UpdatePrivacySettings(ctx, req.(*UpdatePrivacySettingsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_ReportPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReportPostReq)
+func _Super_CheckDirectMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckDirectMessageReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).ReportPost(ctx, in)
+		return srv.(SuperServer).CheckDirectMessage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_ReportPost_FullMethodName,
+		FullMethod: Super_CheckDirectMessage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).ReportPost(ctx, req.(*ReportPostReq))
+		return srv.(SuperServer).CheckDirectMessage(ctx, req.(*CheckDirectMessageReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_LikePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LikePostReq)
+func _Super_SendFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendFriendRequestReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).LikePost(ctx, in)
+		return srv.(SuperServer).SendFriendRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_LikePost_FullMethodName,
+		FullMethod: Super_SendFriendRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).LikePost(ctx, req.(*LikePostReq))
+		return srv.(SuperServer).SendFriendRequest(ctx, req.(*SendFriendRequestReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetPostComments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPostCommentsReq)
+func _Super_ListIncomingFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIncomingFriendRequestsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetPostComments(ctx, in)
+		return srv.(SuperServer).ListIncomingFriendRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetPostComments_FullMethodName,
+		FullMethod: Super_ListIncomingFriendRequests_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetPostComments(ctx, req.(*GetPostCommentsReq))
+		return srv.(SuperServer).ListIncomingFriendRequests(ctx, req.(*ListIncomingFriendRequestsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCommentReq)
+func _Super_ListOutgoingFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutgoingFriendRequestsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).CreateComment(ctx, in)
+		return srv.(SuperServer).ListOutgoingFriendRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_CreateComment_FullMethodName,
+		FullMethod: Super_ListOutgoingFriendRequests_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).CreateComment(ctx, req.(*CreateCommentReq))
+		return srv.(SuperServer).ListOutgoingFriendRequests(ctx, req.(*ListOutgoingFriendRequestsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_LikeComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LikeCommentReq)
+func _Super_AcceptFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptFriendRequestReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).LikeComment(ctx, in)
+		return srv.(SuperServer).AcceptFriendRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_LikeComment_FullMethodName,
+		FullMethod: Super_AcceptFriendRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).LikeComment(ctx, req.(*LikeCommentReq))
+		return srv.(SuperServer).AcceptFriendRequest(ctx, req.(*AcceptFriendRequestReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNotificationsReq)
+func _Super_RejectFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFriendRequestReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetNotifications(ctx, in)
+		return srv.(SuperServer).RejectFriendRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetNotifications_FullMethodName,
+		FullMethod: Super_RejectFriendRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetNotifications(ctx, req.(*GetNotificationsReq))
+		return srv.(SuperServer).RejectFriendRequest(ctx, req.(*RejectFriendRequestReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetUnreadCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUnreadCountReq)
+func _Super_ListFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFriendsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetUnreadCount(ctx, in)
+		return srv.(SuperServer).ListFriends(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetUnreadCount_FullMethodName,
+		FullMethod: Super_ListFriends_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetUnreadCount(ctx, req.(*GetUnreadCountReq))
+		return srv.(SuperServer).ListFriends(ctx, req.(*ListFriendsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_ReadNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadNotificationReq)
+func _Super_GetFriendRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFriendRelationReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).ReadNotification(ctx, in)
+		return srv.(SuperServer).GetFriendRelation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_ReadNotification_FullMethodName,
+		FullMethod: Super_GetFriendRelation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).ReadNotification(ctx, req.(*ReadNotificationReq))
+		return srv.(SuperServer).GetFriendRelation(ctx, req.(*GetFriendRelationReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_ReadAllNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReadAllNotificationsReq)
+func _Super_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).ReadAllNotifications(ctx, in)
+		return srv.(SuperServer).BlockUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_ReadAllNotifications_FullMethodName,
+		FullMethod: Super_BlockUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).ReadAllNotifications(ctx, req.(*ReadAllNotificationsReq))
+		return srv.(SuperServer).BlockUser(ctx, req.(*BlockUserReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_CreateNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateNotificationReq)
+func _Super_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).CreateNotification(ctx, in)
+		return srv.(SuperServer).UnblockUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_CreateNotification_FullMethodName,
+		FullMethod: Super_UnblockUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).CreateNotification(ctx, req.(*CreateNotificationReq))
+		return srv.(SuperServer).UnblockUser(ctx, req.(*BlockUserReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_Recharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RechargeReq)
+func _Super_ListBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedUsersReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).Recharge(ctx, in)
+		return srv.(SuperServer).ListBlockedUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_Recharge_FullMethodName,
+		FullMethod: Super_ListBlockedUsers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).Recharge(ctx, req.(*RechargeReq))
+		return srv.(SuperServer).ListBlockedUsers(ctx, req.(*ListBlockedUsersReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionsReq)
+func _Super_CheckUserBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckUserBlockReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetTransactions(ctx, in)
+		return srv.(SuperServer).CheckUserBlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetTransactions_FullMethodName,
+		FullMethod: Super_CheckUserBlock_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetTransactions(ctx, req.(*GetTransactionsReq))
+		return srv.(SuperServer).CheckUserBlock(ctx, req.(*CheckUserBlockReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionReq)
+func _Super_GetBlockedUserIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockedUserIdsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetTransaction(ctx, in)
+		return srv.(SuperServer).GetBlockedUserIds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetTransaction_FullMethodName,
+		FullMethod: Super_GetBlockedUserIds_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetTransaction(ctx, req.(*GetTransactionReq))
+		return srv.(SuperServer).GetBlockedUserIds(ctx, req.(*GetBlockedUserIdsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FollowUserReq)
+func _Super_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).FollowUser(ctx, in)
+		return srv.(SuperServer).SuspendUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_FollowUser_FullMethodName,
+		FullMethod: Super_SuspendUser_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).FollowUser(ctx, req.(*FollowUserReq))
+		return srv.(SuperServer).SuspendUser(ctx, req.(*SuspendUserReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UnfollowUserReq)
+func _Super_LiftSuspension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LiftSuspensionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).UnfollowUser(ctx, in)
+		return srv.(SuperServer).LiftSuspension(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_UnfollowUser_FullMethodName,
+		FullMethod: Super_LiftSuspension_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).UnfollowUser(ctx, req.(*UnfollowUserReq))
+		return srv.(SuperServer).LiftSuspension(ctx, req.(*LiftSuspensionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetFollowings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowingsReq)
+func _Super_GetUserSuspension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserSuspensionReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetFollowings(ctx, in)
+		return srv.(SuperServer).GetUserSuspension(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetFollowings_FullMethodName,
+		FullMethod: Super_GetUserSuspension_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetFollowings(ctx, req.(*GetFollowingsReq))
+		return srv.(SuperServer).GetUserSuspension(ctx, req.(*GetUserSuspensionReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFollowersReq)
+func _Super_SubmitSuspensionAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSuspensionAppealReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetFollowers(ctx, in)
+		return srv.(SuperServer).SubmitSuspensionAppeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetFollowers_FullMethodName,
+		FullMethod: Super_SubmitSuspensionAppeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetFollowers(ctx, req.(*GetFollowersReq))
+		return srv.(SuperServer).SubmitSuspensionAppeal(ctx, req.(*SubmitSuspensionAppealReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_CheckFollow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckFollowReq)
+func _Super_ListSuspensionAppeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuspensionAppealsReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).CheckFollow(ctx, in)
+		return srv.(SuperServer).ListSuspensionAppeals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_CheckFollow_FullMethodName,
+		FullMethod: Super_ListSuspensionAppeals_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).CheckFollow(ctx, req.(*CheckFollowReq))
+		return srv.(SuperServer).ListSuspensionAppeals(ctx, req.(*ListSuspensionAppealsReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_SendFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendFriendRequestReq)
+func _Super_ReviewSuspensionAppeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewSuspensionAppealReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).SendFriendRequest(ctx, in)
+		return srv.(SuperServer).ReviewSuspensionAppeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_SendFriendRequest_FullMethodName,
+		FullMethod: Super_ReviewSuspensionAppeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).SendFriendRequest(ctx, req.(*SendFriendRequestReq))
+		return srv.(SuperServer).ReviewSuspensionAppeal(ctx, req.(*ReviewSuspensionAppealReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_ListIncomingFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListIncomingFriendRequestsReq)
+func _Super_ListOidcProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOidcProvidersReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).ListIncomingFriendRequests(ctx, in)
+		return srv.(SuperServer).ListOidcProviders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_ListIncomingFriendRequests_FullMethodName,
+		FullMethod: Super_ListOidcProviders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).ListIncomingFriendRequests(ctx, req.(*ListIncomingFriendRequestsReq))
+		return srv.(SuperServer).ListOidcProviders(ctx, req.(*ListOidcProvidersReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_ListOutgoingFriendRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListOutgoingFriendRequestsReq)
+func _Super_StartOidcAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartOidcAuthReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).ListOutgoingFriendRequests(ctx, in)
+		return srv.(SuperServer).StartOidcAuth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_ListOutgoingFriendRequests_FullMethodName,
+		FullMethod: Super_StartOidcAuth_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).ListOutgoingFriendRequests(ctx, req.(*ListOutgoingFriendRequestsReq))
+		return srv.(SuperServer).StartOidcAuth(ctx, req.(*StartOidcAuthReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_AcceptFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AcceptFriendRequestReq)
+func _Super_OidcLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OidcLoginReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).AcceptFriendRequest(ctx, in)
+		return srv.(SuperServer).OidcLogin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_AcceptFriendRequest_FullMethodName,
+		FullMethod: Super_OidcLogin_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).AcceptFriendRequest(ctx, req.(*AcceptFriendRequestReq))
+		return srv.(SuperServer).OidcLogin(ctx, req.(*OidcLoginReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_RejectFriendRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RejectFriendRequestReq)
+func _Super_LinkUserIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkUserIdentityReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).RejectFriendRequest(ctx, in)
+		return srv.(SuperServer).LinkUserIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_RejectFriendRequest_FullMethodName,
+		FullMethod: Super_LinkUserIdentity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).RejectFriendRequest(ctx, req.(*RejectFriendRequestReq))
+		return srv.(SuperServer).LinkUserIdentity(ctx, req.(*LinkUserIdentityReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_ListFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListFriendsReq)
+func _Super_ListUserIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserIdentitiesReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).ListFriends(ctx, in)
+		return srv.(SuperServer).ListUserIdentities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_ListFriends_FullMethodName,
+		FullMethod: Super_ListUserIdentities_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).ListFriends(ctx, req.(*ListFriendsReq))
+		return srv.(SuperServer).ListUserIdentities(ctx, req.(*ListUserIdentitiesReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Super_GetFriendRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetFriendRelationReq)
+func _Super_UnlinkUserIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkUserIdentityReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SuperServer).GetFriendRelation(ctx, in)
+		return srv.(SuperServer).UnlinkUserIdentity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Super_GetFriendRelation_FullMethodName,
+		FullMethod: Super_UnlinkUserIdentity_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SuperServer).GetFriendRelation(ctx, req.(*GetFriendRelationReq))
+		return srv.(SuperServer).UnlinkUserIdentity(ctx, req.(*UnlinkUserIdentityReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2174,6 +4274,96 @@ func _Super_GetExpLogs_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Super_UploadPreKeyBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadPreKeyBundleReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).UploadPreKeyBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_UploadPreKeyBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).UploadPreKeyBundle(ctx, req.(*UploadPreKeyBundleReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_GetPreKeyBundles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreKeyBundlesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).GetPreKeyBundles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_GetPreKeyBundles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).GetPreKeyBundles(ctx, req.(*GetPreKeyBundlesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_StoreEncryptedMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreEncryptedMessageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).StoreEncryptedMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_StoreEncryptedMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).StoreEncryptedMessage(ctx, req.(*StoreEncryptedMessageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_ListPendingEncryptedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingEncryptedMessagesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).ListPendingEncryptedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_ListPendingEncryptedMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).ListPendingEncryptedMessages(ctx, req.(*ListPendingEncryptedMessagesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Super_AckEncryptedMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckEncryptedMessagesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SuperServer).AckEncryptedMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Super_AckEncryptedMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SuperServer).AckEncryptedMessages(ctx, req.(*AckEncryptedMessagesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Super_ServiceDesc is the grpc.ServiceDesc for Super service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -2209,14 +4399,106 @@ var Super_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUserPassword",
 			Handler:    _Super_UpdateUserPassword_Handler,
 		},
+		{
+			MethodName: "RequestPasswordReset",
+			Handler:    _Super_RequestPasswordReset_Handler,
+		},
 		{
 			MethodName: "ResetPassword",
 			Handler:    _Super_ResetPassword_Handler,
 		},
+		{
+			MethodName: "GetUserAuthState",
+			Handler:    _Super_GetUserAuthState_Handler,
+		},
+		{
+			MethodName: "RefreshSession",
+			Handler:    _Super_RefreshSession_Handler,
+		},
+		{
+			MethodName: "ListUserSessions",
+			Handler:    _Super_ListUserSessions_Handler,
+		},
+		{
+			MethodName: "RevokeUserSession",
+			Handler:    _Super_RevokeUserSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllUserSessions",
+			Handler:    _Super_RevokeAllUserSessions_Handler,
+		},
+		{
+			MethodName: "IssueWsTicket",
+			Handler:    _Super_IssueWsTicket_Handler,
+		},
+		{
+			MethodName: "RedeemWsTicket",
+			Handler:    _Super_RedeemWsTicket_Handler,
+		},
+		{
+			MethodName: "SendEmailVerification",
+			Handler:    _Super_SendEmailVerification_Handler,
+		},
+		{
+			MethodName: "VerifyEmail",
+			Handler:    _Super_VerifyEmail_Handler,
+		},
+		{
+			MethodName: "UpdateUserRole",
+			Handler:    _Super_UpdateUserRole_Handler,
+		},
+		{
+			MethodName: "ListRoleAuditLogs",
+			Handler:    _Super_ListRoleAuditLogs_Handler,
+		},
+		{
+			MethodName: "ListSecurityEvents",
+			Handler:    _Super_ListSecurityEvents_Handler,
+		},
+		{
+			MethodName: "VerifyLoginMfa",
+			Handler:    _Super_VerifyLoginMfa_Handler,
+		},
+		{
+			MethodName: "GetMfaStatus",
+			Handler:    _Super_GetMfaStatus_Handler,
+		},
+		{
+			MethodName: "BeginTotpEnrollment",
+			Handler:    _Super_BeginTotpEnrollment_Handler,
+		},
+		{
+			MethodName: "ConfirmTotpEnrollment",
+			Handler:    _Super_ConfirmTotpEnrollment_Handler,
+		},
+		{
+			MethodName: "DisableTotp",
+			Handler:    _Super_DisableTotp_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _Super_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "ListMfaPolicies",
+			Handler:    _Super_ListMfaPolicies_Handler,
+		},
+		{
+			MethodName: "SetMfaPolicy",
+			Handler:    _Super_SetMfaPolicy_Handler,
+		},
 		{
 			MethodName: "DeleteUser",
 			Handler:    _Super_DeleteUser_Handler,
 		},
+		{
+			MethodName: "GetAccountDeletion",
+			Handler:    _Super_GetAccountDeletion_Handler,
+		},
+		{
+			MethodName: "CancelAccountDeletion",
+			Handler:    _Super_CancelAccountDeletion_Handler,
+		},
 		{
 			MethodName: "UpdateUserVip",
 			Handler:    _Super_UpdateUserVip_Handler,
@@ -2297,6 +4579,14 @@ var Super_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreatePost",
 			Handler:    _Super_CreatePost_Handler,
 		},
+		{
+			MethodName: "UpdatePost",
+			Handler:    _Super_UpdatePost_Handler,
+		},
+		{
+			MethodName: "DeletePost",
+			Handler:    _Super_DeletePost_Handler,
+		},
 		{
 			MethodName: "ReportPost",
 			Handler:    _Super_ReportPost_Handler,
@@ -2337,6 +4627,46 @@ var Super_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateNotification",
 			Handler:    _Super_CreateNotification_Handler,
 		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _Super_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreferences",
+			Handler:    _Super_UpdateNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "SetNotificationMute",
+			Handler:    _Super_SetNotificationMute_Handler,
+		},
+		{
+			MethodName: "CreateNotificationCampaign",
+			Handler:    _Super_CreateNotificationCampaign_Handler,
+		},
+		{
+			MethodName: "ListNotificationCampaigns",
+			Handler:    _Super_ListNotificationCampaigns_Handler,
+		},
+		{
+			MethodName: "GetNotificationCampaign",
+			Handler:    _Super_GetNotificationCampaign_Handler,
+		},
+		{
+			MethodName: "CancelNotificationCampaign",
+			Handler:    _Super_CancelNotificationCampaign_Handler,
+		},
+		{
+			MethodName: "RegisterDevice",
+			Handler:    _Super_RegisterDevice_Handler,
+		},
+		{
+			MethodName: "UnregisterDevice",
+			Handler:    _Super_UnregisterDevice_Handler,
+		},
+		{
+			MethodName: "SendDevicePush",
+			Handler:    _Super_SendDevicePush_Handler,
+		},
 		{
 			MethodName: "Recharge",
 			Handler:    _Super_Recharge_Handler,
@@ -2369,6 +4699,26 @@ var Super_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckFollow",
 			Handler:    _Super_CheckFollow_Handler,
 		},
+		{
+			MethodName: "ListFollowRequests",
+			Handler:    _Super_ListFollowRequests_Handler,
+		},
+		{
+			MethodName: "RespondFollowRequest",
+			Handler:    _Super_RespondFollowRequest_Handler,
+		},
+		{
+			MethodName: "GetPrivacySettings",
+			Handler:    _Super_GetPrivacySettings_Handler,
+		},
+		{
+			MethodName: "UpdatePrivacySettings",
+			Handler:    _Super_UpdatePrivacySettings_Handler,
+		},
+		{
+			MethodName: "CheckDirectMessage",
+			Handler:    _Super_CheckDirectMessage_Handler,
+		},
 		{
 			MethodName: "SendFriendRequest",
 			Handler:    _Super_SendFriendRequest_Handler,
@@ -2397,6 +4747,74 @@ var Super_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFriendRelation",
 			Handler:    _Super_GetFriendRelation_Handler,
 		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _Super_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _Super_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListBlockedUsers",
+			Handler:    _Super_ListBlockedUsers_Handler,
+		},
+		{
+			MethodName: "CheckUserBlock",
+			Handler:    _Super_CheckUserBlock_Handler,
+		},
+		{
+			MethodName: "GetBlockedUserIds",
+			Handler:    _Super_GetBlockedUserIds_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _Super_SuspendUser_Handler,
+		},
+		{
+			MethodName: "LiftSuspension",
+			Handler:    _Super_LiftSuspension_Handler,
+		},
+		{
+			MethodName: "GetUserSuspension",
+			Handler:    _Super_GetUserSuspension_Handler,
+		},
+		{
+			MethodName: "SubmitSuspensionAppeal",
+			Handler:    _Super_SubmitSuspensionAppeal_Handler,
+		},
+		{
+			MethodName: "ListSuspensionAppeals",
+			Handler:    _Super_ListSuspensionAppeals_Handler,
+		},
+		{
+			MethodName: "ReviewSuspensionAppeal",
+			Handler:    _Super_ReviewSuspensionAppeal_Handler,
+		},
+		{
+			MethodName: "ListOidcProviders",
+			Handler:    _Super_ListOidcProviders_Handler,
+		},
+		{
+			MethodName: "StartOidcAuth",
+			Handler:    _Super_StartOidcAuth_Handler,
+		},
+		{
+			MethodName: "OidcLogin",
+			Handler:    _Super_OidcLogin_Handler,
+		},
+		{
+			MethodName: "LinkUserIdentity",
+			Handler:    _Super_LinkUserIdentity_Handler,
+		},
+		{
+			MethodName: "ListUserIdentities",
+			Handler:    _Super_ListUserIdentities_Handler,
+		},
+		{
+			MethodName: "UnlinkUserIdentity",
+			Handler:    _Super_UnlinkUserIdentity_Handler,
+		},
 		{
 			MethodName: "GetUserAvatar",
 			Handler:    _Super_GetUserAvatar_Handler,
@@ -2425,7 +4843,38 @@ var Super_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetExpLogs",
 			Handler:    _Super_GetExpLogs_Handler,
 		},
+		{
+			MethodName: "UploadPreKeyBundle",
+			Handler:    _Super_UploadPreKeyBundle_Handler,
+		},
+		{
+			MethodName: "GetPreKeyBundles",
+			Handler:    _Super_GetPreKeyBundles_Handler,
+		},
+		{
+			MethodName: "StoreEncryptedMessage",
+			Handler:    _Super_StoreEncryptedMessage_Handler,
+		},
+		{
+			MethodName: "ListPendingEncryptedMessages",
+			Handler:    _Super_ListPendingEncryptedMessages_Handler,
+		},
+		{
+			MethodName: "AckEncryptedMessages",
+			Handler:    _Super_AckEncryptedMessages_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportUserData",
+			Handler:       _Super_ExportUserData_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchNotifications",
+			Handler:       _Super_WatchNotifications_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "super.proto",
 }
@@ -24,6 +24,9 @@ func main() {
 	var c config.Config
 	conf.MustLoad(*configFile, &c)
 	ctx := svc.NewServiceContext(c)
+	ctx.Campaigns.Start()
+	ctx.Mail.Start()
+	ctx.Deletions.Start()
 
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		super.RegisterSuperServer(grpcServer, server.NewSuperServer(ctx))
@@ -33,6 +36,8 @@ func main() {
 		}
 	})
 	defer s.Stop()
+	// 端到端加密密文不进慢调用日志
+	zrpc.DontLogContentForMethod(super.Super_StoreEncryptedMessage_FullMethodName)
 
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
 	s.Start()
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"backend/model"
+
+	"gorm.io/gorm"
+)
+
+// Blocked 两个用户之间是否存在拉黑关系（任一方拉黑对方）
+func Blocked(db *gorm.DB, a, b uint) (bool, error) {
+	if a == 0 || b == 0 || a == b {
+		return false, nil
+	}
+	var n int64
+	err := db.Model(&model.UserBlock{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", a, b, b, a).
+		Count(&n).Error
+	return n > 0, err
+}
+
+// BlockedUserIDs 与 userID 存在拉黑关系的全部用户（拉黑了对方或被对方拉黑）
+func BlockedUserIDs(db *gorm.DB, userID uint) ([]uint, error) {
+	var blocks []model.UserBlock
+	if err := db.Where("blocker_id = ? OR blocked_id = ?", userID, userID).Find(&blocks).Error; err != nil {
+		return nil, err
+	}
+	ids := make([]uint, 0, len(blocks))
+	for _, b := range blocks {
+		if b.BlockerID == userID {
+			ids = append(ids, b.BlockedID)
+		} else {
+			ids = append(ids, b.BlockerID)
+		}
+	}
+	return ids, nil
+}
+
+// ExcludeBlockedScope 列表查询中排除与 viewerID 存在拉黑关系的用户所发的内容，column 为作者 ID 列；未登录时不过滤
+func ExcludeBlockedScope(viewerID uint, column string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		if viewerID == 0 {
+			return db
+		}
+		blocked := db.Session(&gorm.Session{NewDB: true}).Model(&model.UserBlock{}).
+			Select("blocked_id").Where("blocker_id = ?", viewerID)
+		blockedBy := db.Session(&gorm.Session{NewDB: true}).Model(&model.UserBlock{}).
+			Select("blocker_id").Where("blocked_id = ?", viewerID)
+		return db.Where(column+" NOT IN (?) AND "+column+" NOT IN (?)", blocked, blockedBy)
+	}
+}
@@ -108,20 +108,24 @@ func autoMigrate() error {
 		&model.VipPlan{},
 		&model.VipOrder{}, // 合并了VIP记录功能
 		&model.VipRecord{},
-		&model.Transaction{},   // 交易记录表
-		&model.Post{},          // 帖子表
-		&model.PostReport{},    // 帖子举报
-		&model.Like{},          // 统一点赞表
-		&model.TopicTag{},      // 话题标签表
-		&model.PostTopic{},     // 帖子标签关联表
-		&model.Comment{},       // 评论表
-		&model.Follow{},        // 关注关系表
-		&model.Notification{},  // 通知表
-		&model.UserAvatar{},    // 用户虚拟形象表
-		&model.AvatarOutfit{},  // 虚拟形象装扮物品表
-		&model.Emoji{},         // 单个表情包表
-		&model.EmojiPack{},     // 表情包套餐表
-		&model.UserEmojiPack{}, // 用户拥有的表情包关联表
+		&model.Transaction{},       // 交易记录表
+		&model.Post{},              // 帖子表
+		&model.PostReport{},        // 帖子举报
+		&model.PostRevision{},      // 帖子编辑历史
+		&model.Like{},              // 统一点赞表
+		&model.TopicTag{},          // 话题标签表
+		&model.PostTopic{},         // 帖子标签关联表
+		&model.Comment{},           // 评论表
+		&model.Follow{},            // 关注关系表
+		&model.FollowRequest{},     // 关注私密账号的待批准请求
+		&model.PrivacySettings{},   // 隐私设置
+		&model.Notification{},      // 通知表
+		&model.NotificationActor{}, // 聚合通知发起人
+		&model.UserAvatar{},        // 用户虚拟形象表
+		&model.AvatarOutfit{},      // 虚拟形象装扮物品表
+		&model.Emoji{},             // 单个表情包表
+		&model.EmojiPack{},         // 表情包套餐表
+		&model.UserEmojiPack{},     // 用户拥有的表情包关联表
 		&model.UserMemory{},
 		// 签到等级系统
 		&model.UserLevel{},     // 用户等级表
@@ -130,6 +134,37 @@ func autoMigrate() error {
 		&model.CheckInReward{}, // 签到奖励配置表
 		&model.ExpLog{},        // 经验日志表
 		&model.FriendRequest{}, // 好友申请
+		&model.UserBlock{},     // 拉黑
+		// 端到端加密
+		&model.E2eeDevice{},        // 设备公钥目录
+		&model.E2eeOneTimePreKey{}, // 一次性预共享公钥
+		&model.E2eePreKeyClaim{},   // 一次性公钥领取记录（限流）
+		&model.E2eeMessage{},       // 密文消息信封
+		// 通知设置
+		&model.NotificationPreference{}, // 通知偏好（渠道、免打扰）
+		&model.NotificationMute{},       // 屏蔽的动态 / 用户
+		&model.NotificationCampaign{},   // 系统通知推送活动
+		&model.UserDevice{},             // 设备推送令牌
+		// 邮件
+		&model.EmailOutbox{}, // 邮件发件箱
+		// 账号安全
+		&model.PasswordResetToken{}, // 找回密码验证码
+		&model.EmailVerification{},  // 邮箱验证码
+		&model.RoleAuditLog{},       // 角色变更审计
+		&model.UserSession{},        // 登录会话
+		&model.RefreshToken{},       // 刷新令牌（轮换记录）
+		&model.WsTicket{},           // WebSocket 连接票据
+		&model.SecurityEvent{},      // 安全审计（登录、改密、新设备）
+		&model.LoginLock{},          // 登录失败计数的账号行锁
+		&model.UserTOTP{},           // 两步验证密钥
+		&model.MFARecoveryCode{},    // 两步验证恢复码
+		&model.MFAChallenge{},       // 待第二步验证的登录
+		&model.MFAPolicy{},          // 按角色强制两步验证
+		&model.AccountDeletion{},    // 注销账号申请
+		&model.UserSuspension{},     // 封禁与限制
+		&model.SuspensionAppeal{},   // 处罚申诉
+		&model.UserIdentity{},       // 绑定的第三方账号
+		&model.OidcAuthRequest{},    // 进行中的第三方授权
 	)
 }
 
@@ -7,44 +7,57 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 )
 
-var jwtSecret = []byte("u8K9x2L1n4Q7v5Z0m3P6r9Y2b5X8j1W4")
+// AccessTokenTTL 访问令牌有效期。
+// 过期后客户端用刷新令牌换新，见 UserSession
+const AccessTokenTTL = 15 * time.Minute
 
-// 访问令牌有效期（与 api/etc/super.yaml 中 Auth.AccessExpire 保持一致，单位：秒应对齐为 5 天）
-var jwtExpireTime = 5 * 24 * time.Hour
+var errKeyRingNotInitialized = errors.New("JWT 密钥环未初始化")
 
 // CustomClaims 自定义JWT声明结构体
 type CustomClaims struct {
 	UserID   uint   `json:"user_id"`
 	Username string `json:"username"`
+	// Role 签发时的角色；服务端以 TokenGuard 查询到的当前角色为准，查询失败时才使用该值
+	Role string `json:"role,omitempty"`
+	// TokenVersion 签发时用户的令牌版本（User.TokenVersion），小于当前版本的令牌视为已吊销
+	TokenVersion int64 `json:"tv,omitempty"`
+	// SessionID 所属登录会话（UserSession.ID），会话撤销后令牌失效；旧版令牌没有该字段
+	SessionID uint `json:"sid,omitempty"`
 	jwt.RegisteredClaims
 }
 
 // GenerateToken 生成JWT Token
-func GenerateToken(userID uint, username string) (string, error) {
+func GenerateToken(userID uint, username, role string, tokenVersion int64, sessionID uint) (string, error) {
 	// 创建声明
 	claims := CustomClaims{
-		UserID:   userID,
-		Username: username,
+		UserID:       userID,
+		Username:     username,
+		Role:         role,
+		TokenVersion: tokenVersion,
+		SessionID:    sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(jwtExpireTime)), // 过期时间
-			IssuedAt:  jwt.NewNumericDate(time.Now()),                    // 签发时间
-			NotBefore: jwt.NewNumericDate(time.Now()),                    // 生效时间
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(AccessTokenTTL)), // 过期时间
+			IssuedAt:  jwt.NewNumericDate(time.Now()),                     // 签发时间
+			NotBefore: jwt.NewNumericDate(time.Now()),                     // 生效时间
 		},
 	}
 
-	// 创建Token
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	// 签名并获取完整的编码后的字符串
-	return token.SignedString(jwtSecret)
+	ring := DefaultKeyRing()
+	if ring == nil {
+		return "", errKeyRingNotInitialized
+	}
+	// 用密钥环的签名密钥签名，头部带 kid
+	return ring.Sign(claims)
 }
 
 // ParseToken 解析JWT Token
 func ParseToken(tokenString string) (*CustomClaims, error) {
-	// 解析Token
-	token, err := jwt.ParseWithClaims(tokenString, &CustomClaims{}, func(token *jwt.Token) (interface{}, error) {
-		return jwtSecret, nil
-	})
+	ring := DefaultKeyRing()
+	if ring == nil {
+		return nil, errKeyRingNotInitialized
+	}
+	// 按 kid 选择密钥验证
+	token, err := ring.Parse(tokenString, &CustomClaims{})
 
 	if err != nil {
 		return nil, err
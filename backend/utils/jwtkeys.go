@@ -0,0 +1,255 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/spf13/viper"
+)
+
+// 支持的签名算法
+const (
+	JWTAlgHS256 = "HS256"
+	JWTAlgRS256 = "RS256"
+	JWTAlgEdDSA = "EdDSA"
+)
+
+// HS256 密钥的最小长度（字节）
+const jwtMinSecretLen = 32
+
+// JWTKeyConf 一把 JWT 密钥。HS256 用 Secret / SecretEnv；RS256、EdDSA 用 PEM 文件，
+// 只用于验签（轮换后保留的旧密钥、或只持有公钥的实例）时可以只配公钥
+type JWTKeyConf struct {
+	Kid            string `json:"kid" mapstructure:"kid"`
+	Alg            string `json:"alg" mapstructure:"alg"`               // HS256（默认）/ RS256 / EdDSA
+	Secret         string `json:"secret" mapstructure:"secret"`         // 不建议写进配置文件，优先用 SecretEnv
+	SecretEnv      string `json:"secret_env" mapstructure:"secret_env"` // 从该环境变量读取 HS256 密钥
+	PrivateKeyFile string `json:"private_key_file" mapstructure:"private_key_file"`
+	PublicKeyFile  string `json:"public_key_file" mapstructure:"public_key_file"`
+}
+
+// JWTConf 密钥环配置（config/config.yaml 的 jwt 段，或环境变量）
+type JWTConf struct {
+	SigningKid string       `json:"signing_kid" mapstructure:"signing_kid"` // 签发新令牌用的密钥
+	LegacyKid  string       `json:"legacy_kid" mapstructure:"legacy_kid"`   // 验证不带 kid 的旧令牌；为空时拒绝这类令牌
+	Keys       []JWTKeyConf `json:"keys" mapstructure:"keys"`
+}
+
+type jwtKey struct {
+	kid    string
+	method jwt.SigningMethod
+	sign   interface{} // 签名密钥；只验签的密钥为 nil
+	verify interface{}
+}
+
+// KeyRing JWT 密钥环：一把签名密钥加任意多把验签密钥，令牌头部的 kid 指明用哪一把验证。
+// 轮换时先把新密钥加入并分发到所有实例，再切换 SigningKid，旧密钥保留到旧令牌全部过期后删除
+type KeyRing struct {
+	signing *jwtKey
+	legacy  *jwtKey
+	keys    map[string]*jwtKey
+	methods []string
+}
+
+// NewKeyRing 校验并加载密钥；配置有误时返回错误（服务应拒绝启动）
+func NewKeyRing(c JWTConf) (*KeyRing, error) {
+	if len(c.Keys) == 0 {
+		return nil, errors.New("未配置 JWT 密钥（config.yaml 的 jwt.keys 或环境变量 MOE_JWT_KEYS / MOE_JWT_SECRET）")
+	}
+	ring := &KeyRing{keys: make(map[string]*jwtKey, len(c.Keys))}
+	seen := make(map[string]bool)
+	for _, kc := range c.Keys {
+		k, err := loadJWTKey(kc)
+		if err != nil {
+			return nil, err
+		}
+		if ring.keys[k.kid] != nil {
+			return nil, fmt.Errorf("JWT 密钥 kid 重复: %s", k.kid)
+		}
+		ring.keys[k.kid] = k
+		if alg := k.method.Alg(); !seen[alg] {
+			seen[alg] = true
+			ring.methods = append(ring.methods, alg)
+		}
+	}
+
+	kid := c.SigningKid
+	if kid == "" && len(c.Keys) == 1 {
+		kid = c.Keys[0].Kid
+	}
+	ring.signing = ring.keys[kid]
+	if ring.signing == nil {
+		return nil, fmt.Errorf("JWT 签名密钥不存在: signing_kid=%q", c.SigningKid)
+	}
+	if ring.signing.sign == nil {
+		return nil, fmt.Errorf("JWT 签名密钥 %s 缺少私钥", kid)
+	}
+	if c.LegacyKid != "" {
+		if ring.legacy = ring.keys[c.LegacyKid]; ring.legacy == nil {
+			return nil, fmt.Errorf("JWT 旧令牌密钥不存在: legacy_kid=%q", c.LegacyKid)
+		}
+	}
+	return ring, nil
+}
+
+func loadJWTKey(c JWTKeyConf) (*jwtKey, error) {
+	if strings.TrimSpace(c.Kid) == "" {
+		return nil, errors.New("JWT 密钥缺少 kid")
+	}
+	k := &jwtKey{kid: c.Kid}
+	switch c.Alg {
+	case "", JWTAlgHS256:
+		secret := c.Secret
+		if c.SecretEnv != "" {
+			secret = os.Getenv(c.SecretEnv)
+		}
+		if len(secret) < jwtMinSecretLen {
+			return nil, fmt.Errorf("JWT 密钥 %s：HS256 密钥为空或少于 %d 字节", c.Kid, jwtMinSecretLen)
+		}
+		k.method = jwt.SigningMethodHS256
+		k.sign, k.verify = []byte(secret), []byte(secret)
+	case JWTAlgRS256:
+		k.method = jwt.SigningMethodRS256
+		if c.PrivateKeyFile != "" {
+			pem, err := os.ReadFile(c.PrivateKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("JWT 密钥 %s：读取私钥失败: %w", c.Kid, err)
+			}
+			priv, err := jwt.ParseRSAPrivateKeyFromPEM(pem)
+			if err != nil {
+				return nil, fmt.Errorf("JWT 密钥 %s：解析 RSA 私钥失败: %w", c.Kid, err)
+			}
+			k.sign, k.verify = priv, &priv.PublicKey
+		} else if c.PublicKeyFile != "" {
+			pem, err := os.ReadFile(c.PublicKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("JWT 密钥 %s：读取公钥失败: %w", c.Kid, err)
+			}
+			if k.verify, err = jwt.ParseRSAPublicKeyFromPEM(pem); err != nil {
+				return nil, fmt.Errorf("JWT 密钥 %s：解析 RSA 公钥失败: %w", c.Kid, err)
+			}
+		}
+	case JWTAlgEdDSA:
+		k.method = jwt.SigningMethodEdDSA
+		if c.PrivateKeyFile != "" {
+			pem, err := os.ReadFile(c.PrivateKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("JWT 密钥 %s：读取私钥失败: %w", c.Kid, err)
+			}
+			priv, err := jwt.ParseEdPrivateKeyFromPEM(pem)
+			if err != nil {
+				return nil, fmt.Errorf("JWT 密钥 %s：解析 Ed25519 私钥失败: %w", c.Kid, err)
+			}
+			ed, ok := priv.(ed25519.PrivateKey)
+			if !ok {
+				return nil, fmt.Errorf("JWT 密钥 %s：不是 Ed25519 私钥", c.Kid)
+			}
+			k.sign, k.verify = ed, ed.Public()
+		} else if c.PublicKeyFile != "" {
+			pem, err := os.ReadFile(c.PublicKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("JWT 密钥 %s：读取公钥失败: %w", c.Kid, err)
+			}
+			if k.verify, err = jwt.ParseEdPublicKeyFromPEM(pem); err != nil {
+				return nil, fmt.Errorf("JWT 密钥 %s：解析 Ed25519 公钥失败: %w", c.Kid, err)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("JWT 密钥 %s：不支持的算法 %q（可选 HS256 / RS256 / EdDSA）", c.Kid, c.Alg)
+	}
+	if k.verify == nil {
+		return nil, fmt.Errorf("JWT 密钥 %s：%s 需要配置 private_key_file 或 public_key_file", c.Kid, c.Alg)
+	}
+	return k, nil
+}
+
+// SigningKid 当前签名密钥的 kid
+func (r *KeyRing) SigningKid() string {
+	return r.signing.kid
+}
+
+// Sign 用签名密钥签发令牌，头部带 kid
+func (r *KeyRing) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(r.signing.method, claims)
+	token.Header["kid"] = r.signing.kid
+	return token.SignedString(r.signing.sign)
+}
+
+// Parse 按头部的 kid 选择密钥验证令牌；算法必须与该密钥一致，避免算法混淆攻击
+func (r *KeyRing) Parse(tokenString string, claims jwt.Claims) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (interface{}, error) {
+		var k *jwtKey
+		if kid, ok := t.Header["kid"].(string); ok {
+			k = r.keys[kid]
+		} else if _, has := t.Header["kid"]; !has {
+			k = r.legacy
+		}
+		if k == nil {
+			return nil, fmt.Errorf("unknown kid %v", t.Header["kid"])
+		}
+		if t.Method.Alg() != k.method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method %s for kid %s", t.Method.Alg(), k.kid)
+		}
+		return k.verify, nil
+	}, jwt.WithValidMethods(r.methods))
+}
+
+var defaultKeyRing atomic.Pointer[KeyRing]
+
+// SetKeyRing 替换进程内使用的密钥环（GenerateToken / ParseToken）
+func SetKeyRing(r *KeyRing) {
+	defaultKeyRing.Store(r)
+}
+
+// DefaultKeyRing 进程内使用的密钥环；未初始化时为 nil
+func DefaultKeyRing() *KeyRing {
+	return defaultKeyRing.Load()
+}
+
+// LoadJWTConf 读取密钥环配置：环境变量 MOE_JWT_KEYS（JSON 数组）优先于配置文件的 jwt.keys；
+// 都没有时用 MOE_JWT_SECRET 作为单把 HS256 密钥。MOE_JWT_SIGNING_KID、MOE_JWT_LEGACY_KID 覆盖对应配置。
+// v 为 nil 时只读环境变量
+func LoadJWTConf(v *viper.Viper) (JWTConf, error) {
+	var c JWTConf
+	if v != nil && v.IsSet("jwt") {
+		if err := v.UnmarshalKey("jwt", &c); err != nil {
+			return c, fmt.Errorf("解析 jwt 配置失败: %w", err)
+		}
+	}
+	if raw := os.Getenv("MOE_JWT_KEYS"); raw != "" {
+		c.Keys = nil
+		if err := json.Unmarshal([]byte(raw), &c.Keys); err != nil {
+			return c, fmt.Errorf("解析 MOE_JWT_KEYS 失败: %w", err)
+		}
+	}
+	if len(c.Keys) == 0 && os.Getenv("MOE_JWT_SECRET") != "" {
+		c.Keys = []JWTKeyConf{{Kid: "default", Alg: JWTAlgHS256, SecretEnv: "MOE_JWT_SECRET"}}
+	}
+	if kid := os.Getenv("MOE_JWT_SIGNING_KID"); kid != "" {
+		c.SigningKid = kid
+	}
+	if kid := os.Getenv("MOE_JWT_LEGACY_KID"); kid != "" {
+		c.LegacyKid = kid
+	}
+	return c, nil
+}
+
+// InitKeyRing 读取配置并设置进程内的密钥环，API 与 RPC 启动时调用
+func InitKeyRing(v *viper.Viper) error {
+	c, err := LoadJWTConf(v)
+	if err != nil {
+		return err
+	}
+	ring, err := NewKeyRing(c)
+	if err != nil {
+		return err
+	}
+	SetKeyRing(ring)
+	return nil
+}
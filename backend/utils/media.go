@@ -0,0 +1,15 @@
+package utils
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// UserImageDirs 用户上传图片所在的目录。图片按「<用户ID>_<用户名>」分目录保存，
+// 改过用户名的用户可能有多个目录
+func UserImageDirs(root string, userID uint) ([]string, error) {
+	if root == "" || userID == 0 {
+		return nil, nil
+	}
+	return filepath.Glob(filepath.Join(root, fmt.Sprintf("%d_*", userID)))
+}
@@ -0,0 +1,91 @@
+package utils
+
+// 用户角色（对应 model.User.Role），权限从低到高
+const (
+	RoleUser       = "user"
+	RoleAdmin      = "admin"
+	RoleSuperAdmin = "super_admin"
+)
+
+// Permission 管理操作的权限点
+type Permission string
+
+const (
+	PermUserList         Permission = "user:list"         // 查看用户列表
+	PermUserVipManage    Permission = "user:vip"          // 直接修改用户会员状态
+	PermVipPlanManage    Permission = "vip_plan:manage"   // 创建、修改 VIP 套餐
+	PermNotificationSend Permission = "notification:send" // 定向、批量、全员通知与推送活动
+	PermAuditRead        Permission = "audit:read"        // 查看角色变更等审计记录
+	PermRoleManage       Permission = "role:manage"       // 授予、撤销管理员角色
+	PermSecurityPolicy   Permission = "security:policy"   // 设置按角色强制两步验证
+	PermUserModerate     Permission = "user:moderate"     // 封禁、限制用户与处理申诉
+	PermPostModerate     Permission = "post:moderate"     // 删除任意用户的帖子
+)
+
+// RolePermissions 权限矩阵：每个角色拥有的权限。普通用户没有任何管理权限
+var RolePermissions = map[string][]Permission{
+	RoleAdmin: {
+		PermUserList,
+		PermUserVipManage,
+		PermVipPlanManage,
+		PermNotificationSend,
+		PermAuditRead,
+		PermSecurityPolicy,
+		PermUserModerate,
+		PermPostModerate,
+	},
+	RoleSuperAdmin: {
+		PermUserList,
+		PermUserVipManage,
+		PermVipPlanManage,
+		PermNotificationSend,
+		PermAuditRead,
+		PermRoleManage,
+		PermSecurityPolicy,
+		PermUserModerate,
+		PermPostModerate,
+	},
+}
+
+var roleRank = map[string]int{
+	RoleUser:       0,
+	RoleAdmin:      1,
+	RoleSuperAdmin: 2,
+}
+
+// ValidRole 是否为已知角色
+func ValidRole(role string) bool {
+	_, ok := roleRank[role]
+	return ok
+}
+
+// RoleRank 角色等级，未知角色按普通用户处理
+func RoleRank(role string) int {
+	return roleRank[role]
+}
+
+// IsAdminRole 是否为管理员（admin / super_admin）
+func IsAdminRole(role string) bool {
+	return role == RoleAdmin || role == RoleSuperAdmin
+}
+
+// HasPermission 按权限矩阵判断角色是否拥有某项权限
+func HasPermission(role string, p Permission) bool {
+	for _, have := range RolePermissions[role] {
+		if have == p {
+			return true
+		}
+	}
+	return false
+}
+
+// RolesWithPermission 拥有某项权限的所有角色
+func RolesWithPermission(p Permission) []string {
+	var roles []string
+	for _, role := range []string{RoleUser, RoleAdmin, RoleSuperAdmin} {
+		if HasPermission(role, p) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
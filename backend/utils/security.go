@@ -0,0 +1,17 @@
+package utils
+
+// 登录被限制时 RPC 错误附带的 errdetails.ErrorInfo，API 层据此告诉客户端需要人机验证或多久后重试
+const (
+	LoginThrottledReason     = "LOGIN_THROTTLED"
+	LoginInfoCaptchaRequired = "captcha_required" // 值为 "true" 时下次登录需要带上人机验证令牌
+	LoginInfoRetryAfter      = "retry_after"      // 锁定剩余秒数
+)
+
+// 账号被封禁时登录错误附带的 errdetails.ErrorInfo，API 层据此返回处罚原因、到期时间与申诉令牌
+const (
+	AccountSuspendedReason       = "ACCOUNT_SUSPENDED"
+	SuspensionInfoReason         = "reason"
+	SuspensionInfoExpiresAt      = "expires_at"   // 为空表示永久
+	SuspensionInfoAppealToken    = "appeal_token" // 未登录状态下提交申诉用
+	SuspensionInfoAppealDeadline = "appeal_token_expires_at"
+)
@@ -26,6 +26,7 @@ class AuthResult {
 class AuthService {
   // 存储键名
   static const String _tokenKey = 'auth_token';
+  static const String _refreshTokenKey = 'auth_refresh_token';
   static const String _userIdKey = 'user_id';
   /// 上次登录成功的账号（邮箱 / Moe 号 / 用户名），仅成功登录后写入，不存密码
   static const String _lastLoginAccountKey = 'last_login_account';
@@ -35,6 +36,7 @@ class AuthService {
   // 内存中的登录状态
   static String? _currentUser;
   static String? _token;
+  static String? _refreshToken;
 
   static bool get isLoggedIn =>
       _currentUser != null && _token != null && _token!.isNotEmpty;
@@ -54,13 +56,16 @@ class AuthService {
   static Future<void> init() async {
     final prefs = await SharedPreferences.getInstance();
     _token = prefs.getString(_tokenKey);
+    _refreshToken = prefs.getString(_refreshTokenKey);
     _currentUser = prefs.getString(_userIdKey);
 
     // If token is missing, treat as logged out to avoid half-authenticated state
     if (_token == null || _token!.isEmpty) {
       _token = null;
+      _refreshToken = null;
       _currentUser = null;
       await prefs.remove(_tokenKey);
+      await prefs.remove(_refreshTokenKey);
       await prefs.remove(_userIdKey);
     }
 
@@ -78,6 +83,7 @@ class AuthService {
     // 如果有token，设置到ApiService
     if (_token != null && _token!.isNotEmpty) {
       ApiService.setToken(_token);
+      ApiService.setRefreshToken(_refreshToken);
       // Start websocket-based services as early as possible.
       PresenceService.start();
       ChatPushService.start();
@@ -93,6 +99,7 @@ class AuthService {
         final userData = result['data']['user'] as Map<String, dynamic>;
         _currentUser = userData['id'] as String;
         _token = result['data']['token'] as String;
+        _refreshToken = result['data']['refresh_token'] as String?;
 
         await _saveAuthData();
 
@@ -103,6 +110,7 @@ class AuthService {
         }
 
         ApiService.setToken(_token);
+        ApiService.setRefreshToken(_refreshToken);
         PresenceService.start();
         ChatPushService.start();
 
@@ -155,11 +163,13 @@ class AuthService {
     final uid = _currentUser;
     _currentUser = null;
     _token = null;
+    _refreshToken = null;
     unawaited(_purgeLegacyLocalLikeKeys(uid));
     // 清除持久化存储
     _clearAuthData();
     // 清除ApiService的token
     ApiService.setToken(null);
+    ApiService.setRefreshToken(null);
     // Stop websocket-based services to avoid reconnect loops.
     PresenceService.stop();
     ChatPushService.stop();
@@ -172,6 +182,9 @@ class AuthService {
   static Future<void> _saveAuthData() async {
     final prefs = await SharedPreferences.getInstance();
     await prefs.setString(_tokenKey, _token!);
+    if (_refreshToken != null) {
+      await prefs.setString(_refreshTokenKey, _refreshToken!);
+    }
     await prefs.setString(_userIdKey, _currentUser!);
   }
 
@@ -179,6 +192,7 @@ class AuthService {
   static Future<void> _clearAuthData() async {
     final prefs = await SharedPreferences.getInstance();
     await prefs.remove(_tokenKey);
+    await prefs.remove(_refreshTokenKey);
     await prefs.remove(_userIdKey);
   }
 
@@ -226,13 +240,16 @@ class AuthService {
     return userInfo;
   }
 
-  // 更新token
-  static Future<void> updateToken(String newToken) async {
+  // 更新token（刷新令牌每次刷新都会轮换，需一并保存）
+  static Future<void> updateToken(String newToken, String newRefreshToken) async {
     _token = newToken;
+    _refreshToken = newRefreshToken;
     final prefs = await SharedPreferences.getInstance();
     await prefs.setString(_tokenKey, newToken);
+    await prefs.setString(_refreshTokenKey, newRefreshToken);
     // 更新ApiService的token
     ApiService.setToken(newToken);
+    ApiService.setRefreshToken(newRefreshToken);
     // Ensure websocket services can recover after token refresh.
     PresenceService.start();
     ChatPushService.start();
@@ -32,13 +32,15 @@ class ApiException implements Exception {
 class ApiService {
   // 静态变量存储认证信息和回调函数
   static String? _currentToken;
+  // 刷新令牌：只能使用一次，每次刷新后换成服务端返回的新令牌
+  static String? _currentRefreshToken;
   static VoidCallback? _onLogoutCallback;
-  static Function(String)? _onTokenUpdateCallback;
+  static Function(String token, String refreshToken)? _onTokenUpdateCallback;
 
   // 设置认证相关回调函数
   static void setAuthCallbacks({
     VoidCallback? onLogout,
-    Function(String)? onTokenUpdate,
+    Function(String token, String refreshToken)? onTokenUpdate,
   }) {
     _onLogoutCallback = onLogout;
     _onTokenUpdateCallback = onTokenUpdate;
@@ -49,6 +51,11 @@ class ApiService {
     _currentToken = token;
   }
 
+  // 设置当前刷新令牌
+  static void setRefreshToken(String? refreshToken) {
+    _currentRefreshToken = refreshToken;
+  }
+
   // 获取当前 token
   static String? get token => _currentToken;
 
@@ -184,8 +191,8 @@ class ApiService {
   // 刷新token的端点
   static const String _refreshTokenEndpoint = '/api/user/refresh-token';
 
-  /// 距离过期不足此时长则先发制人调用刷新（当前 token 仍须有效，后端才会签发新 token）
-  static const Duration _proactiveRefreshThreshold = Duration(hours: 6);
+  /// 距离过期不足此时长则先发制人调用刷新（访问令牌有效期只有 15 分钟）
+  static const Duration _proactiveRefreshThreshold = Duration(minutes: 2);
 
   // 防止并发刷新token
   static bool _isRefreshing = false;
@@ -450,13 +457,18 @@ class ApiService {
         ...tunnelBypassHeadersForUrl(baseUrl),
       };
 
-      // 使用当前token请求刷新
-      final currentToken = _currentToken;
-      if (currentToken != null) {
-        headers['Authorization'] = 'Bearer $currentToken';
+      // 使用刷新令牌换取新的访问令牌；没有刷新令牌（旧版本登录）只能重新登录
+      final refreshToken = _currentRefreshToken;
+      if (refreshToken == null || refreshToken.isEmpty) {
+        _log('❌ 没有刷新令牌，需要重新登录');
+        return null;
       }
 
-      final response = await http.post(uri, headers: headers);
+      final response = await http.post(
+        uri,
+        headers: headers,
+        body: json.encode({'refresh_token': refreshToken}),
+      );
       final bodyText = _decodeUtf8Body(response);
 
       if (response.statusCode == 200) {
@@ -470,8 +482,10 @@ class ApiService {
         }
 
         final newToken = result['data']['token'] as String;
+        final newRefreshToken = result['data']['refresh_token'] as String;
         _currentToken = newToken;
-        _onTokenUpdateCallback?.call(newToken);
+        _currentRefreshToken = newRefreshToken;
+        _onTokenUpdateCallback?.call(newToken, newRefreshToken);
         _log('✅ Token刷新成功');
 
         return newToken;
@@ -511,15 +525,17 @@ class ApiService {
   // 发送重置密码验证码
   static Future<Map<String, dynamic>> sendResetPasswordCode(
       String email) async {
-    return await _request('/api/user/send-reset-code',
+    return await _request('/api/user/reset-password/request',
         method: 'POST', body: {'email': email});
   }
 
-  // 验证重置密码验证码
+  // 验证重置密码验证码：服务端在提交新密码时一并校验，这里只检查格式
   static Future<Map<String, dynamic>> verifyResetCode(
       String email, String code) async {
-    return await _request('/api/user/verify-reset-code',
-        method: 'POST', body: {'email': email, 'code': code});
+    if (!RegExp(r'^\d{6}$').hasMatch(code.trim())) {
+      throw ApiException('请输入 6 位数字验证码');
+    }
+    return {};
   }
 
   // 检查邮箱是否存在
@@ -533,7 +549,8 @@ class ApiService {
   static Future<Map<String, dynamic>> resetPassword(
       String email, String code, String newPassword) async {
     return await _request('/api/user/reset-password',
-        method: 'POST', body: {'email': email, 'new_password': newPassword});
+        method: 'POST',
+        body: {'email': email, 'code': code, 'new_password': newPassword});
   }
 
   // 获取帖子列表（支持分页）